@@ -0,0 +1,16 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/clawscli/claws/internal/console"
+	"github.com/clawscli/claws/internal/registry"
+)
+
+func TestAllServicesHaveConsolePage(t *testing.T) {
+	for _, service := range registry.Global.ListServices() {
+		if !console.Supports(service) {
+			t.Errorf("service %q has no console page; add it to console.services", service)
+		}
+	}
+}
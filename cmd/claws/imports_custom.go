@@ -75,6 +75,8 @@ import (
 	_ "github.com/clawscli/claws/custom/budgets/notifications"
 
 	// CloudFormation
+	_ "github.com/clawscli/claws/custom/cfn/changesets"
+	_ "github.com/clawscli/claws/custom/cfn/drifts"
 	_ "github.com/clawscli/claws/custom/cfn/events"
 	_ "github.com/clawscli/claws/custom/cfn/outputs"
 	_ "github.com/clawscli/claws/custom/cfn/resources"
@@ -139,17 +141,24 @@ import (
 	_ "github.com/clawscli/claws/custom/ec2/instances"
 	_ "github.com/clawscli/claws/custom/ec2/keypairs"
 	_ "github.com/clawscli/claws/custom/ec2/launchtemplates"
+	_ "github.com/clawscli/claws/custom/ec2/networkinterfaces"
+	_ "github.com/clawscli/claws/custom/ec2/securitygrouprules"
 	_ "github.com/clawscli/claws/custom/ec2/securitygroups"
 	_ "github.com/clawscli/claws/custom/ec2/snapshots"
 	_ "github.com/clawscli/claws/custom/ec2/volumes"
 
 	// ECR
+	_ "github.com/clawscli/claws/custom/ecr/imagefindings"
 	_ "github.com/clawscli/claws/custom/ecr/images"
+	_ "github.com/clawscli/claws/custom/ecr/lifecyclepreview"
 	_ "github.com/clawscli/claws/custom/ecr/repositories"
 
 	// ECS
 	_ "github.com/clawscli/claws/custom/ecs/clusters"
+	_ "github.com/clawscli/claws/custom/ecs/deployments"
+	_ "github.com/clawscli/claws/custom/ecs/serviceevents"
 	_ "github.com/clawscli/claws/custom/ecs/services"
+	_ "github.com/clawscli/claws/custom/ecs/taskdefinitions"
 	_ "github.com/clawscli/claws/custom/ecs/tasks"
 
 	// ElastiCache
@@ -230,7 +239,11 @@ import (
 	_ "github.com/clawscli/claws/custom/organizations/roots"
 
 	// RDS
+	_ "github.com/clawscli/claws/custom/rds/events"
 	_ "github.com/clawscli/claws/custom/rds/instances"
+	_ "github.com/clawscli/claws/custom/rds/logfiles"
+	_ "github.com/clawscli/claws/custom/rds/parameters"
+	_ "github.com/clawscli/claws/custom/rds/pendingmaintenance"
 	_ "github.com/clawscli/claws/custom/rds/snapshots"
 
 	// Redshift
@@ -247,6 +260,8 @@ import (
 
 	// S3
 	_ "github.com/clawscli/claws/custom/s3/buckets"
+	_ "github.com/clawscli/claws/custom/s3/objects"
+	_ "github.com/clawscli/claws/custom/s3/objectversions"
 
 	// S3 Vectors
 	_ "github.com/clawscli/claws/custom/s3vectors/buckets"
@@ -277,9 +292,12 @@ import (
 	_ "github.com/clawscli/claws/custom/sns/topics"
 
 	// SQS
+	_ "github.com/clawscli/claws/custom/sqs/messages"
+	_ "github.com/clawscli/claws/custom/sqs/movetasks"
 	_ "github.com/clawscli/claws/custom/sqs/queues"
 
 	// SSM
+	_ "github.com/clawscli/claws/custom/ssm/commands"
 	_ "github.com/clawscli/claws/custom/ssm/parameters"
 
 	// Transcribe
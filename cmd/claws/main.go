@@ -9,10 +9,13 @@ import (
 
 	tea "charm.land/bubbletea/v2"
 
+	"github.com/clawscli/claws/internal/action"
 	"github.com/clawscli/claws/internal/app"
 	"github.com/clawscli/claws/internal/config"
 	"github.com/clawscli/claws/internal/log"
+	"github.com/clawscli/claws/internal/plugin"
 	"github.com/clawscli/claws/internal/registry"
+	"github.com/clawscli/claws/internal/tunnel"
 )
 
 // version is set by ldflags during build
@@ -71,6 +74,12 @@ func main() {
 
 	ctx := context.Background()
 
+	// Register resource types provided by external plugins
+	_, errs := plugin.Load(ctx, plugin.DefaultDir(), registry.Global, action.Global)
+	for _, err := range errs {
+		fmt.Fprintf(os.Stderr, "Warning: %v\n", err)
+	}
+
 	// Create the application
 	application := app.New(ctx, registry.Global)
 
@@ -79,7 +88,10 @@ func main() {
 	// v2 has better ESC key handling via x/input package
 	p := tea.NewProgram(application)
 
-	if _, err := p.Run(); err != nil {
+	_, err := p.Run()
+	// Port forwards run in the background and must not outlive claws
+	tunnel.Global.StopAll()
+	if err != nil {
 		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
 		os.Exit(1)
 	}
@@ -167,4 +179,5 @@ func printUsage() {
 	fmt.Println()
 	fmt.Println("Environment Variables:")
 	fmt.Println("  CLAWS_READ_ONLY=1|true   Enable read-only mode")
+	fmt.Println("  CLAWS_PLUGIN_DIR=<path>  Plugin directory (default: <config dir>/claws/plugins)")
 }
@@ -0,0 +1,119 @@
+package groups
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
+
+	"github.com/clawscli/claws/internal/action"
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+)
+
+func init() {
+	// Register actions for Auto Scaling Groups
+	action.Global.Register("autoscaling", "groups", []action.Action{
+		{
+			Name:      "Set Capacity",
+			Shortcut:  "c",
+			Type:      action.ActionTypeAPI,
+			Operation: "UpdateAutoScalingGroup",
+			Confirm:   action.ConfirmSimple,
+			Input: []action.Field{
+				sizeField("min", "Min size", (*AutoScalingGroupResource).MinSize),
+				sizeField("desired", "Desired", (*AutoScalingGroupResource).DesiredCapacity),
+				sizeField("max", "Max size", (*AutoScalingGroupResource).MaxSize),
+			},
+		},
+	})
+
+	// Register executor
+	action.RegisterExecutor("autoscaling", "groups", executeGroupAction)
+}
+
+// sizeField asks for one of the group's sizes, defaulting to its current
+// value
+func sizeField(name, label string, current func(*AutoScalingGroupResource) int32) action.Field {
+	return action.Field{
+		Name:     name,
+		Label:    label,
+		Type:     action.FieldInt,
+		Required: true,
+		Min:      action.Bound(0),
+		Default: func(resource dao.Resource) string {
+			if asg, ok := resource.(*AutoScalingGroupResource); ok {
+				return strconv.Itoa(int(current(asg)))
+			}
+			return ""
+		},
+	}
+}
+
+// executeGroupAction executes an action on an Auto Scaling Group
+func executeGroupAction(ctx context.Context, act action.Action, resource dao.Resource) action.ActionResult {
+	switch act.Operation {
+	case "UpdateAutoScalingGroup":
+		return executeSetCapacity(ctx, resource, act.Values)
+	default:
+		return action.UnknownOperationResult(act.Operation)
+	}
+}
+
+// capacity is the min, desired and max size of a group
+type capacity struct {
+	min, desired, max int32
+}
+
+// parseCapacity reads the sizes entered for Set Capacity, which must
+// satisfy min <= desired <= max
+func parseCapacity(values action.Values) (capacity, error) {
+	var c capacity
+	for _, f := range []struct {
+		name string
+		dst  *int32
+	}{{"min", &c.min}, {"desired", &c.desired}, {"max", &c.max}} {
+		n, err := strconv.ParseInt(values.String(f.name), 10, 32)
+		if err != nil {
+			return c, fmt.Errorf("parse %s size: %w", f.name, err)
+		}
+		*f.dst = int32(n)
+	}
+	if c.min > c.desired || c.desired > c.max {
+		return c, fmt.Errorf("sizes must satisfy min (%d) <= desired (%d) <= max (%d)", c.min, c.desired, c.max)
+	}
+	return c, nil
+}
+
+func executeSetCapacity(ctx context.Context, resource dao.Resource, values action.Values) action.ActionResult {
+	asg, ok := resource.(*AutoScalingGroupResource)
+	if !ok {
+		return action.InvalidResourceResult()
+	}
+
+	c, err := parseCapacity(values)
+	if err != nil {
+		return action.FailResult(err)
+	}
+
+	cfg, err := appaws.NewConfig(ctx)
+	if err != nil {
+		return action.FailResult(err)
+	}
+	client := autoscaling.NewFromConfig(cfg)
+
+	name := asg.AutoScalingGroupName()
+	_, err = client.UpdateAutoScalingGroup(ctx, &autoscaling.UpdateAutoScalingGroupInput{
+		AutoScalingGroupName: &name,
+		MinSize:              &c.min,
+		DesiredCapacity:      &c.desired,
+		MaxSize:              &c.max,
+	})
+	if err != nil {
+		return action.FailResultf(err, "update auto scaling group %s", name)
+	}
+
+	return action.SuccessResult(fmt.Sprintf("Set %s capacity: min %d → %d, desired %d → %d, max %d → %d", name,
+		asg.MinSize(), c.min, asg.DesiredCapacity(), c.desired, asg.MaxSize(), c.max))
+}
@@ -0,0 +1,40 @@
+package groups
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling/types"
+
+	"github.com/clawscli/claws/internal/action"
+)
+
+func TestSetCapacityDefaults(t *testing.T) {
+	asg := NewAutoScalingGroupResource(types.AutoScalingGroup{
+		AutoScalingGroupName: aws.String("web"),
+		MinSize:              aws.Int32(1),
+		DesiredCapacity:      aws.Int32(2),
+		MaxSize:              aws.Int32(4),
+	})
+	acts := action.Global.Get("autoscaling", "groups")
+	if len(acts) == 0 {
+		t.Fatal("no actions registered for autoscaling/groups")
+	}
+	values := action.DefaultValues(acts[0], asg)
+	if values["min"] != "1" || values["desired"] != "2" || values["max"] != "4" {
+		t.Errorf("DefaultValues() = %v, want the group's current sizes", values)
+	}
+}
+
+func TestParseCapacity(t *testing.T) {
+	c, err := parseCapacity(action.Values{"min": "0", "desired": "3", "max": "5"})
+	if err != nil || c != (capacity{min: 0, desired: 3, max: 5}) {
+		t.Errorf("parseCapacity() = %+v, %v", c, err)
+	}
+	if _, err := parseCapacity(action.Values{"min": "2", "desired": "1", "max": "5"}); err == nil {
+		t.Error("parseCapacity() should reject desired below min")
+	}
+	if _, err := parseCapacity(action.Values{"min": "0", "desired": "6", "max": "5"}); err == nil {
+		t.Error("parseCapacity() should reject desired above max")
+	}
+}
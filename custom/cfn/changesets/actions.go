@@ -0,0 +1,89 @@
+package changesets
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
+
+	"github.com/clawscli/claws/custom/cfn"
+	"github.com/clawscli/claws/internal/action"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+)
+
+func init() {
+	action.Global.Register("cloudformation", "change-sets", []action.Action{
+		{
+			Name:         "Execute",
+			Shortcut:     "X",
+			Type:         action.ActionTypeAPI,
+			Operation:    "ExecuteChangeSet",
+			Confirm:      action.ConfirmDangerous,
+			ConfirmToken: action.ConfirmTokenName,
+			Filter: func(r dao.Resource) bool {
+				cs, ok := r.(*ChangeSetResource)
+				return ok && cs.Executable()
+			},
+		},
+		{
+			Name:      "Delete",
+			Shortcut:  "D",
+			Type:      action.ActionTypeAPI,
+			Operation: "DeleteChangeSet",
+			Confirm:   action.ConfirmSimple,
+		},
+	})
+
+	action.RegisterExecutor("cloudformation", "change-sets", executeChangeSetAction)
+}
+
+// executeChangeSetAction executes an action on a change set
+func executeChangeSetAction(ctx context.Context, act action.Action, resource dao.Resource) action.ActionResult {
+	switch act.Operation {
+	case "ExecuteChangeSet":
+		return executeExecuteChangeSet(ctx, resource)
+	case "DeleteChangeSet":
+		return executeDeleteChangeSet(ctx, resource)
+	default:
+		return action.UnknownOperationResult(act.Operation)
+	}
+}
+
+func executeExecuteChangeSet(ctx context.Context, resource dao.Resource) action.ActionResult {
+	cs, ok := resource.(*ChangeSetResource)
+	if !ok {
+		return action.InvalidResourceResult()
+	}
+
+	client, err := cfn.GetClient(ctx)
+	if err != nil {
+		return action.FailResult(err)
+	}
+
+	id := cs.GetID()
+	if _, err := client.ExecuteChangeSet(ctx, &cloudformation.ExecuteChangeSetInput{ChangeSetName: &id}); err != nil {
+		return action.FailResult(apperrors.Wrapf(err, "execute change set %s", cs.GetName()))
+	}
+
+	return action.SuccessResult(fmt.Sprintf("Executing change set %s on stack %s", cs.GetName(), cs.StackName()))
+}
+
+func executeDeleteChangeSet(ctx context.Context, resource dao.Resource) action.ActionResult {
+	cs, ok := resource.(*ChangeSetResource)
+	if !ok {
+		return action.InvalidResourceResult()
+	}
+
+	client, err := cfn.GetClient(ctx)
+	if err != nil {
+		return action.FailResult(err)
+	}
+
+	id := cs.GetID()
+	if _, err := client.DeleteChangeSet(ctx, &cloudformation.DeleteChangeSetInput{ChangeSetName: &id}); err != nil {
+		return action.FailResult(apperrors.Wrapf(err, "delete change set %s", cs.GetName()))
+	}
+
+	return action.SuccessResult(fmt.Sprintf("Deleted change set %s", cs.GetName()))
+}
@@ -0,0 +1,189 @@
+package changesets
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+)
+
+// ChangeSetDAO provides data access for the change sets of a CloudFormation stack
+type ChangeSetDAO struct {
+	dao.BaseDAO
+	client *cloudformation.Client
+}
+
+// NewChangeSetDAO creates a new ChangeSetDAO
+func NewChangeSetDAO(ctx context.Context) (dao.DAO, error) {
+	cfg, err := appaws.NewConfig(ctx)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new cfn/change-sets dao")
+	}
+	return &ChangeSetDAO{
+		BaseDAO: dao.NewBaseDAO("cloudformation", "change-sets"),
+		client:  cloudformation.NewFromConfig(cfg),
+	}, nil
+}
+
+func (d *ChangeSetDAO) List(ctx context.Context) ([]dao.Resource, error) {
+	stackName := dao.GetFilterFromContext(ctx, "StackName")
+	if stackName == "" {
+		return nil, fmt.Errorf("stack name filter required")
+	}
+
+	paginator := cloudformation.NewListChangeSetsPaginator(d.client, &cloudformation.ListChangeSetsInput{
+		StackName: &stackName,
+	})
+
+	var resources []dao.Resource
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, apperrors.Wrapf(err, "list change sets for %s", stackName)
+		}
+		for _, cs := range output.Summaries {
+			resources = append(resources, NewChangeSetResource(cs))
+		}
+	}
+
+	return resources, nil
+}
+
+// Get describes a change set by ARN, including all of its resource changes
+func (d *ChangeSetDAO) Get(ctx context.Context, id string) (dao.Resource, error) {
+	paginator := cloudformation.NewDescribeChangeSetPaginator(d.client, &cloudformation.DescribeChangeSetInput{
+		ChangeSetName: &id,
+	})
+
+	var detail *cloudformation.DescribeChangeSetOutput
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, apperrors.Wrapf(err, "describe change set %s", id)
+		}
+		if detail == nil {
+			detail = output
+			continue
+		}
+		detail.Changes = append(detail.Changes, output.Changes...)
+	}
+	if detail == nil {
+		return nil, fmt.Errorf("change set not found: %s", id)
+	}
+
+	r := NewChangeSetResource(types.ChangeSetSummary{
+		ChangeSetId:         detail.ChangeSetId,
+		ChangeSetName:       detail.ChangeSetName,
+		StackId:             detail.StackId,
+		StackName:           detail.StackName,
+		Status:              detail.Status,
+		StatusReason:        detail.StatusReason,
+		ExecutionStatus:     detail.ExecutionStatus,
+		CreationTime:        detail.CreationTime,
+		Description:         detail.Description,
+		IncludeNestedStacks: detail.IncludeNestedStacks,
+		ParentChangeSetId:   detail.ParentChangeSetId,
+		RootChangeSetId:     detail.RootChangeSetId,
+	})
+	r.Detail = detail
+	return r, nil
+}
+
+func (d *ChangeSetDAO) Delete(ctx context.Context, id string) error {
+	_, err := d.client.DeleteChangeSet(ctx, &cloudformation.DeleteChangeSetInput{
+		ChangeSetName: &id,
+	})
+	if err != nil {
+		if apperrors.IsNotFound(err) {
+			return nil // Already deleted
+		}
+		return apperrors.Wrapf(err, "delete change set %s", id)
+	}
+	return nil
+}
+
+func (d *ChangeSetDAO) Supports(op dao.Operation) bool {
+	switch op {
+	case dao.OpList, dao.OpGet, dao.OpDelete:
+		return true
+	default:
+		return false
+	}
+}
+
+// ChangeSetResource wraps a CloudFormation change set
+type ChangeSetResource struct {
+	dao.BaseResource
+	Item types.ChangeSetSummary
+
+	// Detail holds the full description with resource changes; nil until
+	// the change set is fetched with Get.
+	Detail *cloudformation.DescribeChangeSetOutput
+}
+
+// NewChangeSetResource creates a new ChangeSetResource
+func NewChangeSetResource(cs types.ChangeSetSummary) *ChangeSetResource {
+	return &ChangeSetResource{
+		BaseResource: dao.BaseResource{
+			ID:   appaws.Str(cs.ChangeSetId),
+			Name: appaws.Str(cs.ChangeSetName),
+			ARN:  appaws.Str(cs.ChangeSetId), // ChangeSetId is the ARN
+			Data: cs,
+		},
+		Item: cs,
+	}
+}
+
+// Status returns the change set status
+func (r *ChangeSetResource) Status() string {
+	return string(r.Item.Status)
+}
+
+// ExecutionStatus returns whether the change set can be executed
+func (r *ChangeSetResource) ExecutionStatus() string {
+	return string(r.Item.ExecutionStatus)
+}
+
+// StackName returns the name of the stack the change set belongs to
+func (r *ChangeSetResource) StackName() string {
+	return appaws.Str(r.Item.StackName)
+}
+
+// Executable returns whether the change set is ready to be executed
+func (r *ChangeSetResource) Executable() bool {
+	return r.Item.ExecutionStatus == types.ExecutionStatusAvailable
+}
+
+// ResourceChanges returns the resource-level changes, once described
+func (r *ChangeSetResource) ResourceChanges() []types.ResourceChange {
+	if r.Detail == nil {
+		return nil
+	}
+	changes := make([]types.ResourceChange, 0, len(r.Detail.Changes))
+	for _, c := range r.Detail.Changes {
+		if c.ResourceChange != nil {
+			changes = append(changes, *c.ResourceChange)
+		}
+	}
+	return changes
+}
+
+// Replacements returns the number of resources that will be replaced and
+// the number that may be replaced, depending on property values resolved
+// at execution time
+func (r *ChangeSetResource) Replacements() (replaced, conditional int) {
+	for _, c := range r.ResourceChanges() {
+		switch c.Replacement {
+		case types.ReplacementTrue:
+			replaced++
+		case types.ReplacementConditional:
+			conditional++
+		}
+	}
+	return replaced, conditional
+}
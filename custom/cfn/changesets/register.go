@@ -0,0 +1,20 @@
+package changesets
+
+import (
+	"context"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/registry"
+	"github.com/clawscli/claws/internal/render"
+)
+
+func init() {
+	registry.Global.RegisterCustom("cloudformation", "change-sets", registry.Entry{
+		DAOFactory: func(ctx context.Context) (dao.DAO, error) {
+			return NewChangeSetDAO(ctx)
+		},
+		RendererFactory: func() render.Renderer {
+			return NewChangeSetRenderer()
+		},
+	})
+}
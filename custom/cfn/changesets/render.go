@@ -0,0 +1,249 @@
+package changesets
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/render"
+)
+
+// ChangeSetRenderer renders CloudFormation change sets
+type ChangeSetRenderer struct {
+	render.BaseRenderer
+}
+
+// NewChangeSetRenderer creates a new ChangeSetRenderer
+func NewChangeSetRenderer() render.Renderer {
+	return &ChangeSetRenderer{
+		BaseRenderer: render.BaseRenderer{
+			Service:  "cloudformation",
+			Resource: "change-sets",
+			Cols: []render.Column{
+				{
+					Name:  "NAME",
+					Width: 30,
+					Getter: func(r dao.Resource) string {
+						return r.GetName()
+					},
+					Priority: 0,
+				},
+				{
+					Name:  "STATUS",
+					Width: 20,
+					Getter: func(r dao.Resource) string {
+						if cs, ok := r.(*ChangeSetResource); ok {
+							return cs.Status()
+						}
+						return ""
+					},
+					Priority: 1,
+				},
+				{
+					Name:  "EXECUTION",
+					Width: 20,
+					Getter: func(r dao.Resource) string {
+						if cs, ok := r.(*ChangeSetResource); ok {
+							return cs.ExecutionStatus()
+						}
+						return ""
+					},
+					Priority: 2,
+				},
+				{
+					Name:  "CREATED",
+					Width: 10,
+					Getter: func(r dao.Resource) string {
+						if cs, ok := r.(*ChangeSetResource); ok && cs.Item.CreationTime != nil {
+							return render.FormatAge(*cs.Item.CreationTime)
+						}
+						return ""
+					},
+					Priority: 3,
+				},
+				{
+					Name:  "DESCRIPTION",
+					Width: 40,
+					Getter: func(r dao.Resource) string {
+						if cs, ok := r.(*ChangeSetResource); ok {
+							return appaws.Str(cs.Item.Description)
+						}
+						return ""
+					},
+					Priority: 4,
+				},
+			},
+		},
+	}
+}
+
+// RenderDetail renders detailed change set information
+func (r *ChangeSetRenderer) RenderDetail(resource dao.Resource) string {
+	cs, ok := resource.(*ChangeSetResource)
+	if !ok {
+		return ""
+	}
+
+	d := render.NewDetailBuilder()
+	styles := d.Styles()
+
+	d.Title("CloudFormation Change Set", cs.GetName())
+
+	d.Section("Basic Information")
+	d.Field("Change Set Name", cs.GetName())
+	d.Field("Change Set ID", cs.GetID())
+	d.Field("Stack", cs.StackName())
+	d.FieldStyled("Status", cs.Status(), statusColorer(cs.Status()))
+	d.FieldIf("Status Reason", cs.Item.StatusReason)
+	d.FieldStyled("Execution Status", cs.ExecutionStatus(), statusColorer(cs.ExecutionStatus()))
+	d.FieldIf("Description", cs.Item.Description)
+	if appaws.Bool(cs.Item.IncludeNestedStacks) {
+		d.Field("Nested Stacks", "Included")
+	}
+	d.FieldIf("Parent Change Set", cs.Item.ParentChangeSetId)
+
+	if cs.Item.CreationTime != nil {
+		d.Section("Timestamps")
+		d.Field("Created", cs.Item.CreationTime.Format(time.RFC3339))
+		d.Field("Age", render.FormatAge(*cs.Item.CreationTime))
+	}
+
+	if cs.Detail == nil {
+		return d.String()
+	}
+
+	if len(cs.Detail.Parameters) > 0 {
+		d.Section("Parameters")
+		for _, p := range cs.Detail.Parameters {
+			d.Tag(appaws.Str(p.ParameterKey), appaws.Str(p.ParameterValue))
+		}
+	}
+
+	changes := cs.ResourceChanges()
+	d.Section(fmt.Sprintf("Resource Changes (%d)", len(changes)))
+	if len(changes) == 0 {
+		d.Dim("  No resource changes")
+		return d.String()
+	}
+
+	replaced, conditional := cs.Replacements()
+	if replaced > 0 {
+		d.Line("  " + render.DangerStyle().Render(fmt.Sprintf("⚠ %d resource(s) will be replaced", replaced)))
+	}
+	if conditional > 0 {
+		d.Line("  " + render.WarningStyle().Render(fmt.Sprintf("⚠ %d resource(s) may be replaced", conditional)))
+	}
+
+	for _, c := range changes {
+		line := fmt.Sprintf("  %-8s %s", c.Action, appaws.Str(c.LogicalResourceId))
+		d.Line(actionColorer(c.Action).Render(line) + " " + styles.Dim.Render(appaws.Str(c.ResourceType)))
+		if c.Replacement == types.ReplacementTrue || c.Replacement == types.ReplacementConditional {
+			d.Line("           " + replacementColorer(c.Replacement).Render("Replacement: "+string(c.Replacement)))
+		}
+		if props := recreatedProperties(c); len(props) > 0 {
+			d.Line("           " + styles.Dim.Render("Requires recreation: "+strings.Join(props, ", ")))
+		}
+		if id := appaws.Str(c.PhysicalResourceId); id != "" {
+			d.Line("           " + styles.Dim.Render(id))
+		}
+	}
+
+	return d.String()
+}
+
+// recreatedProperties returns the changed properties that force the
+// resource to be recreated, with "?" marking conditional ones
+func recreatedProperties(c types.ResourceChange) []string {
+	var props []string
+	seen := make(map[string]bool)
+	for _, detail := range c.Details {
+		if detail.Target == nil {
+			continue
+		}
+		name := appaws.Str(detail.Target.Name)
+		switch detail.Target.RequiresRecreation {
+		case types.RequiresRecreationAlways:
+		case types.RequiresRecreationConditionally:
+			name += "?"
+		default:
+			continue
+		}
+		if name == "" || seen[name] {
+			continue
+		}
+		seen[name] = true
+		props = append(props, name)
+	}
+	return props
+}
+
+// RenderSummary returns summary fields for the header panel
+func (r *ChangeSetRenderer) RenderSummary(resource dao.Resource) []render.SummaryField {
+	cs, ok := resource.(*ChangeSetResource)
+	if !ok {
+		return r.BaseRenderer.RenderSummary(resource)
+	}
+
+	fields := []render.SummaryField{
+		{Label: "Name", Value: cs.GetName()},
+		{Label: "Stack", Value: cs.StackName()},
+		{Label: "Status", Value: cs.Status(), Style: statusColorer(cs.Status())},
+		{Label: "Execution", Value: cs.ExecutionStatus(), Style: statusColorer(cs.ExecutionStatus())},
+	}
+
+	if replaced, conditional := cs.Replacements(); replaced > 0 || conditional > 0 {
+		style := render.WarningStyle()
+		if replaced > 0 {
+			style = render.DangerStyle()
+		}
+		fields = append(fields, render.SummaryField{
+			Label: "Replacements",
+			Value: fmt.Sprintf("%d (+%d conditional)", replaced, conditional),
+			Style: style,
+		})
+	}
+
+	return fields
+}
+
+// statusColorer returns a style for change set and execution statuses
+func statusColorer(status string) render.Style {
+	switch {
+	case status == "CREATE_COMPLETE" || status == "AVAILABLE" || status == "EXECUTE_COMPLETE":
+		return render.SuccessStyle()
+	case strings.Contains(status, "IN_PROGRESS") || strings.Contains(status, "PENDING"):
+		return render.WarningStyle()
+	case strings.Contains(status, "FAILED"):
+		return render.DangerStyle()
+	case status == "OBSOLETE" || status == "UNAVAILABLE" || strings.HasPrefix(status, "DELETE"):
+		return render.DimStyle()
+	default:
+		return render.DefaultStyle()
+	}
+}
+
+// actionColorer returns a style for a resource change action
+func actionColorer(a types.ChangeAction) render.Style {
+	switch a {
+	case types.ChangeActionAdd, types.ChangeActionImport:
+		return render.SuccessStyle()
+	case types.ChangeActionModify, types.ChangeActionDynamic:
+		return render.WarningStyle()
+	case types.ChangeActionRemove:
+		return render.DangerStyle()
+	default:
+		return render.DefaultStyle()
+	}
+}
+
+// replacementColorer returns a style for a replacement value
+func replacementColorer(r types.Replacement) render.Style {
+	if r == types.ReplacementTrue {
+		return render.DangerStyle()
+	}
+	return render.WarningStyle()
+}
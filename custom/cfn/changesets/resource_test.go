@@ -0,0 +1,89 @@
+package changesets
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+
+	"github.com/clawscli/claws/internal/action"
+)
+
+func change(action types.ChangeAction, id string, replacement types.Replacement, details ...types.ResourceChangeDetail) types.Change {
+	return types.Change{
+		Type: types.ChangeTypeResource,
+		ResourceChange: &types.ResourceChange{
+			Action:            action,
+			LogicalResourceId: aws.String(id),
+			ResourceType:      aws.String("AWS::S3::Bucket"),
+			Replacement:       replacement,
+			Details:           details,
+		},
+	}
+}
+
+func recreation(name string, r types.RequiresRecreation) types.ResourceChangeDetail {
+	return types.ResourceChangeDetail{Target: &types.ResourceTargetDefinition{Name: aws.String(name), RequiresRecreation: r}}
+}
+
+func TestChangeSetResource(t *testing.T) {
+	cs := NewChangeSetResource(types.ChangeSetSummary{
+		ChangeSetId:     aws.String("arn:aws:cloudformation:us-east-1:123456789012:changeSet/cs-1/abc"),
+		ChangeSetName:   aws.String("cs-1"),
+		StackName:       aws.String("my-stack"),
+		Status:          types.ChangeSetStatusCreateComplete,
+		ExecutionStatus: types.ExecutionStatusAvailable,
+	})
+
+	if cs.GetName() != "cs-1" || cs.StackName() != "my-stack" || !cs.Executable() {
+		t.Errorf("resource = %+v", cs)
+	}
+	if replaced, conditional := cs.Replacements(); replaced != 0 || conditional != 0 {
+		t.Errorf("Replacements() before describe = %d, %d", replaced, conditional)
+	}
+
+	cs.Detail = &cloudformation.DescribeChangeSetOutput{Changes: []types.Change{
+		change(types.ChangeActionAdd, "Queue", ""),
+		change(types.ChangeActionModify, "Bucket", types.ReplacementTrue,
+			recreation("BucketName", types.RequiresRecreationAlways),
+			recreation("BucketName", types.RequiresRecreationAlways),
+			recreation("Tags", types.RequiresRecreationNever)),
+		change(types.ChangeActionModify, "Table", types.ReplacementConditional,
+			recreation("KeySchema", types.RequiresRecreationConditionally)),
+	}}
+
+	if replaced, conditional := cs.Replacements(); replaced != 1 || conditional != 1 {
+		t.Errorf("Replacements() = %d, %d, want 1, 1", replaced, conditional)
+	}
+	if got := recreatedProperties(cs.ResourceChanges()[1]); len(got) != 1 || got[0] != "BucketName" {
+		t.Errorf("recreatedProperties(Bucket) = %v", got)
+	}
+	if got := recreatedProperties(cs.ResourceChanges()[2]); len(got) != 1 || got[0] != "KeySchema?" {
+		t.Errorf("recreatedProperties(Table) = %v", got)
+	}
+
+	detail := NewChangeSetRenderer().RenderDetail(cs)
+	for _, want := range []string{"1 resource(s) will be replaced", "1 resource(s) may be replaced", "Requires recreation: BucketName"} {
+		if !strings.Contains(detail, want) {
+			t.Errorf("detail missing %q:\n%s", want, detail)
+		}
+	}
+}
+
+func TestChangeSetActions_ExecuteOnlyAvailable(t *testing.T) {
+	available := NewChangeSetResource(types.ChangeSetSummary{ExecutionStatus: types.ExecutionStatusAvailable})
+	executed := NewChangeSetResource(types.ChangeSetSummary{ExecutionStatus: types.ExecutionStatusExecuteComplete})
+
+	for _, act := range action.Global.Get("cloudformation", "change-sets") {
+		if act.Operation != "ExecuteChangeSet" {
+			continue
+		}
+		if !act.Filter(available) || act.Filter(executed) {
+			t.Error("Execute should only apply to available change sets")
+		}
+		return
+	}
+	t.Fatal("Execute action not registered")
+}
@@ -0,0 +1,107 @@
+package drifts
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+)
+
+// DriftDAO provides data access for the resource drift results of the
+// latest drift detection on a CloudFormation stack
+type DriftDAO struct {
+	dao.BaseDAO
+	client *cloudformation.Client
+}
+
+// NewDriftDAO creates a new DriftDAO
+func NewDriftDAO(ctx context.Context) (dao.DAO, error) {
+	cfg, err := appaws.NewConfig(ctx)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new cfn/drifts dao")
+	}
+	return &DriftDAO{
+		BaseDAO: dao.NewBaseDAO("cloudformation", "drifts"),
+		client:  cloudformation.NewFromConfig(cfg),
+	}, nil
+}
+
+func (d *DriftDAO) List(ctx context.Context) ([]dao.Resource, error) {
+	stackName := dao.GetFilterFromContext(ctx, "StackName")
+	if stackName == "" {
+		return nil, fmt.Errorf("stack name filter required")
+	}
+
+	paginator := cloudformation.NewDescribeStackResourceDriftsPaginator(d.client, &cloudformation.DescribeStackResourceDriftsInput{
+		StackName: &stackName,
+	})
+
+	var resources []dao.Resource
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, apperrors.Wrapf(err, "describe stack resource drifts for %s", stackName)
+		}
+		for _, drift := range output.StackResourceDrifts {
+			resources = append(resources, NewDriftResource(drift))
+		}
+	}
+
+	return resources, nil
+}
+
+func (d *DriftDAO) Get(ctx context.Context, id string) (dao.Resource, error) {
+	return nil, fmt.Errorf("get by ID not supported for drift results")
+}
+
+func (d *DriftDAO) Delete(ctx context.Context, id string) error {
+	return fmt.Errorf("delete not supported for drift results")
+}
+
+func (d *DriftDAO) Supports(op dao.Operation) bool {
+	return op == dao.OpList
+}
+
+// DriftResource wraps the drift result of a single stack resource
+type DriftResource struct {
+	dao.BaseResource
+	Item types.StackResourceDrift
+}
+
+// NewDriftResource creates a new DriftResource
+func NewDriftResource(drift types.StackResourceDrift) *DriftResource {
+	return &DriftResource{
+		BaseResource: dao.BaseResource{
+			ID:   appaws.Str(drift.LogicalResourceId),
+			Name: appaws.Str(drift.LogicalResourceId),
+			Data: drift,
+		},
+		Item: drift,
+	}
+}
+
+// DriftStatus returns the resource drift status
+func (r *DriftResource) DriftStatus() string {
+	return string(r.Item.StackResourceDriftStatus)
+}
+
+// ResourceType returns the resource type
+func (r *DriftResource) ResourceType() string {
+	return appaws.Str(r.Item.ResourceType)
+}
+
+// PhysicalID returns the physical resource ID
+func (r *DriftResource) PhysicalID() string {
+	return appaws.Str(r.Item.PhysicalResourceId)
+}
+
+// Differences returns the property differences between the template and
+// the live resource
+func (r *DriftResource) Differences() []types.PropertyDifference {
+	return r.Item.PropertyDifferences
+}
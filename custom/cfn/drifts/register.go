@@ -0,0 +1,20 @@
+package drifts
+
+import (
+	"context"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/registry"
+	"github.com/clawscli/claws/internal/render"
+)
+
+func init() {
+	registry.Global.RegisterCustom("cloudformation", "drifts", registry.Entry{
+		DAOFactory: func(ctx context.Context) (dao.DAO, error) {
+			return NewDriftDAO(ctx)
+		},
+		RendererFactory: func() render.Renderer {
+			return NewDriftRenderer()
+		},
+	})
+}
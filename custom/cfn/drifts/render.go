@@ -0,0 +1,167 @@
+package drifts
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/render"
+)
+
+// DriftRenderer renders CloudFormation resource drift results
+type DriftRenderer struct {
+	render.BaseRenderer
+}
+
+// NewDriftRenderer creates a new DriftRenderer
+func NewDriftRenderer() render.Renderer {
+	return &DriftRenderer{
+		BaseRenderer: render.BaseRenderer{
+			Service:  "cloudformation",
+			Resource: "drifts",
+			Cols: []render.Column{
+				{
+					Name:  "LOGICAL ID",
+					Width: 30,
+					Getter: func(r dao.Resource) string {
+						return r.GetName()
+					},
+					Priority: 0,
+				},
+				{
+					Name:  "TYPE",
+					Width: 35,
+					Getter: func(r dao.Resource) string {
+						if dr, ok := r.(*DriftResource); ok {
+							return dr.ResourceType()
+						}
+						return ""
+					},
+					Priority: 1,
+				},
+				{
+					Name:  "DRIFT",
+					Width: 12,
+					Getter: func(r dao.Resource) string {
+						if dr, ok := r.(*DriftResource); ok {
+							return dr.DriftStatus()
+						}
+						return ""
+					},
+					Priority: 2,
+				},
+				{
+					Name:  "DIFFS",
+					Width: 6,
+					Getter: func(r dao.Resource) string {
+						if dr, ok := r.(*DriftResource); ok && len(dr.Differences()) > 0 {
+							return fmt.Sprintf("%d", len(dr.Differences()))
+						}
+						return ""
+					},
+					Priority: 3,
+				},
+				{
+					Name:  "CHECKED",
+					Width: 10,
+					Getter: func(r dao.Resource) string {
+						if dr, ok := r.(*DriftResource); ok && dr.Item.Timestamp != nil {
+							return render.FormatAge(*dr.Item.Timestamp)
+						}
+						return ""
+					},
+					Priority: 4,
+				},
+			},
+		},
+	}
+}
+
+// RenderDetail renders the drift result with its property differences
+func (r *DriftRenderer) RenderDetail(resource dao.Resource) string {
+	dr, ok := resource.(*DriftResource)
+	if !ok {
+		return ""
+	}
+
+	d := render.NewDetailBuilder()
+	styles := d.Styles()
+
+	d.Title("Stack Resource Drift", dr.GetName())
+
+	d.Section("Resource Information")
+	d.Field("Logical Resource ID", dr.GetName())
+	d.Field("Physical Resource ID", dr.PhysicalID())
+	d.Field("Resource Type", dr.ResourceType())
+	d.FieldStyled("Drift Status", dr.DriftStatus(), driftColorer(dr.DriftStatus()))
+	if dr.Item.Timestamp != nil {
+		d.Field("Checked", dr.Item.Timestamp.Format(time.RFC3339))
+	}
+	d.FieldIf("Stack", dr.Item.StackId)
+
+	diffs := dr.Differences()
+	if len(diffs) == 0 {
+		return d.String()
+	}
+
+	d.Section(fmt.Sprintf("Property Differences (%d)", len(diffs)))
+	for _, diff := range diffs {
+		d.Line("  " + differenceColorer(diff.DifferenceType).Render(fmt.Sprintf("%-9s", diff.DifferenceType)) + " " +
+			styles.Label.Render(appaws.Str(diff.PropertyPath)))
+		if diff.DifferenceType != types.DifferenceTypeAdd {
+			d.Line("    " + styles.Dim.Render("expected: ") + styles.Value.Render(appaws.Str(diff.ExpectedValue)))
+		}
+		if diff.DifferenceType != types.DifferenceTypeRemove {
+			d.Line("    " + styles.Dim.Render("actual:   ") + styles.Value.Render(appaws.Str(diff.ActualValue)))
+		}
+	}
+
+	return d.String()
+}
+
+// RenderSummary returns summary fields for the header panel
+func (r *DriftRenderer) RenderSummary(resource dao.Resource) []render.SummaryField {
+	dr, ok := resource.(*DriftResource)
+	if !ok {
+		return r.BaseRenderer.RenderSummary(resource)
+	}
+
+	fields := []render.SummaryField{
+		{Label: "Logical ID", Value: dr.GetName()},
+		{Label: "Type", Value: dr.ResourceType()},
+		{Label: "Drift", Value: dr.DriftStatus(), Style: driftColorer(dr.DriftStatus())},
+	}
+	if n := len(dr.Differences()); n > 0 {
+		fields = append(fields, render.SummaryField{Label: "Differences", Value: fmt.Sprintf("%d", n)})
+	}
+	return fields
+}
+
+// driftColorer returns a style for a resource drift status
+func driftColorer(status string) render.Style {
+	switch status {
+	case "IN_SYNC":
+		return render.SuccessStyle()
+	case "MODIFIED", "DELETED":
+		return render.DangerStyle()
+	case "NOT_CHECKED", "UNKNOWN", "UNSUPPORTED":
+		return render.DimStyle()
+	default:
+		return render.DefaultStyle()
+	}
+}
+
+// differenceColorer returns a style for a property difference type
+func differenceColorer(t types.DifferenceType) render.Style {
+	switch t {
+	case types.DifferenceTypeAdd:
+		return render.SuccessStyle()
+	case types.DifferenceTypeRemove:
+		return render.DangerStyle()
+	default:
+		return render.WarningStyle()
+	}
+}
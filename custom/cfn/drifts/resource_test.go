@@ -0,0 +1,45 @@
+package drifts
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+)
+
+func TestNewDriftResource(t *testing.T) {
+	dr := NewDriftResource(types.StackResourceDrift{
+		LogicalResourceId:        aws.String("Bucket"),
+		PhysicalResourceId:       aws.String("my-bucket-123"),
+		ResourceType:             aws.String("AWS::S3::Bucket"),
+		StackResourceDriftStatus: types.StackResourceDriftStatusModified,
+		PropertyDifferences: []types.PropertyDifference{
+			{
+				PropertyPath:   aws.String("/VersioningConfiguration/Status"),
+				DifferenceType: types.DifferenceTypeNotEqual,
+				ExpectedValue:  aws.String("Enabled"),
+				ActualValue:    aws.String("Suspended"),
+			},
+			{
+				PropertyPath:   aws.String("/Tags/1"),
+				DifferenceType: types.DifferenceTypeAdd,
+				ActualValue:    aws.String(`{"Key":"owner","Value":"ops"}`),
+			},
+		},
+	})
+
+	if dr.GetID() != "Bucket" || dr.PhysicalID() != "my-bucket-123" || dr.DriftStatus() != "MODIFIED" {
+		t.Errorf("resource = %+v", dr)
+	}
+
+	detail := NewDriftRenderer().RenderDetail(dr)
+	for _, want := range []string{"Property Differences (2)", "/VersioningConfiguration/Status", "Enabled", "Suspended", "/Tags/1"} {
+		if !strings.Contains(detail, want) {
+			t.Errorf("detail missing %q:\n%s", want, detail)
+		}
+	}
+	if strings.Count(detail, "expected:") != 1 {
+		t.Errorf("an added property should have no expected value:\n%s", detail)
+	}
+}
@@ -13,6 +13,12 @@ import (
 
 func init() {
 	action.Global.Register("cloudformation", "stacks", []action.Action{
+		{
+			Name:     "Template",
+			Shortcut: "t",
+			Type:     action.ActionTypeView,
+			Target:   action.TargetTemplate,
+		},
 		{
 			Name:         "Delete",
 			Shortcut:     "D",
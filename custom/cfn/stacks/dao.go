@@ -10,6 +10,7 @@ import (
 	appaws "github.com/clawscli/claws/internal/aws"
 	"github.com/clawscli/claws/internal/dao"
 	apperrors "github.com/clawscli/claws/internal/errors"
+	"github.com/clawscli/claws/internal/template"
 )
 
 // StackDAO provides data access for CloudFormation stacks
@@ -34,19 +35,52 @@ func (d *StackDAO) List(ctx context.Context) ([]dao.Resource, error) {
 	input := &cloudformation.DescribeStacksInput{}
 	paginator := cloudformation.NewDescribeStacksPaginator(d.client, input)
 
-	var resources []dao.Resource
+	var stacks []types.Stack
 	for paginator.HasMorePages() {
 		output, err := paginator.NextPage(ctx)
 		if err != nil {
 			return nil, apperrors.Wrap(err, "describe stacks")
 		}
+		stacks = append(stacks, output.Stacks...)
+	}
+
+	return stackTree(stacks), nil
+}
+
+// stackTree orders stacks so that nested stacks follow their parent,
+// recording each stack's depth and number of nested stacks. Stacks whose
+// parent is not in the list are treated as roots.
+func stackTree(stacks []types.Stack) []dao.Resource {
+	byID := make(map[string]bool, len(stacks))
+	for _, s := range stacks {
+		byID[appaws.Str(s.StackId)] = true
+	}
 
-		for _, stack := range output.Stacks {
-			resources = append(resources, NewStackResource(stack))
+	children := make(map[string][]*StackResource)
+	var roots []*StackResource
+	for _, s := range stacks {
+		r := NewStackResource(s)
+		if parent := appaws.Str(s.ParentId); parent != "" && byID[parent] {
+			children[parent] = append(children[parent], r)
+			continue
 		}
+		roots = append(roots, r)
 	}
 
-	return resources, nil
+	resources := make([]dao.Resource, 0, len(stacks))
+	var walk func(r *StackResource, depth int)
+	walk = func(r *StackResource, depth int) {
+		r.Depth = depth
+		r.NestedCount = len(children[r.GetID()])
+		resources = append(resources, r)
+		for _, c := range children[r.GetID()] {
+			walk(c, depth+1)
+		}
+	}
+	for _, r := range roots {
+		walk(r, 0)
+	}
+	return resources
 }
 
 func (d *StackDAO) Get(ctx context.Context, id string) (dao.Resource, error) {
@@ -86,6 +120,11 @@ func (d *StackDAO) Delete(ctx context.Context, id string) error {
 type StackResource struct {
 	dao.BaseResource
 	Item types.Stack
+
+	// Depth is the nesting level in the stack tree (0 for root stacks)
+	Depth int
+	// NestedCount is the number of nested stacks directly under this stack
+	NestedCount int
 }
 
 // NewStackResource creates a new StackResource
@@ -124,3 +163,21 @@ func (r *StackResource) Description() string {
 func (r *StackResource) TerminationProtection() bool {
 	return appaws.Bool(r.Item.EnableTerminationProtection)
 }
+
+// TemplateTarget implements template.Provider
+func (r *StackResource) TemplateTarget() template.Target {
+	return template.Target{StackName: r.GetName(), StackID: r.GetID()}
+}
+
+// IsNested returns whether the stack is a nested stack
+func (r *StackResource) IsNested() bool {
+	return appaws.Str(r.Item.ParentId) != ""
+}
+
+// MergeFrom preserves the tree position computed by List
+func (r *StackResource) MergeFrom(original dao.Resource) {
+	if o, ok := dao.UnwrapResource(original).(*StackResource); ok {
+		r.Depth = o.Depth
+		r.NestedCount = o.NestedCount
+	}
+}
@@ -1,6 +1,7 @@
 package stacks
 
 import (
+	"fmt"
 	"strings"
 	"time"
 
@@ -28,6 +29,9 @@ func NewStackRenderer() render.Renderer {
 					Name:  "NAME",
 					Width: 35,
 					Getter: func(r dao.Resource) string {
+						if sr, ok := r.(*StackResource); ok {
+							return treeName(sr)
+						}
 						return r.GetName()
 					},
 					Priority: 0,
@@ -182,10 +186,13 @@ func (r *StackRenderer) RenderDetail(resource dao.Resource) string {
 	d.Tags(appaws.TagsToMap(sr.Item.Tags))
 
 	// Nested Stack Info
-	if sr.Item.ParentId != nil || sr.Item.RootId != nil {
+	if sr.Item.ParentId != nil || sr.Item.RootId != nil || sr.NestedCount > 0 {
 		d.Section("Nested Stack Info")
 		d.FieldIf("Parent Stack", sr.Item.ParentId)
 		d.FieldIf("Root Stack", sr.Item.RootId)
+		if sr.NestedCount > 0 {
+			d.Field("Nested Stacks", fmt.Sprintf("%d", sr.NestedCount))
+		}
 	}
 
 	return d.String()
@@ -240,6 +247,14 @@ func (r *StackRenderer) RenderSummary(resource dao.Resource) []render.SummaryFie
 	return fields
 }
 
+// treeName returns the stack name indented under its parent stack
+func treeName(sr *StackResource) string {
+	if sr.Depth == 0 {
+		return sr.GetName()
+	}
+	return strings.Repeat("  ", sr.Depth-1) + "└─ " + sr.GetName()
+}
+
 // cfnStateColorer returns a style for CloudFormation stack status
 func cfnStateColorer(status string) render.Style {
 	switch {
@@ -279,7 +294,7 @@ func (r *StackRenderer) Navigations(resource dao.Resource) []render.Navigation {
 
 	stackName := sr.GetName()
 
-	return []render.Navigation{
+	navs := []render.Navigation{
 		{
 			Key: "e", Label: "Events", Service: "cloudformation", Resource: "events",
 			FilterField: "StackName", FilterValue: stackName,
@@ -293,5 +308,29 @@ func (r *StackRenderer) Navigations(resource dao.Resource) []render.Navigation {
 			Key: "o", Label: "Outputs", Service: "cloudformation", Resource: "outputs",
 			FilterField: "StackName", FilterValue: stackName,
 		},
+		{
+			Key: "s", Label: "Change Sets", Service: "cloudformation", Resource: "change-sets",
+			FilterField: "StackName", FilterValue: stackName,
+		},
+		{
+			Key: "f", Label: "Drift Results", Service: "cloudformation", Resource: "drifts",
+			FilterField: "StackName", FilterValue: stackName,
+		},
 	}
+
+	// Nested stacks are filtered client-side from the full stack list
+	if sr.NestedCount > 0 {
+		navs = append(navs, render.Navigation{
+			Key: "n", Label: "Nested Stacks", Service: "cloudformation", Resource: "stacks",
+			FilterField: "ParentId", FilterValue: sr.GetID(),
+		})
+	}
+	if sr.IsNested() {
+		navs = append(navs, render.Navigation{
+			Key: "p", Label: "Parent Stack", Service: "cloudformation", Resource: "stacks",
+			FilterField: "StackId", FilterValue: appaws.Str(sr.Item.ParentId),
+		})
+	}
+
+	return navs
 }
@@ -140,3 +140,44 @@ func TestStackResource_DriftStatusVariations(t *testing.T) {
 		})
 	}
 }
+
+func TestStackTree(t *testing.T) {
+	stack := func(id, parent string) types.Stack {
+		s := types.Stack{StackId: aws.String(id), StackName: aws.String(id)}
+		if parent != "" {
+			s.ParentId = aws.String(parent)
+		}
+		return s
+	}
+	resources := stackTree([]types.Stack{
+		stack("child-a", "root"),
+		stack("root", ""),
+		stack("grandchild", "child-a"),
+		stack("orphan", "deleted-parent"),
+		stack("child-b", "root"),
+	})
+
+	want := []struct {
+		name   string
+		depth  int
+		nested int
+	}{
+		{"root", 0, 2},
+		{"child-a", 1, 1},
+		{"grandchild", 2, 0},
+		{"child-b", 1, 0},
+		{"orphan", 0, 0},
+	}
+	if len(resources) != len(want) {
+		t.Fatalf("stackTree() returned %d stacks, want %d", len(resources), len(want))
+	}
+	for i, w := range want {
+		sr := resources[i].(*StackResource)
+		if sr.GetName() != w.name || sr.Depth != w.depth || sr.NestedCount != w.nested {
+			t.Errorf("stackTree()[%d] = %s depth %d nested %d, want %+v", i, sr.GetName(), sr.Depth, sr.NestedCount, w)
+		}
+	}
+	if got := treeName(resources[2].(*StackResource)); got != "  └─ grandchild" {
+		t.Errorf("treeName() = %q", got)
+	}
+}
@@ -0,0 +1,29 @@
+package distributions
+
+import (
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/usage"
+)
+
+func init() {
+	usage.Global.Register("acm", "certificates", usage.Finder{
+		Service:  "cloudfront",
+		Resource: "distributions",
+		Relation: "viewer certificate",
+		Refs: func(r dao.Resource) []string {
+			d, ok := r.(*DistributionResource)
+			if !ok {
+				return nil
+			}
+			refs := []string{}
+			if d.Item.ViewerCertificate != nil {
+				refs = append(refs, appaws.Str(d.Item.ViewerCertificate.ACMCertificateArn))
+			}
+			if d.ViewerCertificate != nil {
+				refs = append(refs, appaws.Str(d.ViewerCertificate.ACMCertificateArn))
+			}
+			return refs
+		},
+	})
+}
@@ -11,6 +11,7 @@ import (
 	appaws "github.com/clawscli/claws/internal/aws"
 	"github.com/clawscli/claws/internal/dao"
 	apperrors "github.com/clawscli/claws/internal/errors"
+	"github.com/clawscli/claws/internal/logs"
 )
 
 // LogGroupDAO provides data access for CloudWatch Log Groups
@@ -128,6 +129,11 @@ func (r *LogGroupResource) LogGroupName() string {
 	return appaws.Str(r.Item.LogGroupName)
 }
 
+// LogTarget implements logs.Provider
+func (r *LogGroupResource) LogTarget() logs.Target {
+	return logs.Target{Group: r.LogGroupName()}
+}
+
 // StoredBytes returns the stored bytes
 func (r *LogGroupResource) StoredBytes() int64 {
 	if r.Item.StoredBytes != nil {
@@ -0,0 +1,20 @@
+package loggroups
+
+import (
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/usage"
+)
+
+func init() {
+	usage.Global.Register("kms", "keys", usage.Finder{
+		Service:  "cloudwatch",
+		Resource: "log-groups",
+		Relation: "log encryption",
+		Refs: func(r dao.Resource) []string {
+			if lg, ok := r.(*LogGroupResource); ok {
+				return []string{lg.KmsKeyId()}
+			}
+			return nil
+		},
+	})
+}
@@ -17,20 +17,20 @@ func init() {
 		{
 			Name:     action.ActionNameTailLogs,
 			Shortcut: "t",
-			Type:     action.ActionTypeExec,
-			Command:  `aws logs tail "${LOG_GROUP}" --log-stream-names "${NAME}" --since 1h --follow`,
+			Type:     action.ActionTypeView,
+			Target:   action.TargetLogsFollow,
 		},
 		{
 			Name:     action.ActionNameViewRecent1h,
 			Shortcut: "1",
-			Type:     action.ActionTypeExec,
-			Command:  `aws logs tail "${LOG_GROUP}" --log-stream-names "${NAME}" --since 1h | less -R`,
+			Type:     action.ActionTypeView,
+			Target:   action.TargetLogs,
 		},
 		{
 			Name:     action.ActionNameViewRecent24h,
 			Shortcut: "2",
-			Type:     action.ActionTypeExec,
-			Command:  `aws logs tail "${LOG_GROUP}" --log-stream-names "${NAME}" --since 24h | less -R`,
+			Type:     action.ActionTypeView,
+			Target:   action.TargetLogs24h,
 		},
 		{
 			Name:      "Delete",
@@ -10,6 +10,7 @@ import (
 	appaws "github.com/clawscli/claws/internal/aws"
 	"github.com/clawscli/claws/internal/dao"
 	apperrors "github.com/clawscli/claws/internal/errors"
+	"github.com/clawscli/claws/internal/logs"
 )
 
 // LogStreamDAO provides data access for CloudWatch Log Streams
@@ -157,6 +158,11 @@ func (r *LogStreamResource) LogGroupName() string {
 	return r.logGroupName
 }
 
+// LogTarget implements logs.Provider
+func (r *LogStreamResource) LogTarget() logs.Target {
+	return logs.Target{Group: r.logGroupName, Streams: []string{r.LogStreamName()}}
+}
+
 // FirstEventTimestamp returns the first event timestamp
 func (r *LogStreamResource) FirstEventTimestamp() int64 {
 	if r.Item.FirstEventTimestamp != nil {
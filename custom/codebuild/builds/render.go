@@ -2,8 +2,10 @@ package builds
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/logs"
 	"github.com/clawscli/claws/internal/render"
 )
 
@@ -179,5 +181,26 @@ func (r *BuildRenderer) RenderSummary(resource dao.Resource) []render.SummaryFie
 
 // Navigations returns navigation shortcuts
 func (r *BuildRenderer) Navigations(resource dao.Resource) []render.Navigation {
-	return nil
+	build, ok := resource.(*BuildResource)
+	if !ok {
+		return nil
+	}
+
+	var navs []render.Navigation
+	if group := build.LogsGroupName(); group != "" {
+		target := &logs.Target{Group: group}
+		if stream := build.LogsStreamName(); stream != "" {
+			target.Streams = []string{stream}
+		}
+		if start := build.Build.StartTime; start != nil {
+			// Cover the whole build, however long ago it started
+			target.Since = max(time.Since(*start).Round(time.Minute)+time.Minute, logs.DefaultSince)
+		}
+		navs = append(navs, render.Navigation{
+			Key:   "l",
+			Label: "Logs",
+			Logs:  target,
+		})
+	}
+	return navs
 }
@@ -3,6 +3,7 @@ package tables
 import (
 	"context"
 	"fmt"
+	"strconv"
 
 	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
 	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
@@ -15,12 +16,21 @@ import (
 func init() {
 	// Register actions for DynamoDB tables
 	action.Global.Register("dynamodb", "tables", []action.Action{
+		{
+			Name:     "Explore Items",
+			Shortcut: "i",
+			Type:     action.ActionTypeView,
+			Target:   action.TargetItems,
+		},
 		{
 			Name:      "Scale Up RCU",
 			Shortcut:  "r",
 			Type:      action.ActionTypeAPI,
 			Operation: "ScaleUpRCU",
 			Confirm:   action.ConfirmSimple,
+			Input: []action.Field{
+				capacityField("rcu", "Read capacity", func(t *TableResource) int64 { return t.ReadCapacity() }),
+			},
 		},
 		{
 			Name:      "Scale Up WCU",
@@ -28,6 +38,9 @@ func init() {
 			Type:      action.ActionTypeAPI,
 			Operation: "ScaleUpWCU",
 			Confirm:   action.ConfirmSimple,
+			Input: []action.Field{
+				capacityField("wcu", "Write capacity", func(t *TableResource) int64 { return t.WriteCapacity() }),
+			},
 		},
 		{
 			Name:      "Switch to On-Demand",
@@ -42,6 +55,10 @@ func init() {
 			Type:      action.ActionTypeAPI,
 			Operation: "SwitchToProvisioned",
 			Confirm:   action.ConfirmSimple,
+			Input: []action.Field{
+				provisionedField("rcu", "Read capacity"),
+				provisionedField("wcu", "Write capacity"),
+			},
 		},
 		{
 			Name:      "Delete",
@@ -56,17 +73,70 @@ func init() {
 	action.RegisterExecutor("dynamodb", "tables", executeTableAction)
 }
 
+// defaultProvisionedCapacity is the capacity offered when switching a table
+// from on-demand to provisioned mode
+const defaultProvisionedCapacity = 5
+
+// capacityField asks for a new capacity of at least the current one,
+// defaulting to the current one scaled by 50% or at least +5
+func capacityField(name, label string, current func(*TableResource) int64) action.Field {
+	return action.Field{
+		Name:     name,
+		Label:    label,
+		Type:     action.FieldInt,
+		Required: true,
+		Min:      action.Bound(1),
+		Help:     "Capacity units for the table, at least the current",
+		Default: func(resource dao.Resource) string {
+			table, ok := dao.UnwrapResource(resource).(*TableResource)
+			if !ok {
+				return ""
+			}
+			n := current(table)
+			return strconv.FormatInt(n+max(n/2, 5), 10)
+		},
+		Check: func(resource dao.Resource, value string) error {
+			table, ok := dao.UnwrapResource(resource).(*TableResource)
+			if !ok {
+				return nil
+			}
+			n, err := strconv.ParseInt(value, 10, 64)
+			if err != nil {
+				return err
+			}
+			if cur := current(table); n < cur {
+				return fmt.Errorf("must be at least the current %d to scale up", cur)
+			}
+			return nil
+		},
+	}
+}
+
+// provisionedField asks for the capacity of a table and its GSIs when
+// switching to provisioned mode
+func provisionedField(name, label string) action.Field {
+	return action.Field{
+		Name:     name,
+		Label:    label,
+		Type:     action.FieldInt,
+		Required: true,
+		Min:      action.Bound(1),
+		Help:     "Capacity units for the table and each GSI",
+		Default:  func(dao.Resource) string { return strconv.Itoa(defaultProvisionedCapacity) },
+	}
+}
+
 // executeTableAction executes an action on a DynamoDB table
 func executeTableAction(ctx context.Context, act action.Action, resource dao.Resource) action.ActionResult {
 	switch act.Operation {
 	case "ScaleUpRCU":
-		return executeScaleCapacity(ctx, resource, true, false)
+		return executeScaleCapacity(ctx, resource, act.Values, true, false)
 	case "ScaleUpWCU":
-		return executeScaleCapacity(ctx, resource, false, true)
+		return executeScaleCapacity(ctx, resource, act.Values, false, true)
 	case "SwitchToOnDemand":
 		return executeSwitchToOnDemand(ctx, resource)
 	case "SwitchToProvisioned":
-		return executeSwitchToProvisioned(ctx, resource)
+		return executeSwitchToProvisioned(ctx, resource, act.Values)
 	case "DeleteTable":
 		return executeDeleteTable(ctx, resource)
 	default:
@@ -82,8 +152,8 @@ func getDynamoDBClient(ctx context.Context) (*dynamodb.Client, error) {
 	return dynamodb.NewFromConfig(cfg), nil
 }
 
-func executeScaleCapacity(ctx context.Context, resource dao.Resource, scaleRCU, scaleWCU bool) action.ActionResult {
-	table, ok := resource.(*TableResource)
+func executeScaleCapacity(ctx context.Context, resource dao.Resource, values action.Values, scaleRCU, scaleWCU bool) action.ActionResult {
+	table, ok := dao.UnwrapResource(resource).(*TableResource)
 	if !ok {
 		return action.InvalidResourceResult()
 	}
@@ -105,20 +175,14 @@ func executeScaleCapacity(ctx context.Context, resource dao.Resource, scaleRCU,
 	newRCU := currentRCU
 	newWCU := currentWCU
 
-	// Scale by 50% or minimum +5
 	if scaleRCU {
-		delta := currentRCU / 2
-		if delta < 5 {
-			delta = 5
-		}
-		newRCU = currentRCU + delta
+		newRCU, err = values.Int("rcu")
 	}
 	if scaleWCU {
-		delta := currentWCU / 2
-		if delta < 5 {
-			delta = 5
-		}
-		newWCU = currentWCU + delta
+		newWCU, err = values.Int("wcu")
+	}
+	if err != nil {
+		return action.ActionResult{Success: false, Error: fmt.Errorf("parse capacity: %w", err)}
 	}
 
 	input := &dynamodb.UpdateTableInput{
@@ -147,7 +211,7 @@ func executeScaleCapacity(ctx context.Context, resource dao.Resource, scaleRCU,
 }
 
 func executeSwitchToOnDemand(ctx context.Context, resource dao.Resource) action.ActionResult {
-	table, ok := resource.(*TableResource)
+	table, ok := dao.UnwrapResource(resource).(*TableResource)
 	if !ok {
 		return action.InvalidResourceResult()
 	}
@@ -180,8 +244,8 @@ func executeSwitchToOnDemand(ctx context.Context, resource dao.Resource) action.
 	}
 }
 
-func executeSwitchToProvisioned(ctx context.Context, resource dao.Resource) action.ActionResult {
-	table, ok := resource.(*TableResource)
+func executeSwitchToProvisioned(ctx context.Context, resource dao.Resource, values action.Values) action.ActionResult {
+	table, ok := dao.UnwrapResource(resource).(*TableResource)
 	if !ok {
 		return action.InvalidResourceResult()
 	}
@@ -198,8 +262,14 @@ func executeSwitchToProvisioned(ctx context.Context, resource dao.Resource) acti
 
 	tableName := table.GetName()
 
-	// Default to 5 RCU/WCU when switching from on-demand
-	var rcu, wcu int64 = 5, 5
+	rcu, err := values.Int("rcu")
+	if err != nil {
+		return action.ActionResult{Success: false, Error: fmt.Errorf("parse read capacity: %w", err)}
+	}
+	wcu, err := values.Int("wcu")
+	if err != nil {
+		return action.ActionResult{Success: false, Error: fmt.Errorf("parse write capacity: %w", err)}
+	}
 
 	input := &dynamodb.UpdateTableInput{
 		TableName:   &tableName,
@@ -246,7 +316,7 @@ func executeSwitchToProvisioned(ctx context.Context, resource dao.Resource) acti
 }
 
 func executeDeleteTable(ctx context.Context, resource dao.Resource) action.ActionResult {
-	table, ok := resource.(*TableResource)
+	table, ok := dao.UnwrapResource(resource).(*TableResource)
 	if !ok {
 		return action.InvalidResourceResult()
 	}
@@ -0,0 +1,93 @@
+package tables
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+
+	"github.com/clawscli/claws/internal/action"
+	"github.com/clawscli/claws/internal/dao"
+)
+
+func TestCapacityInputDefaults(t *testing.T) {
+	tests := []struct {
+		rcu, wcu int64
+		wantRCU  string
+		wantWCU  string
+	}{
+		{100, 4, "150", "9"},
+		{0, 1, "5", "6"},
+	}
+	for _, tt := range tests {
+		table := NewTableResource(types.TableDescription{
+			TableName: aws.String("orders"),
+			ProvisionedThroughput: &types.ProvisionedThroughputDescription{
+				ReadCapacityUnits:  aws.Int64(tt.rcu),
+				WriteCapacityUnits: aws.Int64(tt.wcu),
+			},
+		})
+		// Multi-region listings pass wrapped resources
+		wrapped := dao.WrapWithRegion(table, "us-east-1")
+		for _, act := range action.Global.Get("dynamodb", "tables") {
+			values := action.DefaultValues(act, wrapped)
+			switch act.Operation {
+			case "ScaleUpRCU":
+				if values["rcu"] != tt.wantRCU {
+					t.Errorf("Scale Up RCU default for %d = %q, want %q", tt.rcu, values["rcu"], tt.wantRCU)
+				}
+			case "ScaleUpWCU":
+				if values["wcu"] != tt.wantWCU {
+					t.Errorf("Scale Up WCU default for %d = %q, want %q", tt.wcu, values["wcu"], tt.wantWCU)
+				}
+			case "SwitchToProvisioned":
+				if values["rcu"] != "5" || values["wcu"] != "5" {
+					t.Errorf("Switch to Provisioned defaults = %v, want 5 and 5", values)
+				}
+			}
+		}
+	}
+}
+
+func TestCapacityInputBelowCurrent(t *testing.T) {
+	table := NewTableResource(types.TableDescription{
+		TableName: aws.String("orders"),
+		ProvisionedThroughput: &types.ProvisionedThroughputDescription{
+			ReadCapacityUnits:  aws.Int64(100),
+			WriteCapacityUnits: aws.Int64(10),
+		},
+	})
+	for _, act := range action.Global.Get("dynamodb", "tables") {
+		if act.Operation != "ScaleUpRCU" {
+			continue
+		}
+		if err := action.ValidateValues(act, table, action.Values{"rcu": "50"}); err == nil {
+			t.Error("Scale Up RCU should reject a capacity below the current 100")
+		}
+		if err := action.ValidateValues(act, table, action.Values{"rcu": "100"}); err != nil {
+			t.Errorf("Scale Up RCU should accept the current capacity: %v", err)
+		}
+	}
+}
+
+func TestExecutorsUnwrapResources(t *testing.T) {
+	provisioned := dao.WrapWithRegion(NewTableResource(types.TableDescription{
+		TableName:          aws.String("orders"),
+		BillingModeSummary: &types.BillingModeSummary{BillingMode: types.BillingModeProvisioned},
+	}), "us-east-1")
+	onDemand := dao.WrapWithRegion(NewTableResource(types.TableDescription{
+		TableName:          aws.String("events"),
+		BillingModeSummary: &types.BillingModeSummary{BillingMode: types.BillingModePayPerRequest},
+	}), "us-east-1")
+
+	// Both fail on the billing mode check, before any API call, rather
+	// than rejecting the wrapped resource
+	if result := executeSwitchToProvisioned(context.Background(), provisioned, nil); result.Error == nil || !strings.Contains(result.Error.Error(), "already in provisioned mode") {
+		t.Errorf("executeSwitchToProvisioned() = %+v", result)
+	}
+	if result := executeSwitchToOnDemand(context.Background(), onDemand); result.Error == nil || !strings.Contains(result.Error.Error(), "already in on-demand mode") {
+		t.Errorf("executeSwitchToOnDemand() = %+v", result)
+	}
+}
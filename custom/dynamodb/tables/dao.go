@@ -9,6 +9,7 @@ import (
 
 	appaws "github.com/clawscli/claws/internal/aws"
 	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/dynamo"
 	apperrors "github.com/clawscli/claws/internal/errors"
 	"github.com/clawscli/claws/internal/log"
 )
@@ -117,6 +118,11 @@ func NewTableResource(table types.TableDescription) *TableResource {
 	}
 }
 
+// ItemTable implements dynamo.Provider
+func (r *TableResource) ItemTable() dynamo.Table {
+	return dynamo.TableFromDescription(r.Item)
+}
+
 // Status returns the table status
 func (r *TableResource) Status() string {
 	return string(r.Item.TableStatus)
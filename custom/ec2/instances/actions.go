@@ -47,6 +47,25 @@ func init() {
 			Type:     action.ActionTypeExec,
 			Command:  "aws ssm start-session --target ${ID}",
 		},
+		{
+			Name:       "Run Command",
+			Shortcut:   "c",
+			Type:       action.ActionTypeView,
+			Target:     action.TargetRunCommand,
+			UsesMarked: true,
+		},
+		{
+			Name:     "Console Output",
+			Shortcut: "o",
+			Type:     action.ActionTypeView,
+			Target:   action.TargetConsoleOutput,
+		},
+		{
+			Name:     "Check Reachability",
+			Shortcut: "n",
+			Type:     action.ActionTypeView,
+			Target:   action.TargetReach,
+		},
 	})
 
 	action.RegisterExecutor("ec2", "instances", executeInstanceAction)
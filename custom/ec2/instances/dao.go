@@ -9,8 +9,12 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/iam"
 
 	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/consoleoutput"
 	"github.com/clawscli/claws/internal/dao"
 	apperrors "github.com/clawscli/claws/internal/errors"
+	"github.com/clawscli/claws/internal/log"
+	"github.com/clawscli/claws/internal/reach"
+	"github.com/clawscli/claws/internal/runcmd"
 )
 
 // InstanceDAO provides data access for EC2 instances
@@ -40,6 +44,12 @@ func (d *InstanceDAO) List(ctx context.Context) ([]dao.Resource, error) {
 	// Cache for instance profile -> role name mapping
 	roleCache := make(map[string]string)
 
+	// Status checks are best-effort: the list is still useful without them
+	statuses, err := d.describeStatuses(ctx, nil)
+	if err != nil {
+		log.Warn("failed to describe instance status", "error", err)
+	}
+
 	var resources []dao.Resource
 	for paginator.HasMorePages() {
 		output, err := paginator.NextPage(ctx)
@@ -50,7 +60,11 @@ func (d *InstanceDAO) List(ctx context.Context) ([]dao.Resource, error) {
 		for _, reservation := range output.Reservations {
 			for _, instance := range reservation.Instances {
 				roleName := d.getRoleNameFromInstance(ctx, instance, roleCache)
-				resources = append(resources, NewInstanceResourceWithRole(instance, roleName))
+				res := NewInstanceResourceWithRole(instance, roleName)
+				if status, ok := statuses[res.GetID()]; ok {
+					res.Status = &status
+				}
+				resources = append(resources, res)
 			}
 		}
 	}
@@ -74,7 +88,15 @@ func (d *InstanceDAO) Get(ctx context.Context, id string) (dao.Resource, error)
 
 	instance := output.Reservations[0].Instances[0]
 	roleName := d.getRoleNameFromInstance(ctx, instance, nil)
-	return NewInstanceResourceWithRole(instance, roleName), nil
+	res := NewInstanceResourceWithRole(instance, roleName)
+	statuses, err := d.describeStatuses(ctx, []string{id})
+	if err != nil {
+		log.Warn("failed to describe instance status", "instance", id, "error", err)
+	}
+	if status, ok := statuses[id]; ok {
+		res.Status = &status
+	}
+	return res, nil
 }
 
 func (d *InstanceDAO) Delete(ctx context.Context, id string) error {
@@ -142,6 +164,7 @@ type InstanceResource struct {
 	dao.BaseResource
 	Item     types.Instance
 	RoleName string
+	Status   *types.InstanceStatus // status checks and events; nil unless running
 }
 
 // NewInstanceResourceWithRole creates a new InstanceResource with IAM role name
@@ -322,3 +345,32 @@ func (r *InstanceResource) EnclaveEnabled() bool {
 	}
 	return false
 }
+
+// ReachEndpoint implements reach.Provider
+func (r *InstanceResource) ReachEndpoint() reach.Endpoint {
+	e := reach.Endpoint{
+		ID:       r.GetID(),
+		Name:     r.GetName(),
+		IP:       r.PrivateIP(),
+		SubnetID: appaws.Str(r.Item.SubnetId),
+		VpcID:    appaws.Str(r.Item.VpcId),
+	}
+	for _, g := range r.Item.SecurityGroups {
+		e.SecurityGroups = append(e.SecurityGroups, appaws.Str(g.GroupId))
+	}
+	return e
+}
+
+// RunInstance implements runcmd.Provider
+func (r *InstanceResource) RunInstance() runcmd.Instance {
+	return runcmd.Instance{
+		ID:      r.GetID(),
+		Name:    r.GetName(),
+		Windows: r.Item.Platform == types.PlatformValuesWindows,
+	}
+}
+
+// ConsoleTarget implements consoleoutput.Provider
+func (r *InstanceResource) ConsoleTarget() consoleoutput.Target {
+	return consoleoutput.Target{InstanceID: r.GetID(), Name: r.GetName()}
+}
@@ -4,6 +4,9 @@ import (
 	"fmt"
 	"time"
 
+	"charm.land/lipgloss/v2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+
 	appaws "github.com/clawscli/claws/internal/aws"
 	"github.com/clawscli/claws/internal/dao"
 	"github.com/clawscli/claws/internal/render"
@@ -53,6 +56,17 @@ func NewInstanceRenderer() render.Renderer {
 					},
 					Priority: 2,
 				},
+				{
+					Name:  "CHECKS",
+					Width: 13,
+					Getter: func(r dao.Resource) string {
+						if ir, ok := r.(*InstanceResource); ok {
+							return ir.StatusChecks()
+						}
+						return ""
+					},
+					Priority: 3,
+				},
 				{
 					Name:  "TYPE",
 					Width: 13,
@@ -62,7 +76,7 @@ func NewInstanceRenderer() render.Renderer {
 						}
 						return ""
 					},
-					Priority: 3,
+					Priority: 4,
 				},
 				{
 					Name:  "PRIVATE IP",
@@ -73,7 +87,7 @@ func NewInstanceRenderer() render.Renderer {
 						}
 						return ""
 					},
-					Priority: 4,
+					Priority: 5,
 				},
 				{
 					Name:  "AZ",
@@ -84,7 +98,7 @@ func NewInstanceRenderer() render.Renderer {
 						}
 						return ""
 					},
-					Priority: 5,
+					Priority: 6,
 				},
 				{
 					Name:  "AGE",
@@ -97,9 +111,20 @@ func NewInstanceRenderer() render.Renderer {
 						}
 						return ""
 					},
-					Priority: 6,
+					Priority: 7,
+				},
+				{
+					Name:  "EVENT",
+					Width: 26,
+					Getter: func(r dao.Resource) string {
+						if ir, ok := r.(*InstanceResource); ok {
+							return ir.NextEvent()
+						}
+						return ""
+					},
+					Priority: 8,
 				},
-				render.TagsColumn(30, 7),
+				render.TagsColumn(30, 9),
 			},
 		},
 	}
@@ -154,6 +179,35 @@ func (r *InstanceRenderer) RenderDetail(resource dao.Resource) string {
 		}
 	}
 
+	if checks := ir.statusChecks(); len(checks) > 0 {
+		d.Section("Status Checks")
+		for _, c := range checks {
+			d.FieldStyled(c.Name, string(c.Summary.Status), checkStyle(c.Summary.Status))
+			for _, detail := range c.Summary.Details {
+				if detail.ImpairedSince != nil {
+					d.FieldStyled(c.Name+" Impaired Since", detail.ImpairedSince.Format(time.RFC3339), render.DangerStyle())
+				}
+			}
+		}
+	}
+
+	if events := ir.ScheduledEvents(); len(events) > 0 {
+		d.Section("Scheduled Events")
+		for _, e := range events {
+			when := ""
+			if e.NotBefore != nil {
+				when = e.NotBefore.Format(time.RFC3339)
+				if e.NotAfter != nil {
+					when += " – " + e.NotAfter.Format(time.RFC3339)
+				}
+			}
+			d.FieldStyled(string(e.Code), when, render.WarningStyle())
+			if desc := appaws.Str(e.Description); desc != "" {
+				d.DimIndent(desc)
+			}
+		}
+	}
+
 	// Compute Configuration
 	d.Section("Compute Configuration")
 	if cores := ir.CpuCoreCount(); cores > 0 {
@@ -274,6 +328,20 @@ func (r *InstanceRenderer) RenderDetail(resource dao.Resource) string {
 	return d.String()
 }
 
+// checkStyle colours a status check result
+func checkStyle(status types.SummaryStatus) lipgloss.Style {
+	switch status {
+	case types.SummaryStatusOk:
+		return render.SuccessStyle()
+	case types.SummaryStatusImpaired:
+		return render.DangerStyle()
+	case types.SummaryStatusInitializing, types.SummaryStatusInsufficientData:
+		return render.WarningStyle()
+	default:
+		return render.DimStyle()
+	}
+}
+
 // RenderSummary returns summary fields for the header panel
 func (r *InstanceRenderer) RenderSummary(resource dao.Resource) []render.SummaryField {
 	ir, ok := resource.(*InstanceResource)
@@ -290,6 +358,19 @@ func (r *InstanceRenderer) RenderSummary(resource dao.Resource) []render.Summary
 		{Label: "State", Value: ir.State(), Style: stateStyle},
 	}
 
+	if checks := ir.StatusChecks(); checks != "" {
+		style := render.SuccessStyle()
+		if ir.StatusChecksFailed() {
+			style = render.DangerStyle()
+		} else if !ir.StatusChecksPassed() {
+			style = render.WarningStyle()
+		}
+		fields = append(fields, render.SummaryField{Label: "Checks", Value: checks, Style: style})
+	}
+	if event := ir.NextEvent(); event != "" {
+		fields = append(fields, render.SummaryField{Label: "Event", Value: event, Style: render.WarningStyle()})
+	}
+
 	// Row 2: Type, AZ, Platform
 	fields = append(fields, render.SummaryField{Label: "Type", Value: ir.InstanceType()})
 	fields = append(fields, render.SummaryField{Label: "AZ", Value: ir.AZ()})
@@ -1,7 +1,9 @@
 package instances
 
 import (
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
@@ -119,3 +121,57 @@ func TestInstanceResource_StateVariations(t *testing.T) {
 		})
 	}
 }
+
+func TestInstanceResource_RunInstance(t *testing.T) {
+	linux := NewInstanceResourceWithRole(types.Instance{
+		InstanceId: aws.String("i-linux"),
+		Tags:       []types.Tag{{Key: aws.String("Name"), Value: aws.String("web")}},
+	}, "")
+	if got := linux.RunInstance(); got.ID != "i-linux" || got.Name != "web" || got.Windows {
+		t.Errorf("RunInstance() = %+v", got)
+	}
+
+	windows := NewInstanceResourceWithRole(types.Instance{
+		InstanceId: aws.String("i-win"),
+		Platform:   types.PlatformValuesWindows,
+	}, "")
+	if got := windows.RunInstance(); !got.Windows {
+		t.Errorf("RunInstance().Windows = false, want true")
+	}
+}
+
+func TestInstanceResource_StatusChecks(t *testing.T) {
+	notBefore := time.Date(2026, 10, 25, 3, 0, 0, 0, time.UTC)
+	resource := NewInstanceResourceWithRole(types.Instance{InstanceId: aws.String("i-1")}, "")
+	if resource.StatusChecks() != "" || resource.NextEvent() != "" {
+		t.Error("an instance without status should have no checks or events")
+	}
+
+	resource.Status = &types.InstanceStatus{
+		SystemStatus:   &types.InstanceStatusSummary{Status: types.SummaryStatusOk},
+		InstanceStatus: &types.InstanceStatusSummary{Status: types.SummaryStatusImpaired},
+		Events: []types.InstanceStatusEvent{
+			{Code: types.EventCodeSystemReboot, Description: aws.String("[Completed] scheduled reboot")},
+			{Code: types.EventCodeInstanceRetirement, Description: aws.String("retirement"), NotBefore: &notBefore},
+		},
+	}
+	if got := resource.StatusChecks(); got != "1/2 passed" {
+		t.Errorf("StatusChecks() = %q", got)
+	}
+	if !resource.StatusChecksFailed() || resource.StatusChecksPassed() {
+		t.Error("an impaired instance check should fail")
+	}
+	if got := resource.NextEvent(); got != "instance-retirement 2026-10-25" {
+		t.Errorf("NextEvent() = %q", got)
+	}
+
+	detail := NewInstanceRenderer().RenderDetail(resource)
+	for _, want := range []string{"Status Checks", "impaired", "Scheduled Events", "retirement"} {
+		if !strings.Contains(detail, want) {
+			t.Errorf("detail missing %q", want)
+		}
+	}
+	if strings.Contains(detail, "scheduled reboot") {
+		t.Error("completed events should be left out")
+	}
+}
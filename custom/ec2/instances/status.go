@@ -0,0 +1,134 @@
+package instances
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	apperrors "github.com/clawscli/claws/internal/errors"
+)
+
+// statusCheck is one status check of an instance.
+type statusCheck struct {
+	Name    string // System, Instance or Attached EBS
+	Summary *types.InstanceStatusSummary
+}
+
+// describeStatuses returns the status checks and scheduled events of the
+// running instances (all of them when ids is empty), by instance ID.
+func (d *InstanceDAO) describeStatuses(ctx context.Context, ids []string) (map[string]types.InstanceStatus, error) {
+	statuses := make(map[string]types.InstanceStatus)
+	paginator := ec2.NewDescribeInstanceStatusPaginator(d.client, &ec2.DescribeInstanceStatusInput{InstanceIds: ids})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, apperrors.Wrap(err, "describe instance status")
+		}
+		for _, s := range output.InstanceStatuses {
+			statuses[appaws.Str(s.InstanceId)] = s
+		}
+	}
+	return statuses, nil
+}
+
+// statusChecks returns the instance's reported status checks.
+func (r *InstanceResource) statusChecks() []statusCheck {
+	if r.Status == nil {
+		return nil
+	}
+	var checks []statusCheck
+	if r.Status.SystemStatus != nil {
+		checks = append(checks, statusCheck{Name: "System", Summary: r.Status.SystemStatus})
+	}
+	if r.Status.InstanceStatus != nil {
+		checks = append(checks, statusCheck{Name: "Instance", Summary: r.Status.InstanceStatus})
+	}
+	if ebs := r.Status.AttachedEbsStatus; ebs != nil && ebs.Status != "" && ebs.Status != types.SummaryStatusNotApplicable {
+		details := make([]types.InstanceStatusDetails, len(ebs.Details))
+		for i, e := range ebs.Details {
+			details[i] = types.InstanceStatusDetails{ImpairedSince: e.ImpairedSince, Name: e.Name, Status: e.Status}
+		}
+		checks = append(checks, statusCheck{Name: "Attached EBS", Summary: &types.InstanceStatusSummary{Status: ebs.Status, Details: details}})
+	}
+	return checks
+}
+
+// StatusChecks summarizes the status checks, e.g. "2/2 passed", "1/2
+// passed" or "initializing". Empty when the instance is not running.
+func (r *InstanceResource) StatusChecks() string {
+	checks := r.statusChecks()
+	if len(checks) == 0 {
+		return ""
+	}
+	passed := 0
+	for _, c := range checks {
+		switch c.Summary.Status {
+		case types.SummaryStatusOk:
+			passed++
+		case types.SummaryStatusInitializing:
+			return "initializing"
+		}
+	}
+	return fmt.Sprintf("%d/%d passed", passed, len(checks))
+}
+
+// StatusChecksPassed reports whether every status check is ok.
+func (r *InstanceResource) StatusChecksPassed() bool {
+	checks := r.statusChecks()
+	for _, c := range checks {
+		if c.Summary.Status != types.SummaryStatusOk {
+			return false
+		}
+	}
+	return len(checks) > 0
+}
+
+// StatusChecksFailed reports whether a status check is impaired.
+func (r *InstanceResource) StatusChecksFailed() bool {
+	for _, c := range r.statusChecks() {
+		if c.Summary.Status == types.SummaryStatusImpaired {
+			return true
+		}
+	}
+	return false
+}
+
+// ScheduledEvents returns the instance's upcoming scheduled events;
+// completed and canceled ones are left out.
+func (r *InstanceResource) ScheduledEvents() []types.InstanceStatusEvent {
+	if r.Status == nil {
+		return nil
+	}
+	var events []types.InstanceStatusEvent
+	for _, e := range r.Status.Events {
+		desc := appaws.Str(e.Description)
+		if strings.HasPrefix(desc, "[Completed]") || strings.HasPrefix(desc, "[Canceled]") {
+			continue
+		}
+		events = append(events, e)
+	}
+	return events
+}
+
+// NextEvent describes the first upcoming scheduled event, e.g.
+// "system-reboot 2026-10-25".
+func (r *InstanceResource) NextEvent() string {
+	events := r.ScheduledEvents()
+	if len(events) == 0 {
+		return ""
+	}
+	e := events[0]
+	for _, other := range events[1:] {
+		if other.NotBefore != nil && (e.NotBefore == nil || other.NotBefore.Before(*e.NotBefore)) {
+			e = other
+		}
+	}
+	if e.NotBefore == nil {
+		return string(e.Code)
+	}
+	return string(e.Code) + " " + e.NotBefore.Format("2006-01-02")
+}
@@ -0,0 +1,47 @@
+package instances
+
+import (
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/usage"
+)
+
+func init() {
+	finder := func(relation string, refs func(*InstanceResource) []string) usage.Finder {
+		return usage.Finder{
+			Service:  "ec2",
+			Resource: "instances",
+			Relation: relation,
+			Refs: func(r dao.Resource) []string {
+				if ir, ok := r.(*InstanceResource); ok {
+					return refs(ir)
+				}
+				return nil
+			},
+		}
+	}
+
+	usage.Global.Register("ec2", "security-groups", finder("security group", func(ir *InstanceResource) []string {
+		var ids []string
+		for _, sg := range ir.Item.SecurityGroups {
+			ids = append(ids, appaws.Str(sg.GroupId))
+		}
+		return ids
+	}))
+	usage.Global.Register("vpc", "subnets", finder("subnet", func(ir *InstanceResource) []string {
+		ids := []string{appaws.Str(ir.Item.SubnetId)}
+		for _, eni := range ir.Item.NetworkInterfaces {
+			ids = append(ids, appaws.Str(eni.SubnetId))
+		}
+		return ids
+	}))
+	usage.Global.Register("iam", "roles", finder("instance profile role", func(ir *InstanceResource) []string {
+		return []string{ir.RoleName}
+	}))
+	usage.Global.Register("iam", "instance-profiles", finder("instance profile", func(ir *InstanceResource) []string {
+		if ir.Item.IamInstanceProfile == nil {
+			return nil
+		}
+		return []string{appaws.Str(ir.Item.IamInstanceProfile.Arn)}
+	}))
+}
@@ -0,0 +1,16 @@
+package networkinterfaces
+
+import (
+	"github.com/clawscli/claws/internal/action"
+)
+
+func init() {
+	action.Global.Register("ec2", "network-interfaces", []action.Action{
+		{
+			Name:     "Check Reachability",
+			Shortcut: "n",
+			Type:     action.ActionTypeView,
+			Target:   action.TargetReach,
+		},
+	})
+}
@@ -0,0 +1,179 @@
+package networkinterfaces
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+	"github.com/clawscli/claws/internal/reach"
+)
+
+// NetworkInterfaceDAO provides data access for elastic network interfaces
+type NetworkInterfaceDAO struct {
+	dao.BaseDAO
+	client *ec2.Client
+}
+
+// NewNetworkInterfaceDAO creates a new NetworkInterfaceDAO
+func NewNetworkInterfaceDAO(ctx context.Context) (dao.DAO, error) {
+	cfg, err := appaws.NewConfig(ctx)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new ec2/network-interfaces dao")
+	}
+	return &NetworkInterfaceDAO{
+		BaseDAO: dao.NewBaseDAO("ec2", "network-interfaces"),
+		client:  ec2.NewFromConfig(cfg),
+	}, nil
+}
+
+func (d *NetworkInterfaceDAO) List(ctx context.Context) ([]dao.Resource, error) {
+	input := &ec2.DescribeNetworkInterfacesInput{}
+	if vpcID := dao.GetFilterFromContext(ctx, "VpcId"); vpcID != "" {
+		input.Filters = append(input.Filters, types.Filter{
+			Name:   appaws.StringPtr("vpc-id"),
+			Values: []string{vpcID},
+		})
+	}
+	if subnetID := dao.GetFilterFromContext(ctx, "SubnetId"); subnetID != "" {
+		input.Filters = append(input.Filters, types.Filter{
+			Name:   appaws.StringPtr("subnet-id"),
+			Values: []string{subnetID},
+		})
+	}
+
+	paginator := ec2.NewDescribeNetworkInterfacesPaginator(d.client, input)
+
+	var resources []dao.Resource
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, apperrors.Wrap(err, "describe network interfaces")
+		}
+
+		for _, eni := range output.NetworkInterfaces {
+			resources = append(resources, NewNetworkInterfaceResource(eni))
+		}
+	}
+
+	return resources, nil
+}
+
+func (d *NetworkInterfaceDAO) Get(ctx context.Context, id string) (dao.Resource, error) {
+	output, err := d.client.DescribeNetworkInterfaces(ctx, &ec2.DescribeNetworkInterfacesInput{
+		NetworkInterfaceIds: []string{id},
+	})
+	if err != nil {
+		return nil, apperrors.Wrapf(err, "describe network interface %s", id)
+	}
+
+	if len(output.NetworkInterfaces) == 0 {
+		return nil, fmt.Errorf("network interface not found: %s", id)
+	}
+
+	return NewNetworkInterfaceResource(output.NetworkInterfaces[0]), nil
+}
+
+func (d *NetworkInterfaceDAO) Delete(ctx context.Context, id string) error {
+	_, err := d.client.DeleteNetworkInterface(ctx, &ec2.DeleteNetworkInterfaceInput{
+		NetworkInterfaceId: &id,
+	})
+	if err != nil {
+		if apperrors.IsNotFound(err) {
+			return nil // Already deleted
+		}
+		if apperrors.IsResourceInUse(err) {
+			return apperrors.Wrapf(err, "network interface %s is attached", id)
+		}
+		return apperrors.Wrapf(err, "delete network interface %s", id)
+	}
+
+	return nil
+}
+
+// NetworkInterfaceResource wraps an elastic network interface
+type NetworkInterfaceResource struct {
+	dao.BaseResource
+	Item types.NetworkInterface
+}
+
+// NewNetworkInterfaceResource creates a new NetworkInterfaceResource
+func NewNetworkInterfaceResource(eni types.NetworkInterface) *NetworkInterfaceResource {
+	name := appaws.EC2NameTag(eni.TagSet)
+	if name == "" {
+		name = appaws.Str(eni.Description)
+	}
+	return &NetworkInterfaceResource{
+		BaseResource: dao.BaseResource{
+			ID:   appaws.Str(eni.NetworkInterfaceId),
+			Name: name,
+			Tags: appaws.TagsToMap(eni.TagSet),
+			Data: eni,
+		},
+		Item: eni,
+	}
+}
+
+func (r *NetworkInterfaceResource) Status() string {
+	return string(r.Item.Status)
+}
+
+func (r *NetworkInterfaceResource) InterfaceType() string {
+	return string(r.Item.InterfaceType)
+}
+
+func (r *NetworkInterfaceResource) PrivateIP() string {
+	return appaws.Str(r.Item.PrivateIpAddress)
+}
+
+func (r *NetworkInterfaceResource) PublicIP() string {
+	if r.Item.Association != nil {
+		return appaws.Str(r.Item.Association.PublicIp)
+	}
+	return ""
+}
+
+func (r *NetworkInterfaceResource) VpcId() string {
+	return appaws.Str(r.Item.VpcId)
+}
+
+func (r *NetworkInterfaceResource) SubnetId() string {
+	return appaws.Str(r.Item.SubnetId)
+}
+
+// AttachedTo returns the instance ID or requester the interface is attached to
+func (r *NetworkInterfaceResource) AttachedTo() string {
+	if r.Item.Attachment != nil {
+		if id := appaws.Str(r.Item.Attachment.InstanceId); id != "" {
+			return id
+		}
+	}
+	return appaws.Str(r.Item.RequesterId)
+}
+
+// SecurityGroupIds returns the IDs of security groups attached to the interface
+func (r *NetworkInterfaceResource) SecurityGroupIds() []string {
+	ids := make([]string, 0, len(r.Item.Groups))
+	for _, g := range r.Item.Groups {
+		if g.GroupId != nil {
+			ids = append(ids, *g.GroupId)
+		}
+	}
+	return ids
+}
+
+// ReachEndpoint implements reach.Provider
+func (r *NetworkInterfaceResource) ReachEndpoint() reach.Endpoint {
+	return reach.Endpoint{
+		ID:             r.GetID(),
+		Name:           r.GetName(),
+		IP:             r.PrivateIP(),
+		SubnetID:       r.SubnetId(),
+		VpcID:          r.VpcId(),
+		SecurityGroups: r.SecurityGroupIds(),
+	}
+}
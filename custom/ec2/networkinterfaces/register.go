@@ -0,0 +1,20 @@
+package networkinterfaces
+
+import (
+	"context"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/registry"
+	"github.com/clawscli/claws/internal/render"
+)
+
+func init() {
+	registry.Global.RegisterCustom("ec2", "network-interfaces", registry.Entry{
+		DAOFactory: func(ctx context.Context) (dao.DAO, error) {
+			return NewNetworkInterfaceDAO(ctx)
+		},
+		RendererFactory: func() render.Renderer {
+			return NewNetworkInterfaceRenderer()
+		},
+	})
+}
@@ -0,0 +1,228 @@
+package networkinterfaces
+
+import (
+	"fmt"
+	"strings"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/render"
+)
+
+// NetworkInterfaceRenderer renders elastic network interfaces
+type NetworkInterfaceRenderer struct {
+	render.BaseRenderer
+}
+
+// NewNetworkInterfaceRenderer creates a new NetworkInterfaceRenderer
+func NewNetworkInterfaceRenderer() render.Renderer {
+	return &NetworkInterfaceRenderer{
+		BaseRenderer: render.BaseRenderer{
+			Service:  "ec2",
+			Resource: "network-interfaces",
+			Cols: []render.Column{
+				{
+					Name:  "ID",
+					Width: 22,
+					Getter: func(r dao.Resource) string {
+						return r.GetID()
+					},
+					Priority: 0,
+				},
+				{
+					Name:  "NAME",
+					Width: 30,
+					Getter: func(r dao.Resource) string {
+						return r.GetName()
+					},
+					Priority: 1,
+				},
+				{
+					Name:  "STATUS",
+					Width: 10,
+					Getter: func(r dao.Resource) string {
+						if e, ok := r.(*NetworkInterfaceResource); ok {
+							return e.Status()
+						}
+						return ""
+					},
+					Priority: 2,
+				},
+				{
+					Name:  "TYPE",
+					Width: 14,
+					Getter: func(r dao.Resource) string {
+						if e, ok := r.(*NetworkInterfaceResource); ok {
+							return e.InterfaceType()
+						}
+						return ""
+					},
+					Priority: 3,
+				},
+				{
+					Name:  "PRIVATE IP",
+					Width: 16,
+					Getter: func(r dao.Resource) string {
+						if e, ok := r.(*NetworkInterfaceResource); ok {
+							return e.PrivateIP()
+						}
+						return ""
+					},
+					Priority: 4,
+				},
+				{
+					Name:  "PUBLIC IP",
+					Width: 16,
+					Getter: func(r dao.Resource) string {
+						if e, ok := r.(*NetworkInterfaceResource); ok {
+							return e.PublicIP()
+						}
+						return ""
+					},
+					Priority: 5,
+				},
+				{
+					Name:  "SUBNET",
+					Width: 26,
+					Getter: func(r dao.Resource) string {
+						if e, ok := r.(*NetworkInterfaceResource); ok {
+							return e.SubnetId()
+						}
+						return ""
+					},
+					Priority: 6,
+				},
+				{
+					Name:  "ATTACHED TO",
+					Width: 22,
+					Getter: func(r dao.Resource) string {
+						if e, ok := r.(*NetworkInterfaceResource); ok {
+							return e.AttachedTo()
+						}
+						return ""
+					},
+					Priority: 7,
+				},
+				render.TagsColumn(25, 8),
+			},
+		},
+	}
+}
+
+// RenderDetail renders detailed network interface information
+func (r *NetworkInterfaceRenderer) RenderDetail(resource dao.Resource) string {
+	e, ok := resource.(*NetworkInterfaceResource)
+	if !ok {
+		return ""
+	}
+
+	d := render.NewDetailBuilder()
+
+	d.Title("Network Interface", e.GetID())
+
+	d.Section("Basic Information")
+	d.Field("Interface ID", e.GetID())
+	d.FieldIf("Description", e.Item.Description)
+	d.FieldStyled("Status", e.Status(), render.StateColorer()(e.Status()))
+	d.Field("Interface Type", e.InterfaceType())
+	d.FieldIf("Availability Zone", e.Item.AvailabilityZone)
+	d.FieldIf("MAC Address", e.Item.MacAddress)
+	if e.Item.RequesterManaged != nil && *e.Item.RequesterManaged {
+		d.Field("Requester Managed", "Yes")
+	}
+	d.FieldIf("Requester ID", e.Item.RequesterId)
+
+	d.Section("Network")
+	d.Field("VPC", e.VpcId())
+	d.Field("Subnet", e.SubnetId())
+	d.Field("Private IP", e.PrivateIP())
+	d.FieldIf("Private DNS", e.Item.PrivateDnsName)
+	if len(e.Item.PrivateIpAddresses) > 1 {
+		var ips []string
+		for _, ip := range e.Item.PrivateIpAddresses {
+			ips = append(ips, appaws.Str(ip.PrivateIpAddress))
+		}
+		d.Field("Private IPs", strings.Join(ips, ", "))
+	}
+	if pub := e.PublicIP(); pub != "" {
+		d.Field("Public IP", pub)
+	}
+	if e.Item.SourceDestCheck != nil && !*e.Item.SourceDestCheck {
+		d.Field("Source/Dest Check", "Disabled")
+	}
+
+	d.Section("Security Groups")
+	if len(e.Item.Groups) > 0 {
+		for _, g := range e.Item.Groups {
+			d.Field(appaws.Str(g.GroupId), appaws.Str(g.GroupName))
+		}
+	} else {
+		d.DimIndent("(none)")
+	}
+
+	if e.Item.Attachment != nil {
+		att := e.Item.Attachment
+		d.Section("Attachment")
+		d.FieldIf("Attachment ID", att.AttachmentId)
+		d.FieldIf("Instance", att.InstanceId)
+		d.FieldIf("Instance Owner", att.InstanceOwnerId)
+		d.Field("Status", string(att.Status))
+		if att.DeviceIndex != nil {
+			d.Field("Device Index", fmt.Sprintf("%d", *att.DeviceIndex))
+		}
+		if att.AttachTime != nil {
+			d.Field("Attach Time", att.AttachTime.Format("2006-01-02 15:04:05"))
+		}
+	}
+
+	d.Tags(appaws.TagsToMap(e.Item.TagSet))
+
+	return d.String()
+}
+
+// RenderSummary returns summary fields for the header panel
+func (r *NetworkInterfaceRenderer) RenderSummary(resource dao.Resource) []render.SummaryField {
+	e, ok := resource.(*NetworkInterfaceResource)
+	if !ok {
+		return nil
+	}
+
+	fields := []render.SummaryField{
+		{Label: "ID", Value: e.GetID()},
+		{Label: "Status", Value: e.Status(), Style: render.StateColorer()(e.Status())},
+		{Label: "Type", Value: e.InterfaceType()},
+		{Label: "Private IP", Value: e.PrivateIP()},
+	}
+	if pub := e.PublicIP(); pub != "" {
+		fields = append(fields, render.SummaryField{Label: "Public IP", Value: pub})
+	}
+	fields = append(fields, render.SummaryField{Label: "Subnet", Value: e.SubnetId()})
+	if attached := e.AttachedTo(); attached != "" {
+		fields = append(fields, render.SummaryField{Label: "Attached To", Value: attached})
+	}
+
+	return fields
+}
+
+// Navigations returns navigation shortcuts
+func (r *NetworkInterfaceRenderer) Navigations(resource dao.Resource) []render.Navigation {
+	e, ok := resource.(*NetworkInterfaceResource)
+	if !ok {
+		return nil
+	}
+
+	var navs []render.Navigation
+	if vpcID := e.VpcId(); vpcID != "" {
+		navs = append(navs,
+			render.Navigation{Key: "v", Label: "VPC", Service: "vpc", Resource: "vpcs", FilterField: "VpcId", FilterValue: vpcID},
+			render.Navigation{Key: "g", Label: "Security Groups", Service: "ec2", Resource: "security-groups", FilterField: "VpcId", FilterValue: vpcID},
+		)
+	}
+	if subnetID := e.SubnetId(); subnetID != "" {
+		navs = append(navs, render.Navigation{Key: "u", Label: "Subnet", Service: "vpc", Resource: "subnets", FilterField: "SubnetId", FilterValue: subnetID})
+	}
+	if e.Item.Attachment != nil && e.Item.Attachment.InstanceId != nil {
+		navs = append(navs, render.Navigation{Key: "e", Label: "Instance", Service: "ec2", Resource: "instances", FilterField: "InstanceId", FilterValue: *e.Item.Attachment.InstanceId})
+	}
+	return navs
+}
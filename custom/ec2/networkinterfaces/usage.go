@@ -0,0 +1,29 @@
+package networkinterfaces
+
+import (
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/usage"
+)
+
+func init() {
+	finder := func(relation string, refs func(*NetworkInterfaceResource) []string) usage.Finder {
+		return usage.Finder{
+			Service:  "ec2",
+			Resource: "network-interfaces",
+			Relation: relation,
+			Refs: func(r dao.Resource) []string {
+				if e, ok := r.(*NetworkInterfaceResource); ok {
+					return refs(e)
+				}
+				return nil
+			},
+		}
+	}
+
+	usage.Global.Register("ec2", "security-groups", finder("security group", func(e *NetworkInterfaceResource) []string {
+		return e.SecurityGroupIds()
+	}))
+	usage.Global.Register("vpc", "subnets", finder("subnet", func(e *NetworkInterfaceResource) []string {
+		return []string{e.SubnetId()}
+	}))
+}
@@ -0,0 +1,257 @@
+package securitygrouprules
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+	"github.com/clawscli/claws/internal/log"
+)
+
+// RuleDAO provides data access for the rules of a security group
+type RuleDAO struct {
+	dao.BaseDAO
+	client *ec2.Client
+}
+
+// NewRuleDAO creates a new RuleDAO
+func NewRuleDAO(ctx context.Context) (dao.DAO, error) {
+	cfg, err := appaws.NewConfig(ctx)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new ec2/security-group-rules dao")
+	}
+	return &RuleDAO{
+		BaseDAO: dao.NewBaseDAO("ec2", "security-group-rules"),
+		client:  ec2.NewFromConfig(cfg),
+	}, nil
+}
+
+// List returns the ingress and egress rules of the group, with referenced
+// security groups and prefix lists resolved to names
+func (d *RuleDAO) List(ctx context.Context) ([]dao.Resource, error) {
+	groupID := dao.GetFilterFromContext(ctx, "GroupId")
+	if groupID == "" {
+		return nil, fmt.Errorf("GroupId required: navigate from ec2/security-groups using 'r' key")
+	}
+
+	paginator := ec2.NewDescribeSecurityGroupRulesPaginator(d.client, &ec2.DescribeSecurityGroupRulesInput{
+		Filters: []types.Filter{{Name: appaws.StringPtr("group-id"), Values: []string{groupID}}},
+	})
+
+	var rules []types.SecurityGroupRule
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, apperrors.Wrapf(err, "describe security group rules for %s", groupID)
+		}
+		rules = append(rules, output.SecurityGroupRules...)
+	}
+
+	names := d.peerNames(ctx, rules)
+	resources := make([]dao.Resource, 0, len(rules))
+	for _, rule := range rules {
+		r := NewRuleResource(rule)
+		r.PeerName = names[r.Peer()]
+		resources = append(resources, r)
+	}
+	return resources, nil
+}
+
+// peerNames resolves referenced security groups and prefix lists to their
+// names. Lookups are best effort: groups in peered VPCs or other accounts
+// may not be describable.
+func (d *RuleDAO) peerNames(ctx context.Context, rules []types.SecurityGroupRule) map[string]string {
+	var groupIDs, prefixListIDs []string
+	seen := make(map[string]bool)
+	for _, rule := range rules {
+		if ref := rule.ReferencedGroupInfo; ref != nil && ref.GroupId != nil && !seen[*ref.GroupId] {
+			seen[*ref.GroupId] = true
+			groupIDs = append(groupIDs, *ref.GroupId)
+		}
+		if id := appaws.Str(rule.PrefixListId); id != "" && !seen[id] {
+			seen[id] = true
+			prefixListIDs = append(prefixListIDs, id)
+		}
+	}
+
+	names := make(map[string]string)
+	if len(groupIDs) > 0 {
+		output, err := d.client.DescribeSecurityGroups(ctx, &ec2.DescribeSecurityGroupsInput{GroupIds: groupIDs})
+		if err != nil {
+			log.Debug("failed to resolve referenced security groups", "error", err)
+		} else {
+			for _, sg := range output.SecurityGroups {
+				names[appaws.Str(sg.GroupId)] = appaws.Str(sg.GroupName)
+			}
+		}
+	}
+	if len(prefixListIDs) > 0 {
+		output, err := d.client.DescribeManagedPrefixLists(ctx, &ec2.DescribeManagedPrefixListsInput{PrefixListIds: prefixListIDs})
+		if err != nil {
+			log.Debug("failed to resolve prefix lists", "error", err)
+		} else {
+			for _, pl := range output.PrefixLists {
+				names[appaws.Str(pl.PrefixListId)] = appaws.Str(pl.PrefixListName)
+			}
+		}
+	}
+	return names
+}
+
+func (d *RuleDAO) Get(ctx context.Context, id string) (dao.Resource, error) {
+	return nil, fmt.Errorf("get by ID not supported for security group rules")
+}
+
+func (d *RuleDAO) Delete(ctx context.Context, id string) error {
+	return fmt.Errorf("delete not supported for security group rules")
+}
+
+func (d *RuleDAO) Supports(op dao.Operation) bool {
+	return op == dao.OpList
+}
+
+// RuleResource wraps a security group rule
+type RuleResource struct {
+	dao.BaseResource
+	Item types.SecurityGroupRule
+
+	// PeerName is the name of the referenced security group or prefix list
+	PeerName string
+}
+
+// NewRuleResource creates a new RuleResource
+func NewRuleResource(rule types.SecurityGroupRule) *RuleResource {
+	id := appaws.Str(rule.SecurityGroupRuleId)
+	return &RuleResource{
+		BaseResource: dao.BaseResource{
+			ID:   id,
+			Name: id,
+			Tags: appaws.TagsToMap(rule.Tags),
+			Data: rule,
+		},
+		Item: rule,
+	}
+}
+
+// Direction returns "inbound" or "outbound"
+func (r *RuleResource) Direction() string {
+	if appaws.Bool(r.Item.IsEgress) {
+		return "outbound"
+	}
+	return "inbound"
+}
+
+// Protocol returns the protocol name, "all" for every protocol
+func (r *RuleResource) Protocol() string {
+	switch p := appaws.Str(r.Item.IpProtocol); p {
+	case "-1", "":
+		return "all"
+	case "6":
+		return "tcp"
+	case "17":
+		return "udp"
+	case "1":
+		return "icmp"
+	default:
+		return p
+	}
+}
+
+// Ports returns the port range, "all" for every port. For ICMP the range
+// holds the type and code.
+func (r *RuleResource) Ports() string {
+	from, to := appaws.Int32(r.Item.FromPort), appaws.Int32(r.Item.ToPort)
+	switch {
+	case r.Protocol() == "all" || from == -1 || (from == 0 && to == 65535):
+		return "all"
+	case r.Protocol() == "icmp":
+		return fmt.Sprintf("type %d code %d", from, to)
+	case from == to:
+		return fmt.Sprintf("%d", from)
+	default:
+		return fmt.Sprintf("%d-%d", from, to)
+	}
+}
+
+// Peer returns the source (inbound) or destination (outbound): a CIDR, a
+// security group ID or a prefix list ID
+func (r *RuleResource) Peer() string {
+	switch {
+	case r.Item.CidrIpv4 != nil:
+		return *r.Item.CidrIpv4
+	case r.Item.CidrIpv6 != nil:
+		return *r.Item.CidrIpv6
+	case r.Item.ReferencedGroupInfo != nil:
+		ref := r.Item.ReferencedGroupInfo
+		id := appaws.Str(ref.GroupId)
+		if owner := appaws.Str(ref.UserId); owner != "" && owner != appaws.Str(r.Item.GroupOwnerId) {
+			id = owner + "/" + id
+		}
+		return id
+	case r.Item.PrefixListId != nil:
+		return *r.Item.PrefixListId
+	}
+	return ""
+}
+
+// Description returns the rule description
+func (r *RuleResource) Description() string {
+	return appaws.Str(r.Item.Description)
+}
+
+// sensitivePorts are services that should not be reachable from the internet
+var sensitivePorts = map[int32]string{
+	20:    "FTP data",
+	21:    "FTP",
+	22:    "SSH",
+	23:    "Telnet",
+	135:   "RPC",
+	445:   "SMB",
+	1433:  "SQL Server",
+	1521:  "Oracle",
+	2375:  "Docker",
+	3306:  "MySQL",
+	3389:  "RDP",
+	5432:  "PostgreSQL",
+	5601:  "Kibana",
+	5900:  "VNC",
+	6379:  "Redis",
+	9200:  "Elasticsearch",
+	11211: "Memcached",
+	27017: "MongoDB",
+}
+
+// OpenToWorld returns whether an inbound rule allows any IPv4 or IPv6 address
+func (r *RuleResource) OpenToWorld() bool {
+	if appaws.Bool(r.Item.IsEgress) {
+		return false
+	}
+	return appaws.Str(r.Item.CidrIpv4) == "0.0.0.0/0" || appaws.Str(r.Item.CidrIpv6) == "::/0"
+}
+
+// ExposedServices returns the sensitive services an inbound rule open to
+// the world exposes, or "all ports" when every port is open
+func (r *RuleResource) ExposedServices() []string {
+	if !r.OpenToWorld() || r.Protocol() == "icmp" {
+		return nil
+	}
+	if r.Ports() == "all" {
+		return []string{"all ports"}
+	}
+	from, to := appaws.Int32(r.Item.FromPort), appaws.Int32(r.Item.ToPort)
+	var exposed []string
+	for port := from; port <= to && port > 0; port++ {
+		if name, ok := sensitivePorts[port]; ok {
+			exposed = append(exposed, fmt.Sprintf("%s (%d)", name, port))
+		}
+		if port == 65535 {
+			break
+		}
+	}
+	return exposed
+}
@@ -0,0 +1,200 @@
+package securitygrouprules
+
+import (
+	"strings"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/render"
+)
+
+// Ensure RuleRenderer implements render.Navigator
+var _ render.Navigator = (*RuleRenderer)(nil)
+
+// RuleRenderer renders security group rules
+type RuleRenderer struct {
+	render.BaseRenderer
+}
+
+// NewRuleRenderer creates a new RuleRenderer
+func NewRuleRenderer() render.Renderer {
+	return &RuleRenderer{
+		BaseRenderer: render.BaseRenderer{
+			Service:  "ec2",
+			Resource: "security-group-rules",
+			Cols: []render.Column{
+				{
+					Name:  "DIR",
+					Width: 9,
+					Getter: func(r dao.Resource) string {
+						if rule, ok := r.(*RuleResource); ok {
+							return rule.Direction()
+						}
+						return ""
+					},
+					Priority: 0,
+				},
+				{
+					Name:  "PROTOCOL",
+					Width: 9,
+					Getter: func(r dao.Resource) string {
+						if rule, ok := r.(*RuleResource); ok {
+							return rule.Protocol()
+						}
+						return ""
+					},
+					Priority: 1,
+				},
+				{
+					Name:  "PORTS",
+					Width: 12,
+					Getter: func(r dao.Resource) string {
+						if rule, ok := r.(*RuleResource); ok {
+							return rule.Ports()
+						}
+						return ""
+					},
+					Priority: 2,
+				},
+				{
+					Name:  "PEER",
+					Width: 26,
+					Getter: func(r dao.Resource) string {
+						if rule, ok := r.(*RuleResource); ok {
+							return rule.Peer()
+						}
+						return ""
+					},
+					Priority: 3,
+				},
+				{
+					Name:  "PEER NAME",
+					Width: 24,
+					Getter: func(r dao.Resource) string {
+						if rule, ok := r.(*RuleResource); ok {
+							return rule.PeerName
+						}
+						return ""
+					},
+					Priority: 5,
+				},
+				{
+					Name:  "RISK",
+					Width: 8,
+					Getter: func(r dao.Resource) string {
+						if rule, ok := r.(*RuleResource); ok && len(rule.ExposedServices()) > 0 {
+							return "⚠ open"
+						}
+						return ""
+					},
+					Style:    render.DangerStyle(),
+					Priority: 4,
+				},
+				{
+					Name:  "DESCRIPTION",
+					Width: 36,
+					Getter: func(r dao.Resource) string {
+						if rule, ok := r.(*RuleResource); ok {
+							return rule.Description()
+						}
+						return ""
+					},
+					Priority: 6,
+				},
+				{
+					Name:  "RULE ID",
+					Width: 24,
+					Getter: func(r dao.Resource) string {
+						return r.GetID()
+					},
+					Priority: 7,
+				},
+			},
+		},
+	}
+}
+
+// RenderDetail renders detailed security group rule information
+func (r *RuleRenderer) RenderDetail(resource dao.Resource) string {
+	rule, ok := resource.(*RuleResource)
+	if !ok {
+		return ""
+	}
+
+	d := render.NewDetailBuilder()
+
+	d.Title("Security Group Rule", rule.GetID())
+
+	d.Section("Rule")
+	d.Field("Rule ID", rule.GetID())
+	d.FieldIf("Group ID", rule.Item.GroupId)
+	d.Field("Direction", rule.Direction())
+	d.Field("Protocol", rule.Protocol())
+	d.Field("Ports", rule.Ports())
+	d.Field("Description", rule.Description())
+
+	d.Section("Peer")
+	switch {
+	case rule.Item.ReferencedGroupInfo != nil:
+		d.Field("Security Group", appaws.Str(rule.Item.ReferencedGroupInfo.GroupId))
+		d.Field("Group Name", rule.PeerName)
+		d.FieldIf("Owner", rule.Item.ReferencedGroupInfo.UserId)
+		d.FieldIf("VPC", rule.Item.ReferencedGroupInfo.VpcId)
+		d.FieldIf("Peering Connection", rule.Item.ReferencedGroupInfo.VpcPeeringConnectionId)
+	case rule.Item.PrefixListId != nil:
+		d.Field("Prefix List", appaws.Str(rule.Item.PrefixListId))
+		d.Field("Prefix List Name", rule.PeerName)
+	default:
+		d.Field("CIDR", rule.Peer())
+	}
+
+	if exposed := rule.ExposedServices(); len(exposed) > 0 {
+		d.Section("Exposure")
+		d.FieldStyled("Open To", rule.Peer()+" (internet)", render.DangerStyle())
+		d.FieldStyled("Services", strings.Join(exposed, ", "), render.DangerStyle())
+	}
+
+	d.Tags(rule.GetTags())
+
+	return d.String()
+}
+
+// RenderSummary returns summary fields for the header panel
+func (r *RuleRenderer) RenderSummary(resource dao.Resource) []render.SummaryField {
+	rule, ok := resource.(*RuleResource)
+	if !ok {
+		return r.BaseRenderer.RenderSummary(resource)
+	}
+
+	fields := []render.SummaryField{
+		{Label: "Rule ID", Value: rule.GetID()},
+		{Label: "Direction", Value: rule.Direction()},
+		{Label: "Protocol", Value: rule.Protocol()},
+		{Label: "Ports", Value: rule.Ports()},
+		{Label: "Peer", Value: rule.Peer()},
+	}
+	if rule.PeerName != "" {
+		fields = append(fields, render.SummaryField{Label: "Peer Name", Value: rule.PeerName})
+	}
+	if exposed := rule.ExposedServices(); len(exposed) > 0 {
+		fields = append(fields, render.SummaryField{Label: "Exposed", Value: strings.Join(exposed, ", "), Style: render.DangerStyle()})
+	}
+	return fields
+}
+
+// Navigations returns navigation shortcuts for security group rules
+func (r *RuleRenderer) Navigations(resource dao.Resource) []render.Navigation {
+	rule, ok := resource.(*RuleResource)
+	if !ok {
+		return nil
+	}
+
+	var navs []render.Navigation
+	if ref := rule.Item.ReferencedGroupInfo; ref != nil && ref.GroupId != nil {
+		navs = append(navs, render.Navigation{
+			Key: "g", Label: "Peer Group", Service: "ec2", Resource: "security-groups",
+			FilterField: "GroupId", FilterValue: *ref.GroupId,
+		})
+	}
+	return navs
+}
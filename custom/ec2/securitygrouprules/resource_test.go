@@ -0,0 +1,141 @@
+package securitygrouprules
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+)
+
+func TestRuleResource(t *testing.T) {
+	tests := []struct {
+		name      string
+		rule      types.SecurityGroupRule
+		direction string
+		protocol  string
+		ports     string
+		peer      string
+		exposed   []string
+	}{
+		{
+			name: "ssh open to the world",
+			rule: types.SecurityGroupRule{
+				IsEgress: aws.Bool(false), IpProtocol: aws.String("tcp"),
+				FromPort: aws.Int32(22), ToPort: aws.Int32(22), CidrIpv4: aws.String("0.0.0.0/0"),
+			},
+			direction: "inbound", protocol: "tcp", ports: "22", peer: "0.0.0.0/0",
+			exposed: []string{"SSH (22)"},
+		},
+		{
+			name: "range over ipv6 covers databases",
+			rule: types.SecurityGroupRule{
+				IsEgress: aws.Bool(false), IpProtocol: aws.String("tcp"),
+				FromPort: aws.Int32(3000), ToPort: aws.Int32(3400), CidrIpv6: aws.String("::/0"),
+			},
+			direction: "inbound", protocol: "tcp", ports: "3000-3400", peer: "::/0",
+			exposed: []string{"MySQL (3306)", "RDP (3389)"},
+		},
+		{
+			name: "https open to the world is fine",
+			rule: types.SecurityGroupRule{
+				IsEgress: aws.Bool(false), IpProtocol: aws.String("tcp"),
+				FromPort: aws.Int32(443), ToPort: aws.Int32(443), CidrIpv4: aws.String("0.0.0.0/0"),
+			},
+			direction: "inbound", protocol: "tcp", ports: "443", peer: "0.0.0.0/0",
+		},
+		{
+			name: "all traffic from the world",
+			rule: types.SecurityGroupRule{
+				IsEgress: aws.Bool(false), IpProtocol: aws.String("-1"),
+				FromPort: aws.Int32(-1), ToPort: aws.Int32(-1), CidrIpv4: aws.String("0.0.0.0/0"),
+			},
+			direction: "inbound", protocol: "all", ports: "all", peer: "0.0.0.0/0",
+			exposed: []string{"all ports"},
+		},
+		{
+			name: "egress to the world is not flagged",
+			rule: types.SecurityGroupRule{
+				IsEgress: aws.Bool(true), IpProtocol: aws.String("-1"),
+				FromPort: aws.Int32(-1), ToPort: aws.Int32(-1), CidrIpv4: aws.String("0.0.0.0/0"),
+			},
+			direction: "outbound", protocol: "all", ports: "all", peer: "0.0.0.0/0",
+		},
+		{
+			name: "cross-account group reference",
+			rule: types.SecurityGroupRule{
+				IsEgress: aws.Bool(false), IpProtocol: aws.String("tcp"), GroupOwnerId: aws.String("111111111111"),
+				FromPort: aws.Int32(5432), ToPort: aws.Int32(5432),
+				ReferencedGroupInfo: &types.ReferencedSecurityGroup{GroupId: aws.String("sg-peer"), UserId: aws.String("222222222222")},
+			},
+			direction: "inbound", protocol: "tcp", ports: "5432", peer: "222222222222/sg-peer",
+		},
+		{
+			name: "prefix list",
+			rule: types.SecurityGroupRule{
+				IsEgress: aws.Bool(true), IpProtocol: aws.String("tcp"),
+				FromPort: aws.Int32(443), ToPort: aws.Int32(443), PrefixListId: aws.String("pl-123"),
+			},
+			direction: "outbound", protocol: "tcp", ports: "443", peer: "pl-123",
+		},
+		{
+			name: "icmp echo",
+			rule: types.SecurityGroupRule{
+				IsEgress: aws.Bool(false), IpProtocol: aws.String("icmp"),
+				FromPort: aws.Int32(8), ToPort: aws.Int32(0), CidrIpv4: aws.String("0.0.0.0/0"),
+			},
+			direction: "inbound", protocol: "icmp", ports: "type 8 code 0", peer: "0.0.0.0/0",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := NewRuleResource(tt.rule)
+			if got := r.Direction(); got != tt.direction {
+				t.Errorf("Direction() = %q, want %q", got, tt.direction)
+			}
+			if got := r.Protocol(); got != tt.protocol {
+				t.Errorf("Protocol() = %q, want %q", got, tt.protocol)
+			}
+			if got := r.Ports(); got != tt.ports {
+				t.Errorf("Ports() = %q, want %q", got, tt.ports)
+			}
+			if got := r.Peer(); got != tt.peer {
+				t.Errorf("Peer() = %q, want %q", got, tt.peer)
+			}
+			if got := r.ExposedServices(); !reflect.DeepEqual(got, tt.exposed) {
+				t.Errorf("ExposedServices() = %v, want %v", got, tt.exposed)
+			}
+		})
+	}
+}
+
+func TestRuleRendererDetail(t *testing.T) {
+	r := NewRuleResource(types.SecurityGroupRule{
+		SecurityGroupRuleId: aws.String("sgr-1"),
+		GroupId:             aws.String("sg-1"),
+		IsEgress:            aws.Bool(false),
+		IpProtocol:          aws.String("tcp"),
+		FromPort:            aws.Int32(22),
+		ToPort:              aws.Int32(22),
+		CidrIpv4:            aws.String("0.0.0.0/0"),
+		Description:         aws.String("bastion"),
+	})
+
+	detail := NewRuleRenderer().RenderDetail(r)
+	for _, want := range []string{"sgr-1", "sg-1", "bastion", "Exposure", "SSH (22)"} {
+		if !strings.Contains(detail, want) {
+			t.Errorf("detail missing %q:\n%s", want, detail)
+		}
+	}
+
+	peer := NewRuleResource(types.SecurityGroupRule{
+		ReferencedGroupInfo: &types.ReferencedSecurityGroup{GroupId: aws.String("sg-app")},
+	})
+	peer.PeerName = "app"
+	navs := NewRuleRenderer().(*RuleRenderer).Navigations(peer)
+	if len(navs) != 1 || navs[0].FilterValue != "sg-app" {
+		t.Errorf("Navigations() = %+v, want peer group navigation", navs)
+	}
+}
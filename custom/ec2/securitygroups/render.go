@@ -267,7 +267,12 @@ func (r *SecurityGroupRenderer) Navigations(resource dao.Resource) []render.Navi
 		return nil
 	}
 
-	var navs []render.Navigation
+	navs := []render.Navigation{
+		{
+			Key: "r", Label: "Rules", Service: "ec2", Resource: "security-group-rules",
+			FilterField: "GroupId", FilterValue: sg.GetID(),
+		},
+	}
 
 	// VPC navigation
 	if sg.Item.VpcId != nil {
@@ -0,0 +1,33 @@
+package securitygroups
+
+import (
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/usage"
+)
+
+func init() {
+	usage.Global.Register("ec2", "security-groups", usage.Finder{
+		Service:  "ec2",
+		Resource: "security-groups",
+		Relation: "rule source/destination",
+		Refs: func(r dao.Resource) []string {
+			sg, ok := r.(*SecurityGroupResource)
+			if !ok {
+				return nil
+			}
+			var ids []string
+			for _, perms := range sg.Item.IpPermissions {
+				for _, pair := range perms.UserIdGroupPairs {
+					ids = append(ids, appaws.Str(pair.GroupId))
+				}
+			}
+			for _, perms := range sg.Item.IpPermissionsEgress {
+				for _, pair := range perms.UserIdGroupPairs {
+					ids = append(ids, appaws.Str(pair.GroupId))
+				}
+			}
+			return ids
+		},
+	})
+}
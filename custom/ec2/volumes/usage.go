@@ -0,0 +1,21 @@
+package volumes
+
+import (
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/usage"
+)
+
+func init() {
+	usage.Global.Register("kms", "keys", usage.Finder{
+		Service:  "ec2",
+		Resource: "volumes",
+		Relation: "volume encryption",
+		Refs: func(r dao.Resource) []string {
+			if v, ok := r.(*VolumeResource); ok {
+				return []string{appaws.Str(v.Item.KmsKeyId)}
+			}
+			return nil
+		},
+	})
+}
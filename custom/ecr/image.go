@@ -0,0 +1,12 @@
+package ecr
+
+import "strings"
+
+// FilterImage is the filter holding an image reference: "repository@digest".
+const FilterImage = "ImageRef"
+
+// SplitImageRef splits "repository@digest" into its repository and digest.
+func SplitImageRef(ref string) (repository, digest string) {
+	repository, digest, _ = strings.Cut(ref, "@")
+	return repository, digest
+}
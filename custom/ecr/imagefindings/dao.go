@@ -0,0 +1,202 @@
+package imagefindings
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/ecr"
+	"github.com/aws/aws-sdk-go-v2/service/ecr/types"
+
+	appecr "github.com/clawscli/claws/custom/ecr"
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+)
+
+// FindingDAO provides data access for the scan findings of an ECR image
+type FindingDAO struct {
+	dao.BaseDAO
+	client *ecr.Client
+}
+
+// NewFindingDAO creates a new FindingDAO
+func NewFindingDAO(ctx context.Context) (dao.DAO, error) {
+	client, err := appecr.GetClient(ctx)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new ecr/image-findings dao")
+	}
+	return &FindingDAO{
+		BaseDAO: dao.NewBaseDAO("ecr", "image-findings"),
+		client:  client,
+	}, nil
+}
+
+// List returns one finding per vulnerability and affected package, most
+// severe first. Both basic and enhanced (Inspector) scan results are read.
+func (d *FindingDAO) List(ctx context.Context) ([]dao.Resource, error) {
+	repo, digest := appecr.SplitImageRef(dao.GetFilterFromContext(ctx, appecr.FilterImage))
+	if digest == "" {
+		return nil, fmt.Errorf("%s required: navigate from ecr/images using 'f' key", appecr.FilterImage)
+	}
+
+	paginator := ecr.NewDescribeImageScanFindingsPaginator(d.client, &ecr.DescribeImageScanFindingsInput{
+		RepositoryName: &repo,
+		ImageId:        &types.ImageIdentifier{ImageDigest: &digest},
+	})
+
+	var findings []*FindingResource
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, apperrors.Wrapf(err, "describe scan findings for %s@%s", repo, digest)
+		}
+		if output.ImageScanFindings == nil {
+			continue
+		}
+		for _, f := range output.ImageScanFindings.Findings {
+			findings = append(findings, NewBasicFindingResource(f))
+		}
+		for _, f := range output.ImageScanFindings.EnhancedFindings {
+			findings = append(findings, NewEnhancedFindingResources(f)...)
+		}
+	}
+
+	sort.SliceStable(findings, func(i, j int) bool {
+		ri, rj := appecr.SeverityRank(findings[i].Severity), appecr.SeverityRank(findings[j].Severity)
+		if ri != rj {
+			return ri < rj
+		}
+		if findings[i].Score != findings[j].Score {
+			return findings[i].Score > findings[j].Score
+		}
+		return findings[i].CVE < findings[j].CVE
+	})
+
+	resources := make([]dao.Resource, len(findings))
+	for i, f := range findings {
+		resources[i] = f
+	}
+	return resources, nil
+}
+
+func (d *FindingDAO) Get(ctx context.Context, id string) (dao.Resource, error) {
+	return nil, fmt.Errorf("get by ID not supported for image findings")
+}
+
+func (d *FindingDAO) Delete(ctx context.Context, id string) error {
+	return fmt.Errorf("delete not supported for image findings")
+}
+
+func (d *FindingDAO) Supports(op dao.Operation) bool {
+	return op == dao.OpList
+}
+
+// FindingResource is one vulnerability in one package of an image
+type FindingResource struct {
+	dao.BaseResource
+
+	CVE            string
+	Severity       string
+	Title          string
+	Description    string
+	URI            string
+	Score          float64
+	Package        string
+	Version        string
+	FixedIn        string
+	PackageManager string
+	FilePath       string
+	Status         string // enhanced findings only, e.g. ACTIVE
+	Exploitable    string // enhanced findings only: YES or NO
+	Enhanced       bool
+}
+
+func newFindingResource(f *FindingResource, data any) *FindingResource {
+	id := f.CVE
+	if f.Package != "" {
+		id += " " + f.Package
+	}
+	f.BaseResource = dao.BaseResource{ID: id, Name: f.CVE, Data: data}
+	return f
+}
+
+// NewBasicFindingResource creates a FindingResource from a basic scan finding
+func NewBasicFindingResource(f types.ImageScanFinding) *FindingResource {
+	r := &FindingResource{
+		CVE:         appaws.Str(f.Name),
+		Severity:    string(f.Severity),
+		Description: appaws.Str(f.Description),
+		URI:         appaws.Str(f.Uri),
+	}
+	for _, attr := range f.Attributes {
+		switch appaws.Str(attr.Key) {
+		case "package_name":
+			r.Package = appaws.Str(attr.Value)
+		case "package_version":
+			r.Version = appaws.Str(attr.Value)
+		case "CVSS3_SCORE", "CVSS2_SCORE":
+			if score, err := strconv.ParseFloat(appaws.Str(attr.Value), 64); err == nil && r.Score == 0 {
+				r.Score = score
+			}
+		}
+	}
+	return newFindingResource(r, f)
+}
+
+// NewEnhancedFindingResources creates one FindingResource per vulnerable
+// package of an enhanced (Inspector) finding
+func NewEnhancedFindingResources(f types.EnhancedImageScanFinding) []*FindingResource {
+	base := FindingResource{
+		CVE:         appaws.Str(f.Title),
+		Severity:    appaws.Str(f.Severity),
+		Title:       appaws.Str(f.Title),
+		Description: appaws.Str(f.Description),
+		Score:       f.Score,
+		Status:      appaws.Str(f.Status),
+		Exploitable: appaws.Str(f.ExploitAvailable),
+		Enhanced:    true,
+	}
+	details := f.PackageVulnerabilityDetails
+	if details == nil {
+		return []*FindingResource{newFindingResource(&base, f)}
+	}
+	if id := appaws.Str(details.VulnerabilityId); id != "" {
+		base.CVE = id
+	}
+	base.URI = appaws.Str(details.SourceUrl)
+	if len(details.VulnerablePackages) == 0 {
+		return []*FindingResource{newFindingResource(&base, f)}
+	}
+
+	resources := make([]*FindingResource, 0, len(details.VulnerablePackages))
+	for _, pkg := range details.VulnerablePackages {
+		r := base
+		r.Package = appaws.Str(pkg.Name)
+		r.Version = packageVersion(pkg)
+		r.FixedIn = appaws.Str(pkg.FixedInVersion)
+		r.PackageManager = appaws.Str(pkg.PackageManager)
+		r.FilePath = appaws.Str(pkg.FilePath)
+		resources = append(resources, newFindingResource(&r, f))
+	}
+	return resources
+}
+
+// packageVersion joins version and release as package managers show them
+func packageVersion(pkg types.VulnerablePackage) string {
+	version := appaws.Str(pkg.Version)
+	if release := appaws.Str(pkg.Release); release != "" {
+		version += "-" + release
+	}
+	if epoch := appaws.Int32(pkg.Epoch); epoch > 0 {
+		version = fmt.Sprintf("%d:%s", epoch, version)
+	}
+	return version
+}
+
+// Fixable returns whether a fixed package version is known
+func (r *FindingResource) Fixable() bool {
+	return r.FixedIn != "" && !strings.EqualFold(r.FixedIn, "NotAvailable")
+}
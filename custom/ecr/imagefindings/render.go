@@ -0,0 +1,169 @@
+package imagefindings
+
+import (
+	"fmt"
+
+	appecr "github.com/clawscli/claws/custom/ecr"
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/render"
+)
+
+// FindingRenderer renders ECR image scan findings
+type FindingRenderer struct {
+	render.BaseRenderer
+}
+
+// NewFindingRenderer creates a new FindingRenderer
+func NewFindingRenderer() render.Renderer {
+	return &FindingRenderer{
+		BaseRenderer: render.BaseRenderer{
+			Service:  "ecr",
+			Resource: "image-findings",
+			Cols: []render.Column{
+				{
+					Name:  "SEVERITY",
+					Width: 13,
+					Getter: func(r dao.Resource) string {
+						if f, ok := r.(*FindingResource); ok {
+							return f.Severity
+						}
+						return ""
+					},
+					Priority: 0,
+				},
+				{
+					Name:  "CVE",
+					Width: 20,
+					Getter: func(r dao.Resource) string {
+						return r.GetName()
+					},
+					Priority: 1,
+				},
+				{
+					Name:  "PACKAGE",
+					Width: 25,
+					Getter: func(r dao.Resource) string {
+						if f, ok := r.(*FindingResource); ok {
+							return f.Package
+						}
+						return ""
+					},
+					Priority: 2,
+				},
+				{
+					Name:  "VERSION",
+					Width: 20,
+					Getter: func(r dao.Resource) string {
+						if f, ok := r.(*FindingResource); ok {
+							return f.Version
+						}
+						return ""
+					},
+					Priority: 3,
+				},
+				{
+					Name:  "FIXED IN",
+					Width: 20,
+					Getter: func(r dao.Resource) string {
+						if f, ok := r.(*FindingResource); ok && f.Fixable() {
+							return f.FixedIn
+						}
+						return ""
+					},
+					Priority: 4,
+				},
+				{
+					Name:  "SCORE",
+					Width: 6,
+					Getter: func(r dao.Resource) string {
+						if f, ok := r.(*FindingResource); ok && f.Score > 0 {
+							return fmt.Sprintf("%.1f", f.Score)
+						}
+						return ""
+					},
+					Priority: 5,
+				},
+			},
+		},
+	}
+}
+
+// RenderDetail renders detailed finding information
+func (r *FindingRenderer) RenderDetail(resource dao.Resource) string {
+	f, ok := resource.(*FindingResource)
+	if !ok {
+		return ""
+	}
+
+	d := render.NewDetailBuilder()
+
+	d.Title("Image Scan Finding", f.CVE)
+
+	d.Section("Vulnerability")
+	d.Field("ID", f.CVE)
+	if f.Title != "" && f.Title != f.CVE {
+		d.Field("Title", f.Title)
+	}
+	d.FieldStyled("Severity", f.Severity, appecr.SeverityStyle(f.Severity))
+	if f.Score > 0 {
+		d.Field("Score", fmt.Sprintf("%.1f", f.Score))
+	}
+	if f.Enhanced {
+		d.Field("Scan Type", "Enhanced (Inspector)")
+		if f.Status != "" {
+			d.Field("Status", f.Status)
+		}
+		if f.Exploitable != "" {
+			d.Field("Exploit Available", f.Exploitable)
+		}
+	} else {
+		d.Field("Scan Type", "Basic")
+	}
+	if f.URI != "" {
+		d.Field("Reference", f.URI)
+	}
+
+	if f.Package != "" {
+		d.Section("Package")
+		d.Field("Name", f.Package)
+		d.Field("Installed Version", f.Version)
+		if f.Fixable() {
+			d.FieldStyled("Fixed In", f.FixedIn, render.SuccessStyle())
+		} else {
+			d.FieldStyled("Fixed In", "no fix available", render.DimStyle())
+		}
+		if f.PackageManager != "" {
+			d.Field("Package Manager", f.PackageManager)
+		}
+		if f.FilePath != "" {
+			d.Field("File Path", f.FilePath)
+		}
+	}
+
+	if f.Description != "" {
+		d.Section("Description")
+		d.Line("  " + f.Description)
+	}
+
+	return d.String()
+}
+
+// RenderSummary returns summary fields for the header panel
+func (r *FindingRenderer) RenderSummary(resource dao.Resource) []render.SummaryField {
+	f, ok := resource.(*FindingResource)
+	if !ok {
+		return r.BaseRenderer.RenderSummary(resource)
+	}
+
+	fields := []render.SummaryField{
+		{Label: "CVE", Value: f.CVE},
+		{Label: "Severity", Value: f.Severity, Style: appecr.SeverityStyle(f.Severity)},
+	}
+	if f.Package != "" {
+		fields = append(fields, render.SummaryField{Label: "Package", Value: f.Package + " " + f.Version})
+	}
+	if f.Fixable() {
+		fields = append(fields, render.SummaryField{Label: "Fixed In", Value: f.FixedIn, Style: render.SuccessStyle()})
+	}
+	return fields
+}
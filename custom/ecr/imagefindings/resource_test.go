@@ -0,0 +1,67 @@
+package imagefindings
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ecr/types"
+)
+
+func TestNewBasicFindingResource(t *testing.T) {
+	f := NewBasicFindingResource(types.ImageScanFinding{
+		Name:     aws.String("CVE-2024-0001"),
+		Severity: types.FindingSeverityHigh,
+		Uri:      aws.String("https://security-tracker.debian.org/tracker/CVE-2024-0001"),
+		Attributes: []types.Attribute{
+			{Key: aws.String("package_name"), Value: aws.String("openssl")},
+			{Key: aws.String("package_version"), Value: aws.String("3.0.11-1")},
+			{Key: aws.String("CVSS3_SCORE"), Value: aws.String("7.5")},
+		},
+	})
+
+	if f.GetID() != "CVE-2024-0001 openssl" || f.GetName() != "CVE-2024-0001" {
+		t.Errorf("id = %q, name = %q", f.GetID(), f.GetName())
+	}
+	if f.Package != "openssl" || f.Version != "3.0.11-1" || f.Score != 7.5 || f.Severity != "HIGH" {
+		t.Errorf("package = %q, version = %q, score = %v, severity = %q", f.Package, f.Version, f.Score, f.Severity)
+	}
+	if f.Fixable() || f.Enhanced {
+		t.Error("basic findings carry no fix version")
+	}
+}
+
+func TestNewEnhancedFindingResources(t *testing.T) {
+	findings := NewEnhancedFindingResources(types.EnhancedImageScanFinding{
+		Title:            aws.String("CVE-2024-0002 - libxml2, zlib"),
+		Severity:         aws.String("CRITICAL"),
+		Score:            9.8,
+		Status:           aws.String("ACTIVE"),
+		ExploitAvailable: aws.String("YES"),
+		PackageVulnerabilityDetails: &types.PackageVulnerabilityDetails{
+			VulnerabilityId: aws.String("CVE-2024-0002"),
+			VulnerablePackages: []types.VulnerablePackage{
+				{Name: aws.String("libxml2"), Version: aws.String("2.9.14"), Release: aws.String("1.el9"), Epoch: aws.Int32(1), FixedInVersion: aws.String("1:2.9.14-2.el9")},
+				{Name: aws.String("zlib"), Version: aws.String("1.2.11"), FixedInVersion: aws.String("NotAvailable")},
+			},
+		},
+	})
+
+	if len(findings) != 2 {
+		t.Fatalf("got %d findings, want one per package", len(findings))
+	}
+	libxml, zlib := findings[0], findings[1]
+	if libxml.CVE != "CVE-2024-0002" || libxml.Version != "1:2.9.14-1.el9" || !libxml.Fixable() {
+		t.Errorf("libxml2: cve = %q, version = %q, fixable = %v", libxml.CVE, libxml.Version, libxml.Fixable())
+	}
+	if zlib.GetID() != "CVE-2024-0002 zlib" || zlib.Fixable() {
+		t.Errorf("zlib: id = %q, fixable = %v", zlib.GetID(), zlib.Fixable())
+	}
+
+	detail := NewFindingRenderer().RenderDetail(libxml)
+	for _, want := range []string{"CVE-2024-0002", "libxml2", "1:2.9.14-2.el9", "CRITICAL", "9.8"} {
+		if !strings.Contains(detail, want) {
+			t.Errorf("detail missing %q:\n%s", want, detail)
+		}
+	}
+}
@@ -0,0 +1,62 @@
+package images
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/ecr"
+	"github.com/aws/aws-sdk-go-v2/service/ecr/types"
+
+	appecr "github.com/clawscli/claws/custom/ecr"
+	"github.com/clawscli/claws/internal/action"
+	"github.com/clawscli/claws/internal/dao"
+)
+
+func init() {
+	action.Global.Register("ecr", "images", []action.Action{
+		{
+			Name:      "Start Scan",
+			Shortcut:  "S",
+			Type:      action.ActionTypeAPI,
+			Operation: "StartImageScan",
+		},
+	})
+
+	action.RegisterExecutor("ecr", "images", executeImageAction)
+}
+
+func executeImageAction(ctx context.Context, act action.Action, resource dao.Resource) action.ActionResult {
+	switch act.Operation {
+	case "StartImageScan":
+		return executeStartImageScan(ctx, resource)
+	default:
+		return action.UnknownOperationResult(act.Operation)
+	}
+}
+
+func executeStartImageScan(ctx context.Context, resource dao.Resource) action.ActionResult {
+	img, ok := resource.(*ImageResource)
+	if !ok {
+		return action.InvalidResourceResult()
+	}
+
+	client, err := appecr.GetClient(ctx)
+	if err != nil {
+		return action.FailResult(err)
+	}
+
+	digest := img.ImageDigest()
+	output, err := client.StartImageScan(ctx, &ecr.StartImageScanInput{
+		RepositoryName: &img.RepositoryName,
+		ImageId:        &types.ImageIdentifier{ImageDigest: &digest},
+	})
+	if err != nil {
+		return action.FailResultf(err, "start scan of %s", img.TagsFormatted())
+	}
+
+	status := "IN_PROGRESS"
+	if output.ImageScanStatus != nil {
+		status = string(output.ImageScanStatus.Status)
+	}
+	return action.SuccessResult(fmt.Sprintf("Started scan of %s:%s (%s)", img.RepositoryName, img.TagsFormatted(), status))
+}
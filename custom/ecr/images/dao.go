@@ -8,6 +8,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/ecr"
 	"github.com/aws/aws-sdk-go-v2/service/ecr/types"
 
+	appecr "github.com/clawscli/claws/custom/ecr"
 	appaws "github.com/clawscli/claws/internal/aws"
 	"github.com/clawscli/claws/internal/dao"
 	apperrors "github.com/clawscli/claws/internal/errors"
@@ -259,6 +260,40 @@ func (r *ImageResource) ScanFindingsCount() int {
 	return 0
 }
 
+// SeverityCount returns the number of findings of a severity
+func (r *ImageResource) SeverityCount(severity string) int {
+	if r.Image.ImageScanFindingsSummary == nil {
+		return 0
+	}
+	return int(r.Image.ImageScanFindingsSummary.FindingSeverityCounts[severity])
+}
+
+// HighestSeverity returns the most severe finding severity, or "" when
+// the image has no findings
+func (r *ImageResource) HighestSeverity() string {
+	for _, severity := range appecr.Severities {
+		if r.SeverityCount(severity) > 0 {
+			return severity
+		}
+	}
+	return ""
+}
+
+// ScanComplete returns whether the image has scan results
+func (r *ImageResource) ScanComplete() bool {
+	switch r.ScanStatus() {
+	case string(types.ScanStatusComplete), string(types.ScanStatusActive):
+		return true
+	}
+	return false
+}
+
+// ImageRef returns the image reference "repository@digest" used to
+// navigate to its scan findings
+func (r *ImageResource) ImageRef() string {
+	return r.RepositoryName + "@" + r.ImageDigest()
+}
+
 // ArtifactMediaType returns the artifact media type
 func (r *ImageResource) ArtifactMediaType() string {
 	if r.Image.ArtifactMediaType != nil {
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strings"
 
+	appecr "github.com/clawscli/claws/custom/ecr"
 	"github.com/clawscli/claws/internal/dao"
 	"github.com/clawscli/claws/internal/render"
 )
@@ -27,6 +28,9 @@ func NewImageRenderer() *ImageRenderer {
 				{Name: "DIGEST", Width: 20, Getter: getDigest},
 				{Name: "SIZE", Width: 12, Getter: getSize},
 				{Name: "SCAN", Width: 12, Getter: getScanStatus},
+				{Name: "CRIT", Width: 5, Getter: severityGetter("CRITICAL")},
+				{Name: "HIGH", Width: 5, Getter: severityGetter("HIGH")},
+				{Name: "MED", Width: 5, Getter: severityGetter("MEDIUM")},
 				{Name: "PUSHED", Width: 20, Getter: getPushed},
 			},
 		},
@@ -65,15 +69,22 @@ func getScanStatus(r dao.Resource) string {
 		if status == "" {
 			return "-"
 		}
-		findings := img.ScanFindingsCount()
-		if findings > 0 {
-			return fmt.Sprintf("%s (%d)", status, findings)
-		}
 		return status
 	}
 	return "-"
 }
 
+// severityGetter returns the finding count of a severity, blank for
+// images without scan results
+func severityGetter(severity string) func(dao.Resource) string {
+	return func(r dao.Resource) string {
+		if img, ok := r.(*ImageResource); ok && img.ScanComplete() {
+			return fmt.Sprintf("%d", img.SeverityCount(severity))
+		}
+		return ""
+	}
+}
+
 func getPushed(r dao.Resource) string {
 	if img, ok := r.(*ImageResource); ok {
 		return img.PushedAt()
@@ -118,14 +129,20 @@ func (r *ImageRenderer) RenderDetail(resource dao.Resource) string {
 	d.Section("Scan Status")
 	if status := img.ScanStatus(); status != "" {
 		d.Field("Status", status)
-		d.Field("Findings Count", fmt.Sprintf("%d", img.ScanFindingsCount()))
+		if img.Image.ImageScanStatus != nil {
+			d.FieldIf("Description", img.Image.ImageScanStatus.Description)
+		}
+		d.FieldStyled("Findings Count", fmt.Sprintf("%d", img.ScanFindingsCount()), appecr.SeverityStyle(img.HighestSeverity()))
 
-		// Show severity breakdown if available
-		if img.Image.ImageScanFindingsSummary != nil && img.Image.ImageScanFindingsSummary.FindingSeverityCounts != nil {
-			for severity, count := range img.Image.ImageScanFindingsSummary.FindingSeverityCounts {
-				d.Field(severity, fmt.Sprintf("%d", count))
+		// Show severity breakdown, most severe first
+		for _, severity := range appecr.Severities {
+			if count := img.SeverityCount(severity); count > 0 {
+				d.FieldStyled(severity, fmt.Sprintf("%d", count), appecr.SeverityStyle(severity))
 			}
 		}
+		if summary := img.Image.ImageScanFindingsSummary; summary != nil && summary.ImageScanCompletedAt != nil {
+			d.Field("Scan Completed", summary.ImageScanCompletedAt.Format("2006-01-02 15:04:05"))
+		}
 	} else {
 		d.Field("Status", "Not scanned")
 	}
@@ -159,6 +176,13 @@ func (r *ImageRenderer) RenderSummary(resource dao.Resource) []render.SummaryFie
 	if status := img.ScanStatus(); status != "" {
 		fields = append(fields, render.SummaryField{Label: "Scan", Value: status})
 	}
+	if img.ScanComplete() {
+		fields = append(fields, render.SummaryField{
+			Label: "Findings",
+			Value: fmt.Sprintf("%d critical, %d high, %d medium", img.SeverityCount("CRITICAL"), img.SeverityCount("HIGH"), img.SeverityCount("MEDIUM")),
+			Style: appecr.SeverityStyle(img.HighestSeverity()),
+		})
+	}
 
 	if pushed := img.PushedAt(); pushed != "" {
 		fields = append(fields, render.SummaryField{Label: "Pushed", Value: pushed})
@@ -169,5 +193,14 @@ func (r *ImageRenderer) RenderSummary(resource dao.Resource) []render.SummaryFie
 
 // Navigations returns navigation shortcuts
 func (r *ImageRenderer) Navigations(resource dao.Resource) []render.Navigation {
-	return nil
+	img, ok := resource.(*ImageResource)
+	if !ok || !img.ScanComplete() {
+		return nil
+	}
+	return []render.Navigation{
+		{
+			Key: "f", Label: "Findings", Service: "ecr", Resource: "image-findings",
+			FilterField: appecr.FilterImage, FilterValue: img.ImageRef(),
+		},
+	}
 }
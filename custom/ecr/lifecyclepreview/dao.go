@@ -0,0 +1,117 @@
+package lifecyclepreview
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/ecr"
+	"github.com/aws/aws-sdk-go-v2/service/ecr/types"
+
+	appecr "github.com/clawscli/claws/custom/ecr"
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+)
+
+// PreviewDAO provides data access for the lifecycle policy preview of an
+// ECR repository: the images the policy would act on
+type PreviewDAO struct {
+	dao.BaseDAO
+	client *ecr.Client
+}
+
+// NewPreviewDAO creates a new PreviewDAO
+func NewPreviewDAO(ctx context.Context) (dao.DAO, error) {
+	client, err := appecr.GetClient(ctx)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new ecr/lifecycle-preview dao")
+	}
+	return &PreviewDAO{
+		BaseDAO: dao.NewBaseDAO("ecr", "lifecycle-preview"),
+		client:  client,
+	}, nil
+}
+
+// List returns the images of the latest preview. A preview still in
+// progress has no results yet; the list reloads until it completes.
+func (d *PreviewDAO) List(ctx context.Context) ([]dao.Resource, error) {
+	repoName := dao.GetFilterFromContext(ctx, "RepositoryName")
+	if repoName == "" {
+		return nil, fmt.Errorf("RepositoryName required: navigate from ecr/repositories using 'l' key")
+	}
+
+	paginator := ecr.NewGetLifecyclePolicyPreviewPaginator(d.client, &ecr.GetLifecyclePolicyPreviewInput{
+		RepositoryName: &repoName,
+	})
+
+	var resources []dao.Resource
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			if apperrors.GetErrorCode(err) == "LifecyclePolicyPreviewNotFoundException" {
+				return nil, fmt.Errorf("no lifecycle policy preview for %s: run 'Preview Lifecycle' from the repository's actions", repoName)
+			}
+			return nil, apperrors.Wrapf(err, "get lifecycle policy preview for %s", repoName)
+		}
+		if output.Status == types.LifecyclePolicyPreviewStatusFailed {
+			return nil, fmt.Errorf("lifecycle policy preview for %s failed", repoName)
+		}
+		for _, result := range output.PreviewResults {
+			resources = append(resources, NewPreviewResource(result, repoName))
+		}
+	}
+	return resources, nil
+}
+
+func (d *PreviewDAO) Get(ctx context.Context, id string) (dao.Resource, error) {
+	return nil, fmt.Errorf("get by ID not supported for lifecycle preview results")
+}
+
+func (d *PreviewDAO) Delete(ctx context.Context, id string) error {
+	return fmt.Errorf("delete not supported for lifecycle preview results")
+}
+
+func (d *PreviewDAO) Supports(op dao.Operation) bool {
+	return op == dao.OpList
+}
+
+// PreviewResource is an image the lifecycle policy would act on
+type PreviewResource struct {
+	dao.BaseResource
+	Item           types.LifecyclePolicyPreviewResult
+	RepositoryName string
+}
+
+// NewPreviewResource creates a new PreviewResource
+func NewPreviewResource(result types.LifecyclePolicyPreviewResult, repoName string) *PreviewResource {
+	digest := appaws.Str(result.ImageDigest)
+	name := digest
+	if len(result.ImageTags) > 0 {
+		name = result.ImageTags[0]
+	}
+	return &PreviewResource{
+		BaseResource: dao.BaseResource{
+			ID:   digest,
+			Name: name,
+			Data: result,
+		},
+		Item:           result,
+		RepositoryName: repoName,
+	}
+}
+
+// Action returns what the policy would do, e.g. EXPIRE or TRANSITION
+func (r *PreviewResource) Action() string {
+	if r.Item.Action == nil {
+		return ""
+	}
+	if target := r.Item.Action.TargetStorageClass; target != "" {
+		return fmt.Sprintf("%s → %s", r.Item.Action.Type, target)
+	}
+	return string(r.Item.Action.Type)
+}
+
+// RulePriority returns the priority of the rule that applies
+func (r *PreviewResource) RulePriority() int32 {
+	return appaws.Int32(r.Item.AppliedRulePriority)
+}
@@ -0,0 +1,130 @@
+package lifecyclepreview
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/render"
+)
+
+// PreviewRenderer renders lifecycle policy preview results
+type PreviewRenderer struct {
+	render.BaseRenderer
+}
+
+// NewPreviewRenderer creates a new PreviewRenderer
+func NewPreviewRenderer() render.Renderer {
+	return &PreviewRenderer{
+		BaseRenderer: render.BaseRenderer{
+			Service:  "ecr",
+			Resource: "lifecycle-preview",
+			Cols: []render.Column{
+				{
+					Name:  "TAG",
+					Width: 25,
+					Getter: func(r dao.Resource) string {
+						if p, ok := r.(*PreviewResource); ok && len(p.Item.ImageTags) == 0 {
+							return "<untagged>"
+						}
+						return r.GetName()
+					},
+					Priority: 0,
+				},
+				{
+					Name:  "ACTION",
+					Width: 12,
+					Getter: func(r dao.Resource) string {
+						if p, ok := r.(*PreviewResource); ok {
+							return p.Action()
+						}
+						return ""
+					},
+					Priority: 1,
+				},
+				{
+					Name:  "RULE",
+					Width: 5,
+					Getter: func(r dao.Resource) string {
+						if p, ok := r.(*PreviewResource); ok {
+							return fmt.Sprintf("%d", p.RulePriority())
+						}
+						return ""
+					},
+					Priority: 2,
+				},
+				{
+					Name:  "DIGEST",
+					Width: 22,
+					Getter: func(r dao.Resource) string {
+						digest := r.GetID()
+						if strings.HasPrefix(digest, "sha256:") && len(digest) > 19 {
+							return digest[:19] + "..."
+						}
+						return digest
+					},
+					Priority: 3,
+				},
+				{
+					Name:  "PUSHED",
+					Width: 10,
+					Getter: func(r dao.Resource) string {
+						if p, ok := r.(*PreviewResource); ok && p.Item.ImagePushedAt != nil {
+							return render.FormatAge(*p.Item.ImagePushedAt)
+						}
+						return ""
+					},
+					Priority: 4,
+				},
+			},
+		},
+	}
+}
+
+// RenderDetail renders detailed preview result information
+func (r *PreviewRenderer) RenderDetail(resource dao.Resource) string {
+	p, ok := resource.(*PreviewResource)
+	if !ok {
+		return ""
+	}
+
+	d := render.NewDetailBuilder()
+
+	d.Title("Lifecycle Preview", p.GetName())
+
+	d.Section("Image")
+	d.Field("Repository", p.RepositoryName)
+	d.Field("Digest", p.GetID())
+	if len(p.Item.ImageTags) > 0 {
+		d.Field("Tags", strings.Join(p.Item.ImageTags, ", "))
+	} else {
+		d.Field("Tags", "<untagged>")
+	}
+	if p.Item.ImagePushedAt != nil {
+		d.Field("Pushed At", p.Item.ImagePushedAt.Format(time.RFC3339))
+	}
+	if p.Item.StorageClass != "" {
+		d.Field("Storage Class", string(p.Item.StorageClass))
+	}
+
+	d.Section("Lifecycle")
+	d.FieldStyled("Action", p.Action(), render.WarningStyle())
+	d.Field("Applied Rule", fmt.Sprintf("%d", p.RulePriority()))
+
+	return d.String()
+}
+
+// RenderSummary returns summary fields for the header panel
+func (r *PreviewRenderer) RenderSummary(resource dao.Resource) []render.SummaryField {
+	p, ok := resource.(*PreviewResource)
+	if !ok {
+		return r.BaseRenderer.RenderSummary(resource)
+	}
+	return []render.SummaryField{
+		{Label: "Repository", Value: p.RepositoryName},
+		{Label: "Image", Value: p.GetName()},
+		{Label: "Action", Value: p.Action(), Style: render.WarningStyle()},
+		{Label: "Rule", Value: fmt.Sprintf("%d", p.RulePriority())},
+	}
+}
@@ -13,6 +13,12 @@ import (
 
 func init() {
 	action.Global.Register("ecr", "repositories", []action.Action{
+		{
+			Name:      "Preview Lifecycle",
+			Shortcut:  "L",
+			Type:      action.ActionTypeAPI,
+			Operation: "StartLifecyclePolicyPreview",
+		},
 		{
 			Name:      "Delete",
 			Shortcut:  "D",
@@ -27,6 +33,8 @@ func init() {
 
 func executeRepositoryAction(ctx context.Context, act action.Action, resource dao.Resource) action.ActionResult {
 	switch act.Operation {
+	case "StartLifecyclePolicyPreview":
+		return executeStartLifecyclePolicyPreview(ctx, resource)
 	case "DeleteRepository":
 		return executeDeleteRepository(ctx, resource)
 	default:
@@ -62,3 +70,20 @@ func executeDeleteRepository(ctx context.Context, resource dao.Resource) action.
 		Message: fmt.Sprintf("Deleted repository %s", repoName),
 	}
 }
+
+func executeStartLifecyclePolicyPreview(ctx context.Context, resource dao.Resource) action.ActionResult {
+	client, err := getECRClient(ctx)
+	if err != nil {
+		return action.FailResult(err)
+	}
+
+	repoName := resource.GetName()
+	_, err = client.StartLifecyclePolicyPreview(ctx, &ecr.StartLifecyclePolicyPreviewInput{
+		RepositoryName: &repoName,
+	})
+	if err != nil {
+		return action.FailResultf(err, "start lifecycle policy preview for %s", repoName)
+	}
+
+	return action.SuccessResult(fmt.Sprintf("Started lifecycle policy preview for %s, press 'l' to view", repoName))
+}
@@ -2,7 +2,10 @@ package repositories
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"sort"
+	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/service/ecr"
 	"github.com/aws/aws-sdk-go-v2/service/ecr/types"
@@ -10,6 +13,7 @@ import (
 	appaws "github.com/clawscli/claws/internal/aws"
 	"github.com/clawscli/claws/internal/dao"
 	apperrors "github.com/clawscli/claws/internal/errors"
+	"github.com/clawscli/claws/internal/log"
 )
 
 // RepositoryDAO provides data access for ECR repositories
@@ -63,7 +67,54 @@ func (d *RepositoryDAO) Get(ctx context.Context, id string) (dao.Resource, error
 		return nil, fmt.Errorf("repository not found: %s", id)
 	}
 
-	return NewRepositoryResource(output.Repositories[0]), nil
+	repo := NewRepositoryResource(output.Repositories[0])
+	d.loadScanning(ctx, repo)
+	if err := d.loadLifecyclePolicy(ctx, repo); err != nil {
+		return nil, err
+	}
+	return repo, nil
+}
+
+// loadScanning reads the effective scanning configuration, which the
+// registry's scanning rules override for enhanced scanning. Failures are
+// ignored: the repository-level setting is still shown.
+func (d *RepositoryDAO) loadScanning(ctx context.Context, repo *RepositoryResource) {
+	registry, err := d.client.GetRegistryScanningConfiguration(ctx, &ecr.GetRegistryScanningConfigurationInput{})
+	if err != nil {
+		log.Debug("failed to get registry scanning configuration", "error", err)
+		return
+	}
+	if registry.ScanningConfiguration != nil {
+		repo.ScanType = string(registry.ScanningConfiguration.ScanType)
+	}
+
+	output, err := d.client.BatchGetRepositoryScanningConfiguration(ctx, &ecr.BatchGetRepositoryScanningConfigurationInput{
+		RepositoryNames: []string{repo.GetID()},
+	})
+	if err != nil {
+		log.Debug("failed to get repository scanning configuration", "repository", repo.GetID(), "error", err)
+		return
+	}
+	if len(output.ScanningConfigurations) > 0 {
+		cfg := output.ScanningConfigurations[0]
+		repo.ScanFrequency = string(cfg.ScanFrequency)
+		repo.EffectiveScanOnPush = &cfg.ScanOnPush
+	}
+}
+
+// loadLifecyclePolicy reads the repository's lifecycle policy, if any
+func (d *RepositoryDAO) loadLifecyclePolicy(ctx context.Context, repo *RepositoryResource) error {
+	output, err := d.client.GetLifecyclePolicy(ctx, &ecr.GetLifecyclePolicyInput{
+		RepositoryName: appaws.StringPtr(repo.GetID()),
+	})
+	if err != nil {
+		if apperrors.GetErrorCode(err) == "LifecyclePolicyNotFoundException" {
+			return nil
+		}
+		return apperrors.Wrapf(err, "get lifecycle policy for %s", repo.GetID())
+	}
+	repo.LifecyclePolicy = appaws.Str(output.LifecyclePolicyText)
+	return nil
 }
 
 func (d *RepositoryDAO) Delete(ctx context.Context, id string) error {
@@ -90,6 +141,12 @@ func (d *RepositoryDAO) Delete(ctx context.Context, id string) error {
 type RepositoryResource struct {
 	dao.BaseResource
 	Item types.Repository
+
+	// Loaded by Get only
+	ScanType            string // registry scan type: BASIC or ENHANCED
+	ScanFrequency       string
+	EffectiveScanOnPush *bool
+	LifecyclePolicy     string // JSON policy text, empty if none
 }
 
 // NewRepositoryResource creates a new RepositoryResource
@@ -126,6 +183,9 @@ func (r *RepositoryResource) ARN() string {
 
 // ScanOnPush returns whether image scanning on push is enabled
 func (r *RepositoryResource) ScanOnPush() bool {
+	if r.EffectiveScanOnPush != nil {
+		return *r.EffectiveScanOnPush
+	}
 	if r.Item.ImageScanningConfiguration != nil {
 		return r.Item.ImageScanningConfiguration.ScanOnPush
 	}
@@ -144,3 +204,64 @@ func (r *RepositoryResource) EncryptionType() string {
 	}
 	return ""
 }
+
+// LifecycleRule is one rule of a lifecycle policy
+type LifecycleRule struct {
+	RulePriority int    `json:"rulePriority"`
+	Description  string `json:"description"`
+	Selection    struct {
+		TagStatus      string   `json:"tagStatus"`
+		TagPrefixList  []string `json:"tagPrefixList"`
+		TagPatternList []string `json:"tagPatternList"`
+		CountType      string   `json:"countType"`
+		CountUnit      string   `json:"countUnit"`
+		CountNumber    int      `json:"countNumber"`
+	} `json:"selection"`
+	Action struct {
+		Type string `json:"type"`
+	} `json:"action"`
+}
+
+// LifecycleRules parses the lifecycle policy's rules, in priority order
+func (r *RepositoryResource) LifecycleRules() []LifecycleRule {
+	var policy struct {
+		Rules []LifecycleRule `json:"rules"`
+	}
+	if r.LifecyclePolicy == "" || json.Unmarshal([]byte(r.LifecyclePolicy), &policy) != nil {
+		return nil
+	}
+	sort.SliceStable(policy.Rules, func(i, j int) bool {
+		return policy.Rules[i].RulePriority < policy.Rules[j].RulePriority
+	})
+	return policy.Rules
+}
+
+// Summary describes the rule, e.g. "expire untagged images pushed more
+// than 14 days ago"
+func (rule LifecycleRule) Summary() string {
+	sel := rule.Selection
+	images := "images"
+	switch sel.TagStatus {
+	case "untagged":
+		images = "untagged images"
+	case "tagged":
+		tags := append(append([]string{}, sel.TagPrefixList...), sel.TagPatternList...)
+		images = fmt.Sprintf("images tagged %s", strings.Join(tags, ", "))
+	}
+
+	var count string
+	switch sel.CountType {
+	case "imageCountMoreThan":
+		count = fmt.Sprintf("beyond the newest %d", sel.CountNumber)
+	case "sinceImagePushed":
+		count = fmt.Sprintf("pushed more than %d %s ago", sel.CountNumber, sel.CountUnit)
+	default:
+		count = fmt.Sprintf("%s %d %s", sel.CountType, sel.CountNumber, sel.CountUnit)
+	}
+
+	action := rule.Action.Type
+	if action == "" {
+		action = "expire"
+	}
+	return fmt.Sprintf("%s %s %s", action, images, count)
+}
@@ -1,6 +1,7 @@
 package repositories
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/clawscli/claws/internal/dao"
@@ -53,8 +54,8 @@ func NewRepositoryRenderer() render.Renderer {
 					Priority: 2,
 				},
 				{
-					Name:  "SCAN",
-					Width: 6,
+					Name:  "SCAN ON PUSH",
+					Width: 12,
 					Getter: func(r dao.Resource) string {
 						if rr, ok := r.(*RepositoryResource); ok {
 							if rr.ScanOnPush() {
@@ -119,10 +120,36 @@ func (r *RepositoryRenderer) RenderDetail(resource dao.Resource) string {
 	// Configuration
 	d.Section("Configuration")
 	d.Field("Image Tag Mutability", rr.ImageTagMutability())
+
+	// Scanning
+	d.Section("Image Scanning")
+	if rr.ScanType != "" {
+		d.Field("Scan Type", rr.ScanType)
+	}
 	if rr.ScanOnPush() {
-		d.Field("Scan on Push", "Enabled")
+		d.FieldStyled("Scan on Push", "Enabled", render.SuccessStyle())
+	} else {
+		d.FieldStyled("Scan on Push", "Disabled", render.WarningStyle())
+	}
+	if rr.ScanFrequency != "" {
+		d.Field("Scan Frequency", rr.ScanFrequency)
+	}
+
+	// Lifecycle
+	d.Section("Lifecycle Policy")
+	if rules := rr.LifecycleRules(); len(rules) > 0 {
+		for _, rule := range rules {
+			line := fmt.Sprintf("  %d. %s", rule.RulePriority, rule.Summary())
+			if rule.Description != "" {
+				line += " " + render.DimStyle().Render("("+rule.Description+")")
+			}
+			d.Line(line)
+		}
+		d.DimIndent("Press 'l' for the lifecycle policy preview")
+	} else if rr.LifecyclePolicy != "" {
+		d.Line(rr.LifecyclePolicy)
 	} else {
-		d.Field("Scan on Push", "Disabled")
+		d.DimIndent("(none)")
 	}
 
 	// Encryption
@@ -179,5 +206,10 @@ func (r *RepositoryRenderer) Navigations(resource dao.Resource) []render.Navigat
 			Key: "i", Label: "Images", Service: "ecr", Resource: "images",
 			FilterField: "RepositoryName", FilterValue: rr.GetID(),
 		},
+		{
+			Key: "l", Label: "Lifecycle Preview", Service: "ecr", Resource: "lifecycle-preview",
+			FilterField: "RepositoryName", FilterValue: rr.GetID(),
+			AutoReload: true,
+		},
 	}
 }
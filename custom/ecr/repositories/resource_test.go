@@ -0,0 +1,47 @@
+package repositories
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ecr/types"
+)
+
+func TestLifecycleRules(t *testing.T) {
+	repo := NewRepositoryResource(types.Repository{RepositoryName: aws.String("app")})
+	repo.LifecyclePolicy = `{"rules": [
+		{"rulePriority": 2, "selection": {"tagStatus": "tagged", "tagPrefixList": ["release-"], "countType": "imageCountMoreThan", "countNumber": 10}, "action": {"type": "expire"}},
+		{"rulePriority": 1, "selection": {"tagStatus": "untagged", "countType": "sinceImagePushed", "countUnit": "days", "countNumber": 14}, "action": {"type": "expire"}}
+	]}`
+
+	var got []string
+	for _, rule := range repo.LifecycleRules() {
+		got = append(got, rule.Summary())
+	}
+	want := []string{
+		"expire untagged images pushed more than 14 days ago",
+		"expire images tagged release- beyond the newest 10",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("summaries = %q, want %q", got, want)
+	}
+
+	repo.LifecyclePolicy = ""
+	if rules := repo.LifecycleRules(); rules != nil {
+		t.Errorf("LifecycleRules() = %v, want nil without a policy", rules)
+	}
+}
+
+func TestScanOnPush(t *testing.T) {
+	repo := NewRepositoryResource(types.Repository{
+		ImageScanningConfiguration: &types.ImageScanningConfiguration{ScanOnPush: false},
+	})
+	if repo.ScanOnPush() {
+		t.Error("ScanOnPush() should follow the repository setting")
+	}
+	repo.EffectiveScanOnPush = aws.Bool(true)
+	if !repo.ScanOnPush() {
+		t.Error("ScanOnPush() should prefer the effective registry setting")
+	}
+}
@@ -0,0 +1,37 @@
+package ecr
+
+import (
+	"strings"
+
+	"github.com/clawscli/claws/internal/render"
+)
+
+// Severities lists scan finding severities, most severe first. Basic and
+// enhanced (Inspector) scanning use the same names.
+var Severities = []string{"CRITICAL", "HIGH", "MEDIUM", "LOW", "INFORMATIONAL", "UNDEFINED"}
+
+// SeverityRank returns the position of severity in Severities, so that
+// lower ranks are more severe. Unknown severities rank last.
+func SeverityRank(severity string) int {
+	severity = strings.ToUpper(severity)
+	for i, s := range Severities {
+		if s == severity {
+			return i
+		}
+	}
+	return len(Severities)
+}
+
+// SeverityStyle returns a style for a finding severity
+func SeverityStyle(severity string) render.Style {
+	switch strings.ToUpper(severity) {
+	case "CRITICAL", "HIGH":
+		return render.DangerStyle()
+	case "MEDIUM":
+		return render.WarningStyle()
+	case "LOW", "INFORMATIONAL":
+		return render.DimStyle()
+	default:
+		return render.DefaultStyle()
+	}
+}
@@ -0,0 +1,131 @@
+package deployments
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/ecs"
+	"github.com/aws/aws-sdk-go-v2/service/ecs/types"
+
+	appecs "github.com/clawscli/claws/custom/ecs"
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+)
+
+// DeploymentDAO provides data access for the deployments of an ECS service
+type DeploymentDAO struct {
+	dao.BaseDAO
+	client *ecs.Client
+}
+
+// NewDeploymentDAO creates a new DeploymentDAO
+func NewDeploymentDAO(ctx context.Context) (dao.DAO, error) {
+	client, err := appecs.GetClient(ctx)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new ecs/deployments dao")
+	}
+	return &DeploymentDAO{
+		BaseDAO: dao.NewBaseDAO("ecs", "deployments"),
+		client:  client,
+	}, nil
+}
+
+// List returns the service's PRIMARY and ACTIVE deployments
+func (d *DeploymentDAO) List(ctx context.Context) ([]dao.Resource, error) {
+	ref := dao.GetFilterFromContext(ctx, appecs.FilterService)
+	svc, err := appecs.DescribeService(ctx, d.client, ref)
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]dao.Resource, 0, len(svc.Deployments))
+	for _, dep := range svc.Deployments {
+		resources = append(resources, NewDeploymentResource(dep, svc))
+	}
+	return resources, nil
+}
+
+func (d *DeploymentDAO) Get(ctx context.Context, id string) (dao.Resource, error) {
+	resources, err := d.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range resources {
+		if r.GetID() == id {
+			return r, nil
+		}
+	}
+	return nil, fmt.Errorf("deployment not found: %s", id)
+}
+
+func (d *DeploymentDAO) Delete(ctx context.Context, id string) error {
+	return fmt.Errorf("delete not supported for deployments")
+}
+
+func (d *DeploymentDAO) Supports(op dao.Operation) bool {
+	switch op {
+	case dao.OpList, dao.OpGet:
+		return true
+	default:
+		return false
+	}
+}
+
+// DeploymentResource wraps an ECS service deployment
+type DeploymentResource struct {
+	dao.BaseResource
+	Item           types.Deployment
+	ServiceName    string
+	CircuitBreaker *types.DeploymentCircuitBreaker
+}
+
+// NewDeploymentResource creates a new DeploymentResource
+func NewDeploymentResource(dep types.Deployment, svc types.Service) *DeploymentResource {
+	r := &DeploymentResource{
+		BaseResource: dao.BaseResource{
+			ID:   appaws.Str(dep.Id),
+			Name: appaws.Str(dep.Id),
+			Data: dep,
+		},
+		Item:        dep,
+		ServiceName: appaws.Str(svc.ServiceName),
+	}
+	if svc.DeploymentConfiguration != nil {
+		r.CircuitBreaker = svc.DeploymentConfiguration.DeploymentCircuitBreaker
+	}
+	return r
+}
+
+// Status returns PRIMARY for the newest deployment, ACTIVE for those
+// being replaced
+func (r *DeploymentResource) Status() string {
+	return appaws.Str(r.Item.Status)
+}
+
+// RolloutState returns the rollout state (IN_PROGRESS, COMPLETED or FAILED)
+func (r *DeploymentResource) RolloutState() string {
+	return string(r.Item.RolloutState)
+}
+
+// TaskDefinition returns the "family:revision" the deployment runs
+func (r *DeploymentResource) TaskDefinition() string {
+	return appaws.ExtractResourceName(appaws.Str(r.Item.TaskDefinition))
+}
+
+// CircuitBreakerStatus describes the deployment circuit breaker: off, on,
+// or tripped when it stopped a failed rollout
+func (r *DeploymentResource) CircuitBreakerStatus() string {
+	cb := r.CircuitBreaker
+	if cb == nil || !cb.Enable {
+		return "off"
+	}
+	status := "on"
+	if r.Item.RolloutState == types.DeploymentRolloutStateFailed {
+		status = "tripped"
+	}
+	if cb.Rollback {
+		status += " (rollback)"
+	}
+	return status
+}
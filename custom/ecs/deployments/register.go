@@ -0,0 +1,20 @@
+package deployments
+
+import (
+	"context"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/registry"
+	"github.com/clawscli/claws/internal/render"
+)
+
+func init() {
+	registry.Global.RegisterCustom("ecs", "deployments", registry.Entry{
+		DAOFactory: func(ctx context.Context) (dao.DAO, error) {
+			return NewDeploymentDAO(ctx)
+		},
+		RendererFactory: func() render.Renderer {
+			return NewDeploymentRenderer()
+		},
+	})
+}
@@ -0,0 +1,189 @@
+package deployments
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"charm.land/lipgloss/v2"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/render"
+)
+
+// Ensure DeploymentRenderer implements render.Navigator
+var _ render.Navigator = (*DeploymentRenderer)(nil)
+
+// DeploymentRenderer renders ECS service deployments
+type DeploymentRenderer struct {
+	render.BaseRenderer
+}
+
+// NewDeploymentRenderer creates a new DeploymentRenderer
+func NewDeploymentRenderer() render.Renderer {
+	return &DeploymentRenderer{
+		BaseRenderer: render.BaseRenderer{
+			Service:  "ecs",
+			Resource: "deployments",
+			Cols: []render.Column{
+				{Name: "ID", Width: 30, Getter: func(r dao.Resource) string { return r.GetID() }},
+				{Name: "STATUS", Width: 8, Getter: getStatus},
+				{Name: "ROLLOUT", Width: 12, Getter: getRollout},
+				{Name: "TASK DEF", Width: 25, Getter: getTaskDef},
+				{Name: "DESIRED", Width: 8, Getter: countGetter(func(r *DeploymentResource) int32 { return r.Item.DesiredCount })},
+				{Name: "RUNNING", Width: 8, Getter: countGetter(func(r *DeploymentResource) int32 { return r.Item.RunningCount })},
+				{Name: "PENDING", Width: 8, Getter: countGetter(func(r *DeploymentResource) int32 { return r.Item.PendingCount })},
+				{Name: "FAILED", Width: 7, Getter: countGetter(func(r *DeploymentResource) int32 { return r.Item.FailedTasks })},
+				{Name: "CIRCUIT BREAKER", Width: 16, Getter: getCircuitBreaker},
+				{Name: "UPDATED", Width: 8, Getter: getUpdated},
+			},
+		},
+	}
+}
+
+func getStatus(r dao.Resource) string {
+	if dep, ok := r.(*DeploymentResource); ok {
+		return dep.Status()
+	}
+	return ""
+}
+
+func getRollout(r dao.Resource) string {
+	if dep, ok := r.(*DeploymentResource); ok {
+		return strings.ToLower(dep.RolloutState())
+	}
+	return ""
+}
+
+func getTaskDef(r dao.Resource) string {
+	if dep, ok := r.(*DeploymentResource); ok {
+		return dep.TaskDefinition()
+	}
+	return ""
+}
+
+func countGetter(count func(*DeploymentResource) int32) func(dao.Resource) string {
+	return func(r dao.Resource) string {
+		if dep, ok := r.(*DeploymentResource); ok {
+			return fmt.Sprintf("%d", count(dep))
+		}
+		return ""
+	}
+}
+
+func getCircuitBreaker(r dao.Resource) string {
+	if dep, ok := r.(*DeploymentResource); ok {
+		return dep.CircuitBreakerStatus()
+	}
+	return ""
+}
+
+func getUpdated(r dao.Resource) string {
+	if dep, ok := r.(*DeploymentResource); ok && dep.Item.UpdatedAt != nil {
+		return render.FormatAge(*dep.Item.UpdatedAt)
+	}
+	return ""
+}
+
+// rolloutStyle colours a rollout state
+func rolloutStyle(state string) lipgloss.Style {
+	switch state {
+	case "COMPLETED":
+		return render.SuccessStyle()
+	case "FAILED":
+		return render.DangerStyle()
+	case "IN_PROGRESS":
+		return render.WarningStyle()
+	default:
+		return render.DefaultStyle()
+	}
+}
+
+// RenderDetail renders detailed deployment information
+func (r *DeploymentRenderer) RenderDetail(resource dao.Resource) string {
+	dep, ok := resource.(*DeploymentResource)
+	if !ok {
+		return ""
+	}
+
+	d := render.NewDetailBuilder()
+
+	d.Title("ECS Deployment", dep.GetID())
+
+	d.Section("Deployment")
+	d.Field("Service", dep.ServiceName)
+	d.Field("Status", dep.Status())
+	if state := dep.RolloutState(); state != "" {
+		d.FieldStyled("Rollout State", state, rolloutStyle(state))
+	}
+	d.FieldIf("Rollout Reason", dep.Item.RolloutStateReason)
+	d.Field("Task Definition", dep.TaskDefinition())
+	if lt := dep.Item.LaunchType; lt != "" {
+		d.Field("Launch Type", string(lt))
+	}
+	d.FieldIf("Platform Version", dep.Item.PlatformVersion)
+
+	d.Section("Tasks")
+	d.Field("Desired", fmt.Sprintf("%d", dep.Item.DesiredCount))
+	d.Field("Running", fmt.Sprintf("%d", dep.Item.RunningCount))
+	d.Field("Pending", fmt.Sprintf("%d", dep.Item.PendingCount))
+	if dep.Item.FailedTasks > 0 {
+		d.FieldStyled("Failed", fmt.Sprintf("%d", dep.Item.FailedTasks), render.DangerStyle())
+	}
+
+	d.Section("Circuit Breaker")
+	cb := dep.CircuitBreakerStatus()
+	style := render.DimStyle()
+	switch {
+	case strings.HasPrefix(cb, "tripped"):
+		style = render.DangerStyle()
+	case strings.HasPrefix(cb, "on"):
+		style = render.SuccessStyle()
+	}
+	d.FieldStyled("Status", cb, style)
+
+	d.Section("Timestamps")
+	if dep.Item.CreatedAt != nil {
+		d.Field("Created", dep.Item.CreatedAt.Format(time.RFC3339))
+	}
+	if dep.Item.UpdatedAt != nil {
+		d.Field("Updated", dep.Item.UpdatedAt.Format(time.RFC3339))
+	}
+
+	return d.String()
+}
+
+// RenderSummary returns summary fields for the header panel
+func (r *DeploymentRenderer) RenderSummary(resource dao.Resource) []render.SummaryField {
+	dep, ok := resource.(*DeploymentResource)
+	if !ok {
+		return r.BaseRenderer.RenderSummary(resource)
+	}
+	state := dep.RolloutState()
+	return []render.SummaryField{
+		{Label: "Deployment", Value: dep.GetID()},
+		{Label: "Status", Value: dep.Status()},
+		{Label: "Rollout", Value: state, Style: rolloutStyle(state)},
+		{Label: "Tasks", Value: fmt.Sprintf("%d/%d running, %d pending", dep.Item.RunningCount, dep.Item.DesiredCount, dep.Item.PendingCount)},
+		{Label: "Task Definition", Value: dep.TaskDefinition()},
+		{Label: "Circuit Breaker", Value: dep.CircuitBreakerStatus()},
+	}
+}
+
+// Navigations returns navigation shortcuts
+func (r *DeploymentRenderer) Navigations(resource dao.Resource) []render.Navigation {
+	dep, ok := resource.(*DeploymentResource)
+	if !ok || dep.TaskDefinition() == "" {
+		return nil
+	}
+	return []render.Navigation{
+		{
+			Key:         "T",
+			Label:       "Task Definition",
+			Service:     "ecs",
+			Resource:    "task-definitions",
+			FilterField: "TaskDefinition",
+			FilterValue: dep.TaskDefinition(),
+		},
+	}
+}
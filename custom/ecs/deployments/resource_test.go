@@ -0,0 +1,82 @@
+package deployments
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ecs/types"
+)
+
+func testService(cb *types.DeploymentCircuitBreaker) types.Service {
+	return types.Service{
+		ServiceName:             aws.String("web"),
+		DeploymentConfiguration: &types.DeploymentConfiguration{DeploymentCircuitBreaker: cb},
+	}
+}
+
+func testDeployment(state types.DeploymentRolloutState) types.Deployment {
+	return types.Deployment{
+		Id:             aws.String("ecs-svc/123"),
+		Status:         aws.String("PRIMARY"),
+		RolloutState:   state,
+		TaskDefinition: aws.String("arn:aws:ecs:us-east-1:123456789012:task-definition/web:7"),
+		DesiredCount:   3,
+		RunningCount:   1,
+		PendingCount:   2,
+	}
+}
+
+func TestNewDeploymentResource(t *testing.T) {
+	dep := NewDeploymentResource(testDeployment(types.DeploymentRolloutStateInProgress), testService(nil))
+
+	if dep.GetID() != "ecs-svc/123" || dep.ServiceName != "web" {
+		t.Errorf("id = %q, service = %q", dep.GetID(), dep.ServiceName)
+	}
+	if dep.Status() != "PRIMARY" || dep.RolloutState() != "IN_PROGRESS" {
+		t.Errorf("status = %q, rollout = %q", dep.Status(), dep.RolloutState())
+	}
+	if dep.TaskDefinition() != "web:7" {
+		t.Errorf("TaskDefinition() = %q, want web:7", dep.TaskDefinition())
+	}
+}
+
+func TestCircuitBreakerStatus(t *testing.T) {
+	tests := []struct {
+		name  string
+		cb    *types.DeploymentCircuitBreaker
+		state types.DeploymentRolloutState
+		want  string
+	}{
+		{"not configured", nil, types.DeploymentRolloutStateCompleted, "off"},
+		{"disabled", &types.DeploymentCircuitBreaker{}, types.DeploymentRolloutStateFailed, "off"},
+		{"enabled", &types.DeploymentCircuitBreaker{Enable: true}, types.DeploymentRolloutStateInProgress, "on"},
+		{"rollback", &types.DeploymentCircuitBreaker{Enable: true, Rollback: true}, types.DeploymentRolloutStateCompleted, "on (rollback)"},
+		{"tripped", &types.DeploymentCircuitBreaker{Enable: true, Rollback: true}, types.DeploymentRolloutStateFailed, "tripped (rollback)"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dep := NewDeploymentResource(testDeployment(tt.state), testService(tt.cb))
+			if got := dep.CircuitBreakerStatus(); got != tt.want {
+				t.Errorf("CircuitBreakerStatus() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDeploymentRenderer(t *testing.T) {
+	r := NewDeploymentRenderer().(*DeploymentRenderer)
+	dep := NewDeploymentResource(testDeployment(types.DeploymentRolloutStateInProgress), testService(nil))
+
+	detail := r.RenderDetail(dep)
+	for _, want := range []string{"IN_PROGRESS", "web:7", "Pending"} {
+		if !strings.Contains(detail, want) {
+			t.Errorf("detail missing %q", want)
+		}
+	}
+
+	navs := r.Navigations(dep)
+	if len(navs) != 1 || navs[0].FilterValue != "web:7" {
+		t.Errorf("Navigations() = %+v", navs)
+	}
+}
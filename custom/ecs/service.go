@@ -0,0 +1,45 @@
+package ecs
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/ecs"
+	"github.com/aws/aws-sdk-go-v2/service/ecs/types"
+
+	apperrors "github.com/clawscli/claws/internal/errors"
+)
+
+// FilterService is the filter holding a service reference: "cluster/service".
+const FilterService = "ServiceRef"
+
+// ServiceRef joins a cluster and service name into a service reference.
+func ServiceRef(cluster, service string) string {
+	return cluster + "/" + service
+}
+
+// SplitServiceRef splits "cluster/service" into its cluster and service.
+func SplitServiceRef(ref string) (cluster, service string) {
+	cluster, service, _ = strings.Cut(ref, "/")
+	return cluster, service
+}
+
+// DescribeService describes the service named by ref.
+func DescribeService(ctx context.Context, client *ecs.Client, ref string) (types.Service, error) {
+	cluster, service := SplitServiceRef(ref)
+	if cluster == "" || service == "" {
+		return types.Service{}, fmt.Errorf("%s required: navigate from ecs/services", FilterService)
+	}
+	output, err := client.DescribeServices(ctx, &ecs.DescribeServicesInput{
+		Cluster:  &cluster,
+		Services: []string{service},
+	})
+	if err != nil {
+		return types.Service{}, apperrors.Wrapf(err, "describe service %s", service)
+	}
+	if len(output.Services) == 0 {
+		return types.Service{}, fmt.Errorf("service not found: %s", service)
+	}
+	return output.Services[0], nil
+}
@@ -0,0 +1,84 @@
+package serviceevents
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/ecs"
+	"github.com/aws/aws-sdk-go-v2/service/ecs/types"
+
+	appecs "github.com/clawscli/claws/custom/ecs"
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+)
+
+// EventDAO provides data access for the events of an ECS service
+type EventDAO struct {
+	dao.BaseDAO
+	client *ecs.Client
+}
+
+// NewEventDAO creates a new EventDAO
+func NewEventDAO(ctx context.Context) (dao.DAO, error) {
+	client, err := appecs.GetClient(ctx)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new ecs/service-events dao")
+	}
+	return &EventDAO{
+		BaseDAO: dao.NewBaseDAO("ecs", "service-events"),
+		client:  client,
+	}, nil
+}
+
+// List returns the service's most recent events (up to 100), newest first
+func (d *EventDAO) List(ctx context.Context) ([]dao.Resource, error) {
+	ref := dao.GetFilterFromContext(ctx, appecs.FilterService)
+	svc, err := appecs.DescribeService(ctx, d.client, ref)
+	if err != nil {
+		return nil, err
+	}
+
+	resources := make([]dao.Resource, 0, len(svc.Events))
+	for _, event := range svc.Events {
+		resources = append(resources, NewEventResource(event, appaws.Str(svc.ServiceName)))
+	}
+	return resources, nil
+}
+
+func (d *EventDAO) Get(ctx context.Context, id string) (dao.Resource, error) {
+	return nil, fmt.Errorf("get by ID not supported for service events")
+}
+
+func (d *EventDAO) Delete(ctx context.Context, id string) error {
+	return fmt.Errorf("delete not supported for service events")
+}
+
+func (d *EventDAO) Supports(op dao.Operation) bool {
+	return op == dao.OpList
+}
+
+// EventResource wraps an ECS service event
+type EventResource struct {
+	dao.BaseResource
+	Item        types.ServiceEvent
+	ServiceName string
+}
+
+// NewEventResource creates a new EventResource
+func NewEventResource(event types.ServiceEvent, serviceName string) *EventResource {
+	return &EventResource{
+		BaseResource: dao.BaseResource{
+			ID:   appaws.Str(event.Id),
+			Name: appaws.Str(event.Message),
+			Data: event,
+		},
+		Item:        event,
+		ServiceName: serviceName,
+	}
+}
+
+// Message returns the event message
+func (r *EventResource) Message() string {
+	return appaws.Str(r.Item.Message)
+}
@@ -0,0 +1,107 @@
+package serviceevents
+
+import (
+	"strings"
+	"time"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/render"
+)
+
+// EventRenderer renders ECS service events
+type EventRenderer struct {
+	render.BaseRenderer
+}
+
+// NewEventRenderer creates a new EventRenderer
+func NewEventRenderer() render.Renderer {
+	return &EventRenderer{
+		BaseRenderer: render.BaseRenderer{
+			Service:  "ecs",
+			Resource: "service-events",
+			Cols: []render.Column{
+				{Name: "TIME", Width: 20, Getter: getTime},
+				{Name: "AGE", Width: 8, Getter: getAge},
+				{Name: "MESSAGE", Width: 120, Getter: getMessage},
+			},
+		},
+	}
+}
+
+func getTime(r dao.Resource) string {
+	if e, ok := r.(*EventResource); ok && e.Item.CreatedAt != nil {
+		return e.Item.CreatedAt.Local().Format("2006-01-02 15:04:05")
+	}
+	return ""
+}
+
+func getAge(r dao.Resource) string {
+	if e, ok := r.(*EventResource); ok && e.Item.CreatedAt != nil {
+		return render.FormatAge(*e.Item.CreatedAt)
+	}
+	return ""
+}
+
+func getMessage(r dao.Resource) string {
+	if e, ok := r.(*EventResource); ok {
+		return e.Message()
+	}
+	return ""
+}
+
+// problemWords mark an event as reporting a failure
+var problemWords = []string{"unable", "failed", "unhealthy", "error", "stopped", "rolling back"}
+
+// IsProblem returns whether the event reports a failure
+func (r *EventResource) IsProblem() bool {
+	msg := strings.ToLower(r.Message())
+	for _, word := range problemWords {
+		if strings.Contains(msg, word) {
+			return true
+		}
+	}
+	return false
+}
+
+// RenderDetail renders the full event message
+func (r *EventRenderer) RenderDetail(resource dao.Resource) string {
+	e, ok := resource.(*EventResource)
+	if !ok {
+		return ""
+	}
+
+	d := render.NewDetailBuilder()
+
+	d.Title("ECS Service Event", e.GetID())
+
+	d.Section("Event")
+	d.Field("Service", e.ServiceName)
+	if e.Item.CreatedAt != nil {
+		d.Field("Time", e.Item.CreatedAt.Format(time.RFC3339))
+	}
+	if e.IsProblem() {
+		d.FieldStyled("Message", e.Message(), render.DangerStyle())
+	} else {
+		d.Field("Message", e.Message())
+	}
+
+	return d.String()
+}
+
+// RenderSummary returns summary fields for the header panel
+func (r *EventRenderer) RenderSummary(resource dao.Resource) []render.SummaryField {
+	e, ok := resource.(*EventResource)
+	if !ok {
+		return r.BaseRenderer.RenderSummary(resource)
+	}
+	fields := []render.SummaryField{
+		{Label: "Service", Value: e.ServiceName},
+		{Label: "Time", Value: getTime(e)},
+	}
+	if e.IsProblem() {
+		fields = append(fields, render.SummaryField{Label: "Message", Value: e.Message(), Style: render.DangerStyle()})
+	} else {
+		fields = append(fields, render.SummaryField{Label: "Message", Value: e.Message()})
+	}
+	return fields
+}
@@ -0,0 +1,44 @@
+package serviceevents
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ecs/types"
+)
+
+func TestNewEventResource(t *testing.T) {
+	created := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	e := NewEventResource(types.ServiceEvent{
+		Id:        aws.String("evt-1"),
+		Message:   aws.String("(service web) has reached a steady state."),
+		CreatedAt: &created,
+	}, "web")
+
+	if e.GetID() != "evt-1" || e.ServiceName != "web" {
+		t.Errorf("id = %q, service = %q", e.GetID(), e.ServiceName)
+	}
+	if e.IsProblem() {
+		t.Error("a steady state event is not a problem")
+	}
+
+	detail := NewEventRenderer().RenderDetail(e)
+	if !strings.Contains(detail, "steady state") {
+		t.Errorf("detail missing message:\n%s", detail)
+	}
+}
+
+func TestIsProblem(t *testing.T) {
+	for _, msg := range []string{
+		"(service web) was unable to place a task because no container instance met all of its requirements.",
+		"(service web) (task 123) failed container health checks.",
+		"(service web) deployment ecs-svc/123 deployment failed: tasks failed to start.",
+	} {
+		e := NewEventResource(types.ServiceEvent{Message: aws.String(msg)}, "web")
+		if !e.IsProblem() {
+			t.Errorf("IsProblem(%q) = false", msg)
+		}
+	}
+}
@@ -29,6 +29,12 @@ func init() {
 			Operation: "ScaleDown",
 			Confirm:   action.ConfirmSimple,
 		},
+		{
+			Name:     "Set Desired Count",
+			Shortcut: "=",
+			Type:     action.ActionTypeView,
+			Target:   action.TargetDesiredCount,
+		},
 		{
 			Name:      "Force Deploy",
 			Shortcut:  "f",
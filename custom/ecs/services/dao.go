@@ -11,6 +11,7 @@ import (
 	"github.com/clawscli/claws/internal/dao"
 	apperrors "github.com/clawscli/claws/internal/errors"
 	"github.com/clawscli/claws/internal/log"
+	"github.com/clawscli/claws/internal/scale"
 )
 
 // ServiceDAO provides data access for ECS services
@@ -280,3 +281,12 @@ func (r *ServiceResource) CapacityProviderStrategy() []types.CapacityProviderStr
 func (r *ServiceResource) PlatformVersion() string {
 	return appaws.Str(r.Item.PlatformVersion)
 }
+
+// ScaleTarget implements scale.Provider
+func (r *ServiceResource) ScaleTarget() scale.Target {
+	return scale.Target{
+		Cluster: appaws.ExtractResourceName(r.ClusterArn()),
+		Service: r.GetName(),
+		Desired: r.DesiredCount(),
+	}
+}
@@ -0,0 +1,59 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/ecs"
+	"github.com/aws/aws-sdk-go-v2/service/ecs/types"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	apperrors "github.com/clawscli/claws/internal/errors"
+	"github.com/clawscli/claws/internal/logs"
+)
+
+// LogTarget implements logs.Provider. The log group comes from the awslogs
+// configuration of the service's task definition, resolved when the viewer
+// opens.
+func (r *ServiceResource) LogTarget() logs.Target {
+	taskDefArn := r.TaskDefinition()
+	name := r.GetName()
+	return logs.Target{
+		Title: "service " + name,
+		Resolve: func(ctx context.Context) (logs.Target, error) {
+			cfg, err := appaws.NewConfig(ctx)
+			if err != nil {
+				return logs.Target{}, err
+			}
+			out, err := ecs.NewFromConfig(cfg).DescribeTaskDefinition(ctx, &ecs.DescribeTaskDefinitionInput{
+				TaskDefinition: appaws.StringPtr(taskDefArn),
+			})
+			if err != nil {
+				return logs.Target{}, apperrors.Wrapf(err, "describe task definition %s", taskDefArn)
+			}
+			return awslogsTarget(out.TaskDefinition, name)
+		},
+	}
+}
+
+// awslogsTarget builds the log target for a service from its task
+// definition: the first awslogs container's group, narrowed to that
+// container's streams ("prefix/container-name/task-id") across all tasks
+// when awslogs-stream-prefix is set.
+func awslogsTarget(td *types.TaskDefinition, service string) (logs.Target, error) {
+	if td == nil {
+		return logs.Target{}, fmt.Errorf("task definition not found")
+	}
+	for _, c := range td.ContainerDefinitions {
+		lc := c.LogConfiguration
+		if lc == nil || lc.LogDriver != types.LogDriverAwslogs || lc.Options["awslogs-group"] == "" {
+			continue
+		}
+		target := logs.Target{Group: lc.Options["awslogs-group"]}
+		if prefix := lc.Options["awslogs-stream-prefix"]; prefix != "" {
+			target.StreamPrefix = prefix + "/" + appaws.Str(c.Name) + "/"
+		}
+		return target, nil
+	}
+	return logs.Target{}, fmt.Errorf("service %s has no awslogs log configuration", service)
+}
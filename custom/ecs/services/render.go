@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strings"
 
+	appecs "github.com/clawscli/claws/custom/ecs"
 	appaws "github.com/clawscli/claws/internal/aws"
 	"github.com/clawscli/claws/internal/dao"
 	"github.com/clawscli/claws/internal/render"
@@ -362,7 +363,7 @@ func (r *ServiceRenderer) Navigations(resource dao.Resource) []render.Navigation
 	// Extract cluster name from ARN for filtering
 	clusterName := appaws.ExtractResourceName(svc.ClusterArn())
 
-	return []render.Navigation{
+	navs := []render.Navigation{
 		{
 			Key:         "t",
 			Label:       "Tasks",
@@ -380,12 +381,42 @@ func (r *ServiceRenderer) Navigations(resource dao.Resource) []render.Navigation
 			FilterValue: clusterName,
 		},
 		{
-			Key:         "l",
-			Label:       "Logs",
-			Service:     "cloudwatch",
-			Resource:    "log-groups",
-			FilterField: "LogGroupPrefix",
-			FilterValue: "/ecs/" + svc.GetName(),
+			Key:         "p",
+			Label:       "Deployments",
+			Service:     "ecs",
+			Resource:    "deployments",
+			FilterField: appecs.FilterService,
+			FilterValue: appecs.ServiceRef(clusterName, svc.GetName()),
+			AutoReload:  true,
+		},
+		{
+			Key:         "e",
+			Label:       "Events",
+			Service:     "ecs",
+			Resource:    "service-events",
+			FilterField: appecs.FilterService,
+			FilterValue: appecs.ServiceRef(clusterName, svc.GetName()),
+			AutoReload:  true,
+		},
+		{
+			Key:         "T",
+			Label:       "Task Definition",
+			Service:     "ecs",
+			Resource:    "task-definitions",
+			FilterField: "TaskDefinition",
+			FilterValue: appaws.ExtractResourceName(svc.TaskDefinition()),
 		},
 	}
+
+	// Open the service's container logs
+	if svc.TaskDefinition() != "" {
+		target := svc.LogTarget()
+		navs = append(navs, render.Navigation{
+			Key:   "l",
+			Label: "Logs",
+			Logs:  &target,
+		})
+	}
+
+	return navs
 }
@@ -0,0 +1,44 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ecs/types"
+)
+
+func TestScaleTarget(t *testing.T) {
+	svc := NewServiceResource(types.Service{
+		ServiceName:  aws.String("web"),
+		ClusterArn:   aws.String("arn:aws:ecs:us-east-1:123456789012:cluster/prod"),
+		DesiredCount: 3,
+	})
+	got := svc.ScaleTarget()
+	if got.Cluster != "prod" || got.Service != "web" || got.Desired != 3 {
+		t.Errorf("ScaleTarget() = %+v", got)
+	}
+}
+
+func TestAwslogsTarget(t *testing.T) {
+	td := &types.TaskDefinition{ContainerDefinitions: []types.ContainerDefinition{
+		{Name: aws.String("init")},
+		{
+			Name: aws.String("app"),
+			LogConfiguration: &types.LogConfiguration{
+				LogDriver: types.LogDriverAwslogs,
+				Options:   map[string]string{"awslogs-group": "/ecs/web", "awslogs-stream-prefix": "ecs"},
+			},
+		},
+	}}
+	target, err := awslogsTarget(td, "web")
+	if err != nil {
+		t.Fatalf("awslogsTarget() error = %v", err)
+	}
+	if target.Group != "/ecs/web" || target.StreamPrefix != "ecs/app/" {
+		t.Errorf("awslogsTarget() = %+v", target)
+	}
+
+	if _, err := awslogsTarget(&types.TaskDefinition{}, "web"); err == nil {
+		t.Error("expected error without an awslogs container")
+	}
+}
@@ -0,0 +1,64 @@
+package taskdefinitions
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/ecs"
+
+	ecsClient "github.com/clawscli/claws/custom/ecs"
+	"github.com/clawscli/claws/internal/action"
+	"github.com/clawscli/claws/internal/dao"
+)
+
+func init() {
+	action.Global.Register("ecs", "task-definitions", []action.Action{
+		{
+			Name:     "Diff with Previous",
+			Shortcut: "d",
+			Type:     action.ActionTypeView,
+			Target:   action.TargetTaskDefDiff,
+		},
+		{
+			Name:         "Deregister",
+			Shortcut:     "D",
+			Type:         action.ActionTypeAPI,
+			Operation:    "DeregisterTaskDefinition",
+			Confirm:      action.ConfirmDangerous,
+			ConfirmToken: action.ConfirmTokenName,
+		},
+	})
+
+	action.RegisterExecutor("ecs", "task-definitions", executeTaskDefinitionAction)
+}
+
+// executeTaskDefinitionAction executes an action on a task definition revision
+func executeTaskDefinitionAction(ctx context.Context, act action.Action, resource dao.Resource) action.ActionResult {
+	switch act.Operation {
+	case "DeregisterTaskDefinition":
+		return executeDeregister(ctx, resource)
+	default:
+		return action.UnknownOperationResult(act.Operation)
+	}
+}
+
+func executeDeregister(ctx context.Context, resource dao.Resource) action.ActionResult {
+	td, ok := resource.(*TaskDefinitionResource)
+	if !ok {
+		return action.InvalidResourceResult()
+	}
+
+	client, err := ecsClient.GetClient(ctx)
+	if err != nil {
+		return action.FailResult(err)
+	}
+
+	id := td.GetID()
+	if _, err := client.DeregisterTaskDefinition(ctx, &ecs.DeregisterTaskDefinitionInput{
+		TaskDefinition: &id,
+	}); err != nil {
+		return action.FailResultf(err, "deregister task definition %s", id)
+	}
+
+	return action.SuccessResult(fmt.Sprintf("Deregistered %s; running tasks and services using it are not affected", id))
+}
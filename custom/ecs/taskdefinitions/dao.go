@@ -0,0 +1,270 @@
+package taskdefinitions
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/ecs"
+	"github.com/aws/aws-sdk-go-v2/service/ecs/types"
+	"golang.org/x/sync/errgroup"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+	"github.com/clawscli/claws/internal/log"
+	"github.com/clawscli/claws/internal/taskdef"
+)
+
+// describeConcurrency bounds the DescribeTaskDefinition calls made for a
+// page, as the API describes one revision per call.
+const describeConcurrency = 10
+
+// TaskDefinitionDAO provides data access for ECS task definition revisions
+type TaskDefinitionDAO struct {
+	dao.BaseDAO
+	client *ecs.Client
+}
+
+// NewTaskDefinitionDAO creates a new TaskDefinitionDAO
+func NewTaskDefinitionDAO(ctx context.Context) (dao.DAO, error) {
+	cfg, err := appaws.NewConfig(ctx)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new ecs/task-definitions dao")
+	}
+	return &TaskDefinitionDAO{
+		BaseDAO: dao.NewBaseDAO("ecs", "task-definitions"),
+		client:  ecs.NewFromConfig(cfg),
+	}, nil
+}
+
+// List returns the first page of active task definition revisions.
+// For paginated access, use ListPage instead.
+func (d *TaskDefinitionDAO) List(ctx context.Context) ([]dao.Resource, error) {
+	resources, _, err := d.ListPage(ctx, 100, "")
+	return resources, err
+}
+
+// ListPage returns a page of active revisions, newest first. With a
+// "Family" filter only that family's revisions are listed; a
+// "TaskDefinition" filter lists the one revision a service or task uses.
+// Implements dao.PaginatedDAO interface.
+func (d *TaskDefinitionDAO) ListPage(ctx context.Context, pageSize int, pageToken string) ([]dao.Resource, string, error) {
+	if id := dao.GetFilterFromContext(ctx, "TaskDefinition"); id != "" {
+		resource, err := d.Get(ctx, id)
+		if err != nil {
+			return nil, "", err
+		}
+		return []dao.Resource{resource}, "", nil
+	}
+
+	maxResults := int32(pageSize)
+	if maxResults > 100 {
+		maxResults = 100 // AWS API max
+	}
+
+	input := &ecs.ListTaskDefinitionsInput{
+		Status:     types.TaskDefinitionStatusActive,
+		Sort:       types.SortOrderDesc,
+		MaxResults: &maxResults,
+	}
+	family := dao.GetFilterFromContext(ctx, "Family")
+	if family != "" {
+		input.FamilyPrefix = &family
+	}
+	if pageToken != "" {
+		input.NextToken = &pageToken
+	}
+
+	output, err := d.client.ListTaskDefinitions(ctx, input)
+	if err != nil {
+		return nil, "", apperrors.Wrap(err, "list task definitions")
+	}
+
+	// FamilyPrefix also matches longer family names
+	var arns []string
+	for _, arn := range output.TaskDefinitionArns {
+		if t, ok := taskdef.ParseTarget(arn); ok && (family == "" || t.Family == family) {
+			arns = append(arns, arn)
+		}
+	}
+
+	resources := d.describe(ctx, arns)
+
+	nextToken := ""
+	if output.NextToken != nil {
+		nextToken = *output.NextToken
+	}
+
+	return resources, nextToken, nil
+}
+
+// describe describes each revision concurrently, keeping the list order.
+// A revision that cannot be described is listed from its ARN alone.
+func (d *TaskDefinitionDAO) describe(ctx context.Context, arns []string) []dao.Resource {
+	resources := make([]dao.Resource, len(arns))
+	g, ctx := errgroup.WithContext(ctx)
+	g.SetLimit(describeConcurrency)
+	for i, arn := range arns {
+		g.Go(func() error {
+			output, err := d.client.DescribeTaskDefinition(ctx, &ecs.DescribeTaskDefinitionInput{
+				TaskDefinition: &arn,
+			})
+			if err != nil || output.TaskDefinition == nil {
+				log.Warn("failed to describe task definition", "arn", arn, "error", err)
+				resources[i] = NewTaskDefinitionResourceFromARN(arn)
+				return nil // list the remaining revisions
+			}
+			resources[i] = NewTaskDefinitionResource(*output.TaskDefinition, nil)
+			return nil
+		})
+	}
+	_ = g.Wait() // goroutines never fail
+	return resources
+}
+
+// Get returns a revision by "family:revision" or ARN, with its tags
+func (d *TaskDefinitionDAO) Get(ctx context.Context, id string) (dao.Resource, error) {
+	output, err := d.client.DescribeTaskDefinition(ctx, &ecs.DescribeTaskDefinitionInput{
+		TaskDefinition: &id,
+		Include:        []types.TaskDefinitionField{types.TaskDefinitionFieldTags},
+	})
+	if err != nil {
+		return nil, apperrors.Wrapf(err, "describe task definition %s", id)
+	}
+	if output.TaskDefinition == nil {
+		return nil, fmt.Errorf("task definition not found: %s", id)
+	}
+	return NewTaskDefinitionResource(*output.TaskDefinition, output.Tags), nil
+}
+
+// Delete is not supported; revisions are deregistered from the action menu
+func (d *TaskDefinitionDAO) Delete(ctx context.Context, id string) error {
+	return fmt.Errorf("delete not supported for task definitions, use the Deregister action")
+}
+
+func (d *TaskDefinitionDAO) Supports(op dao.Operation) bool {
+	switch op {
+	case dao.OpList, dao.OpGet:
+		return true
+	default:
+		return false
+	}
+}
+
+// TaskDefinitionResource wraps an ECS task definition revision. Item is
+// nil when the revision is only known from its ARN.
+type TaskDefinitionResource struct {
+	dao.BaseResource
+	Item   *types.TaskDefinition
+	target taskdef.Target
+}
+
+// NewTaskDefinitionResource creates a new TaskDefinitionResource
+func NewTaskDefinitionResource(td types.TaskDefinition, tags []types.Tag) *TaskDefinitionResource {
+	target := taskdef.Target{Family: appaws.Str(td.Family), Revision: td.Revision}
+	r := &TaskDefinitionResource{
+		BaseResource: dao.BaseResource{
+			ID:   target.String(),
+			Name: target.String(),
+			ARN:  appaws.Str(td.TaskDefinitionArn),
+			Data: td,
+		},
+		Item:   &td,
+		target: target,
+	}
+	if len(tags) > 0 {
+		r.Tags = make(map[string]string, len(tags))
+		for _, tag := range tags {
+			r.Tags[appaws.Str(tag.Key)] = appaws.Str(tag.Value)
+		}
+	}
+	return r
+}
+
+// NewTaskDefinitionResourceFromARN creates a TaskDefinitionResource for a
+// revision that has not been described
+func NewTaskDefinitionResourceFromARN(arn string) *TaskDefinitionResource {
+	target, _ := taskdef.ParseTarget(arn)
+	return &TaskDefinitionResource{
+		BaseResource: dao.BaseResource{
+			ID:   target.String(),
+			Name: target.String(),
+			ARN:  arn,
+		},
+		target: target,
+	}
+}
+
+// TaskDefTarget implements taskdef.Provider
+func (r *TaskDefinitionResource) TaskDefTarget() taskdef.Target {
+	return r.target
+}
+
+// Family returns the task definition family
+func (r *TaskDefinitionResource) Family() string {
+	return r.target.Family
+}
+
+// Revision returns the revision number
+func (r *TaskDefinitionResource) Revision() int32 {
+	return r.target.Revision
+}
+
+// Status returns the revision status (ACTIVE, INACTIVE or DELETE_IN_PROGRESS)
+func (r *TaskDefinitionResource) Status() string {
+	if r.Item == nil {
+		return ""
+	}
+	return string(r.Item.Status)
+}
+
+// CPU returns the task-level CPU units
+func (r *TaskDefinitionResource) CPU() string {
+	if r.Item == nil {
+		return ""
+	}
+	return appaws.Str(r.Item.Cpu)
+}
+
+// Memory returns the task-level memory in MiB
+func (r *TaskDefinitionResource) Memory() string {
+	if r.Item == nil {
+		return ""
+	}
+	return appaws.Str(r.Item.Memory)
+}
+
+// Containers returns the container definitions
+func (r *TaskDefinitionResource) Containers() []types.ContainerDefinition {
+	if r.Item == nil {
+		return nil
+	}
+	return r.Item.ContainerDefinitions
+}
+
+// Images returns the distinct container images, in container order
+func (r *TaskDefinitionResource) Images() []string {
+	var images []string
+	seen := make(map[string]bool)
+	for _, c := range r.Containers() {
+		image := appaws.Str(c.Image)
+		if image != "" && !seen[image] {
+			seen[image] = true
+			images = append(images, image)
+		}
+	}
+	return images
+}
+
+// Compatibilities returns the launch types the revision requires, e.g. FARGATE
+func (r *TaskDefinitionResource) Compatibilities() string {
+	if r.Item == nil {
+		return ""
+	}
+	parts := make([]string, len(r.Item.RequiresCompatibilities))
+	for i, c := range r.Item.RequiresCompatibilities {
+		parts[i] = string(c)
+	}
+	return strings.Join(parts, ", ")
+}
@@ -0,0 +1,20 @@
+package taskdefinitions
+
+import (
+	"context"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/registry"
+	"github.com/clawscli/claws/internal/render"
+)
+
+func init() {
+	registry.Global.RegisterCustom("ecs", "task-definitions", registry.Entry{
+		DAOFactory: func(ctx context.Context) (dao.DAO, error) {
+			return NewTaskDefinitionDAO(ctx)
+		},
+		RendererFactory: func() render.Renderer {
+			return NewTaskDefinitionRenderer()
+		},
+	})
+}
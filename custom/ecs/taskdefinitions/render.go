@@ -0,0 +1,316 @@
+package taskdefinitions
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/ecs/types"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/render"
+	"github.com/clawscli/claws/internal/taskdef"
+)
+
+// Ensure TaskDefinitionRenderer implements render.Navigator
+var _ render.Navigator = (*TaskDefinitionRenderer)(nil)
+
+// TaskDefinitionRenderer renders ECS task definition revisions
+type TaskDefinitionRenderer struct {
+	render.BaseRenderer
+}
+
+// NewTaskDefinitionRenderer creates a new TaskDefinitionRenderer
+func NewTaskDefinitionRenderer() render.Renderer {
+	return &TaskDefinitionRenderer{
+		BaseRenderer: render.BaseRenderer{
+			Service:  "ecs",
+			Resource: "task-definitions",
+			Cols: []render.Column{
+				{Name: "FAMILY", Width: 35, Getter: getFamily},
+				{Name: "REV", Width: 5, Getter: getRevision},
+				{Name: "CPU", Width: 6, Getter: getCPU},
+				{Name: "MEMORY", Width: 7, Getter: getMemory},
+				{Name: "COMPAT", Width: 12, Getter: getCompatibilities},
+				{Name: "IMAGE", Width: 45, Getter: getImage},
+				{Name: "AGE", Width: 8, Getter: getAge},
+			},
+		},
+	}
+}
+
+func getFamily(r dao.Resource) string {
+	if td, ok := r.(*TaskDefinitionResource); ok {
+		return td.Family()
+	}
+	return ""
+}
+
+func getRevision(r dao.Resource) string {
+	if td, ok := r.(*TaskDefinitionResource); ok {
+		return fmt.Sprintf("%d", td.Revision())
+	}
+	return ""
+}
+
+func getCPU(r dao.Resource) string {
+	if td, ok := r.(*TaskDefinitionResource); ok {
+		return td.CPU()
+	}
+	return ""
+}
+
+func getMemory(r dao.Resource) string {
+	if td, ok := r.(*TaskDefinitionResource); ok {
+		return td.Memory()
+	}
+	return ""
+}
+
+func getCompatibilities(r dao.Resource) string {
+	if td, ok := r.(*TaskDefinitionResource); ok {
+		return td.Compatibilities()
+	}
+	return ""
+}
+
+func getImage(r dao.Resource) string {
+	if td, ok := r.(*TaskDefinitionResource); ok {
+		images := td.Images()
+		switch len(images) {
+		case 0:
+			return ""
+		case 1:
+			return images[0]
+		default:
+			return fmt.Sprintf("%s (+%d)", images[0], len(images)-1)
+		}
+	}
+	return ""
+}
+
+func getAge(r dao.Resource) string {
+	if td, ok := r.(*TaskDefinitionResource); ok && td.Item != nil && td.Item.RegisteredAt != nil {
+		return render.FormatAge(*td.Item.RegisteredAt)
+	}
+	return ""
+}
+
+// RenderDetail renders detailed task definition information
+func (r *TaskDefinitionRenderer) RenderDetail(resource dao.Resource) string {
+	td, ok := resource.(*TaskDefinitionResource)
+	if !ok {
+		return ""
+	}
+
+	d := render.NewDetailBuilder()
+
+	d.Title("ECS Task Definition", td.GetName())
+
+	d.Section("Basic Information")
+	d.Field("Family", td.Family())
+	d.Field("Revision", fmt.Sprintf("%d", td.Revision()))
+	d.Field("ARN", td.GetARN())
+
+	item := td.Item
+	if item == nil {
+		return d.String()
+	}
+
+	d.FieldStyled("Status", td.Status(), render.StateColorer()(strings.ToLower(td.Status())))
+	if compat := td.Compatibilities(); compat != "" {
+		d.Field("Compatibilities", compat)
+	}
+	if item.NetworkMode != "" {
+		d.Field("Network Mode", string(item.NetworkMode))
+	}
+	if p := item.RuntimePlatform; p != nil && (p.CpuArchitecture != "" || p.OperatingSystemFamily != "") {
+		d.Field("Platform", strings.Trim(fmt.Sprintf("%s/%s", p.OperatingSystemFamily, p.CpuArchitecture), "/"))
+	}
+
+	d.Section("Resources")
+	if cpu := td.CPU(); cpu != "" {
+		d.Field("CPU", cpu+" units")
+	}
+	if mem := td.Memory(); mem != "" {
+		d.Field("Memory", mem+" MiB")
+	}
+	if item.EphemeralStorage != nil {
+		d.Field("Ephemeral Storage", fmt.Sprintf("%d GiB", item.EphemeralStorage.SizeInGiB))
+	}
+
+	if item.TaskRoleArn != nil || item.ExecutionRoleArn != nil {
+		d.Section("IAM Roles")
+		d.FieldIf("Task Role", item.TaskRoleArn)
+		d.FieldIf("Execution Role", item.ExecutionRoleArn)
+	}
+
+	for _, c := range td.Containers() {
+		renderContainer(d, c)
+	}
+
+	if len(item.Volumes) > 0 {
+		d.Section("Volumes")
+		for _, v := range item.Volumes {
+			d.Field(appaws.Str(v.Name), volumeSource(v))
+		}
+	}
+
+	d.Section("Timestamps")
+	if item.RegisteredAt != nil {
+		d.Field("Registered", item.RegisteredAt.Format(time.RFC3339))
+	}
+	if item.RegisteredBy != nil {
+		d.Field("Registered By", appaws.ExtractResourceName(*item.RegisteredBy))
+	}
+	if item.DeregisteredAt != nil {
+		d.Field("Deregistered", item.DeregisteredAt.Format(time.RFC3339))
+	}
+
+	d.Tags(td.GetTags())
+
+	return d.String()
+}
+
+// renderContainer renders a container definition; environment values that
+// look like secrets are masked, and secrets show only where they come from
+func renderContainer(d *render.DetailBuilder, c types.ContainerDefinition) {
+	d.Section("Container: " + appaws.Str(c.Name))
+	d.Field("Image", appaws.Str(c.Image))
+	if c.Essential != nil && !*c.Essential {
+		d.Field("Essential", "No")
+	}
+	if c.Cpu > 0 {
+		d.Field("CPU", fmt.Sprintf("%d units", c.Cpu))
+	}
+	if c.Memory != nil {
+		d.Field("Memory (hard)", fmt.Sprintf("%d MiB", *c.Memory))
+	}
+	if c.MemoryReservation != nil {
+		d.Field("Memory (soft)", fmt.Sprintf("%d MiB", *c.MemoryReservation))
+	}
+	if len(c.PortMappings) > 0 {
+		ports := make([]string, len(c.PortMappings))
+		for i, p := range c.PortMappings {
+			ports[i] = fmt.Sprintf("%d/%s", appaws.Int32(p.ContainerPort), strings.ToLower(string(p.Protocol)))
+			if host := appaws.Int32(p.HostPort); host != 0 && host != appaws.Int32(p.ContainerPort) {
+				ports[i] = fmt.Sprintf("%d→%s", host, ports[i])
+			}
+		}
+		d.Field("Ports", strings.Join(ports, ", "))
+	}
+	if len(c.EntryPoint) > 0 {
+		d.Field("Entry Point", strings.Join(c.EntryPoint, " "))
+	}
+	if len(c.Command) > 0 {
+		d.Field("Command", strings.Join(c.Command, " "))
+	}
+
+	if len(c.Environment) > 0 {
+		env := append([]types.KeyValuePair{}, c.Environment...)
+		sort.Slice(env, func(i, j int) bool { return appaws.Str(env[i].Name) < appaws.Str(env[j].Name) })
+		d.Field("Environment", fmt.Sprintf("%d variables", len(env)))
+		for _, kv := range env {
+			name := appaws.Str(kv.Name)
+			d.Tag(name, taskdef.MaskEnv(name, appaws.Str(kv.Value)))
+		}
+	}
+	if len(c.Secrets) > 0 {
+		d.Field("Secrets", fmt.Sprintf("%d references", len(c.Secrets)))
+		for _, s := range c.Secrets {
+			d.Tag(appaws.Str(s.Name), "from "+appaws.Str(s.ValueFrom))
+		}
+	}
+
+	if lc := c.LogConfiguration; lc != nil {
+		d.Field("Log Driver", string(lc.LogDriver))
+		keys := make([]string, 0, len(lc.Options))
+		for k := range lc.Options {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			d.Tag(k, lc.Options[k])
+		}
+	}
+
+	if hc := c.HealthCheck; hc != nil && len(hc.Command) > 0 {
+		d.Field("Health Check", strings.Join(hc.Command, " "))
+	}
+}
+
+// volumeSource describes where a volume's data lives
+func volumeSource(v types.Volume) string {
+	switch {
+	case v.EfsVolumeConfiguration != nil:
+		return "EFS " + appaws.Str(v.EfsVolumeConfiguration.FileSystemId)
+	case v.Host != nil && v.Host.SourcePath != nil:
+		return "host " + *v.Host.SourcePath
+	case v.DockerVolumeConfiguration != nil:
+		return "docker " + appaws.Str(v.DockerVolumeConfiguration.Driver)
+	case v.FsxWindowsFileServerVolumeConfiguration != nil:
+		return "FSx " + appaws.Str(v.FsxWindowsFileServerVolumeConfiguration.FileSystemId)
+	default:
+		return "task storage"
+	}
+}
+
+// RenderSummary returns summary fields for the header panel
+func (r *TaskDefinitionRenderer) RenderSummary(resource dao.Resource) []render.SummaryField {
+	td, ok := resource.(*TaskDefinitionResource)
+	if !ok {
+		return r.BaseRenderer.RenderSummary(resource)
+	}
+
+	fields := []render.SummaryField{
+		{Label: "Family", Value: td.Family()},
+		{Label: "Revision", Value: fmt.Sprintf("%d", td.Revision())},
+	}
+	if cpu, mem := td.CPU(), td.Memory(); cpu != "" || mem != "" {
+		fields = append(fields, render.SummaryField{Label: "CPU/Memory", Value: fmt.Sprintf("%s / %s", cpu, mem)})
+	}
+	if images := td.Images(); len(images) > 0 {
+		fields = append(fields, render.SummaryField{Label: "Images", Value: strings.Join(images, ", ")})
+	}
+	if td.Item != nil && td.Item.TaskRoleArn != nil {
+		fields = append(fields, render.SummaryField{Label: "Task Role", Value: appaws.ExtractResourceName(*td.Item.TaskRoleArn)})
+	}
+	return fields
+}
+
+// Navigations returns navigation shortcuts
+func (r *TaskDefinitionRenderer) Navigations(resource dao.Resource) []render.Navigation {
+	td, ok := resource.(*TaskDefinitionResource)
+	if !ok {
+		return nil
+	}
+
+	navs := []render.Navigation{
+		{
+			Key:         "r",
+			Label:       "Revisions",
+			Service:     "ecs",
+			Resource:    "task-definitions",
+			FilterField: "Family",
+			FilterValue: td.Family(),
+		},
+	}
+
+	for _, c := range td.Containers() {
+		if lc := c.LogConfiguration; lc != nil && lc.LogDriver == types.LogDriverAwslogs && lc.Options["awslogs-group"] != "" {
+			navs = append(navs, render.Navigation{
+				Key:         "l",
+				Label:       "Log Group",
+				Service:     "cloudwatch",
+				Resource:    "log-groups",
+				FilterField: "LogGroupPrefix",
+				FilterValue: lc.Options["awslogs-group"],
+			})
+			break
+		}
+	}
+
+	return navs
+}
@@ -0,0 +1,115 @@
+package taskdefinitions
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ecs/types"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/usage"
+)
+
+func testTaskDefinition() types.TaskDefinition {
+	return types.TaskDefinition{
+		Family:                  aws.String("web"),
+		Revision:                7,
+		TaskDefinitionArn:       aws.String("arn:aws:ecs:us-east-1:123456789012:task-definition/web:7"),
+		Status:                  types.TaskDefinitionStatusActive,
+		Cpu:                     aws.String("512"),
+		Memory:                  aws.String("1024"),
+		RequiresCompatibilities: []types.Compatibility{types.CompatibilityFargate},
+		ContainerDefinitions: []types.ContainerDefinition{
+			{
+				Name:  aws.String("app"),
+				Image: aws.String("123456789012.dkr.ecr.us-east-1.amazonaws.com/web:1.4"),
+				Environment: []types.KeyValuePair{
+					{Name: aws.String("LOG_LEVEL"), Value: aws.String("info")},
+					{Name: aws.String("DB_PASSWORD"), Value: aws.String("hunter2")},
+				},
+				Secrets: []types.Secret{
+					{Name: aws.String("API_KEY"), ValueFrom: aws.String("arn:aws:ssm:us-east-1:123456789012:parameter/web/api-key")},
+				},
+				LogConfiguration: &types.LogConfiguration{
+					LogDriver: types.LogDriverAwslogs,
+					Options:   map[string]string{"awslogs-group": "/ecs/web", "awslogs-stream-prefix": "app"},
+				},
+			},
+			{Name: aws.String("sidecar"), Image: aws.String("envoy:v1.29")},
+		},
+	}
+}
+
+func TestNewTaskDefinitionResource(t *testing.T) {
+	td := NewTaskDefinitionResource(testTaskDefinition(), []types.Tag{{Key: aws.String("team"), Value: aws.String("web")}})
+
+	if td.GetID() != "web:7" || td.Family() != "web" || td.Revision() != 7 {
+		t.Errorf("id = %q, family = %q, revision = %d", td.GetID(), td.Family(), td.Revision())
+	}
+	if td.TaskDefTarget().String() != "web:7" {
+		t.Errorf("TaskDefTarget() = %v", td.TaskDefTarget())
+	}
+	if images := td.Images(); len(images) != 2 || getImage(td) != images[0]+" (+1)" {
+		t.Errorf("Images() = %v, column = %q", images, getImage(td))
+	}
+	if td.Tags["team"] != "web" {
+		t.Errorf("Tags = %v", td.Tags)
+	}
+
+	fromARN := NewTaskDefinitionResourceFromARN("arn:aws:ecs:us-east-1:123456789012:task-definition/web:6")
+	if fromARN.GetID() != "web:6" || fromARN.CPU() != "" || fromARN.Containers() != nil {
+		t.Errorf("undescribed revision: id = %q", fromARN.GetID())
+	}
+}
+
+func TestRenderDetail(t *testing.T) {
+	detail := NewTaskDefinitionRenderer().RenderDetail(NewTaskDefinitionResource(testTaskDefinition(), nil))
+
+	for _, want := range []string{"Container: app", "web:1.4", "LOG_LEVEL", "info", "DB_PASSWORD", "parameter/web/api-key", "awslogs", "/ecs/web", "1024 MiB", "FARGATE"} {
+		if !strings.Contains(detail, want) {
+			t.Errorf("detail missing %q:\n%s", want, detail)
+		}
+	}
+	if strings.Contains(detail, "hunter2") {
+		t.Errorf("detail should mask sensitive environment values:\n%s", detail)
+	}
+}
+
+func TestNavigations(t *testing.T) {
+	navs := NewTaskDefinitionRenderer().(*TaskDefinitionRenderer).Navigations(NewTaskDefinitionResource(testTaskDefinition(), nil))
+	got := map[string]string{}
+	for _, n := range navs {
+		got[n.Key] = n.FilterValue
+	}
+	if got["r"] != "web" || got["l"] != "/ecs/web" {
+		t.Errorf("Navigations() = %v", got)
+	}
+}
+
+func TestRoleUsage(t *testing.T) {
+	td := testTaskDefinition()
+	td.TaskRoleArn = aws.String("arn:aws:iam::123456789012:role/web-task")
+	td.ExecutionRoleArn = aws.String("arn:aws:iam::123456789012:role/ecsTaskExecutionRole")
+	r := NewTaskDefinitionResource(td, nil)
+
+	var finder *usage.Finder
+	for _, f := range usage.Global.Get("iam", "roles") {
+		if f.Service == "ecs" && f.Resource == "task-definitions" {
+			finder = &f
+		}
+	}
+	if finder == nil {
+		t.Fatal("no iam/roles finder registered for task definitions")
+	}
+	for _, role := range []string{"web-task", "ecsTaskExecutionRole"} {
+		target := &dao.BaseResource{ID: role, Name: role}
+		if !slices.ContainsFunc(finder.Refs(r), func(ref string) bool { return usage.Matches(target, ref) }) {
+			t.Errorf("Refs() = %v, want a match for role %s", finder.Refs(r), role)
+		}
+	}
+	if refs := finder.Refs(NewTaskDefinitionResourceFromARN("arn:aws:ecs:us-east-1:123456789012:task-definition/web:1")); len(refs) != 0 {
+		t.Errorf("Refs() of an undescribed revision = %v, want none", refs)
+	}
+}
@@ -0,0 +1,24 @@
+package taskdefinitions
+
+import (
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/usage"
+)
+
+func init() {
+	// One finder for both roles: listing describes every revision, so a
+	// second finder would double the DescribeTaskDefinition calls.
+	usage.Global.Register("iam", "roles", usage.Finder{
+		Service:  "ecs",
+		Resource: "task-definitions",
+		Relation: "task or execution role",
+		Refs: func(r dao.Resource) []string {
+			td, ok := r.(*TaskDefinitionResource)
+			if !ok || td.Item == nil {
+				return nil
+			}
+			return []string{appaws.Str(td.Item.TaskRoleArn), appaws.Str(td.Item.ExecutionRoleArn)}
+		},
+	})
+}
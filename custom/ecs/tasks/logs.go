@@ -0,0 +1,72 @@
+package tasks
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/ecs"
+	"github.com/aws/aws-sdk-go-v2/service/ecs/types"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	apperrors "github.com/clawscli/claws/internal/errors"
+	"github.com/clawscli/claws/internal/logs"
+)
+
+// LogTarget implements logs.Provider. The log group and streams come from the
+// awslogs configuration of the task definition, resolved when the viewer opens.
+func (r *TaskResource) LogTarget() logs.Target {
+	taskDefArn := r.TaskDefinitionArn()
+	taskID := r.GetID()
+	return logs.Target{
+		Title: "task " + taskID,
+		Resolve: func(ctx context.Context) (logs.Target, error) {
+			cfg, err := appaws.NewConfig(ctx)
+			if err != nil {
+				return logs.Target{}, err
+			}
+			out, err := ecs.NewFromConfig(cfg).DescribeTaskDefinition(ctx, &ecs.DescribeTaskDefinitionInput{
+				TaskDefinition: appaws.StringPtr(taskDefArn),
+			})
+			if err != nil {
+				return logs.Target{}, apperrors.Wrapf(err, "describe task definition %s", taskDefArn)
+			}
+			return awslogsTarget(out.TaskDefinition, taskID)
+		},
+	}
+}
+
+// awslogsTarget builds the log target for a task from its task definition.
+// With awslogs-stream-prefix set, each container logs to
+// "prefix/container-name/task-id"; without it the stream names are not
+// predictable and the whole group is shown.
+func awslogsTarget(td *types.TaskDefinition, taskID string) (logs.Target, error) {
+	if td == nil {
+		return logs.Target{}, fmt.Errorf("task definition not found")
+	}
+
+	var target logs.Target
+	for _, c := range td.ContainerDefinitions {
+		lc := c.LogConfiguration
+		if lc == nil || lc.LogDriver != types.LogDriverAwslogs {
+			continue
+		}
+		group := lc.Options["awslogs-group"]
+		if group == "" {
+			continue
+		}
+		if target.Group == "" {
+			target.Group = group
+		} else if group != target.Group {
+			// A viewer shows a single group; keep the first container's.
+			continue
+		}
+		if prefix := lc.Options["awslogs-stream-prefix"]; prefix != "" {
+			target.Streams = append(target.Streams, strings.Join([]string{prefix, appaws.Str(c.Name), taskID}, "/"))
+		}
+	}
+	if target.Group == "" {
+		return logs.Target{}, fmt.Errorf("task %s has no awslogs log configuration", taskID)
+	}
+	return target, nil
+}
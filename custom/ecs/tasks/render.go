@@ -330,21 +330,24 @@ func (r *TaskRenderer) Navigations(resource dao.Resource) []render.Navigation {
 		})
 	}
 
-	// Add logs navigation - use task definition family as log group prefix
-	if taskDef := task.TaskDefinitionArn(); taskDef != "" {
-		// Task definition ARN: arn:aws:ecs:region:account:task-definition/family:revision
-		taskDefName := appaws.ExtractResourceName(taskDef)
-		// Remove revision number (e.g., "my-task:5" -> "my-task")
-		if idx := strings.LastIndex(taskDefName, ":"); idx > 0 {
-			taskDefName = taskDefName[:idx]
-		}
+	// Open the task's container logs
+	if task.TaskDefinitionArn() != "" {
+		target := task.LogTarget()
 		navs = append(navs, render.Navigation{
-			Key:         "l",
-			Label:       "Logs",
-			Service:     "cloudwatch",
-			Resource:    "log-groups",
-			FilterField: "LogGroupPrefix",
-			FilterValue: "/ecs/" + taskDefName,
+			Key:   "l",
+			Label: "Logs",
+			Logs:  &target,
+		})
+	}
+
+	if td := task.TaskDefinitionArn(); td != "" {
+		navs = append(navs, render.Navigation{
+			Key:         "T",
+			Label:       "Task Definition",
+			Service:     "ecs",
+			Resource:    "task-definitions",
+			FilterField: "TaskDefinition",
+			FilterValue: appaws.ExtractResourceName(td),
 		})
 	}
 
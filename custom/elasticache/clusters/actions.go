@@ -0,0 +1,16 @@
+package clusters
+
+import (
+	"github.com/clawscli/claws/internal/action"
+)
+
+func init() {
+	action.Global.Register("elasticache", "clusters", []action.Action{
+		{
+			Name:     "Port Forward",
+			Shortcut: "f",
+			Type:     action.ActionTypeView,
+			Target:   action.TargetPortForward,
+		},
+	})
+}
@@ -10,6 +10,7 @@ import (
 	appaws "github.com/clawscli/claws/internal/aws"
 	"github.com/clawscli/claws/internal/dao"
 	apperrors "github.com/clawscli/claws/internal/errors"
+	"github.com/clawscli/claws/internal/tunnel"
 )
 
 // ClusterDAO provides data access for ElastiCache clusters
@@ -241,3 +242,23 @@ func (r *ClusterResource) AtRestEncryptionEnabled() bool {
 	}
 	return false
 }
+
+// TunnelEndpoint implements tunnel.Provider. ElastiCache does not report
+// the cluster's VPC, so it is found from the security groups.
+func (r *ClusterResource) TunnelEndpoint() tunnel.Endpoint {
+	e := tunnel.Endpoint{
+		Service:        "elasticache",
+		ResourceType:   "clusters",
+		ID:             r.GetID(),
+		SecurityGroups: r.SecurityGroups(),
+	}
+	endpoint := r.Item.ConfigurationEndpoint
+	if endpoint == nil && len(r.Item.CacheNodes) > 0 {
+		endpoint = r.Item.CacheNodes[0].Endpoint
+	}
+	if endpoint != nil {
+		e.Host = appaws.Str(endpoint.Address)
+		e.Port = appaws.Int32(endpoint.Port)
+	}
+	return e
+}
@@ -0,0 +1,42 @@
+package clusters
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/elasticache/types"
+)
+
+func TestClusterResource_TunnelEndpoint(t *testing.T) {
+	redis := NewClusterResource(types.CacheCluster{
+		CacheClusterId: aws.String("sessions-001"),
+		CacheNodes: []types.CacheNode{{Endpoint: &types.Endpoint{
+			Address: aws.String("sessions-001.abc123.0001.use1.cache.amazonaws.com"),
+			Port:    aws.Int32(6379),
+		}}},
+		SecurityGroups: []types.SecurityGroupMembership{{SecurityGroupId: aws.String("sg-1")}},
+	})
+	e := redis.TunnelEndpoint()
+	if e.Address() != "sessions-001.abc123.0001.use1.cache.amazonaws.com:6379" {
+		t.Errorf("Address() = %q, want the first node's endpoint", e.Address())
+	}
+	if e.VpcID != "" || len(e.SecurityGroups) != 1 || e.SecurityGroups[0] != "sg-1" {
+		t.Errorf("VpcID = %q, SecurityGroups = %v, want the VPC left to the security groups", e.VpcID, e.SecurityGroups)
+	}
+
+	memcached := NewClusterResource(types.CacheCluster{
+		CacheClusterId: aws.String("cache"),
+		ConfigurationEndpoint: &types.Endpoint{
+			Address: aws.String("cache.abc123.cfg.use1.cache.amazonaws.com"),
+			Port:    aws.Int32(11211),
+		},
+		CacheNodes: []types.CacheNode{{Endpoint: &types.Endpoint{Address: aws.String("node"), Port: aws.Int32(11211)}}},
+	})
+	if got := memcached.TunnelEndpoint().Host; got != "cache.abc123.cfg.use1.cache.amazonaws.com" {
+		t.Errorf("Host = %q, want the configuration endpoint", got)
+	}
+
+	if got := NewClusterResource(types.CacheCluster{CacheClusterId: aws.String("creating")}).TunnelEndpoint().Host; got != "" {
+		t.Errorf("Host = %q, want empty without nodes", got)
+	}
+}
@@ -52,29 +52,59 @@ func (d *ListenerDAO) List(ctx context.Context) ([]dao.Resource, error) {
 		return resources, nil
 	}
 
-	// Paginate through results
-	listeners, err := appaws.Paginate(ctx, func(token *string) ([]types.Listener, *string, error) {
-		input := &elasticloadbalancingv2.DescribeListenersInput{
-			Marker: token,
+	// DescribeListeners requires a load balancer, so without one the
+	// listeners of every load balancer are listed
+	lbArns := []string{lbArn}
+	if lbArn == "" {
+		var err error
+		lbArns, err = d.loadBalancerArns(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var resources []dao.Resource
+	for _, arn := range lbArns {
+		listeners, err := d.listForLoadBalancer(ctx, arn)
+		if err != nil {
+			return nil, err
 		}
-		if lbArn != "" {
-			input.LoadBalancerArn = &lbArn
+		for _, listener := range listeners {
+			resources = append(resources, NewListenerResource(listener))
 		}
-		output, err := d.client.DescribeListeners(ctx, input)
+	}
+	return resources, nil
+}
+
+// listForLoadBalancer returns the listeners of one load balancer
+func (d *ListenerDAO) listForLoadBalancer(ctx context.Context, lbArn string) ([]types.Listener, error) {
+	return appaws.Paginate(ctx, func(token *string) ([]types.Listener, *string, error) {
+		output, err := d.client.DescribeListeners(ctx, &elasticloadbalancingv2.DescribeListenersInput{
+			LoadBalancerArn: &lbArn,
+			Marker:          token,
+		})
 		if err != nil {
 			return nil, nil, apperrors.Wrap(err, "list listeners")
 		}
 		return output.Listeners, output.NextMarker, nil
 	})
-	if err != nil {
-		return nil, err
-	}
+}
 
-	resources := make([]dao.Resource, 0, len(listeners))
-	for _, listener := range listeners {
-		resources = append(resources, NewListenerResource(listener))
-	}
-	return resources, nil
+// loadBalancerArns returns the ARNs of all load balancers
+func (d *ListenerDAO) loadBalancerArns(ctx context.Context) ([]string, error) {
+	return appaws.Paginate(ctx, func(token *string) ([]string, *string, error) {
+		output, err := d.client.DescribeLoadBalancers(ctx, &elasticloadbalancingv2.DescribeLoadBalancersInput{
+			Marker: token,
+		})
+		if err != nil {
+			return nil, nil, apperrors.Wrap(err, "list load balancers")
+		}
+		arns := make([]string, 0, len(output.LoadBalancers))
+		for _, lb := range output.LoadBalancers {
+			arns = append(arns, appaws.Str(lb.LoadBalancerArn))
+		}
+		return arns, output.NextMarker, nil
+	})
 }
 
 // Get returns a specific listener
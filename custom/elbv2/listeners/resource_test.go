@@ -7,6 +7,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
 	"github.com/clawscli/claws/internal/registry"
+	"github.com/clawscli/claws/internal/usage"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -69,3 +70,21 @@ func TestListenerRegistry(t *testing.T) {
 	renderer := entry.RendererFactory()
 	require.NotNil(t, renderer)
 }
+
+func TestCertificateUsage(t *testing.T) {
+	certArn := "arn:aws:acm:us-east-1:123456789012:certificate/1234abcd-12ab-34cd-56ef-1234567890ab"
+	r := NewListenerResource(types.Listener{
+		ListenerArn:  aws.String("arn:aws:elasticloadbalancing:us-east-1:123456789012:listener/app/web/50dc6c495c0c9188/f2f7dc8efc522ab2"),
+		Protocol:     types.ProtocolEnumHttps,
+		Certificates: []types.Certificate{{CertificateArn: aws.String(certArn)}},
+	})
+
+	var finder *usage.Finder
+	for _, f := range usage.Global.Get("acm", "certificates") {
+		if f.Service == "elbv2" && f.Resource == "listeners" {
+			finder = &f
+		}
+	}
+	require.NotNil(t, finder, "no acm/certificates finder registered for listeners")
+	assert.Equal(t, []string{certArn}, finder.Refs(r))
+}
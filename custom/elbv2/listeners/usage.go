@@ -0,0 +1,28 @@
+package listeners
+
+import (
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/usage"
+)
+
+func init() {
+	// DescribeListeners returns only the default certificate; SNI
+	// certificates added to a listener are not matched
+	usage.Global.Register("acm", "certificates", usage.Finder{
+		Service:  "elbv2",
+		Resource: "listeners",
+		Relation: "default certificate",
+		Refs: func(r dao.Resource) []string {
+			l, ok := r.(*ListenerResource)
+			if !ok {
+				return nil
+			}
+			var refs []string
+			for _, c := range l.Certificates() {
+				refs = append(refs, appaws.Str(c.CertificateArn))
+			}
+			return refs
+		},
+	})
+}
@@ -0,0 +1,34 @@
+package loadbalancers
+
+import (
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/usage"
+)
+
+func init() {
+	finder := func(relation string, refs func(*LoadBalancerResource) []string) usage.Finder {
+		return usage.Finder{
+			Service:  "elbv2",
+			Resource: "load-balancers",
+			Relation: relation,
+			Refs: func(r dao.Resource) []string {
+				if lb, ok := r.(*LoadBalancerResource); ok {
+					return refs(lb)
+				}
+				return nil
+			},
+		}
+	}
+
+	usage.Global.Register("ec2", "security-groups", finder("security group", func(lb *LoadBalancerResource) []string {
+		return lb.SecurityGroups()
+	}))
+	usage.Global.Register("vpc", "subnets", finder("subnet", func(lb *LoadBalancerResource) []string {
+		var ids []string
+		for _, az := range lb.Item.AvailabilityZones {
+			ids = append(ids, appaws.Str(az.SubnetId))
+		}
+		return ids
+	}))
+}
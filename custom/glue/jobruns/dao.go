@@ -11,6 +11,7 @@ import (
 	appaws "github.com/clawscli/claws/internal/aws"
 	"github.com/clawscli/claws/internal/dao"
 	apperrors "github.com/clawscli/claws/internal/errors"
+	"github.com/clawscli/claws/internal/logs"
 )
 
 // JobRunDAO provides data access for Glue job runs.
@@ -170,3 +171,18 @@ func (r *JobRunResource) NumberOfWorkers() int32 {
 func (r *JobRunResource) GlueVersion() string {
 	return appaws.Str(r.Item.GlueVersion)
 }
+
+// LogTarget implements logs.Provider. Glue writes driver and executor output
+// to "<group>/output" in streams prefixed with the run ID.
+func (r *JobRunResource) LogTarget() logs.Target {
+	group := appaws.Str(r.Item.LogGroupName)
+	if group == "" {
+		group = "/aws-glue/jobs"
+	}
+	target := logs.Target{Group: group + "/output", StreamPrefix: r.GetID()}
+	if started := r.StartedOn(); started != nil {
+		// Cover the whole run, however long ago it started
+		target.Since = max(time.Since(*started).Round(time.Minute)+time.Minute, logs.DefaultSince)
+	}
+	return target
+}
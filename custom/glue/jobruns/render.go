@@ -8,6 +8,9 @@ import (
 )
 
 // JobRunRenderer renders Glue job runs.
+// Ensure JobRunRenderer implements render.Navigator
+var _ render.Navigator = (*JobRunRenderer)(nil)
+
 type JobRunRenderer struct {
 	render.BaseRenderer
 }
@@ -147,3 +150,16 @@ func (r *JobRunRenderer) RenderSummary(resource dao.Resource) []render.SummaryFi
 
 	return fields
 }
+
+// Navigations returns navigation shortcuts
+func (r *JobRunRenderer) Navigations(resource dao.Resource) []render.Navigation {
+	run, ok := resource.(*JobRunResource)
+	if !ok {
+		return nil
+	}
+
+	target := run.LogTarget()
+	return []render.Navigation{
+		{Key: "l", Label: "Logs", Logs: &target},
+	}
+}
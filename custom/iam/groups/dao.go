@@ -9,6 +9,7 @@ import (
 	appaws "github.com/clawscli/claws/internal/aws"
 	"github.com/clawscli/claws/internal/dao"
 	apperrors "github.com/clawscli/claws/internal/errors"
+	"github.com/clawscli/claws/internal/policy"
 )
 
 // GroupDAO provides data access for IAM Groups
@@ -113,3 +114,8 @@ func (r *GroupResource) GroupId() string {
 	}
 	return ""
 }
+
+// PolicyTarget implements policy.Provider
+func (r *GroupResource) PolicyTarget() policy.Target {
+	return policy.Target{Kind: policy.KindGroup, Name: r.GetName(), ARN: r.GetARN()}
+}
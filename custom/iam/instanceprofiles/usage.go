@@ -0,0 +1,20 @@
+package instanceprofiles
+
+import (
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/usage"
+)
+
+func init() {
+	usage.Global.Register("iam", "roles", usage.Finder{
+		Service:  "iam",
+		Resource: "instance-profiles",
+		Relation: "profile role",
+		Refs: func(r dao.Resource) []string {
+			if p, ok := r.(*InstanceProfileResource); ok {
+				return p.RoleNames()
+			}
+			return nil
+		},
+	})
+}
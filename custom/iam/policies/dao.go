@@ -9,6 +9,7 @@ import (
 	appaws "github.com/clawscli/claws/internal/aws"
 	"github.com/clawscli/claws/internal/dao"
 	apperrors "github.com/clawscli/claws/internal/errors"
+	"github.com/clawscli/claws/internal/policy"
 )
 
 // PolicyDAO provides data access for IAM Policies
@@ -195,3 +196,8 @@ func (r *PolicyResource) Scope() string {
 	}
 	return "Local"
 }
+
+// PolicyTarget implements policy.Provider
+func (r *PolicyResource) PolicyTarget() policy.Target {
+	return policy.Target{Kind: policy.KindPolicy, Name: r.GetName(), ARN: r.GetARN()}
+}
@@ -9,6 +9,7 @@ import (
 	appaws "github.com/clawscli/claws/internal/aws"
 	"github.com/clawscli/claws/internal/dao"
 	apperrors "github.com/clawscli/claws/internal/errors"
+	"github.com/clawscli/claws/internal/policy"
 )
 
 // RoleDAO provides data access for IAM Roles
@@ -163,3 +164,8 @@ func (r *RoleResource) Description() string {
 	}
 	return ""
 }
+
+// PolicyTarget implements policy.Provider
+func (r *RoleResource) PolicyTarget() policy.Target {
+	return policy.Target{Kind: policy.KindRole, Name: r.GetName(), ARN: r.GetARN()}
+}
@@ -13,6 +13,12 @@ import (
 
 func init() {
 	action.Global.Register("iam", "users", []action.Action{
+		{
+			Name:     "Policy Documents",
+			Shortcut: "p",
+			Type:     action.ActionTypeView,
+			Target:   action.TargetPolicy,
+		},
 		{
 			Name:      "Delete",
 			Shortcut:  "D",
@@ -9,6 +9,7 @@ import (
 	appaws "github.com/clawscli/claws/internal/aws"
 	"github.com/clawscli/claws/internal/dao"
 	apperrors "github.com/clawscli/claws/internal/errors"
+	"github.com/clawscli/claws/internal/policy"
 )
 
 // UserDetail contains extended user information from multiple API calls
@@ -176,3 +177,8 @@ func (r *UserResource) UserId() string {
 	}
 	return ""
 }
+
+// PolicyTarget implements policy.Provider
+func (r *UserResource) PolicyTarget() policy.Target {
+	return policy.Target{Kind: policy.KindUser, Name: r.GetName(), ARN: r.GetARN()}
+}
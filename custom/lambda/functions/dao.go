@@ -10,6 +10,7 @@ import (
 	appaws "github.com/clawscli/claws/internal/aws"
 	"github.com/clawscli/claws/internal/dao"
 	apperrors "github.com/clawscli/claws/internal/errors"
+	"github.com/clawscli/claws/internal/invoke"
 )
 
 // FunctionDAO provides data access for Lambda functions
@@ -265,3 +266,17 @@ func (r *FunctionResource) CodeSha256() string {
 func (r *FunctionResource) Version() string {
 	return appaws.Str(r.Item.Version)
 }
+
+// LogGroupName returns the function's log group: the custom group from its
+// logging config, or the default "/aws/lambda/<name>".
+func (r *FunctionResource) LogGroupName() string {
+	if lc := r.Item.LoggingConfig; lc != nil && appaws.Str(lc.LogGroup) != "" {
+		return appaws.Str(lc.LogGroup)
+	}
+	return "/aws/lambda/" + r.GetName()
+}
+
+// InvokeTarget returns the function for the invoke dialog.
+func (r *FunctionResource) InvokeTarget() invoke.Target {
+	return invoke.Target{Name: r.GetName(), ARN: r.GetARN()}
+}
@@ -7,6 +7,7 @@ import (
 
 	appaws "github.com/clawscli/claws/internal/aws"
 	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/logs"
 	"github.com/clawscli/claws/internal/render"
 )
 
@@ -321,15 +322,11 @@ func (r *FunctionRenderer) Navigations(resource dao.Resource) []render.Navigatio
 
 	var navs []render.Navigation
 
-	// Navigate to CloudWatch Logs
-	logGroupName := "/aws/lambda/" + fn.GetName()
+	// Open the function's logs
 	navs = append(navs, render.Navigation{
-		Key:         "l",
-		Label:       "Logs",
-		Service:     "cloudwatch",
-		Resource:    "log-groups",
-		FilterField: "LogGroupPrefix",
-		FilterValue: logGroupName,
+		Key:   "l",
+		Label: "Logs",
+		Logs:  &logs.Target{Group: fn.LogGroupName()},
 	})
 
 	// Navigate to IAM role
@@ -139,3 +139,18 @@ func TestFunctionResource_TracingConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestFunctionResource_LogGroupName(t *testing.T) {
+	fn := NewFunctionResource(types.FunctionConfiguration{FunctionName: aws.String("my-function")})
+	if got := fn.LogGroupName(); got != "/aws/lambda/my-function" {
+		t.Errorf("LogGroupName() = %q, want default group", got)
+	}
+
+	fn = NewFunctionResource(types.FunctionConfiguration{
+		FunctionName:  aws.String("my-function"),
+		LoggingConfig: &types.LoggingConfig{LogGroup: aws.String("/custom/group")},
+	})
+	if got := fn.LogGroupName(); got != "/custom/group" {
+		t.Errorf("LogGroupName() = %q, want /custom/group", got)
+	}
+}
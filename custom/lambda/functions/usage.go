@@ -0,0 +1,41 @@
+package functions
+
+import (
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/usage"
+)
+
+func init() {
+	finder := func(relation string, refs func(*FunctionResource) []string) usage.Finder {
+		return usage.Finder{
+			Service:  "lambda",
+			Resource: "functions",
+			Relation: relation,
+			Refs: func(r dao.Resource) []string {
+				if fn, ok := r.(*FunctionResource); ok {
+					return refs(fn)
+				}
+				return nil
+			},
+		}
+	}
+
+	usage.Global.Register("ec2", "security-groups", finder("security group", func(fn *FunctionResource) []string {
+		if fn.Item.VpcConfig == nil {
+			return nil
+		}
+		return fn.Item.VpcConfig.SecurityGroupIds
+	}))
+	usage.Global.Register("vpc", "subnets", finder("subnet", func(fn *FunctionResource) []string {
+		if fn.Item.VpcConfig == nil {
+			return nil
+		}
+		return fn.Item.VpcConfig.SubnetIds
+	}))
+	usage.Global.Register("iam", "roles", finder("execution role", func(fn *FunctionResource) []string {
+		return []string{fn.Role()}
+	}))
+	usage.Global.Register("kms", "keys", finder("environment encryption", func(fn *FunctionResource) []string {
+		return []string{fn.KMSKeyArn()}
+	}))
+}
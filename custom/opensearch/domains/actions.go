@@ -0,0 +1,16 @@
+package domains
+
+import (
+	"github.com/clawscli/claws/internal/action"
+)
+
+func init() {
+	action.Global.Register("opensearch", "domains", []action.Action{
+		{
+			Name:     "Port Forward",
+			Shortcut: "f",
+			Type:     action.ActionTypeView,
+			Target:   action.TargetPortForward,
+		},
+	})
+}
@@ -10,6 +10,7 @@ import (
 	appaws "github.com/clawscli/claws/internal/aws"
 	"github.com/clawscli/claws/internal/dao"
 	apperrors "github.com/clawscli/claws/internal/errors"
+	"github.com/clawscli/claws/internal/tunnel"
 )
 
 // DomainDAO provides data access for OpenSearch domains
@@ -361,3 +362,23 @@ func (r *DomainResource) AutoTuneState() string {
 	}
 	return ""
 }
+
+// opensearchPort is the HTTPS port domain endpoints listen on.
+const opensearchPort = 443
+
+// TunnelEndpoint implements tunnel.Provider
+func (r *DomainResource) TunnelEndpoint() tunnel.Endpoint {
+	host := r.Endpoints()["vpc"]
+	if host == "" {
+		host = r.Endpoint()
+	}
+	return tunnel.Endpoint{
+		Service:        "opensearch",
+		ResourceType:   "domains",
+		ID:             r.GetID(),
+		Host:           host,
+		Port:           opensearchPort,
+		VpcID:          r.VPCId(),
+		SecurityGroups: r.SecurityGroupIds(),
+	}
+}
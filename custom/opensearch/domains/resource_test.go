@@ -0,0 +1,31 @@
+package domains
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/opensearch/types"
+)
+
+func TestDomainResource_TunnelEndpoint(t *testing.T) {
+	vpc := NewDomainResource(types.DomainStatus{
+		DomainName: aws.String("logs"),
+		Endpoints:  map[string]string{"vpc": "vpc-logs-abc123.us-east-1.es.amazonaws.com"},
+		VPCOptions: &types.VPCDerivedInfo{VPCId: aws.String("vpc-1"), SecurityGroupIds: []string{"sg-1"}},
+	})
+	e := vpc.TunnelEndpoint()
+	if e.Address() != "vpc-logs-abc123.us-east-1.es.amazonaws.com:443" {
+		t.Errorf("Address() = %q, want the VPC endpoint on 443", e.Address())
+	}
+	if e.VpcID != "vpc-1" || e.ID != "logs" || e.Service != "opensearch" {
+		t.Errorf("endpoint = %+v", e)
+	}
+
+	public := NewDomainResource(types.DomainStatus{
+		DomainName: aws.String("search"),
+		Endpoint:   aws.String("search-abc123.us-east-1.es.amazonaws.com"),
+	})
+	if got := public.TunnelEndpoint().Host; got != "search-abc123.us-east-1.es.amazonaws.com" {
+		t.Errorf("Host = %q, want the public endpoint", got)
+	}
+}
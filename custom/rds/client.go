@@ -8,6 +8,12 @@ import (
 	appaws "github.com/clawscli/claws/internal/aws"
 )
 
+// Filters set by the rds/instances navigations for their sub-resources.
+const (
+	FilterInstance       = "DBInstanceIdentifier"
+	FilterParameterGroup = "DBParameterGroupName"
+)
+
 // GetClient returns an RDS client configured for the current context
 func GetClient(ctx context.Context) (*rds.Client, error) {
 	cfg, err := appaws.NewConfig(ctx)
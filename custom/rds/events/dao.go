@@ -0,0 +1,129 @@
+package events
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/aws/aws-sdk-go-v2/service/rds/types"
+
+	rdsClient "github.com/clawscli/claws/custom/rds"
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+)
+
+// eventDuration is how far back events are listed: 14 days, the most
+// DescribeEvents keeps
+const eventDuration = 14 * 24 * 60
+
+// EventDAO provides data access for the events of an RDS instance
+type EventDAO struct {
+	dao.BaseDAO
+	client *rds.Client
+}
+
+// NewEventDAO creates a new EventDAO
+func NewEventDAO(ctx context.Context) (dao.DAO, error) {
+	client, err := rdsClient.GetClient(ctx)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new rds/events dao")
+	}
+	return &EventDAO{
+		BaseDAO: dao.NewBaseDAO("rds", "events"),
+		client:  client,
+	}, nil
+}
+
+// List returns the instance's events of the last 14 days, newest first
+func (d *EventDAO) List(ctx context.Context) ([]dao.Resource, error) {
+	instance := dao.GetFilterFromContext(ctx, rdsClient.FilterInstance)
+	if instance == "" {
+		return nil, fmt.Errorf("%s required: navigate from rds/instances", rdsClient.FilterInstance)
+	}
+
+	paginator := rds.NewDescribeEventsPaginator(d.client, &rds.DescribeEventsInput{
+		SourceIdentifier: &instance,
+		SourceType:       types.SourceTypeDbInstance,
+		Duration:         appaws.Int32Ptr(eventDuration),
+	})
+	var events []types.Event
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, apperrors.Wrapf(err, "describe events %s", instance)
+		}
+		events = append(events, output.Events...)
+	}
+	slices.SortStableFunc(events, func(a, b types.Event) int {
+		return appaws.Time(b.Date).Compare(appaws.Time(a.Date))
+	})
+
+	resources := make([]dao.Resource, len(events))
+	for i, event := range events {
+		resources[i] = NewEventResource(event)
+	}
+	return resources, nil
+}
+
+func (d *EventDAO) Get(ctx context.Context, id string) (dao.Resource, error) {
+	return nil, fmt.Errorf("get by ID not supported for rds events")
+}
+
+func (d *EventDAO) Delete(ctx context.Context, id string) error {
+	return fmt.Errorf("delete not supported for rds events")
+}
+
+func (d *EventDAO) Supports(op dao.Operation) bool {
+	return op == dao.OpList
+}
+
+// EventResource wraps an RDS event
+type EventResource struct {
+	dao.BaseResource
+	Item types.Event
+}
+
+// NewEventResource creates a new EventResource. Events have no ID, so the
+// source and time identify them.
+func NewEventResource(event types.Event) *EventResource {
+	id := appaws.Str(event.SourceIdentifier)
+	if event.Date != nil {
+		id += "@" + event.Date.UTC().Format(time.RFC3339Nano)
+	}
+	return &EventResource{
+		BaseResource: dao.BaseResource{
+			ID:   id,
+			Name: appaws.Str(event.Message),
+			ARN:  appaws.Str(event.SourceArn),
+			Data: event,
+		},
+		Item: event,
+	}
+}
+
+// Message returns the event message
+func (r *EventResource) Message() string {
+	return appaws.Str(r.Item.Message)
+}
+
+// Categories returns the event categories, comma separated
+func (r *EventResource) Categories() string {
+	return strings.Join(r.Item.EventCategories, ", ")
+}
+
+// problemCategories mark an event as reporting a failure
+var problemCategories = []string{"failure", "low storage"}
+
+// IsProblem returns whether the event reports a failure
+func (r *EventResource) IsProblem() bool {
+	for _, c := range r.Item.EventCategories {
+		if slices.Contains(problemCategories, c) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,105 @@
+package events
+
+import (
+	"time"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/render"
+)
+
+// EventRenderer renders RDS events
+type EventRenderer struct {
+	render.BaseRenderer
+}
+
+// NewEventRenderer creates a new EventRenderer
+func NewEventRenderer() render.Renderer {
+	return &EventRenderer{
+		BaseRenderer: render.BaseRenderer{
+			Service:  "rds",
+			Resource: "events",
+			Cols: []render.Column{
+				{Name: "TIME", Width: 20, Getter: getTime},
+				{Name: "AGE", Width: 8, Getter: getAge},
+				{Name: "CATEGORIES", Width: 24, Getter: getCategories},
+				{Name: "MESSAGE", Width: 100, Getter: getMessage},
+			},
+		},
+	}
+}
+
+func getTime(r dao.Resource) string {
+	if e, ok := r.(*EventResource); ok && e.Item.Date != nil {
+		return e.Item.Date.Local().Format("2006-01-02 15:04:05")
+	}
+	return ""
+}
+
+func getAge(r dao.Resource) string {
+	if e, ok := r.(*EventResource); ok && e.Item.Date != nil {
+		return render.FormatAge(*e.Item.Date)
+	}
+	return ""
+}
+
+func getCategories(r dao.Resource) string {
+	if e, ok := r.(*EventResource); ok {
+		return e.Categories()
+	}
+	return ""
+}
+
+func getMessage(r dao.Resource) string {
+	if e, ok := r.(*EventResource); ok {
+		return e.Message()
+	}
+	return ""
+}
+
+// RenderDetail renders the full event message
+func (r *EventRenderer) RenderDetail(resource dao.Resource) string {
+	e, ok := resource.(*EventResource)
+	if !ok {
+		return ""
+	}
+
+	d := render.NewDetailBuilder()
+
+	d.Title("RDS Event", e.GetID())
+
+	d.Section("Event")
+	d.FieldIf("Source", e.Item.SourceIdentifier)
+	d.Field("Source Type", string(e.Item.SourceType))
+	if e.Item.Date != nil {
+		d.Field("Time", e.Item.Date.Format(time.RFC3339))
+	}
+	d.Field("Categories", e.Categories())
+	if e.IsProblem() {
+		d.FieldStyled("Message", e.Message(), render.DangerStyle())
+	} else {
+		d.Field("Message", e.Message())
+	}
+	if e.GetARN() != "" {
+		d.Field("Source ARN", e.GetARN())
+	}
+
+	return d.String()
+}
+
+// RenderSummary returns summary fields for the header panel
+func (r *EventRenderer) RenderSummary(resource dao.Resource) []render.SummaryField {
+	e, ok := resource.(*EventResource)
+	if !ok {
+		return r.BaseRenderer.RenderSummary(resource)
+	}
+	fields := []render.SummaryField{
+		{Label: "Time", Value: getTime(e)},
+		{Label: "Categories", Value: e.Categories()},
+	}
+	if e.IsProblem() {
+		fields = append(fields, render.SummaryField{Label: "Message", Value: e.Message(), Style: render.DangerStyle()})
+	} else {
+		fields = append(fields, render.SummaryField{Label: "Message", Value: e.Message()})
+	}
+	return fields
+}
@@ -0,0 +1,46 @@
+package events
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rds/types"
+)
+
+func TestNewEventResource(t *testing.T) {
+	date := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	resource := NewEventResource(types.Event{
+		SourceIdentifier: aws.String("orders"),
+		SourceType:       types.SourceTypeDbInstance,
+		SourceArn:        aws.String("arn:aws:rds:us-east-1:123456789012:db:orders"),
+		Date:             &date,
+		Message:          aws.String("DB instance restarted"),
+		EventCategories:  []string{"availability", "notification"},
+	})
+
+	if resource.GetID() != "orders@2024-01-02T03:04:05Z" {
+		t.Errorf("GetID() = %q", resource.GetID())
+	}
+	if resource.GetARN() != "arn:aws:rds:us-east-1:123456789012:db:orders" {
+		t.Errorf("GetARN() = %q", resource.GetARN())
+	}
+	if resource.Message() != "DB instance restarted" {
+		t.Errorf("Message() = %q", resource.Message())
+	}
+	if resource.Categories() != "availability, notification" {
+		t.Errorf("Categories() = %q", resource.Categories())
+	}
+	if resource.IsProblem() {
+		t.Error("IsProblem() = true for a notification")
+	}
+}
+
+func TestEventResource_IsProblem(t *testing.T) {
+	for _, category := range []string{"failure", "low storage"} {
+		resource := NewEventResource(types.Event{EventCategories: []string{category}})
+		if !resource.IsProblem() {
+			t.Errorf("IsProblem() = false for category %q", category)
+		}
+	}
+}
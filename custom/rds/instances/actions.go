@@ -43,6 +43,12 @@ func init() {
 			Operation: "DeleteDBInstance",
 			Confirm:   action.ConfirmDangerous,
 		},
+		{
+			Name:     "Port Forward",
+			Shortcut: "f",
+			Type:     action.ActionTypeView,
+			Target:   action.TargetPortForward,
+		},
 	})
 
 	// Register executor
@@ -10,6 +10,7 @@ import (
 	appaws "github.com/clawscli/claws/internal/aws"
 	"github.com/clawscli/claws/internal/dao"
 	apperrors "github.com/clawscli/claws/internal/errors"
+	"github.com/clawscli/claws/internal/tunnel"
 )
 
 // InstanceDAO provides data access for RDS instances
@@ -184,3 +185,21 @@ func (r *InstanceResource) AllocatedStorage() int32 {
 	}
 	return 0
 }
+
+// TunnelEndpoint implements tunnel.Provider
+func (r *InstanceResource) TunnelEndpoint() tunnel.Endpoint {
+	e := tunnel.Endpoint{
+		Service:      "rds",
+		ResourceType: "instances",
+		ID:           r.GetID(),
+		Host:         r.Endpoint(),
+		Port:         r.Port(),
+	}
+	if r.Item.DBSubnetGroup != nil {
+		e.VpcID = appaws.Str(r.Item.DBSubnetGroup.VpcId)
+	}
+	for _, sg := range r.Item.VpcSecurityGroups {
+		e.SecurityGroups = append(e.SecurityGroups, appaws.Str(sg.VpcSecurityGroupId))
+	}
+	return e
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"time"
 
+	rdsClient "github.com/clawscli/claws/custom/rds"
 	appaws "github.com/clawscli/claws/internal/aws"
 	"github.com/clawscli/claws/internal/dao"
 	"github.com/clawscli/claws/internal/render"
@@ -303,6 +304,28 @@ func (r *InstanceRenderer) Navigations(resource dao.Resource) []render.Navigatio
 		})
 	}
 
+	// Troubleshooting navigations
+	navs = append(navs,
+		render.Navigation{
+			Key: "l", Label: "Log Files", Service: "rds", Resource: "log-files",
+			FilterField: rdsClient.FilterInstance, FilterValue: ir.GetID(),
+		},
+		render.Navigation{
+			Key: "e", Label: "Events", Service: "rds", Resource: "events",
+			FilterField: rdsClient.FilterInstance, FilterValue: ir.GetID(),
+		},
+	)
+	if len(ir.Item.DBParameterGroups) > 0 && ir.Item.DBParameterGroups[0].DBParameterGroupName != nil {
+		navs = append(navs, render.Navigation{
+			Key: "p", Label: "Parameters", Service: "rds", Resource: "parameters",
+			FilterField: rdsClient.FilterParameterGroup, FilterValue: *ir.Item.DBParameterGroups[0].DBParameterGroupName,
+		})
+	}
+	navs = append(navs, render.Navigation{
+		Key: "w", Label: "Maintenance", Service: "rds", Resource: "pending-maintenance",
+		FilterField: rdsClient.FilterInstance, FilterValue: ir.GetID(),
+	})
+
 	return navs
 }
 
@@ -161,3 +161,47 @@ func TestInstanceResource_EngineVariations(t *testing.T) {
 		})
 	}
 }
+
+func TestInstanceResource_TunnelEndpoint(t *testing.T) {
+	resource := NewInstanceResource(types.DBInstance{
+		DBInstanceIdentifier: aws.String("orders"),
+		Endpoint: &types.Endpoint{
+			Address: aws.String("orders.123456789012.us-east-1.rds.amazonaws.com"),
+			Port:    aws.Int32(3306),
+		},
+		DBSubnetGroup:     &types.DBSubnetGroup{VpcId: aws.String("vpc-1")},
+		VpcSecurityGroups: []types.VpcSecurityGroupMembership{{VpcSecurityGroupId: aws.String("sg-1")}},
+	})
+
+	e := resource.TunnelEndpoint()
+	if e.Address() != "orders.123456789012.us-east-1.rds.amazonaws.com:3306" {
+		t.Errorf("Address() = %q", e.Address())
+	}
+	if e.Service != "rds" || e.ResourceType != "instances" || e.ID != "orders" {
+		t.Errorf("endpoint = %+v", e)
+	}
+	if e.VpcID != "vpc-1" || len(e.SecurityGroups) != 1 || e.SecurityGroups[0] != "sg-1" {
+		t.Errorf("VpcID = %q, SecurityGroups = %v", e.VpcID, e.SecurityGroups)
+	}
+}
+
+func TestInstanceRenderer_Navigations(t *testing.T) {
+	resource := NewInstanceResource(types.DBInstance{
+		DBInstanceIdentifier: aws.String("orders"),
+		DBParameterGroups:    []types.DBParameterGroupStatus{{DBParameterGroupName: aws.String("orders-pg15")}},
+	})
+
+	navs := NewInstanceRenderer().(*InstanceRenderer).Navigations(resource)
+	want := map[string]string{"l": "orders", "e": "orders", "p": "orders-pg15", "w": "orders"}
+	for _, nav := range navs {
+		if value, ok := want[nav.Key]; ok {
+			if nav.FilterValue != value {
+				t.Errorf("navigation %q filters %q, want %q", nav.Key, nav.FilterValue, value)
+			}
+			delete(want, nav.Key)
+		}
+	}
+	if len(want) != 0 {
+		t.Errorf("missing navigations %v", want)
+	}
+}
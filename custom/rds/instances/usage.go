@@ -0,0 +1,44 @@
+package instances
+
+import (
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/usage"
+)
+
+func init() {
+	finder := func(relation string, refs func(*InstanceResource) []string) usage.Finder {
+		return usage.Finder{
+			Service:  "rds",
+			Resource: "instances",
+			Relation: relation,
+			Refs: func(r dao.Resource) []string {
+				if ir, ok := r.(*InstanceResource); ok {
+					return refs(ir)
+				}
+				return nil
+			},
+		}
+	}
+
+	usage.Global.Register("ec2", "security-groups", finder("VPC security group", func(ir *InstanceResource) []string {
+		var ids []string
+		for _, sg := range ir.Item.VpcSecurityGroups {
+			ids = append(ids, appaws.Str(sg.VpcSecurityGroupId))
+		}
+		return ids
+	}))
+	usage.Global.Register("vpc", "subnets", finder("DB subnet group", func(ir *InstanceResource) []string {
+		if ir.Item.DBSubnetGroup == nil {
+			return nil
+		}
+		var ids []string
+		for _, s := range ir.Item.DBSubnetGroup.Subnets {
+			ids = append(ids, appaws.Str(s.SubnetIdentifier))
+		}
+		return ids
+	}))
+	usage.Global.Register("kms", "keys", finder("storage encryption", func(ir *InstanceResource) []string {
+		return []string{appaws.Str(ir.Item.KmsKeyId), appaws.Str(ir.Item.PerformanceInsightsKMSKeyId)}
+	}))
+}
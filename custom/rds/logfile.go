@@ -0,0 +1,105 @@
+package rds
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"os"
+	"path"
+	"path/filepath"
+
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	apperrors "github.com/clawscli/claws/internal/errors"
+)
+
+// logTailLines is the number of lines requested for a log file preview.
+// DownloadDBLogFilePortion truncates larger responses at 1 MB.
+const logTailLines = 10000
+
+// LogFileClient is the subset of the RDS API used to read log files.
+type LogFileClient interface {
+	DownloadDBLogFilePortion(ctx context.Context, params *rds.DownloadDBLogFilePortionInput, optFns ...func(*rds.Options)) (*rds.DownloadDBLogFilePortionOutput, error)
+}
+
+// ReadLogFile returns the end of an instance's log file, at most limit
+// bytes and starting at a line.
+func ReadLogFile(ctx context.Context, client LogFileClient, instance, file string, limit int64) ([]byte, error) {
+	output, err := client.DownloadDBLogFilePortion(ctx, &rds.DownloadDBLogFilePortionInput{
+		DBInstanceIdentifier: &instance,
+		LogFileName:          &file,
+		NumberOfLines:        appaws.Int32Ptr(logTailLines),
+	})
+	if err != nil {
+		return nil, apperrors.Wrapf(err, "download db log file %s", file)
+	}
+	data := []byte(appaws.Str(output.LogFileData))
+	if limit > 0 && int64(len(data)) > limit {
+		data = data[int64(len(data))-limit:]
+		if i := bytes.IndexByte(data, '\n'); i >= 0 {
+			data = data[i+1:]
+		}
+	}
+	return data, nil
+}
+
+// DownloadLogFile writes an instance's whole log file to path, or into path
+// when it is a directory, and returns the file written and its size.
+func DownloadLogFile(ctx context.Context, client LogFileClient, instance, file, dest string) (string, int64, error) {
+	if dest == "" {
+		return "", 0, errors.New("download path is empty")
+	}
+	if info, err := os.Stat(dest); err == nil && info.IsDir() {
+		dest = filepath.Join(dest, LogFileBaseName(file))
+	}
+
+	f, err := os.OpenFile(dest, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o644)
+	if err != nil {
+		return "", 0, apperrors.Wrap(err, "create download file")
+	}
+	n, err := copyLogFile(ctx, client, instance, file, f)
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		_ = os.Remove(dest)
+		return "", 0, err
+	}
+	return dest, n, nil
+}
+
+// copyLogFile writes the log file from its start, one portion at a time.
+func copyLogFile(ctx context.Context, client LogFileClient, instance, file string, f *os.File) (int64, error) {
+	var n int64
+	marker := "0"
+	for {
+		output, err := client.DownloadDBLogFilePortion(ctx, &rds.DownloadDBLogFilePortionInput{
+			DBInstanceIdentifier: &instance,
+			LogFileName:          &file,
+			Marker:               &marker,
+		})
+		if err != nil {
+			return n, apperrors.Wrapf(err, "download db log file %s", file)
+		}
+		written, err := f.WriteString(appaws.Str(output.LogFileData))
+		n += int64(written)
+		if err != nil {
+			return n, apperrors.Wrap(err, "write download file")
+		}
+		if output.AdditionalDataPending == nil || !*output.AdditionalDataPending || output.Marker == nil {
+			return n, nil
+		}
+		marker = *output.Marker
+	}
+}
+
+// LogFileBaseName returns a local file name for a log file, whose name may
+// include a directory such as "error/".
+func LogFileBaseName(file string) string {
+	name := path.Base(file)
+	if name == "." || name == "/" || name == ".." {
+		return "logfile"
+	}
+	return name
+}
@@ -0,0 +1,88 @@
+package rds
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+)
+
+// fakeLogClient serves a log file in fixed portions, or its last lines when
+// no marker is given.
+type fakeLogClient struct {
+	portions []string
+	calls    int
+}
+
+func (f *fakeLogClient) DownloadDBLogFilePortion(_ context.Context, in *rds.DownloadDBLogFilePortionInput, _ ...func(*rds.Options)) (*rds.DownloadDBLogFilePortionOutput, error) {
+	f.calls++
+	if in.Marker == nil {
+		return &rds.DownloadDBLogFilePortionOutput{LogFileData: aws.String(strings.Join(f.portions, ""))}, nil
+	}
+	i := 0
+	if *in.Marker != "0" {
+		i = int((*in.Marker)[0] - 'a')
+	}
+	out := &rds.DownloadDBLogFilePortionOutput{LogFileData: aws.String(f.portions[i])}
+	if i < len(f.portions)-1 {
+		out.AdditionalDataPending = aws.Bool(true)
+		out.Marker = aws.String(string(rune('a' + i + 1)))
+	}
+	return out, nil
+}
+
+func TestReadLogFile(t *testing.T) {
+	client := &fakeLogClient{portions: []string{"first line\n", "second line\n", "third line\n"}}
+
+	data, err := ReadLogFile(context.Background(), client, "db", "error/postgres.log", 0)
+	if err != nil {
+		t.Fatalf("ReadLogFile() error = %v", err)
+	}
+	if string(data) != "first line\nsecond line\nthird line\n" {
+		t.Errorf("ReadLogFile() = %q", data)
+	}
+
+	data, _ = ReadLogFile(context.Background(), client, "db", "error/postgres.log", 16)
+	if string(data) != "third line\n" {
+		t.Errorf("ReadLogFile() with limit = %q, want the last whole lines", data)
+	}
+}
+
+func TestDownloadLogFile(t *testing.T) {
+	client := &fakeLogClient{portions: []string{"one\n", "two\n", "three\n"}}
+	dir := t.TempDir()
+
+	path, n, err := DownloadLogFile(context.Background(), client, "db", "error/postgres.log", dir)
+	if err != nil {
+		t.Fatalf("DownloadLogFile() error = %v", err)
+	}
+	if path != filepath.Join(dir, "postgres.log") || n != 14 || client.calls != 3 {
+		t.Errorf("DownloadLogFile() = %q, %d after %d calls", path, n, client.calls)
+	}
+	data, _ := os.ReadFile(path)
+	if string(data) != "one\ntwo\nthree\n" {
+		t.Errorf("downloaded %q", data)
+	}
+
+	if _, _, err := DownloadLogFile(context.Background(), client, "db", "error/postgres.log", path); err == nil {
+		t.Error("DownloadLogFile() should not overwrite an existing file")
+	}
+}
+
+func TestLogFileBaseName(t *testing.T) {
+	tests := map[string]string{
+		"error/postgresql.log.2024-01-01-00": "postgresql.log.2024-01-01-00",
+		"slowquery/mysql-slowquery.log":      "mysql-slowquery.log",
+		"alert.log":                          "alert.log",
+		"":                                   "logfile",
+	}
+	for file, want := range tests {
+		if got := LogFileBaseName(file); got != want {
+			t.Errorf("LogFileBaseName(%q) = %q, want %q", file, got, want)
+		}
+	}
+}
@@ -0,0 +1,16 @@
+package logfiles
+
+import (
+	"github.com/clawscli/claws/internal/action"
+)
+
+func init() {
+	action.Global.Register("rds", "log-files", []action.Action{
+		{
+			Name:     "View",
+			Shortcut: "v",
+			Type:     action.ActionTypeView,
+			Target:   action.TargetPreview,
+		},
+	})
+}
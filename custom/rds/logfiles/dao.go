@@ -0,0 +1,142 @@
+package logfiles
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/aws/aws-sdk-go-v2/service/rds/types"
+
+	rdsClient "github.com/clawscli/claws/custom/rds"
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+)
+
+// LogFileDAO provides data access for the log files of an RDS instance
+type LogFileDAO struct {
+	dao.BaseDAO
+	client *rds.Client
+}
+
+// NewLogFileDAO creates a new LogFileDAO
+func NewLogFileDAO(ctx context.Context) (dao.DAO, error) {
+	client, err := rdsClient.GetClient(ctx)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new rds/log-files dao")
+	}
+	return &LogFileDAO{
+		BaseDAO: dao.NewBaseDAO("rds", "log-files"),
+		client:  client,
+	}, nil
+}
+
+// List returns the instance's log files, most recently written first
+func (d *LogFileDAO) List(ctx context.Context) ([]dao.Resource, error) {
+	instance := dao.GetFilterFromContext(ctx, rdsClient.FilterInstance)
+	if instance == "" {
+		return nil, fmt.Errorf("%s required: navigate from rds/instances", rdsClient.FilterInstance)
+	}
+
+	paginator := rds.NewDescribeDBLogFilesPaginator(d.client, &rds.DescribeDBLogFilesInput{
+		DBInstanceIdentifier: &instance,
+	})
+	var files []types.DescribeDBLogFilesDetails
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, apperrors.Wrapf(err, "describe db log files %s", instance)
+		}
+		files = append(files, output.DescribeDBLogFiles...)
+	}
+	slices.SortStableFunc(files, func(a, b types.DescribeDBLogFilesDetails) int {
+		return cmp.Compare(appaws.Int64(b.LastWritten), appaws.Int64(a.LastWritten))
+	})
+
+	resources := make([]dao.Resource, len(files))
+	for i, file := range files {
+		resources[i] = NewLogFileResource(file, instance)
+	}
+	return resources, nil
+}
+
+func (d *LogFileDAO) Get(ctx context.Context, id string) (dao.Resource, error) {
+	return nil, fmt.Errorf("get by ID not supported for log files")
+}
+
+func (d *LogFileDAO) Delete(ctx context.Context, id string) error {
+	return fmt.Errorf("delete not supported for log files")
+}
+
+func (d *LogFileDAO) Supports(op dao.Operation) bool {
+	return op == dao.OpList
+}
+
+// LogFileResource wraps an RDS log file
+type LogFileResource struct {
+	dao.BaseResource
+	Item     types.DescribeDBLogFilesDetails
+	Instance string
+}
+
+// NewLogFileResource creates a new LogFileResource
+func NewLogFileResource(file types.DescribeDBLogFilesDetails, instance string) *LogFileResource {
+	return &LogFileResource{
+		BaseResource: dao.BaseResource{
+			ID:   appaws.Str(file.LogFileName),
+			Name: appaws.Str(file.LogFileName),
+			Data: file,
+		},
+		Item:     file,
+		Instance: instance,
+	}
+}
+
+// Size returns the file size in bytes
+func (r *LogFileResource) Size() int64 {
+	return appaws.Int64(r.Item.Size)
+}
+
+// LastWritten returns when the file was last written, zero if unknown
+func (r *LogFileResource) LastWritten() time.Time {
+	if r.Item.LastWritten == nil {
+		return time.Time{}
+	}
+	return time.UnixMilli(*r.Item.LastWritten)
+}
+
+// ContentName implements the view package's ContentSource
+func (r *LogFileResource) ContentName() string {
+	return r.Instance + "/" + r.GetID()
+}
+
+// ReadContent implements the view package's ContentSource, reading the end
+// of the file where the latest entries are
+func (r *LogFileResource) ReadContent(ctx context.Context, limit int64) ([]byte, int64, error) {
+	client, err := rdsClient.GetClient(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	data, err := rdsClient.ReadLogFile(ctx, client, r.Instance, r.GetID(), limit)
+	if err != nil {
+		return nil, 0, err
+	}
+	return data, max(r.Size(), int64(len(data))), nil
+}
+
+// TailContent implements the view package's TailContentSource
+func (r *LogFileResource) TailContent() bool {
+	return true
+}
+
+// DownloadContent implements the view package's ContentSource
+func (r *LogFileResource) DownloadContent(ctx context.Context, path string) (string, int64, error) {
+	client, err := rdsClient.GetClient(ctx)
+	if err != nil {
+		return "", 0, err
+	}
+	return rdsClient.DownloadLogFile(ctx, client, r.Instance, r.GetID(), path)
+}
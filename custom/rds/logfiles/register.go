@@ -0,0 +1,20 @@
+package logfiles
+
+import (
+	"context"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/registry"
+	"github.com/clawscli/claws/internal/render"
+)
+
+func init() {
+	registry.Global.RegisterCustom("rds", "log-files", registry.Entry{
+		DAOFactory: func(ctx context.Context) (dao.DAO, error) {
+			return NewLogFileDAO(ctx)
+		},
+		RendererFactory: func() render.Renderer {
+			return NewLogFileRenderer()
+		},
+	})
+}
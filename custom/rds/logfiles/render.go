@@ -0,0 +1,86 @@
+package logfiles
+
+import (
+	"time"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/render"
+)
+
+// LogFileRenderer renders RDS log files
+type LogFileRenderer struct {
+	render.BaseRenderer
+}
+
+// NewLogFileRenderer creates a new LogFileRenderer
+func NewLogFileRenderer() render.Renderer {
+	return &LogFileRenderer{
+		BaseRenderer: render.BaseRenderer{
+			Service:  "rds",
+			Resource: "log-files",
+			Cols: []render.Column{
+				{Name: "FILE", Width: 50, Getter: func(r dao.Resource) string { return r.GetID() }},
+				{Name: "SIZE", Width: 10, Getter: getSize},
+				{Name: "LAST WRITTEN", Width: 20, Getter: getLastWritten},
+				{Name: "AGE", Width: 8, Getter: getAge},
+			},
+		},
+	}
+}
+
+func getSize(r dao.Resource) string {
+	if f, ok := r.(*LogFileResource); ok {
+		return render.FormatSize(f.Size())
+	}
+	return ""
+}
+
+func getLastWritten(r dao.Resource) string {
+	if f, ok := r.(*LogFileResource); ok && !f.LastWritten().IsZero() {
+		return f.LastWritten().Local().Format("2006-01-02 15:04:05")
+	}
+	return ""
+}
+
+func getAge(r dao.Resource) string {
+	if f, ok := r.(*LogFileResource); ok && !f.LastWritten().IsZero() {
+		return render.FormatAge(f.LastWritten())
+	}
+	return ""
+}
+
+// RenderDetail renders the log file's details
+func (r *LogFileRenderer) RenderDetail(resource dao.Resource) string {
+	f, ok := resource.(*LogFileResource)
+	if !ok {
+		return ""
+	}
+
+	d := render.NewDetailBuilder()
+
+	d.Title("RDS Log File", f.GetID())
+
+	d.Section("Log File")
+	d.Field("Instance", f.Instance)
+	d.Field("Name", f.GetID())
+	d.Field("Size", render.FormatSize(f.Size()))
+	if !f.LastWritten().IsZero() {
+		d.Field("Last Written", f.LastWritten().Format(time.RFC3339))
+	}
+
+	return d.String()
+}
+
+// RenderSummary returns summary fields for the header panel
+func (r *LogFileRenderer) RenderSummary(resource dao.Resource) []render.SummaryField {
+	f, ok := resource.(*LogFileResource)
+	if !ok {
+		return r.BaseRenderer.RenderSummary(resource)
+	}
+	return []render.SummaryField{
+		{Label: "Instance", Value: f.Instance},
+		{Label: "File", Value: f.GetID()},
+		{Label: "Size", Value: getSize(f)},
+		{Label: "Last Written", Value: getLastWritten(f)},
+	}
+}
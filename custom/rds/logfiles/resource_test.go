@@ -0,0 +1,41 @@
+package logfiles
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rds/types"
+)
+
+func TestNewLogFileResource(t *testing.T) {
+	written := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	resource := NewLogFileResource(types.DescribeDBLogFilesDetails{
+		LogFileName: aws.String("error/postgresql.log.2024-01-02-03"),
+		Size:        aws.Int64(2048),
+		LastWritten: aws.Int64(written.UnixMilli()),
+	}, "orders")
+
+	if resource.GetID() != "error/postgresql.log.2024-01-02-03" {
+		t.Errorf("GetID() = %q", resource.GetID())
+	}
+	if resource.Size() != 2048 {
+		t.Errorf("Size() = %d, want 2048", resource.Size())
+	}
+	if !resource.LastWritten().Equal(written) {
+		t.Errorf("LastWritten() = %v, want %v", resource.LastWritten(), written)
+	}
+	if resource.ContentName() != "orders/error/postgresql.log.2024-01-02-03" {
+		t.Errorf("ContentName() = %q", resource.ContentName())
+	}
+	if !resource.TailContent() {
+		t.Error("TailContent() = false, want log files shown from the end")
+	}
+}
+
+func TestLogFileResource_Minimal(t *testing.T) {
+	resource := NewLogFileResource(types.DescribeDBLogFilesDetails{LogFileName: aws.String("alert.log")}, "orders")
+	if resource.Size() != 0 || !resource.LastWritten().IsZero() {
+		t.Errorf("Size() = %d, LastWritten() = %v, want zero values", resource.Size(), resource.LastWritten())
+	}
+}
@@ -0,0 +1,110 @@
+package parameters
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/aws/aws-sdk-go-v2/service/rds/types"
+
+	rdsClient "github.com/clawscli/claws/custom/rds"
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+)
+
+// sourceUser is the parameter source of values changed from the engine
+// default
+const sourceUser = "user"
+
+// ParameterDAO provides data access for the non-default parameters of an
+// RDS DB parameter group
+type ParameterDAO struct {
+	dao.BaseDAO
+	client *rds.Client
+}
+
+// NewParameterDAO creates a new ParameterDAO
+func NewParameterDAO(ctx context.Context) (dao.DAO, error) {
+	client, err := rdsClient.GetClient(ctx)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new rds/parameters dao")
+	}
+	return &ParameterDAO{
+		BaseDAO: dao.NewBaseDAO("rds", "parameters"),
+		client:  client,
+	}, nil
+}
+
+// List returns the parameters set in the group rather than left at the
+// engine default
+func (d *ParameterDAO) List(ctx context.Context) ([]dao.Resource, error) {
+	group := dao.GetFilterFromContext(ctx, rdsClient.FilterParameterGroup)
+	if group == "" {
+		return nil, fmt.Errorf("%s required: navigate from rds/instances", rdsClient.FilterParameterGroup)
+	}
+
+	paginator := rds.NewDescribeDBParametersPaginator(d.client, &rds.DescribeDBParametersInput{
+		DBParameterGroupName: &group,
+		Source:               appaws.StringPtr(sourceUser),
+	})
+	var resources []dao.Resource
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, apperrors.Wrapf(err, "describe db parameters %s", group)
+		}
+		for _, p := range output.Parameters {
+			resources = append(resources, NewParameterResource(p, group))
+		}
+	}
+	return resources, nil
+}
+
+func (d *ParameterDAO) Get(ctx context.Context, id string) (dao.Resource, error) {
+	return nil, fmt.Errorf("get by ID not supported for db parameters")
+}
+
+func (d *ParameterDAO) Delete(ctx context.Context, id string) error {
+	return fmt.Errorf("delete not supported for db parameters")
+}
+
+func (d *ParameterDAO) Supports(op dao.Operation) bool {
+	return op == dao.OpList
+}
+
+// ParameterResource wraps a DB parameter
+type ParameterResource struct {
+	dao.BaseResource
+	Item  types.Parameter
+	Group string
+}
+
+// NewParameterResource creates a new ParameterResource
+func NewParameterResource(p types.Parameter, group string) *ParameterResource {
+	return &ParameterResource{
+		BaseResource: dao.BaseResource{
+			ID:   appaws.Str(p.ParameterName),
+			Name: appaws.Str(p.ParameterName),
+			Data: p,
+		},
+		Item:  p,
+		Group: group,
+	}
+}
+
+// Value returns the parameter value
+func (r *ParameterResource) Value() string {
+	return appaws.Str(r.Item.ParameterValue)
+}
+
+// ApplyType returns "static" (needs a reboot) or "dynamic"
+func (r *ParameterResource) ApplyType() string {
+	return appaws.Str(r.Item.ApplyType)
+}
+
+// NeedsReboot returns whether changes to the parameter apply only after a
+// reboot
+func (r *ParameterResource) NeedsReboot() bool {
+	return r.ApplyType() == "static" || r.Item.ApplyMethod == types.ApplyMethodPendingReboot
+}
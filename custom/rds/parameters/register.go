@@ -0,0 +1,20 @@
+package parameters
+
+import (
+	"context"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/registry"
+	"github.com/clawscli/claws/internal/render"
+)
+
+func init() {
+	registry.Global.RegisterCustom("rds", "parameters", registry.Entry{
+		DAOFactory: func(ctx context.Context) (dao.DAO, error) {
+			return NewParameterDAO(ctx)
+		},
+		RendererFactory: func() render.Renderer {
+			return NewParameterRenderer()
+		},
+	})
+}
@@ -0,0 +1,113 @@
+package parameters
+
+import (
+	"github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/render"
+)
+
+// ParameterRenderer renders DB parameters
+type ParameterRenderer struct {
+	render.BaseRenderer
+}
+
+// NewParameterRenderer creates a new ParameterRenderer
+func NewParameterRenderer() render.Renderer {
+	return &ParameterRenderer{
+		BaseRenderer: render.BaseRenderer{
+			Service:  "rds",
+			Resource: "parameters",
+			Cols: []render.Column{
+				{Name: "NAME", Width: 40, Getter: func(r dao.Resource) string { return r.GetID() }},
+				{Name: "VALUE", Width: 40, Getter: getValue},
+				{Name: "APPLY TYPE", Width: 10, Getter: getApplyType},
+				{Name: "APPLY METHOD", Width: 18, Getter: getApplyMethod},
+				{Name: "DESCRIPTION", Width: 60, Getter: getDescription},
+			},
+		},
+	}
+}
+
+func getValue(r dao.Resource) string {
+	if p, ok := r.(*ParameterResource); ok {
+		return p.Value()
+	}
+	return ""
+}
+
+func getApplyType(r dao.Resource) string {
+	if p, ok := r.(*ParameterResource); ok {
+		return p.ApplyType()
+	}
+	return ""
+}
+
+func getApplyMethod(r dao.Resource) string {
+	if p, ok := r.(*ParameterResource); ok {
+		return string(p.Item.ApplyMethod)
+	}
+	return ""
+}
+
+func getDescription(r dao.Resource) string {
+	if p, ok := r.(*ParameterResource); ok {
+		return aws.Str(p.Item.Description)
+	}
+	return ""
+}
+
+// RenderDetail renders the parameter's value and constraints
+func (r *ParameterRenderer) RenderDetail(resource dao.Resource) string {
+	p, ok := resource.(*ParameterResource)
+	if !ok {
+		return ""
+	}
+
+	d := render.NewDetailBuilder()
+
+	d.Title("DB Parameter", p.GetID())
+
+	d.Section("Parameter")
+	d.Field("Parameter Group", p.Group)
+	d.Field("Name", p.GetID())
+	d.Field("Value", p.Value())
+	d.FieldIf("Description", p.Item.Description)
+
+	d.Section("Constraints")
+	d.FieldIf("Data Type", p.Item.DataType)
+	d.FieldIf("Allowed Values", p.Item.AllowedValues)
+	if p.Item.IsModifiable != nil {
+		if *p.Item.IsModifiable {
+			d.Field("Modifiable", "Yes")
+		} else {
+			d.Field("Modifiable", "No")
+		}
+	}
+	d.FieldIf("Minimum Engine Version", p.Item.MinimumEngineVersion)
+
+	d.Section("Apply")
+	if p.NeedsReboot() {
+		d.FieldStyled("Apply Type", p.ApplyType(), render.WarningStyle())
+	} else {
+		d.Field("Apply Type", p.ApplyType())
+	}
+	if p.Item.ApplyMethod != "" {
+		d.Field("Apply Method", string(p.Item.ApplyMethod))
+	}
+
+	return d.String()
+}
+
+// RenderSummary returns summary fields for the header panel
+func (r *ParameterRenderer) RenderSummary(resource dao.Resource) []render.SummaryField {
+	p, ok := resource.(*ParameterResource)
+	if !ok {
+		return r.BaseRenderer.RenderSummary(resource)
+	}
+	return []render.SummaryField{
+		{Label: "Parameter Group", Value: p.Group},
+		{Label: "Name", Value: p.GetID()},
+		{Label: "Value", Value: p.Value()},
+		{Label: "Apply Type", Value: p.ApplyType()},
+	}
+}
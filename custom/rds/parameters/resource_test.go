@@ -0,0 +1,43 @@
+package parameters
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rds/types"
+)
+
+func TestNewParameterResource(t *testing.T) {
+	resource := NewParameterResource(types.Parameter{
+		ParameterName:  aws.String("max_connections"),
+		ParameterValue: aws.String("500"),
+		ApplyType:      aws.String("static"),
+		ApplyMethod:    types.ApplyMethodPendingReboot,
+		Source:         aws.String("user"),
+	}, "orders-pg15")
+
+	if resource.GetID() != "max_connections" || resource.Group != "orders-pg15" {
+		t.Errorf("GetID() = %q, Group = %q", resource.GetID(), resource.Group)
+	}
+	if resource.Value() != "500" {
+		t.Errorf("Value() = %q, want 500", resource.Value())
+	}
+	if !resource.NeedsReboot() {
+		t.Error("NeedsReboot() = false for a static parameter")
+	}
+}
+
+func TestParameterResource_Dynamic(t *testing.T) {
+	resource := NewParameterResource(types.Parameter{
+		ParameterName: aws.String("log_min_duration_statement"),
+		ApplyType:     aws.String("dynamic"),
+		ApplyMethod:   types.ApplyMethodImmediate,
+	}, "orders-pg15")
+
+	if resource.NeedsReboot() {
+		t.Error("NeedsReboot() = true for a dynamic parameter applied immediately")
+	}
+	if resource.Value() != "" {
+		t.Errorf("Value() = %q, want empty", resource.Value())
+	}
+}
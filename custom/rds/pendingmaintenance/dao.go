@@ -0,0 +1,122 @@
+package pendingmaintenance
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/aws/aws-sdk-go-v2/service/rds/types"
+
+	rdsClient "github.com/clawscli/claws/custom/rds"
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+)
+
+// PendingMaintenanceDAO provides data access for pending RDS maintenance
+// actions
+type PendingMaintenanceDAO struct {
+	dao.BaseDAO
+	client *rds.Client
+}
+
+// NewPendingMaintenanceDAO creates a new PendingMaintenanceDAO
+func NewPendingMaintenanceDAO(ctx context.Context) (dao.DAO, error) {
+	client, err := rdsClient.GetClient(ctx)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new rds/pending-maintenance dao")
+	}
+	return &PendingMaintenanceDAO{
+		BaseDAO: dao.NewBaseDAO("rds", "pending-maintenance"),
+		client:  client,
+	}, nil
+}
+
+// List returns one resource per pending action. When navigated from an
+// instance, only that instance's actions are listed.
+func (d *PendingMaintenanceDAO) List(ctx context.Context) ([]dao.Resource, error) {
+	input := &rds.DescribePendingMaintenanceActionsInput{}
+	if instance := dao.GetFilterFromContext(ctx, rdsClient.FilterInstance); instance != "" {
+		input.Filters = []types.Filter{{Name: appaws.StringPtr("db-instance-id"), Values: []string{instance}}}
+	}
+
+	paginator := rds.NewDescribePendingMaintenanceActionsPaginator(d.client, input)
+	var resources []dao.Resource
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, apperrors.Wrap(err, "describe pending maintenance actions")
+		}
+		for _, r := range output.PendingMaintenanceActions {
+			for _, a := range r.PendingMaintenanceActionDetails {
+				resources = append(resources, NewPendingMaintenanceResource(appaws.Str(r.ResourceIdentifier), a))
+			}
+		}
+	}
+	return resources, nil
+}
+
+func (d *PendingMaintenanceDAO) Get(ctx context.Context, id string) (dao.Resource, error) {
+	return nil, fmt.Errorf("get by ID not supported for pending maintenance actions")
+}
+
+func (d *PendingMaintenanceDAO) Delete(ctx context.Context, id string) error {
+	return fmt.Errorf("delete not supported for pending maintenance actions")
+}
+
+func (d *PendingMaintenanceDAO) Supports(op dao.Operation) bool {
+	return op == dao.OpList
+}
+
+// PendingMaintenanceResource wraps a pending maintenance action of an RDS
+// resource
+type PendingMaintenanceResource struct {
+	dao.BaseResource
+	Item        types.PendingMaintenanceAction
+	ResourceARN string
+}
+
+// NewPendingMaintenanceResource creates a new PendingMaintenanceResource.
+// Actions have no ID, so the resource ARN and action name identify them.
+func NewPendingMaintenanceResource(arn string, a types.PendingMaintenanceAction) *PendingMaintenanceResource {
+	return &PendingMaintenanceResource{
+		BaseResource: dao.BaseResource{
+			ID:   arn + "/" + appaws.Str(a.Action),
+			Name: resourceName(arn),
+			ARN:  arn,
+			Data: a,
+		},
+		Item:        a,
+		ResourceARN: arn,
+	}
+}
+
+// resourceName returns the identifier at the end of an RDS ARN, e.g.
+// "mydb" for "arn:aws:rds:us-east-1:123456789012:db:mydb"
+func resourceName(arn string) string {
+	if i := strings.LastIndex(arn, ":"); i >= 0 {
+		return arn[i+1:]
+	}
+	return arn
+}
+
+// ResourceType returns the kind of resource the action applies to, e.g.
+// "db" or "cluster"
+func (r *PendingMaintenanceResource) ResourceType() string {
+	parts := strings.Split(r.ResourceARN, ":")
+	if len(parts) < 7 {
+		return ""
+	}
+	return parts[5]
+}
+
+// Action returns the pending action, e.g. "system-update"
+func (r *PendingMaintenanceResource) Action() string {
+	return appaws.Str(r.Item.Action)
+}
+
+// OptInStatus returns the opt-in request type, if the action was opted in
+func (r *PendingMaintenanceResource) OptInStatus() string {
+	return appaws.Str(r.Item.OptInStatus)
+}
@@ -0,0 +1,20 @@
+package pendingmaintenance
+
+import (
+	"context"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/registry"
+	"github.com/clawscli/claws/internal/render"
+)
+
+func init() {
+	registry.Global.RegisterCustom("rds", "pending-maintenance", registry.Entry{
+		DAOFactory: func(ctx context.Context) (dao.DAO, error) {
+			return NewPendingMaintenanceDAO(ctx)
+		},
+		RendererFactory: func() render.Renderer {
+			return NewPendingMaintenanceRenderer()
+		},
+	})
+}
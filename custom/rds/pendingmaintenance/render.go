@@ -0,0 +1,138 @@
+package pendingmaintenance
+
+import (
+	"time"
+
+	"github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/render"
+)
+
+// PendingMaintenanceRenderer renders pending RDS maintenance actions
+type PendingMaintenanceRenderer struct {
+	render.BaseRenderer
+}
+
+// NewPendingMaintenanceRenderer creates a new PendingMaintenanceRenderer
+func NewPendingMaintenanceRenderer() render.Renderer {
+	return &PendingMaintenanceRenderer{
+		BaseRenderer: render.BaseRenderer{
+			Service:  "rds",
+			Resource: "pending-maintenance",
+			Cols: []render.Column{
+				{Name: "RESOURCE", Width: 28, Getter: func(r dao.Resource) string { return r.GetName() }},
+				{Name: "TYPE", Width: 8, Getter: getType},
+				{Name: "ACTION", Width: 20, Getter: getAction},
+				{Name: "AUTO APPLIED AFTER", Width: 18, Getter: getAutoApplied},
+				{Name: "FORCED APPLY", Width: 18, Getter: getForcedApply},
+				{Name: "OPT-IN", Width: 16, Getter: getOptIn},
+				{Name: "DESCRIPTION", Width: 60, Getter: getDescription},
+			},
+		},
+	}
+}
+
+func formatDate(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.Local().Format("2006-01-02 15:04")
+}
+
+func getType(r dao.Resource) string {
+	if p, ok := r.(*PendingMaintenanceResource); ok {
+		return p.ResourceType()
+	}
+	return ""
+}
+
+func getAction(r dao.Resource) string {
+	if p, ok := r.(*PendingMaintenanceResource); ok {
+		return p.Action()
+	}
+	return ""
+}
+
+func getAutoApplied(r dao.Resource) string {
+	if p, ok := r.(*PendingMaintenanceResource); ok {
+		return formatDate(p.Item.AutoAppliedAfterDate)
+	}
+	return ""
+}
+
+func getForcedApply(r dao.Resource) string {
+	if p, ok := r.(*PendingMaintenanceResource); ok {
+		return formatDate(p.Item.ForcedApplyDate)
+	}
+	return ""
+}
+
+func getOptIn(r dao.Resource) string {
+	if p, ok := r.(*PendingMaintenanceResource); ok {
+		return p.OptInStatus()
+	}
+	return ""
+}
+
+func getDescription(r dao.Resource) string {
+	if p, ok := r.(*PendingMaintenanceResource); ok {
+		return aws.Str(p.Item.Description)
+	}
+	return ""
+}
+
+// RenderDetail renders the action and its apply dates
+func (r *PendingMaintenanceRenderer) RenderDetail(resource dao.Resource) string {
+	p, ok := resource.(*PendingMaintenanceResource)
+	if !ok {
+		return ""
+	}
+
+	d := render.NewDetailBuilder()
+
+	d.Title("Pending Maintenance", p.GetName())
+
+	d.Section("Resource")
+	d.Field("Name", p.GetName())
+	if t := p.ResourceType(); t != "" {
+		d.Field("Type", t)
+	}
+	d.Field("ARN", p.ResourceARN)
+
+	d.Section("Action")
+	d.Field("Action", p.Action())
+	d.FieldIf("Description", p.Item.Description)
+	d.FieldIf("Opt-In Status", p.Item.OptInStatus)
+
+	d.Section("Schedule")
+	if p.Item.CurrentApplyDate != nil {
+		d.Field("Current Apply Date", p.Item.CurrentApplyDate.Format(time.RFC3339))
+	}
+	if p.Item.AutoAppliedAfterDate != nil {
+		d.Field("Auto Applied After", p.Item.AutoAppliedAfterDate.Format(time.RFC3339))
+	}
+	if p.Item.ForcedApplyDate != nil {
+		d.FieldStyled("Forced Apply Date", p.Item.ForcedApplyDate.Format(time.RFC3339), render.WarningStyle())
+	}
+
+	return d.String()
+}
+
+// RenderSummary returns summary fields for the header panel
+func (r *PendingMaintenanceRenderer) RenderSummary(resource dao.Resource) []render.SummaryField {
+	p, ok := resource.(*PendingMaintenanceResource)
+	if !ok {
+		return r.BaseRenderer.RenderSummary(resource)
+	}
+	fields := []render.SummaryField{
+		{Label: "Resource", Value: p.GetName()},
+		{Label: "Action", Value: p.Action()},
+	}
+	if p.Item.CurrentApplyDate != nil {
+		fields = append(fields, render.SummaryField{Label: "Current Apply", Value: formatDate(p.Item.CurrentApplyDate)})
+	}
+	if p.Item.ForcedApplyDate != nil {
+		fields = append(fields, render.SummaryField{Label: "Forced Apply", Value: formatDate(p.Item.ForcedApplyDate), Style: render.WarningStyle()})
+	}
+	return fields
+}
@@ -0,0 +1,44 @@
+package pendingmaintenance
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rds/types"
+)
+
+func TestNewPendingMaintenanceResource(t *testing.T) {
+	arn := "arn:aws:rds:us-east-1:123456789012:db:orders"
+	resource := NewPendingMaintenanceResource(arn, types.PendingMaintenanceAction{
+		Action:      aws.String("system-update"),
+		Description: aws.String("New Operating System update is available"),
+		OptInStatus: aws.String("next-maintenance"),
+	})
+
+	tests := []struct {
+		name     string
+		got      string
+		expected string
+	}{
+		{"GetID", resource.GetID(), arn + "/system-update"},
+		{"GetName", resource.GetName(), "orders"},
+		{"GetARN", resource.GetARN(), arn},
+		{"ResourceType", resource.ResourceType(), "db"},
+		{"Action", resource.Action(), "system-update"},
+		{"OptInStatus", resource.OptInStatus(), "next-maintenance"},
+	}
+	for _, tt := range tests {
+		if tt.got != tt.expected {
+			t.Errorf("%s = %q, want %q", tt.name, tt.got, tt.expected)
+		}
+	}
+}
+
+func TestPendingMaintenanceResource_Cluster(t *testing.T) {
+	resource := NewPendingMaintenanceResource("arn:aws:rds:us-east-1:123456789012:cluster:orders-aurora", types.PendingMaintenanceAction{
+		Action: aws.String("db-upgrade"),
+	})
+	if resource.ResourceType() != "cluster" || resource.GetName() != "orders-aurora" {
+		t.Errorf("ResourceType() = %q, GetName() = %q", resource.ResourceType(), resource.GetName())
+	}
+}
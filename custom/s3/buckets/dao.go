@@ -8,6 +8,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 	"github.com/aws/aws-sdk-go-v2/service/s3/types"
 
+	apps3 "github.com/clawscli/claws/custom/s3"
 	appaws "github.com/clawscli/claws/internal/aws"
 	"github.com/clawscli/claws/internal/dao"
 	apperrors "github.com/clawscli/claws/internal/errors"
@@ -63,15 +64,9 @@ func (d *BucketDAO) List(ctx context.Context) ([]dao.Resource, error) {
 
 func (d *BucketDAO) Get(ctx context.Context, id string) (dao.Resource, error) {
 	// Get bucket location first (this works from any region)
-	region := "us-east-1" // default for buckets without explicit location
-	locOutput, err := d.client.GetBucketLocation(ctx, &s3.GetBucketLocationInput{
-		Bucket: &id,
-	})
+	region, err := apps3.BucketRegion(ctx, d.client, id)
 	if err != nil {
-		return nil, apperrors.Wrapf(err, "get bucket location for %s", id)
-	}
-	if locOutput != nil && locOutput.LocationConstraint != "" {
-		region = string(locOutput.LocationConstraint)
+		return nil, err
 	}
 
 	resource := &BucketResource{
@@ -103,11 +98,7 @@ func (d *BucketDAO) Get(ctx context.Context, id string) (dao.Resource, error) {
 
 // getRegionClient creates an S3 client for the specified region
 func (d *BucketDAO) getRegionClient(ctx context.Context, region string) (*s3.Client, error) {
-	cfg, err := appaws.NewConfigWithRegion(ctx, region)
-	if err != nil {
-		return nil, err
-	}
-	return s3.NewFromConfig(cfg), nil
+	return apps3.GetClientForRegion(ctx, region)
 }
 
 // fetchVersioning fetches bucket versioning configuration
@@ -7,6 +7,9 @@ import (
 	"github.com/clawscli/claws/internal/render"
 )
 
+// Ensure BucketRenderer implements render.Navigator
+var _ render.Navigator = (*BucketRenderer)(nil)
+
 // BucketRenderer renders S3 buckets
 type BucketRenderer struct {
 	render.BaseRenderer
@@ -246,3 +249,22 @@ func (r *BucketRenderer) RenderSummary(resource dao.Resource) []render.SummaryFi
 
 	return fields
 }
+
+// Navigations returns navigation shortcuts
+func (r *BucketRenderer) Navigations(resource dao.Resource) []render.Navigation {
+	b, ok := resource.(*BucketResource)
+	if !ok {
+		return nil
+	}
+
+	return []render.Navigation{
+		{
+			Key:         "o",
+			Label:       "Objects",
+			Service:     "s3",
+			Resource:    "objects",
+			FilterField: "S3Path",
+			FilterValue: b.BucketName,
+		},
+	}
+}
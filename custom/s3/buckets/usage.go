@@ -0,0 +1,22 @@
+package buckets
+
+import (
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/usage"
+)
+
+func init() {
+	// Default encryption is only fetched in Get.
+	usage.Global.Register("kms", "keys", usage.Finder{
+		Service:  "s3",
+		Resource: "buckets",
+		Relation: "default encryption",
+		Detail:   true,
+		Refs: func(r dao.Resource) []string {
+			if b, ok := r.(*BucketResource); ok {
+				return []string{b.EncryptionKMSKeyID}
+			}
+			return nil
+		},
+	})
+}
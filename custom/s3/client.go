@@ -2,12 +2,17 @@ package s3
 
 import (
 	"context"
+	"sync"
 
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 
 	appaws "github.com/clawscli/claws/internal/aws"
+	apperrors "github.com/clawscli/claws/internal/errors"
 )
 
+// bucketRegions caches bucket locations; a bucket's region never changes.
+var bucketRegions sync.Map // bucket name -> region
+
 // GetClient returns an S3 client configured for the current context
 func GetClient(ctx context.Context) (*s3.Client, error) {
 	cfg, err := appaws.NewConfig(ctx)
@@ -25,3 +30,43 @@ func GetClientForRegion(ctx context.Context, region string) (*s3.Client, error)
 	}
 	return s3.NewFromConfig(cfg), nil
 }
+
+// BucketRegion returns the region a bucket lives in.
+// GetBucketLocation works from any region, so client can be for any region.
+func BucketRegion(ctx context.Context, client *s3.Client, bucket string) (string, error) {
+	if region, ok := bucketRegions.Load(bucket); ok {
+		return region.(string), nil
+	}
+	output, err := client.GetBucketLocation(ctx, &s3.GetBucketLocationInput{
+		Bucket: &bucket,
+	})
+	if err != nil {
+		return "", apperrors.Wrapf(err, "get bucket location for %s", bucket)
+	}
+	region := "us-east-1" // default for buckets without explicit location
+	switch output.LocationConstraint {
+	case "":
+	case "EU":
+		region = "eu-west-1" // legacy constraint
+	default:
+		region = string(output.LocationConstraint)
+	}
+	bucketRegions.Store(bucket, region)
+	return region, nil
+}
+
+// GetClientForBucket returns an S3 client for the bucket's region
+func GetClientForBucket(ctx context.Context, bucket string) (*s3.Client, error) {
+	client, err := GetClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	region, err := BucketRegion(ctx, client, bucket)
+	if err != nil {
+		return nil, err
+	}
+	if region == client.Options().Region {
+		return client, nil
+	}
+	return GetClientForRegion(ctx, region)
+}
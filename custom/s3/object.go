@@ -0,0 +1,212 @@
+package s3
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+
+	"github.com/clawscli/claws/internal/action"
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+)
+
+// DownloadPathField asks where the Download actions save an object, like
+// the save prompt of the content preview.
+var DownloadPathField = action.Field{
+	Name:     "path",
+	Label:    "Save to",
+	Type:     action.FieldString,
+	Help:     "File or directory; existing files are never overwritten",
+	Required: true,
+	Default:  func(dao.Resource) string { return "." + string(filepath.Separator) },
+}
+
+// SplitPath splits "bucket/key" into bucket and key.
+// The key is empty for a bare bucket name.
+func SplitPath(path string) (bucket, key string) {
+	bucket, key, _ = strings.Cut(path, "/")
+	return bucket, key
+}
+
+// ReadObject reads up to limit bytes from the start of an object.
+// It also returns the object's full size, so callers can tell whether the
+// content was truncated. An empty versionID reads the current version.
+func ReadObject(ctx context.Context, bucket, key, versionID string, limit int64) ([]byte, int64, error) {
+	client, err := GetClientForBucket(ctx, bucket)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	input := &s3.GetObjectInput{
+		Bucket: &bucket,
+		Key:    &key,
+	}
+	if versionID != "" {
+		input.VersionId = &versionID
+	}
+	if limit > 0 {
+		input.Range = appaws.StringPtr(fmt.Sprintf("bytes=0-%d", limit-1))
+	}
+
+	output, err := client.GetObject(ctx, input)
+	if err != nil {
+		// Ranged reads of empty objects fail with InvalidRange
+		if limit > 0 && apperrors.GetErrorCode(err) == "InvalidRange" {
+			return nil, 0, nil
+		}
+		return nil, 0, apperrors.Wrapf(err, "get object s3://%s/%s", bucket, key)
+	}
+	defer output.Body.Close()
+
+	data, err := io.ReadAll(output.Body)
+	if err != nil {
+		return nil, 0, apperrors.Wrapf(err, "read object s3://%s/%s", bucket, key)
+	}
+
+	size := int64(len(data))
+	if output.ContentRange != nil {
+		// "bytes 0-1023/4096"
+		if _, total, ok := strings.Cut(*output.ContentRange, "/"); ok {
+			if n, err := strconv.ParseInt(total, 10, 64); err == nil {
+				size = n
+			}
+		}
+	}
+	return data, size, nil
+}
+
+// DownloadObject writes an object to path and returns the file written and
+// its size. If path is a directory, the object's base name is used inside it.
+// Existing files are never overwritten.
+func DownloadObject(ctx context.Context, bucket, key, versionID, path string) (string, int64, error) {
+	if path == "" {
+		return "", 0, errors.New("download path is empty")
+	}
+	if info, err := os.Stat(path); err == nil && info.IsDir() {
+		path = filepath.Join(path, ObjectBaseName(key))
+	}
+
+	client, err := GetClientForBucket(ctx, bucket)
+	if err != nil {
+		return "", 0, err
+	}
+
+	input := &s3.GetObjectInput{
+		Bucket: &bucket,
+		Key:    &key,
+	}
+	if versionID != "" {
+		input.VersionId = &versionID
+	}
+	output, err := client.GetObject(ctx, input)
+	if err != nil {
+		return "", 0, apperrors.Wrapf(err, "get object s3://%s/%s", bucket, key)
+	}
+	defer output.Body.Close()
+
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o644)
+	if err != nil {
+		return "", 0, apperrors.Wrap(err, "create download file")
+	}
+	n, err := io.Copy(f, output.Body)
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		_ = os.Remove(path)
+		return "", 0, apperrors.Wrapf(err, "download s3://%s/%s", bucket, key)
+	}
+	return path, n, nil
+}
+
+// UniqueDownloadPath returns a path in dir for key that does not exist yet,
+// adding a numeric suffix when needed ("file (1).txt").
+func UniqueDownloadPath(dir, key string) string {
+	name := ObjectBaseName(key)
+	path := filepath.Join(dir, name)
+	ext := filepath.Ext(name)
+	stem := strings.TrimSuffix(name, ext)
+	for i := 1; ; i++ {
+		if _, err := os.Stat(path); errors.Is(err, os.ErrNotExist) {
+			return path
+		}
+		path = filepath.Join(dir, fmt.Sprintf("%s (%d)%s", stem, i, ext))
+	}
+}
+
+// DownloadDestination returns the file to download key to when the user
+// entered path: a new file inside path when it is a directory, otherwise
+// path itself. A leading "~/" is the home directory.
+func DownloadDestination(path, key string) string {
+	if rest, ok := strings.CutPrefix(path, "~/"); ok {
+		if home, err := os.UserHomeDir(); err == nil {
+			path = filepath.Join(home, rest)
+		}
+	}
+	if info, err := os.Stat(path); err == nil && info.IsDir() {
+		return UniqueDownloadPath(path, key)
+	}
+	return path
+}
+
+// ObjectBaseName returns the last path segment of an object key.
+func ObjectBaseName(key string) string {
+	name := key[strings.LastIndex(strings.TrimSuffix(key, "/"), "/")+1:]
+	name = strings.TrimSuffix(name, "/")
+	if name == "" || name == "." || name == ".." {
+		return "object"
+	}
+	return name
+}
+
+// PresignGetObject returns a presigned GET URL for an object that is valid
+// for expires.
+func PresignGetObject(ctx context.Context, bucket, key, versionID string, expires time.Duration) (string, error) {
+	client, err := GetClientForBucket(ctx, bucket)
+	if err != nil {
+		return "", err
+	}
+
+	input := &s3.GetObjectInput{
+		Bucket: &bucket,
+		Key:    &key,
+	}
+	if versionID != "" {
+		input.VersionId = &versionID
+	}
+	req, err := s3.NewPresignClient(client).PresignGetObject(ctx, input, s3.WithPresignExpires(expires))
+	if err != nil {
+		return "", apperrors.Wrapf(err, "presign s3://%s/%s", bucket, key)
+	}
+	return req.URL, nil
+}
+
+// DeleteObject deletes an object, or one version of it when versionID is set.
+// In a versioned bucket, deleting without a version adds a delete marker.
+func DeleteObject(ctx context.Context, bucket, key, versionID string) error {
+	client, err := GetClientForBucket(ctx, bucket)
+	if err != nil {
+		return err
+	}
+
+	input := &s3.DeleteObjectInput{
+		Bucket: &bucket,
+		Key:    &key,
+	}
+	if versionID != "" {
+		input.VersionId = &versionID
+	}
+	if _, err := client.DeleteObject(ctx, input); err != nil {
+		return apperrors.Wrapf(err, "delete s3://%s/%s", bucket, key)
+	}
+	return nil
+}
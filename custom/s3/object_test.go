@@ -0,0 +1,75 @@
+package s3
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestSplitPath(t *testing.T) {
+	tests := []struct {
+		path, bucket, key string
+	}{
+		{"my-bucket", "my-bucket", ""},
+		{"my-bucket/", "my-bucket", ""},
+		{"my-bucket/logs/2024/", "my-bucket", "logs/2024/"},
+		{"my-bucket/a.txt", "my-bucket", "a.txt"},
+	}
+	for _, tt := range tests {
+		bucket, key := SplitPath(tt.path)
+		if bucket != tt.bucket || key != tt.key {
+			t.Errorf("SplitPath(%q) = %q, %q", tt.path, bucket, key)
+		}
+	}
+}
+
+func TestObjectBaseName(t *testing.T) {
+	tests := map[string]string{
+		"a.txt":          "a.txt",
+		"logs/2024/a.gz": "a.gz",
+		"logs/2024/":     "2024",
+		"..":             "object",
+		"":               "object",
+	}
+	for key, want := range tests {
+		if got := ObjectBaseName(key); got != want {
+			t.Errorf("ObjectBaseName(%q) = %q, want %q", key, got, want)
+		}
+	}
+}
+
+func TestUniqueDownloadPath(t *testing.T) {
+	dir := t.TempDir()
+	if got := UniqueDownloadPath(dir, "logs/report.csv"); got != filepath.Join(dir, "report.csv") {
+		t.Errorf("UniqueDownloadPath() = %q", got)
+	}
+
+	for _, name := range []string{"report.csv", "report (1).csv"} {
+		if err := os.WriteFile(filepath.Join(dir, name), nil, 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if got := UniqueDownloadPath(dir, "logs/report.csv"); got != filepath.Join(dir, "report (2).csv") {
+		t.Errorf("UniqueDownloadPath() = %q, want report (2).csv", got)
+	}
+}
+
+func TestDownloadDestination(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "report.csv"), nil, 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	if got := DownloadDestination(dir, "logs/report.csv"); got != filepath.Join(dir, "report (1).csv") {
+		t.Errorf("DownloadDestination(dir) = %q, want a new file in dir", got)
+	}
+	file := filepath.Join(dir, "out.csv")
+	if got := DownloadDestination(file, "logs/report.csv"); got != file {
+		t.Errorf("DownloadDestination(file) = %q, want %q", got, file)
+	}
+	if home, err := os.UserHomeDir(); err == nil {
+		if got := DownloadDestination("~/claws-test-missing.csv", "k"); got != filepath.Join(home, "claws-test-missing.csv") {
+			t.Errorf("DownloadDestination(~/...) = %q", got)
+		}
+	}
+}
@@ -0,0 +1,87 @@
+package objects
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	apps3 "github.com/clawscli/claws/custom/s3"
+	"github.com/clawscli/claws/internal/action"
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/render"
+)
+
+// presignExpiry is how long copied presigned URLs stay valid.
+const presignExpiry = time.Hour
+
+func init() {
+	isObject := func(r dao.Resource) bool {
+		o, ok := r.(*ObjectResource)
+		return ok && !o.IsFolder
+	}
+
+	action.Global.Register("s3", "objects", []action.Action{
+		{
+			Name:     "Preview",
+			Shortcut: "p",
+			Type:     action.ActionTypeView,
+			Target:   action.TargetPreview,
+			Filter:   isObject,
+		},
+		{
+			Name:      "Download",
+			Shortcut:  "w",
+			Type:      action.ActionTypeAPI,
+			Operation: action.OperationDownloadObject,
+			Input:     []action.Field{apps3.DownloadPathField},
+			Filter:    isObject,
+		},
+		{
+			Name:      "Copy Presigned URL",
+			Shortcut:  "u",
+			Type:      action.ActionTypeAPI,
+			Operation: action.OperationPresignObjectURL,
+			Filter:    isObject,
+		},
+		{
+			Name:      "Delete",
+			Shortcut:  "D",
+			Type:      action.ActionTypeAPI,
+			Operation: "DeleteObject",
+			Confirm:   action.ConfirmDangerous,
+			Filter:    isObject,
+		},
+	})
+
+	action.RegisterExecutor("s3", "objects", executeObjectAction)
+}
+
+// executeObjectAction executes an action on an S3 object
+func executeObjectAction(ctx context.Context, act action.Action, resource dao.Resource) action.ActionResult {
+	o, ok := resource.(*ObjectResource)
+	if !ok || o.IsFolder {
+		return action.InvalidResourceResult()
+	}
+
+	switch act.Operation {
+	case action.OperationDownloadObject:
+		path, n, err := apps3.DownloadObject(ctx, o.Bucket, o.Key, "", apps3.DownloadDestination(act.Values.String("path"), o.Key))
+		if err != nil {
+			return action.FailResult(err)
+		}
+		return action.SuccessResult(fmt.Sprintf("Downloaded %s to %s (%s)", o.URI(), path, render.FormatSize(n)))
+	case action.OperationPresignObjectURL:
+		url, err := apps3.PresignGetObject(ctx, o.Bucket, o.Key, "", presignExpiry)
+		if err != nil {
+			return action.FailResult(err)
+		}
+		return action.CopyResult(url, "Presigned URL (valid 1h) copied to clipboard")
+	case "DeleteObject":
+		if err := apps3.DeleteObject(ctx, o.Bucket, o.Key, ""); err != nil {
+			return action.FailResult(err)
+		}
+		return action.SuccessResult(fmt.Sprintf("Deleted %s", o.URI()))
+	default:
+		return action.UnknownOperationResult(act.Operation)
+	}
+}
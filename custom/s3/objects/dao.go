@@ -0,0 +1,368 @@
+package objects
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+
+	apps3 "github.com/clawscli/claws/custom/s3"
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+)
+
+// FilterPath is the filter holding the folder to list: "bucket" for the
+// bucket root, or "bucket/prefix/" for a folder inside it.
+const FilterPath = "S3Path"
+
+// maxVersionPages bounds the ListObjectVersions calls made to count the
+// versions of one page of objects.
+const maxVersionPages = 5
+
+// ObjectDAO provides data access for S3 objects.
+// Objects are listed one folder level at a time, using "/" as the delimiter.
+// Each bucket is accessed through a client for the bucket's own region.
+type ObjectDAO struct {
+	dao.BaseDAO
+
+	mu      sync.Mutex
+	buckets map[string]bucketAccess
+}
+
+// bucketAccess is what ObjectDAO looks up once per bucket and reuses for
+// every page: the regional client and whether the bucket is versioned.
+type bucketAccess struct {
+	client    *s3.Client
+	versioned bool
+}
+
+// NewObjectDAO creates a new ObjectDAO
+func NewObjectDAO(ctx context.Context) (dao.DAO, error) {
+	return &ObjectDAO{
+		BaseDAO: dao.NewBaseDAO("s3", "objects"),
+	}, nil
+}
+
+// List returns the first page of objects.
+// For paginated access, use ListPage instead.
+func (d *ObjectDAO) List(ctx context.Context) ([]dao.Resource, error) {
+	resources, _, err := d.ListPage(ctx, 1000, "")
+	return resources, err
+}
+
+// ListPage returns a page of folders and objects.
+// Implements dao.PaginatedDAO interface.
+func (d *ObjectDAO) ListPage(ctx context.Context, pageSize int, pageToken string) ([]dao.Resource, string, error) {
+	path := dao.GetFilterFromContext(ctx, FilterPath)
+	if path == "" {
+		return nil, "", fmt.Errorf("%s required: navigate from s3/buckets using 'o' key", FilterPath)
+	}
+	bucket, prefix := apps3.SplitPath(path)
+
+	access, err := d.access(ctx, bucket)
+	if err != nil {
+		return nil, "", err
+	}
+	client := access.client
+
+	limit := int32(pageSize)
+	if limit <= 0 || limit > 1000 {
+		limit = 1000 // AWS API max
+	}
+	continuation, after := decodePageToken(pageToken)
+
+	input := &s3.ListObjectsV2Input{
+		Bucket:    &bucket,
+		Delimiter: appaws.StringPtr("/"),
+		MaxKeys:   &limit,
+	}
+	if prefix != "" {
+		input.Prefix = &prefix
+	}
+	if continuation != "" {
+		input.ContinuationToken = &continuation
+	}
+
+	output, err := client.ListObjectsV2(ctx, input)
+	if err != nil {
+		return nil, "", apperrors.Wrapf(err, "list objects in s3://%s/%s", bucket, prefix)
+	}
+
+	resources := make([]dao.Resource, 0, len(output.CommonPrefixes)+len(output.Contents))
+	last := ""
+	for _, cp := range output.CommonPrefixes {
+		key := appaws.Str(cp.Prefix)
+		resources = append(resources, NewFolderResource(bucket, key))
+		last = max(last, key)
+	}
+	objects := make([]*ObjectResource, 0, len(output.Contents))
+	for _, obj := range output.Contents {
+		key := appaws.Str(obj.Key)
+		last = max(last, key)
+		if key == prefix {
+			continue // the folder's own placeholder object
+		}
+		r := NewObjectResource(bucket, obj)
+		objects = append(objects, r)
+		resources = append(resources, r)
+	}
+
+	if access.versioned {
+		countVersions(ctx, client, bucket, prefix, after, objects)
+	}
+
+	nextToken := ""
+	if appaws.Bool(output.IsTruncated) && output.NextContinuationToken != nil {
+		nextToken = encodePageToken(*output.NextContinuationToken, last)
+	}
+
+	return resources, nextToken, nil
+}
+
+// Get returns an object by "bucket/key" ID, with its metadata and version count.
+func (d *ObjectDAO) Get(ctx context.Context, id string) (dao.Resource, error) {
+	bucket, key := apps3.SplitPath(id)
+	if key == "" || strings.HasSuffix(key, "/") {
+		return NewFolderResource(bucket, key), nil
+	}
+
+	access, err := d.access(ctx, bucket)
+	if err != nil {
+		return nil, err
+	}
+
+	output, err := access.client.HeadObject(ctx, &s3.HeadObjectInput{
+		Bucket: &bucket,
+		Key:    &key,
+	})
+	if err != nil {
+		return nil, apperrors.Wrapf(err, "head object s3://%s", id)
+	}
+
+	r := newObjectResource(bucket, key)
+	r.Size = appaws.Int64(output.ContentLength)
+	r.StorageClass = storageClass(string(output.StorageClass))
+	r.LastModified = appaws.Time(output.LastModified)
+	r.ETag = strings.Trim(appaws.Str(output.ETag), `"`)
+	r.ContentType = appaws.Str(output.ContentType)
+	r.VersionID = appaws.Str(output.VersionId)
+	r.Encryption = string(output.ServerSideEncryption)
+	r.KMSKeyID = appaws.Str(output.SSEKMSKeyId)
+	r.Metadata = output.Metadata
+	r.BaseResource.Data = output
+
+	if access.versioned {
+		countVersions(ctx, access.client, bucket, key, "", []*ObjectResource{r})
+	}
+
+	return r, nil
+}
+
+// Delete deletes an object by "bucket/key" ID.
+// In a versioned bucket this adds a delete marker.
+func (d *ObjectDAO) Delete(ctx context.Context, id string) error {
+	bucket, key := apps3.SplitPath(id)
+	if key == "" || strings.HasSuffix(key, "/") {
+		return fmt.Errorf("cannot delete folder s3://%s: delete its objects instead", id)
+	}
+	return apps3.DeleteObject(ctx, bucket, key, "")
+}
+
+// access returns the bucket's regional client and versioning state, looking
+// them up on first use.
+func (d *ObjectDAO) access(ctx context.Context, bucket string) (bucketAccess, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if a, ok := d.buckets[bucket]; ok {
+		return a, nil
+	}
+
+	client, err := apps3.GetClientForBucket(ctx, bucket)
+	if err != nil {
+		return bucketAccess{}, apperrors.Wrapf(err, "s3 client for %s", bucket)
+	}
+	a := bucketAccess{client: client, versioned: isVersioned(ctx, client, bucket)}
+	if d.buckets == nil {
+		d.buckets = make(map[string]bucketAccess)
+	}
+	d.buckets[bucket] = a
+	return a, nil
+}
+
+// isVersioned reports whether versioning is, or has ever been, enabled on
+// the bucket. Errors count as unversioned.
+func isVersioned(ctx context.Context, client *s3.Client, bucket string) bool {
+	output, err := client.GetBucketVersioning(ctx, &s3.GetBucketVersioningInput{
+		Bucket: &bucket,
+	})
+	return err == nil && output.Status != ""
+}
+
+// countVersions fills in VersionCount for one page of objects.
+// Versions are listed from after the previous page's last key. The scan
+// stops after maxVersionPages calls; objects it did not fully cover keep
+// a count of 0, which renders as unknown.
+func countVersions(ctx context.Context, client *s3.Client, bucket, prefix, after string, objects []*ObjectResource) {
+	if len(objects) == 0 {
+		return
+	}
+	byKey := make(map[string]*ObjectResource, len(objects))
+	last := ""
+	for _, o := range objects {
+		byKey[o.Key] = o
+		last = max(last, o.Key)
+	}
+
+	counts := make(map[string]int, len(objects))
+	input := &s3.ListObjectVersionsInput{
+		Bucket:    &bucket,
+		Delimiter: appaws.StringPtr("/"),
+	}
+	if prefix != "" {
+		input.Prefix = &prefix
+	}
+	if after != "" {
+		input.KeyMarker = &after
+	}
+
+	for range maxVersionPages {
+		output, err := client.ListObjectVersions(ctx, input)
+		if err != nil {
+			return
+		}
+		for _, v := range output.Versions {
+			if _, ok := byKey[appaws.Str(v.Key)]; ok {
+				counts[appaws.Str(v.Key)]++
+			}
+		}
+		next := appaws.Str(output.NextKeyMarker)
+		if !appaws.Bool(output.IsTruncated) || next > last {
+			for key, o := range byKey {
+				o.VersionCount = counts[key]
+			}
+			return
+		}
+		input.KeyMarker = output.NextKeyMarker
+		input.VersionIdMarker = output.NextVersionIdMarker
+	}
+
+	// Versions of the marker key may continue on the next page
+	reached := appaws.Str(input.KeyMarker)
+	for key, o := range byKey {
+		if key < reached {
+			o.VersionCount = counts[key]
+		}
+	}
+}
+
+// Page tokens carry the last key of the page along with the continuation
+// token, so the next page's version scan can start after it.
+// Continuation tokens are base64 and never contain a newline.
+func encodePageToken(continuation, lastKey string) string {
+	return continuation + "\n" + lastKey
+}
+
+func decodePageToken(token string) (continuation, lastKey string) {
+	continuation, lastKey, _ = strings.Cut(token, "\n")
+	return continuation, lastKey
+}
+
+// storageClass returns the storage class, which S3 omits for STANDARD.
+func storageClass(class string) string {
+	if class == "" {
+		return string(types.StorageClassStandard)
+	}
+	return class
+}
+
+// ObjectResource wraps an S3 object, or a folder (common prefix).
+type ObjectResource struct {
+	dao.BaseResource
+	Bucket       string
+	Key          string
+	IsFolder     bool
+	Size         int64
+	StorageClass string
+	LastModified time.Time
+	ETag         string
+	VersionCount int // 0 when the bucket is unversioned or the count is unknown
+
+	// Extended info (fetched in Get() only)
+	ContentType string
+	VersionID   string
+	Encryption  string
+	KMSKeyID    string
+	Metadata    map[string]string
+}
+
+func newObjectResource(bucket, key string) *ObjectResource {
+	name := apps3.ObjectBaseName(key)
+	if strings.HasSuffix(key, "/") {
+		name += "/"
+	}
+	return &ObjectResource{
+		BaseResource: dao.BaseResource{
+			ID:   bucket + "/" + key,
+			Name: name,
+			ARN:  fmt.Sprintf("arn:aws:s3:::%s/%s", bucket, key),
+		},
+		Bucket: bucket,
+		Key:    key,
+	}
+}
+
+// NewObjectResource creates a new ObjectResource from a ListObjectsV2 entry
+func NewObjectResource(bucket string, obj types.Object) *ObjectResource {
+	r := newObjectResource(bucket, appaws.Str(obj.Key))
+	r.Size = appaws.Int64(obj.Size)
+	r.StorageClass = storageClass(string(obj.StorageClass))
+	r.LastModified = appaws.Time(obj.LastModified)
+	r.ETag = strings.Trim(appaws.Str(obj.ETag), `"`)
+	r.BaseResource.Data = obj
+	return r
+}
+
+// NewFolderResource creates a folder resource for a common prefix
+func NewFolderResource(bucket, prefix string) *ObjectResource {
+	r := newObjectResource(bucket, prefix)
+	r.IsFolder = true
+	if prefix == "" {
+		r.Name = bucket
+		r.ID = bucket
+		r.ARN = "arn:aws:s3:::" + bucket
+	}
+	return r
+}
+
+// URI returns the object's s3:// URI
+func (r *ObjectResource) URI() string {
+	return "s3://" + r.Bucket + "/" + r.Key
+}
+
+// Age returns the time since the object was last modified
+func (r *ObjectResource) Age() time.Duration {
+	if r.LastModified.IsZero() {
+		return 0
+	}
+	return time.Since(r.LastModified)
+}
+
+// ContentName implements the view package's ContentSource
+func (r *ObjectResource) ContentName() string {
+	return r.URI()
+}
+
+// ReadContent implements the view package's ContentSource
+func (r *ObjectResource) ReadContent(ctx context.Context, limit int64) ([]byte, int64, error) {
+	return apps3.ReadObject(ctx, r.Bucket, r.Key, "", limit)
+}
+
+// DownloadContent implements the view package's ContentSource
+func (r *ObjectResource) DownloadContent(ctx context.Context, path string) (string, int64, error) {
+	return apps3.DownloadObject(ctx, r.Bucket, r.Key, "", path)
+}
@@ -0,0 +1,20 @@
+package objects
+
+import (
+	"context"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/registry"
+	"github.com/clawscli/claws/internal/render"
+)
+
+func init() {
+	registry.Global.RegisterCustom("s3", "objects", registry.Entry{
+		DAOFactory: func(ctx context.Context) (dao.DAO, error) {
+			return NewObjectDAO(ctx)
+		},
+		RendererFactory: func() render.Renderer {
+			return NewObjectRenderer()
+		},
+	})
+}
@@ -0,0 +1,203 @@
+package objects
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/render"
+)
+
+// Ensure ObjectRenderer implements render.Navigator
+var _ render.Navigator = (*ObjectRenderer)(nil)
+
+// ObjectRenderer renders S3 objects and folders
+type ObjectRenderer struct {
+	render.BaseRenderer
+}
+
+// NewObjectRenderer creates a new ObjectRenderer
+func NewObjectRenderer() render.Renderer {
+	return &ObjectRenderer{
+		BaseRenderer: render.BaseRenderer{
+			Service:  "s3",
+			Resource: "objects",
+			Cols: []render.Column{
+				{Name: "NAME", Width: 50, Getter: func(r dao.Resource) string { return r.GetName() }, Priority: 0},
+				{Name: "SIZE", Width: 10, Getter: getSize, Priority: 1},
+				{Name: "STORAGE CLASS", Width: 20, Getter: getStorageClass, Priority: 2},
+				{Name: "LAST MODIFIED", Width: 18, Getter: getLastModified, Priority: 3},
+				{Name: "VERSIONS", Width: 9, Getter: getVersions, Priority: 4},
+			},
+		},
+	}
+}
+
+func getSize(r dao.Resource) string {
+	if o, ok := r.(*ObjectResource); ok && !o.IsFolder {
+		return render.FormatSize(o.Size)
+	}
+	return ""
+}
+
+func getStorageClass(r dao.Resource) string {
+	if o, ok := r.(*ObjectResource); ok {
+		if o.IsFolder {
+			return "Folder"
+		}
+		return o.StorageClass
+	}
+	return ""
+}
+
+func getLastModified(r dao.Resource) string {
+	if o, ok := r.(*ObjectResource); ok && !o.LastModified.IsZero() {
+		return o.LastModified.Format("2006-01-02 15:04")
+	}
+	return ""
+}
+
+func getVersions(r dao.Resource) string {
+	if o, ok := r.(*ObjectResource); ok && !o.IsFolder {
+		if o.VersionCount > 0 {
+			return fmt.Sprintf("%d", o.VersionCount)
+		}
+		return "-"
+	}
+	return ""
+}
+
+// RenderDetail renders detailed object information
+func (r *ObjectRenderer) RenderDetail(resource dao.Resource) string {
+	o, ok := resource.(*ObjectResource)
+	if !ok {
+		return ""
+	}
+
+	d := render.NewDetailBuilder()
+
+	if o.IsFolder {
+		d.Title("S3 Folder", o.GetName())
+		d.Section("Basic Information")
+		d.Field("Bucket", o.Bucket)
+		d.Field("Prefix", o.Key)
+		d.Field("S3 URI", o.URI())
+		return d.String()
+	}
+
+	d.Title("S3 Object", o.GetName())
+
+	d.Section("Basic Information")
+	d.Field("Bucket", o.Bucket)
+	d.Field("Key", o.Key)
+	d.Field("Size", fmt.Sprintf("%s (%d bytes)", render.FormatSize(o.Size), o.Size))
+	d.Field("Storage Class", o.StorageClass)
+	if o.ContentType != "" {
+		d.Field("Content Type", o.ContentType)
+	}
+	if o.ETag != "" {
+		d.Field("ETag", o.ETag)
+	}
+
+	d.Section("Access")
+	d.Field("ARN", o.GetARN())
+	d.Field("S3 URI", o.URI())
+
+	if o.VersionID != "" || o.VersionCount > 0 {
+		d.Section("Versioning")
+		if o.VersionID != "" {
+			d.Field("Version ID", o.VersionID)
+		}
+		if o.VersionCount > 0 {
+			d.Field("Versions", fmt.Sprintf("%d", o.VersionCount))
+		}
+	}
+
+	if o.Encryption != "" {
+		d.Section("Server-Side Encryption")
+		d.Field("Algorithm", o.Encryption)
+		if o.KMSKeyID != "" {
+			d.Field("KMS Key ID", o.KMSKeyID)
+		}
+	}
+
+	if len(o.Metadata) > 0 {
+		d.Section("Metadata")
+		keys := make([]string, 0, len(o.Metadata))
+		for k := range o.Metadata {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			d.Field(k, o.Metadata[k])
+		}
+	}
+
+	if !o.LastModified.IsZero() {
+		d.Section("Timestamps")
+		d.Field("Last Modified", o.LastModified.Format("2006-01-02 15:04:05"))
+		d.Field("Age", render.FormatAge(o.LastModified))
+	}
+
+	return d.String()
+}
+
+// RenderSummary returns summary fields for the header panel
+func (r *ObjectRenderer) RenderSummary(resource dao.Resource) []render.SummaryField {
+	o, ok := resource.(*ObjectResource)
+	if !ok {
+		return r.BaseRenderer.RenderSummary(resource)
+	}
+
+	fields := []render.SummaryField{
+		{Label: "URI", Value: o.URI()},
+	}
+	if o.IsFolder {
+		return fields
+	}
+
+	fields = append(fields,
+		render.SummaryField{Label: "Size", Value: render.FormatSize(o.Size)},
+		render.SummaryField{Label: "Storage Class", Value: o.StorageClass},
+	)
+	if !o.LastModified.IsZero() {
+		fields = append(fields, render.SummaryField{Label: "Last Modified", Value: o.LastModified.Format("2006-01-02 15:04")})
+	}
+	if o.VersionCount > 0 {
+		fields = append(fields, render.SummaryField{Label: "Versions", Value: fmt.Sprintf("%d", o.VersionCount)})
+	}
+	return fields
+}
+
+// Navigations returns navigation shortcuts
+func (r *ObjectRenderer) Navigations(resource dao.Resource) []render.Navigation {
+	o, ok := resource.(*ObjectResource)
+	if !ok {
+		return nil
+	}
+
+	// Folders open like directories
+	if o.IsFolder {
+		return []render.Navigation{
+			{
+				Key:         "enter",
+				Label:       "Open",
+				Service:     "s3",
+				Resource:    "objects",
+				FilterField: FilterPath,
+				FilterValue: o.GetID(),
+			},
+		}
+	}
+
+	return []render.Navigation{
+		{
+			Key:         "v",
+			Label:       "Versions",
+			Service:     "s3",
+			Resource:    "object-versions",
+			FilterField: FilterPath,
+			FilterValue: o.GetID(),
+		},
+	}
+}
@@ -0,0 +1,105 @@
+package objects
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+)
+
+func TestNewObjectResource(t *testing.T) {
+	modified := time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC)
+	r := NewObjectResource("my-bucket", types.Object{
+		Key:          aws.String("logs/2024/app.json"),
+		Size:         aws.Int64(2048),
+		ETag:         aws.String(`"abc123"`),
+		LastModified: &modified,
+	})
+
+	tests := []struct {
+		name     string
+		got      string
+		expected string
+	}{
+		{"GetID", r.GetID(), "my-bucket/logs/2024/app.json"},
+		{"GetName", r.GetName(), "app.json"},
+		{"GetARN", r.GetARN(), "arn:aws:s3:::my-bucket/logs/2024/app.json"},
+		{"URI", r.URI(), "s3://my-bucket/logs/2024/app.json"},
+		{"StorageClass", r.StorageClass, "STANDARD"},
+		{"ETag", r.ETag, "abc123"},
+		{"SIZE", getSize(r), "2.0 KiB"},
+		{"VERSIONS", getVersions(r), "-"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.expected {
+				t.Errorf("%s = %q, want %q", tt.name, tt.got, tt.expected)
+			}
+		})
+	}
+
+	r.VersionCount = 3
+	if got := getVersions(r); got != "3" {
+		t.Errorf("VERSIONS = %q, want 3", got)
+	}
+}
+
+func TestNewFolderResource(t *testing.T) {
+	folder := NewFolderResource("my-bucket", "logs/2024/")
+	if folder.GetID() != "my-bucket/logs/2024/" || folder.GetName() != "2024/" || !folder.IsFolder {
+		t.Errorf("folder = %q %q %v", folder.GetID(), folder.GetName(), folder.IsFolder)
+	}
+	if getSize(folder) != "" || getStorageClass(folder) != "Folder" {
+		t.Errorf("folder columns: size=%q class=%q", getSize(folder), getStorageClass(folder))
+	}
+
+	root := NewFolderResource("my-bucket", "")
+	if root.GetID() != "my-bucket" || root.GetName() != "my-bucket" {
+		t.Errorf("root = %q %q", root.GetID(), root.GetName())
+	}
+}
+
+func TestPageToken(t *testing.T) {
+	token := encodePageToken("1Xz+/abc=", "logs/a b\tc.txt")
+	continuation, last := decodePageToken(token)
+	if continuation != "1Xz+/abc=" || last != "logs/a b\tc.txt" {
+		t.Errorf("decodePageToken() = %q, %q", continuation, last)
+	}
+
+	continuation, last = decodePageToken("")
+	if continuation != "" || last != "" {
+		t.Errorf("decodePageToken(\"\") = %q, %q", continuation, last)
+	}
+}
+
+func TestObjectNavigations(t *testing.T) {
+	renderer := NewObjectRenderer().(*ObjectRenderer)
+
+	navs := renderer.Navigations(NewFolderResource("b", "logs/"))
+	if len(navs) != 1 || navs[0].Key != "enter" || navs[0].Resource != "objects" || navs[0].FilterValue != "b/logs/" {
+		t.Errorf("folder navigations = %+v", navs)
+	}
+
+	navs = renderer.Navigations(NewObjectResource("b", types.Object{Key: aws.String("logs/app.log")}))
+	if len(navs) != 1 || navs[0].Key != "v" || navs[0].Resource != "object-versions" || navs[0].FilterValue != "b/logs/app.log" {
+		t.Errorf("object navigations = %+v", navs)
+	}
+}
+
+func TestObjectDAO_AccessReused(t *testing.T) {
+	client := s3.New(s3.Options{Region: "eu-west-1"})
+	d := &ObjectDAO{buckets: map[string]bucketAccess{"my-bucket": {client: client, versioned: true}}}
+
+	// A looked-up bucket needs no further GetBucketLocation or
+	// GetBucketVersioning calls on later pages
+	a, err := d.access(context.Background(), "my-bucket")
+	if err != nil {
+		t.Fatalf("access() error = %v", err)
+	}
+	if a.client != client || !a.versioned {
+		t.Errorf("access() = %+v, want the cached entry", a)
+	}
+}
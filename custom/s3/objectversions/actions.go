@@ -0,0 +1,87 @@
+package objectversions
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	apps3 "github.com/clawscli/claws/custom/s3"
+	"github.com/clawscli/claws/internal/action"
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/render"
+)
+
+// presignExpiry is how long copied presigned URLs stay valid.
+const presignExpiry = time.Hour
+
+func init() {
+	hasContent := func(r dao.Resource) bool {
+		v, ok := r.(*VersionResource)
+		return ok && !v.IsDeleteMarker
+	}
+
+	action.Global.Register("s3", "object-versions", []action.Action{
+		{
+			Name:     "Preview",
+			Shortcut: "p",
+			Type:     action.ActionTypeView,
+			Target:   action.TargetPreview,
+			Filter:   hasContent,
+		},
+		{
+			Name:      "Download",
+			Shortcut:  "w",
+			Type:      action.ActionTypeAPI,
+			Operation: action.OperationDownloadObject,
+			Input:     []action.Field{apps3.DownloadPathField},
+			Filter:    hasContent,
+		},
+		{
+			Name:      "Copy Presigned URL",
+			Shortcut:  "u",
+			Type:      action.ActionTypeAPI,
+			Operation: action.OperationPresignObjectURL,
+			Filter:    hasContent,
+		},
+		{
+			Name:      "Delete Version",
+			Shortcut:  "D",
+			Type:      action.ActionTypeAPI,
+			Operation: "DeleteObjectVersion",
+			Confirm:   action.ConfirmDangerous,
+		},
+	})
+
+	action.RegisterExecutor("s3", "object-versions", executeVersionAction)
+}
+
+// executeVersionAction executes an action on an S3 object version
+func executeVersionAction(ctx context.Context, act action.Action, resource dao.Resource) action.ActionResult {
+	v, ok := resource.(*VersionResource)
+	if !ok {
+		return action.InvalidResourceResult()
+	}
+
+	switch act.Operation {
+	case action.OperationDownloadObject:
+		path, n, err := apps3.DownloadObject(ctx, v.Bucket, v.Key, v.VersionID, apps3.DownloadDestination(act.Values.String("path"), v.Key))
+		if err != nil {
+			return action.FailResult(err)
+		}
+		return action.SuccessResult(fmt.Sprintf("Downloaded %s to %s (%s)", v.ContentName(), path, render.FormatSize(n)))
+	case action.OperationPresignObjectURL:
+		url, err := apps3.PresignGetObject(ctx, v.Bucket, v.Key, v.VersionID, presignExpiry)
+		if err != nil {
+			return action.FailResult(err)
+		}
+		return action.CopyResult(url, "Presigned URL (valid 1h) copied to clipboard")
+	case "DeleteObjectVersion":
+		// Deleting a specific version is permanent, even in versioned buckets
+		if err := apps3.DeleteObject(ctx, v.Bucket, v.Key, v.VersionID); err != nil {
+			return action.FailResult(err)
+		}
+		return action.SuccessResult(fmt.Sprintf("Permanently deleted version %s of %s", v.VersionID, v.URI()))
+	default:
+		return action.UnknownOperationResult(act.Operation)
+	}
+}
@@ -0,0 +1,175 @@
+package objectversions
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+
+	apps3 "github.com/clawscli/claws/custom/s3"
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+)
+
+// FilterPath is the filter holding the object: "bucket/key".
+const FilterPath = "S3Path"
+
+// VersionDAO provides data access for the versions of one S3 object
+type VersionDAO struct {
+	dao.BaseDAO
+}
+
+// NewVersionDAO creates a new VersionDAO
+func NewVersionDAO(ctx context.Context) (dao.DAO, error) {
+	return &VersionDAO{
+		BaseDAO: dao.NewBaseDAO("s3", "object-versions"),
+	}, nil
+}
+
+// List returns the first page of versions.
+// For paginated access, use ListPage instead.
+func (d *VersionDAO) List(ctx context.Context) ([]dao.Resource, error) {
+	resources, _, err := d.ListPage(ctx, 1000, "")
+	return resources, err
+}
+
+// ListPage returns a page of versions and delete markers, newest first.
+// Implements dao.PaginatedDAO interface.
+func (d *VersionDAO) ListPage(ctx context.Context, pageSize int, pageToken string) ([]dao.Resource, string, error) {
+	path := dao.GetFilterFromContext(ctx, FilterPath)
+	bucket, key := apps3.SplitPath(path)
+	if key == "" {
+		return nil, "", fmt.Errorf("%s required: navigate from s3/objects using 'v' key", FilterPath)
+	}
+
+	client, err := apps3.GetClientForBucket(ctx, bucket)
+	if err != nil {
+		return nil, "", apperrors.Wrapf(err, "s3 client for %s", bucket)
+	}
+
+	limit := int32(pageSize)
+	if limit <= 0 || limit > 1000 {
+		limit = 1000 // AWS API max
+	}
+	input := &s3.ListObjectVersionsInput{
+		Bucket:  &bucket,
+		Prefix:  &key,
+		MaxKeys: &limit,
+	}
+	if pageToken != "" {
+		keyMarker, versionMarker, _ := strings.Cut(pageToken, "\n")
+		input.KeyMarker = &keyMarker
+		input.VersionIdMarker = &versionMarker
+	}
+
+	output, err := client.ListObjectVersions(ctx, input)
+	if err != nil {
+		return nil, "", apperrors.Wrapf(err, "list versions of s3://%s", path)
+	}
+
+	// The prefix also matches longer keys; keep only this object
+	var resources []dao.Resource
+	for _, v := range output.Versions {
+		if appaws.Str(v.Key) == key {
+			r := NewVersionResource(bucket, key, appaws.Str(v.VersionId), appaws.Bool(v.IsLatest), appaws.Time(v.LastModified))
+			r.Size = appaws.Int64(v.Size)
+			r.StorageClass = string(v.StorageClass)
+			r.ETag = strings.Trim(appaws.Str(v.ETag), `"`)
+			r.BaseResource.Data = v
+			resources = append(resources, r)
+		}
+	}
+	for _, m := range output.DeleteMarkers {
+		if appaws.Str(m.Key) == key {
+			r := NewVersionResource(bucket, key, appaws.Str(m.VersionId), appaws.Bool(m.IsLatest), appaws.Time(m.LastModified))
+			r.IsDeleteMarker = true
+			r.BaseResource.Data = m
+			resources = append(resources, r)
+		}
+	}
+
+	sort.SliceStable(resources, func(i, j int) bool {
+		return resources[i].(*VersionResource).LastModified.After(resources[j].(*VersionResource).LastModified)
+	})
+
+	nextToken := ""
+	if appaws.Bool(output.IsTruncated) && appaws.Str(output.NextKeyMarker) == key {
+		nextToken = appaws.Str(output.NextKeyMarker) + "\n" + appaws.Str(output.NextVersionIdMarker)
+	}
+
+	return resources, nextToken, nil
+}
+
+func (d *VersionDAO) Get(ctx context.Context, id string) (dao.Resource, error) {
+	return nil, fmt.Errorf("get by ID not supported for object versions")
+}
+
+func (d *VersionDAO) Delete(ctx context.Context, id string) error {
+	return fmt.Errorf("delete not supported for object versions: use the Delete Version action")
+}
+
+func (d *VersionDAO) Supports(op dao.Operation) bool {
+	return op == dao.OpList
+}
+
+// VersionResource wraps one version, or delete marker, of an S3 object
+type VersionResource struct {
+	dao.BaseResource
+	Bucket         string
+	Key            string
+	VersionID      string
+	IsLatest       bool
+	IsDeleteMarker bool
+	Size           int64
+	StorageClass   string
+	LastModified   time.Time
+	ETag           string
+}
+
+// NewVersionResource creates a new VersionResource
+func NewVersionResource(bucket, key, versionID string, isLatest bool, lastModified time.Time) *VersionResource {
+	return &VersionResource{
+		BaseResource: dao.BaseResource{
+			ID:   versionID,
+			Name: versionID,
+			ARN:  fmt.Sprintf("arn:aws:s3:::%s/%s", bucket, key),
+		},
+		Bucket:       bucket,
+		Key:          key,
+		VersionID:    versionID,
+		IsLatest:     isLatest,
+		LastModified: lastModified,
+	}
+}
+
+// URI returns the object's s3:// URI
+func (r *VersionResource) URI() string {
+	return "s3://" + r.Bucket + "/" + r.Key
+}
+
+// Age returns the time since the version was created
+func (r *VersionResource) Age() time.Duration {
+	if r.LastModified.IsZero() {
+		return 0
+	}
+	return time.Since(r.LastModified)
+}
+
+// ContentName implements the view package's ContentSource
+func (r *VersionResource) ContentName() string {
+	return r.URI() + " (" + r.VersionID + ")"
+}
+
+// ReadContent implements the view package's ContentSource
+func (r *VersionResource) ReadContent(ctx context.Context, limit int64) ([]byte, int64, error) {
+	return apps3.ReadObject(ctx, r.Bucket, r.Key, r.VersionID, limit)
+}
+
+// DownloadContent implements the view package's ContentSource
+func (r *VersionResource) DownloadContent(ctx context.Context, path string) (string, int64, error) {
+	return apps3.DownloadObject(ctx, r.Bucket, r.Key, r.VersionID, path)
+}
@@ -0,0 +1,100 @@
+package objectversions
+
+import (
+	"fmt"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/render"
+)
+
+// VersionRenderer renders S3 object versions
+type VersionRenderer struct {
+	render.BaseRenderer
+}
+
+// NewVersionRenderer creates a new VersionRenderer
+func NewVersionRenderer() render.Renderer {
+	return &VersionRenderer{
+		BaseRenderer: render.BaseRenderer{
+			Service:  "s3",
+			Resource: "object-versions",
+			Cols: []render.Column{
+				{Name: "VERSION ID", Width: 36, Getter: func(r dao.Resource) string { return r.GetID() }, Priority: 0},
+				{Name: "LATEST", Width: 7, Getter: getLatest, Priority: 1},
+				{Name: "SIZE", Width: 10, Getter: getSize, Priority: 2},
+				{Name: "STORAGE CLASS", Width: 20, Getter: getStorageClass, Priority: 3},
+				{Name: "LAST MODIFIED", Width: 18, Getter: getLastModified, Priority: 4},
+			},
+		},
+	}
+}
+
+func getLatest(r dao.Resource) string {
+	if v, ok := r.(*VersionResource); ok && v.IsLatest {
+		return "yes"
+	}
+	return ""
+}
+
+func getSize(r dao.Resource) string {
+	if v, ok := r.(*VersionResource); ok && !v.IsDeleteMarker {
+		return render.FormatSize(v.Size)
+	}
+	return ""
+}
+
+func getStorageClass(r dao.Resource) string {
+	if v, ok := r.(*VersionResource); ok {
+		if v.IsDeleteMarker {
+			return "Delete marker"
+		}
+		return v.StorageClass
+	}
+	return ""
+}
+
+func getLastModified(r dao.Resource) string {
+	if v, ok := r.(*VersionResource); ok && !v.LastModified.IsZero() {
+		return v.LastModified.Format("2006-01-02 15:04")
+	}
+	return ""
+}
+
+// RenderDetail renders detailed version information
+func (r *VersionRenderer) RenderDetail(resource dao.Resource) string {
+	v, ok := resource.(*VersionResource)
+	if !ok {
+		return ""
+	}
+
+	d := render.NewDetailBuilder()
+
+	d.Title("S3 Object Version", v.VersionID)
+
+	d.Section("Basic Information")
+	d.Field("Bucket", v.Bucket)
+	d.Field("Key", v.Key)
+	d.Field("Version ID", v.VersionID)
+	if v.IsLatest {
+		d.Field("Latest", "Yes")
+	} else {
+		d.Field("Latest", "No")
+	}
+	if v.IsDeleteMarker {
+		d.Field("Type", "Delete marker")
+	} else {
+		d.Field("Size", fmt.Sprintf("%s (%d bytes)", render.FormatSize(v.Size), v.Size))
+		d.Field("Storage Class", v.StorageClass)
+		if v.ETag != "" {
+			d.Field("ETag", v.ETag)
+		}
+	}
+
+	if !v.LastModified.IsZero() {
+		d.Section("Timestamps")
+		d.Field("Last Modified", v.LastModified.Format("2006-01-02 15:04:05"))
+		d.Field("Age", render.FormatAge(v.LastModified))
+	}
+
+	return d.String()
+}
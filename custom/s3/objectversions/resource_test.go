@@ -0,0 +1,26 @@
+package objectversions
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNewVersionResource(t *testing.T) {
+	modified := time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC)
+	r := NewVersionResource("my-bucket", "data/report.csv", "v2", true, modified)
+
+	if r.GetID() != "v2" || r.Key != "data/report.csv" || !r.IsLatest {
+		t.Errorf("resource = %+v", r)
+	}
+	if got := r.ContentName(); got != "s3://my-bucket/data/report.csv (v2)" {
+		t.Errorf("ContentName() = %q", got)
+	}
+	if getLatest(r) != "yes" {
+		t.Errorf("LATEST = %q, want yes", getLatest(r))
+	}
+
+	r.IsDeleteMarker = true
+	if getSize(r) != "" || getStorageClass(r) != "Delete marker" {
+		t.Errorf("delete marker columns: size=%q class=%q", getSize(r), getStorageClass(r))
+	}
+}
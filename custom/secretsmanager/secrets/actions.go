@@ -16,10 +16,16 @@ func init() {
 		{
 			Name:     "View Value",
 			Shortcut: "v",
-			Type:     action.ActionTypeExec,
-			Command:  `aws secretsmanager get-secret-value --secret-id "${ID}" --query 'SecretString' --output text | less`,
+			Type:     action.ActionTypeView,
+			Target:   action.TargetSecretValue,
 			Confirm:  action.ConfirmSimple,
 		},
+		{
+			Name:     "Put New Version",
+			Shortcut: "p",
+			Type:     action.ActionTypeView,
+			Target:   action.TargetPutValue,
+		},
 		{
 			Name:     "Describe (JSON)",
 			Shortcut: "j",
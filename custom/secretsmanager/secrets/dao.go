@@ -9,6 +9,7 @@ import (
 	appaws "github.com/clawscli/claws/internal/aws"
 	"github.com/clawscli/claws/internal/dao"
 	apperrors "github.com/clawscli/claws/internal/errors"
+	"github.com/clawscli/claws/internal/secretvalue"
 )
 
 // SecretDAO provides data access for Secrets Manager secrets
@@ -193,3 +194,12 @@ func (r *SecretResource) CurrentVersionId() string {
 	}
 	return ""
 }
+
+// SecretValueTarget implements secretvalue.Provider
+func (r *SecretResource) SecretValueTarget() secretvalue.Target {
+	id := r.GetARN()
+	if id == "" {
+		id = r.GetID()
+	}
+	return secretvalue.Target{Kind: secretvalue.KindSecret, ID: id, Name: r.GetName()}
+}
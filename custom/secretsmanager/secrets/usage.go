@@ -0,0 +1,21 @@
+package secrets
+
+import (
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/usage"
+)
+
+func init() {
+	usage.Global.Register("kms", "keys", usage.Finder{
+		Service:  "secretsmanager",
+		Resource: "secrets",
+		Relation: "secret encryption",
+		Refs: func(r dao.Resource) []string {
+			if s, ok := r.(*SecretResource); ok {
+				return []string{appaws.Str(s.Item.KmsKeyId), s.KmsKeyId}
+			}
+			return nil
+		},
+	})
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/aws/aws-sdk-go-v2/service/sfn"
+	"github.com/aws/aws-sdk-go-v2/service/sfn/types"
 
 	sfnClient "github.com/clawscli/claws/custom/sfn"
 	"github.com/clawscli/claws/internal/action"
@@ -14,6 +15,30 @@ import (
 func init() {
 	// Register actions for Step Functions executions
 	action.Global.Register("sfn", "executions", []action.Action{
+		{
+			Name:     "Execution History",
+			Shortcut: "h",
+			Type:     action.ActionTypeView,
+			Target:   action.TargetExecution,
+		},
+		{
+			Name:      "Redrive",
+			Shortcut:  "R",
+			Type:      action.ActionTypeAPI,
+			Operation: "RedriveExecution",
+			Confirm:   action.ConfirmSimple,
+			Filter: func(r dao.Resource) bool {
+				er, ok := r.(*ExecutionResource)
+				if !ok {
+					return false
+				}
+				switch er.Item.Status {
+				case types.ExecutionStatusFailed, types.ExecutionStatusTimedOut, types.ExecutionStatusAborted:
+					return true
+				}
+				return false
+			},
+		},
 		{
 			Name:      "Stop",
 			Shortcut:  "S",
@@ -32,6 +57,8 @@ func executeExecutionAction(ctx context.Context, act action.Action, resource dao
 	switch act.Operation {
 	case "StopExecution":
 		return executeStopExecution(ctx, resource)
+	case "RedriveExecution":
+		return executeRedriveExecution(ctx, resource)
 	default:
 		return action.UnknownOperationResult(act.Operation)
 	}
@@ -63,3 +90,24 @@ func executeStopExecution(ctx context.Context, resource dao.Resource) action.Act
 		Message: fmt.Sprintf("Stopped execution %s", exec.GetName()),
 	}
 }
+
+// executeRedriveExecution restarts a failed, timed out or aborted execution
+// from the state that did not succeed.
+func executeRedriveExecution(ctx context.Context, resource dao.Resource) action.ActionResult {
+	exec, ok := resource.(*ExecutionResource)
+	if !ok {
+		return action.InvalidResourceResult()
+	}
+
+	client, err := sfnClient.GetClient(ctx)
+	if err != nil {
+		return action.FailResult(err)
+	}
+
+	arn := exec.ARN()
+	if _, err := client.RedriveExecution(ctx, &sfn.RedriveExecutionInput{ExecutionArn: &arn}); err != nil {
+		return action.FailResultf(err, "redrive execution %s", exec.GetName())
+	}
+
+	return action.SuccessResult(fmt.Sprintf("Redriving execution %s", exec.GetName()))
+}
@@ -11,6 +11,7 @@ import (
 	appaws "github.com/clawscli/claws/internal/aws"
 	"github.com/clawscli/claws/internal/dao"
 	apperrors "github.com/clawscli/claws/internal/errors"
+	"github.com/clawscli/claws/internal/states"
 )
 
 // ExecutionDAO provides data access for Step Functions executions
@@ -228,3 +229,8 @@ func (r *ExecutionResource) Cause() string {
 	}
 	return ""
 }
+
+// ExecutionTarget returns the execution for the execution history view.
+func (r *ExecutionResource) ExecutionTarget() states.Target {
+	return states.Target{ExecutionARN: r.ARN(), Name: r.GetName()}
+}
@@ -13,6 +13,12 @@ import (
 
 func init() {
 	action.Global.Register("sfn", "state-machines", []action.Action{
+		{
+			Name:     "Start Execution",
+			Shortcut: "s",
+			Type:     action.ActionTypeView,
+			Target:   action.TargetStartExecution,
+		},
 		{
 			Name:         "Delete",
 			Shortcut:     "D",
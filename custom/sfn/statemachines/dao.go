@@ -10,6 +10,7 @@ import (
 	appaws "github.com/clawscli/claws/internal/aws"
 	"github.com/clawscli/claws/internal/dao"
 	apperrors "github.com/clawscli/claws/internal/errors"
+	"github.com/clawscli/claws/internal/states"
 )
 
 // StateMachineDAO provides data access for Step Functions state machines
@@ -158,3 +159,8 @@ func (r *StateMachineResource) Definition() string {
 	}
 	return ""
 }
+
+// MachineTarget returns the state machine for the start execution view.
+func (r *StateMachineResource) MachineTarget() states.Machine {
+	return states.Machine{ARN: r.ARN(), Name: r.GetName()}
+}
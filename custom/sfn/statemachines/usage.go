@@ -0,0 +1,23 @@
+package statemachines
+
+import (
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/usage"
+)
+
+func init() {
+	// The role ARN is only available from DescribeStateMachine, so each
+	// state machine is fetched via Get.
+	usage.Global.Register("iam", "roles", usage.Finder{
+		Service:  "sfn",
+		Resource: "state-machines",
+		Relation: "execution role",
+		Detail:   true,
+		Refs: func(r dao.Resource) []string {
+			if sm, ok := r.(*StateMachineResource); ok {
+				return []string{sm.RoleARN()}
+			}
+			return nil
+		},
+	})
+}
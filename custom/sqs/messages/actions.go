@@ -0,0 +1,62 @@
+package messages
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/sqs"
+
+	appsqs "github.com/clawscli/claws/custom/sqs"
+	"github.com/clawscli/claws/internal/action"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+)
+
+func init() {
+	action.Global.Register("sqs", "messages", []action.Action{
+		{
+			Name:      "Delete Message",
+			Shortcut:  "D",
+			Type:      action.ActionTypeAPI,
+			Operation: "DeleteMessage",
+			Confirm:   action.ConfirmDangerous,
+		},
+	})
+
+	action.RegisterExecutor("sqs", "messages", executeMessageAction)
+}
+
+// executeMessageAction executes an action on a peeked SQS message
+func executeMessageAction(ctx context.Context, act action.Action, resource dao.Resource) action.ActionResult {
+	switch act.Operation {
+	case "DeleteMessage":
+		return executeDeleteMessage(ctx, resource)
+	default:
+		return action.UnknownOperationResult(act.Operation)
+	}
+}
+
+// executeDeleteMessage deletes the message with the receipt handle from the
+// peek. This fails once another consumer has received the message since.
+func executeDeleteMessage(ctx context.Context, resource dao.Resource) action.ActionResult {
+	m, ok := resource.(*MessageResource)
+	if !ok {
+		return action.InvalidResourceResult()
+	}
+
+	client, err := appsqs.GetClient(ctx)
+	if err != nil {
+		return action.FailResult(err)
+	}
+
+	_, err = client.DeleteMessage(ctx, &sqs.DeleteMessageInput{
+		QueueUrl:      &m.QueueURL,
+		ReceiptHandle: &m.ReceiptHandle,
+	})
+	if err != nil {
+		return action.FailResult(apperrors.Wrapf(err, "delete message %s", m.GetID()))
+	}
+	forgetMessage(m.QueueURL, m.GetID())
+
+	return action.SuccessResult(fmt.Sprintf("Deleted message %s from %s", m.GetID(), m.QueueName()))
+}
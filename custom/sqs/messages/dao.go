@@ -0,0 +1,245 @@
+package messages
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/sqs"
+	"github.com/aws/aws-sdk-go-v2/service/sqs/types"
+
+	"github.com/clawscli/claws/internal/action"
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/config"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+)
+
+// FilterQueueURL is the filter holding the queue URL.
+const FilterQueueURL = "QueueUrl"
+
+const (
+	// peekVisibilityTimeout hides peeked messages only briefly, so that
+	// consumers see them again soon. It must outlast one List, or the same
+	// messages would be received twice.
+	peekVisibilityTimeout = 10
+
+	// maxPeekMessages bounds the messages received per List.
+	maxPeekMessages = 50
+
+	// maxPeekCalls bounds the ReceiveMessage calls per List.
+	maxPeekCalls = 10
+
+	// peekCacheTTL is how long a peek is reused, counted from the first
+	// receive. Reloads within it show the same messages instead of receiving
+	// them again. It is shorter than peekVisibilityTimeout so that cached
+	// receipt handles are still valid for Delete Message.
+	peekCacheTTL = peekVisibilityTimeout * time.Second / 2
+)
+
+type peek struct {
+	resources []dao.Resource
+	expires   time.Time
+}
+
+// peeks holds the last peek of each queue, keyed by queue URL.
+var peeks = struct {
+	sync.Mutex
+	byQueue map[string]peek
+}{byQueue: map[string]peek{}}
+
+// cachedPeek returns the last peek of the queue if it has not expired.
+func cachedPeek(queueURL string) ([]dao.Resource, bool) {
+	peeks.Lock()
+	defer peeks.Unlock()
+	p, ok := peeks.byQueue[queueURL]
+	if !ok || time.Now().After(p.expires) {
+		delete(peeks.byQueue, queueURL)
+		return nil, false
+	}
+	return append([]dao.Resource(nil), p.resources...), true
+}
+
+// storePeek caches the messages received from the queue since received.
+func storePeek(queueURL string, resources []dao.Resource, received time.Time) {
+	peeks.Lock()
+	defer peeks.Unlock()
+	peeks.byQueue[queueURL] = peek{resources: resources, expires: received.Add(peekCacheTTL)}
+}
+
+// forgetMessage drops a deleted message from the last peek of its queue.
+func forgetMessage(queueURL, id string) {
+	peeks.Lock()
+	defer peeks.Unlock()
+	p, ok := peeks.byQueue[queueURL]
+	if !ok {
+		return
+	}
+	kept := make([]dao.Resource, 0, len(p.resources))
+	for _, r := range p.resources {
+		if r.GetID() != id {
+			kept = append(kept, r)
+		}
+	}
+	p.resources = kept
+	peeks.byQueue[queueURL] = p
+}
+
+// MessageDAO peeks at the messages of an SQS queue. Messages are received
+// but not deleted; they become visible again after a short timeout.
+type MessageDAO struct {
+	dao.BaseDAO
+	client *sqs.Client
+}
+
+// NewMessageDAO creates a new MessageDAO
+func NewMessageDAO(ctx context.Context) (dao.DAO, error) {
+	cfg, err := appaws.NewConfig(ctx)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new sqs/messages dao")
+	}
+	return &MessageDAO{
+		BaseDAO: dao.NewBaseDAO("sqs", "messages"),
+		client:  sqs.NewFromConfig(cfg),
+	}, nil
+}
+
+// List receives up to maxPeekMessages messages, oldest first. Receiving
+// increments each message's receive count, which counts toward the queue's
+// redrive policy, so peeks are reused for peekCacheTTL and denied in
+// read-only mode.
+func (d *MessageDAO) List(ctx context.Context) ([]dao.Resource, error) {
+	queueURL := dao.GetFilterFromContext(ctx, FilterQueueURL)
+	if queueURL == "" {
+		return nil, fmt.Errorf("%s required: navigate from sqs/queues using 'v' key", FilterQueueURL)
+	}
+	if config.Global().ReadOnly() {
+		return nil, fmt.Errorf("peek messages: %w", action.ErrReadOnlyDenied)
+	}
+	if cached, ok := cachedPeek(queueURL); ok {
+		return cached, nil
+	}
+
+	received := time.Now()
+	seen := map[string]bool{}
+	var resources []dao.Resource
+	for range maxPeekCalls {
+		output, err := d.client.ReceiveMessage(ctx, &sqs.ReceiveMessageInput{
+			QueueUrl:                    &queueURL,
+			MaxNumberOfMessages:         int32(min(10, maxPeekMessages-len(resources))),
+			VisibilityTimeout:           peekVisibilityTimeout,
+			WaitTimeSeconds:             1,
+			MessageSystemAttributeNames: []types.MessageSystemAttributeName{types.MessageSystemAttributeNameAll},
+			MessageAttributeNames:       []string{"All"},
+		})
+		if err != nil {
+			return nil, apperrors.Wrapf(err, "receive messages from %s", appaws.ExtractResourceName(queueURL))
+		}
+		if len(output.Messages) == 0 {
+			break
+		}
+		for _, m := range output.Messages {
+			id := appaws.Str(m.MessageId)
+			if seen[id] {
+				continue
+			}
+			seen[id] = true
+			resources = append(resources, NewMessageResource(queueURL, m))
+		}
+		if len(resources) >= maxPeekMessages {
+			break
+		}
+	}
+
+	sort.SliceStable(resources, func(i, j int) bool {
+		return resources[i].(*MessageResource).SentTime().Before(resources[j].(*MessageResource).SentTime())
+	})
+	storePeek(queueURL, resources, received)
+	return resources, nil
+}
+
+func (d *MessageDAO) Get(ctx context.Context, id string) (dao.Resource, error) {
+	return nil, fmt.Errorf("get by ID not supported for messages")
+}
+
+func (d *MessageDAO) Delete(ctx context.Context, id string) error {
+	return fmt.Errorf("delete not supported for messages: use the Delete Message action")
+}
+
+func (d *MessageDAO) Supports(op dao.Operation) bool {
+	return op == dao.OpList
+}
+
+// MessageResource wraps a peeked SQS message
+type MessageResource struct {
+	dao.BaseResource
+	QueueURL          string
+	Body              string
+	ReceiptHandle     string
+	Attributes        map[string]string
+	MessageAttributes map[string]types.MessageAttributeValue
+}
+
+// NewMessageResource creates a new MessageResource
+func NewMessageResource(queueURL string, m types.Message) *MessageResource {
+	id := appaws.Str(m.MessageId)
+	return &MessageResource{
+		BaseResource: dao.BaseResource{
+			ID:   id,
+			Name: id,
+			Data: m,
+		},
+		QueueURL:          queueURL,
+		Body:              appaws.Str(m.Body),
+		ReceiptHandle:     appaws.Str(m.ReceiptHandle),
+		Attributes:        m.Attributes,
+		MessageAttributes: m.MessageAttributes,
+	}
+}
+
+// QueueName returns the name of the message's queue
+func (r *MessageResource) QueueName() string {
+	return appaws.ExtractResourceName(r.QueueURL)
+}
+
+// SentTime returns when the message was sent
+func (r *MessageResource) SentTime() time.Time {
+	return r.timestamp("SentTimestamp")
+}
+
+// FirstReceiveTime returns when the message was first received
+func (r *MessageResource) FirstReceiveTime() time.Time {
+	return r.timestamp("ApproximateFirstReceiveTimestamp")
+}
+
+// ReceiveCount returns how often the message was received, including by
+// the peek that listed it
+func (r *MessageResource) ReceiveCount() int {
+	n, _ := strconv.Atoi(r.Attributes["ApproximateReceiveCount"])
+	return n
+}
+
+// GroupID returns the FIFO message group ID
+func (r *MessageResource) GroupID() string {
+	return r.Attributes["MessageGroupId"]
+}
+
+// timestamp parses an attribute holding epoch milliseconds
+func (r *MessageResource) timestamp(name string) time.Time {
+	ms, err := strconv.ParseInt(r.Attributes[name], 10, 64)
+	if err != nil {
+		return time.Time{}
+	}
+	return time.UnixMilli(ms)
+}
+
+// Age returns the time since the message was sent
+func (r *MessageResource) Age() time.Duration {
+	if t := r.SentTime(); !t.IsZero() {
+		return time.Since(t)
+	}
+	return 0
+}
@@ -0,0 +1,156 @@
+package messages
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/render"
+)
+
+// MessageRenderer renders peeked SQS messages
+type MessageRenderer struct {
+	render.BaseRenderer
+}
+
+// NewMessageRenderer creates a new MessageRenderer
+func NewMessageRenderer() render.Renderer {
+	return &MessageRenderer{
+		BaseRenderer: render.BaseRenderer{
+			Service:  "sqs",
+			Resource: "messages",
+			Cols: []render.Column{
+				{Name: "MESSAGE ID", Width: 38, Getter: func(r dao.Resource) string { return r.GetID() }, Priority: 0},
+				{Name: "SENT", Width: 18, Getter: getSent, Priority: 1},
+				{Name: "RECEIVES", Width: 9, Getter: getReceives, Priority: 2},
+				{Name: "GROUP", Width: 16, Getter: getGroup, Priority: 4},
+				{Name: "BODY", Width: 60, Getter: getBody, Priority: 3},
+			},
+		},
+	}
+}
+
+func getSent(r dao.Resource) string {
+	if m, ok := r.(*MessageResource); ok && !m.SentTime().IsZero() {
+		return m.SentTime().Format("2006-01-02 15:04")
+	}
+	return ""
+}
+
+func getReceives(r dao.Resource) string {
+	if m, ok := r.(*MessageResource); ok {
+		return fmt.Sprintf("%d", m.ReceiveCount())
+	}
+	return ""
+}
+
+func getGroup(r dao.Resource) string {
+	if m, ok := r.(*MessageResource); ok {
+		return m.GroupID()
+	}
+	return ""
+}
+
+func getBody(r dao.Resource) string {
+	if m, ok := r.(*MessageResource); ok {
+		return strings.Join(strings.Fields(m.Body), " ")
+	}
+	return ""
+}
+
+// RenderDetail renders the message body and attributes
+func (r *MessageRenderer) RenderDetail(resource dao.Resource) string {
+	m, ok := resource.(*MessageResource)
+	if !ok {
+		return ""
+	}
+
+	d := render.NewDetailBuilder()
+
+	d.Title("SQS Message", m.GetID())
+
+	d.Section("Basic Information")
+	d.Field("Message ID", m.GetID())
+	d.Field("Queue", m.QueueName())
+	d.Field("Receive Count", fmt.Sprintf("%d (including this peek)", m.ReceiveCount()))
+	if group := m.GroupID(); group != "" {
+		d.Field("Message Group ID", group)
+	}
+	if dedup := m.Attributes["MessageDeduplicationId"]; dedup != "" {
+		d.Field("Deduplication ID", dedup)
+	}
+	if seq := m.Attributes["SequenceNumber"]; seq != "" {
+		d.Field("Sequence Number", seq)
+	}
+	if sender := m.Attributes["SenderId"]; sender != "" {
+		d.Field("Sender ID", sender)
+	}
+	d.Field("Body Size", render.FormatSize(int64(len(m.Body))))
+
+	d.Section("Timestamps")
+	if t := m.SentTime(); !t.IsZero() {
+		d.Field("Sent", t.Format("2006-01-02 15:04:05"))
+		d.Field("Age", render.FormatAge(t))
+	}
+	if t := m.FirstReceiveTime(); !t.IsZero() {
+		d.Field("First Received", t.Format("2006-01-02 15:04:05"))
+	}
+
+	d.Section("Body")
+	d.Line(prettyJSON(m.Body))
+
+	if len(m.MessageAttributes) > 0 {
+		d.Section("Message Attributes")
+		names := make([]string, 0, len(m.MessageAttributes))
+		for name := range m.MessageAttributes {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			attr := m.MessageAttributes[name]
+			value := ""
+			switch {
+			case attr.StringValue != nil:
+				value = *attr.StringValue
+			case attr.BinaryValue != nil:
+				value = fmt.Sprintf("<%d bytes>", len(attr.BinaryValue))
+			}
+			if attr.DataType != nil {
+				value += " (" + *attr.DataType + ")"
+			}
+			d.Field(name, value)
+		}
+	}
+
+	return d.String()
+}
+
+// prettyJSON formats JSON string with indentation
+func prettyJSON(s string) string {
+	var buf bytes.Buffer
+	if err := json.Indent(&buf, []byte(s), "", "  "); err != nil {
+		return s
+	}
+	return buf.String()
+}
+
+// RenderSummary returns summary fields for the header panel
+func (r *MessageRenderer) RenderSummary(resource dao.Resource) []render.SummaryField {
+	m, ok := resource.(*MessageResource)
+	if !ok {
+		return r.BaseRenderer.RenderSummary(resource)
+	}
+
+	fields := []render.SummaryField{
+		{Label: "Message ID", Value: m.GetID()},
+		{Label: "Queue", Value: m.QueueName()},
+		{Label: "Receive Count", Value: fmt.Sprintf("%d", m.ReceiveCount())},
+	}
+	if t := m.SentTime(); !t.IsZero() {
+		fields = append(fields, render.SummaryField{Label: "Sent", Value: t.Format("2006-01-02 15:04:05")})
+	}
+	return fields
+}
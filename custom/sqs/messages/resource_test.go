@@ -0,0 +1,90 @@
+package messages
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sqs/types"
+
+	"github.com/clawscli/claws/internal/action"
+	"github.com/clawscli/claws/internal/config"
+	"github.com/clawscli/claws/internal/dao"
+)
+
+func TestNewMessageResource(t *testing.T) {
+	sent := time.Date(2024, 1, 15, 10, 30, 0, 0, time.UTC)
+	m := NewMessageResource("https://sqs.us-east-1.amazonaws.com/123456789012/orders.fifo", types.Message{
+		MessageId:     aws.String("m-1"),
+		Body:          aws.String(`{"order": 42}`),
+		ReceiptHandle: aws.String("handle"),
+		Attributes: map[string]string{
+			"SentTimestamp":           "1705314600000",
+			"ApproximateReceiveCount": "3",
+			"MessageGroupId":          "g1",
+		},
+		MessageAttributes: map[string]types.MessageAttributeValue{
+			"source": {DataType: aws.String("String"), StringValue: aws.String("web")},
+		},
+	})
+
+	if m.GetID() != "m-1" || m.QueueName() != "orders.fifo" || m.ReceiptHandle != "handle" {
+		t.Errorf("id = %q, queue = %q, handle = %q", m.GetID(), m.QueueName(), m.ReceiptHandle)
+	}
+	if !m.SentTime().Equal(sent) {
+		t.Errorf("SentTime() = %v, want %v", m.SentTime(), sent)
+	}
+	if m.ReceiveCount() != 3 || m.GroupID() != "g1" {
+		t.Errorf("ReceiveCount() = %d, GroupID() = %q", m.ReceiveCount(), m.GroupID())
+	}
+	if !m.FirstReceiveTime().IsZero() {
+		t.Error("FirstReceiveTime() should be zero without the attribute")
+	}
+
+	detail := NewMessageRenderer().RenderDetail(m)
+	for _, want := range []string{`"order": 42`, "source", "web (String)"} {
+		if !strings.Contains(detail, want) {
+			t.Errorf("detail missing %q:\n%s", want, detail)
+		}
+	}
+}
+
+func TestPeekCache(t *testing.T) {
+	queueURL := "https://sqs.us-east-1.amazonaws.com/123456789012/peek-cache"
+	d := &MessageDAO{}
+	ctx := dao.WithFilter(context.Background(), FilterQueueURL, queueURL)
+
+	storePeek(queueURL, []dao.Resource{
+		NewMessageResource(queueURL, types.Message{MessageId: aws.String("m-1")}),
+		NewMessageResource(queueURL, types.Message{MessageId: aws.String("m-2")}),
+	}, time.Now())
+	got, err := d.List(ctx)
+	if err != nil || len(got) != 2 {
+		t.Fatalf("List() should reuse the peek: %d messages, err = %v", len(got), err)
+	}
+
+	forgetMessage(queueURL, "m-1")
+	got, _ = d.List(ctx)
+	if len(got) != 1 || got[0].GetID() != "m-2" {
+		t.Errorf("deleted message should be dropped from the peek, got %d messages", len(got))
+	}
+
+	// A peek expires before its messages become visible again, so cached
+	// receipt handles can still delete them
+	if peekCacheTTL >= peekVisibilityTimeout*time.Second {
+		t.Errorf("peekCacheTTL = %v, want less than the %ds visibility timeout", peekCacheTTL, peekVisibilityTimeout)
+	}
+	storePeek(queueURL, got, time.Now().Add(-peekCacheTTL-time.Second))
+	if _, ok := cachedPeek(queueURL); ok {
+		t.Error("an expired peek should not be reused")
+	}
+
+	config.Global().SetReadOnly(true)
+	defer config.Global().SetReadOnly(false)
+	if _, err := d.List(ctx); !errors.Is(err, action.ErrReadOnlyDenied) {
+		t.Errorf("List() in read-only mode: err = %v", err)
+	}
+}
@@ -0,0 +1,62 @@
+package movetasks
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/sqs"
+
+	appsqs "github.com/clawscli/claws/custom/sqs"
+	"github.com/clawscli/claws/internal/action"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+)
+
+func init() {
+	action.Global.Register("sqs", "move-tasks", []action.Action{
+		{
+			Name:      "Cancel",
+			Shortcut:  "c",
+			Type:      action.ActionTypeAPI,
+			Operation: "CancelMessageMoveTask",
+			Confirm:   action.ConfirmSimple,
+			Filter: func(r dao.Resource) bool {
+				t, ok := r.(*MoveTaskResource)
+				return ok && t.Status() == StatusRunning
+			},
+		},
+	})
+
+	action.RegisterExecutor("sqs", "move-tasks", executeMoveTaskAction)
+}
+
+// executeMoveTaskAction executes an action on a message move task
+func executeMoveTaskAction(ctx context.Context, act action.Action, resource dao.Resource) action.ActionResult {
+	switch act.Operation {
+	case "CancelMessageMoveTask":
+		return executeCancelMoveTask(ctx, resource)
+	default:
+		return action.UnknownOperationResult(act.Operation)
+	}
+}
+
+func executeCancelMoveTask(ctx context.Context, resource dao.Resource) action.ActionResult {
+	t, ok := resource.(*MoveTaskResource)
+	if !ok || t.Item.TaskHandle == nil {
+		return action.InvalidResourceResult()
+	}
+
+	client, err := appsqs.GetClient(ctx)
+	if err != nil {
+		return action.FailResult(err)
+	}
+
+	output, err := client.CancelMessageMoveTask(ctx, &sqs.CancelMessageMoveTaskInput{
+		TaskHandle: t.Item.TaskHandle,
+	})
+	if err != nil {
+		return action.FailResult(apperrors.Wrap(err, "cancel message move task"))
+	}
+
+	return action.SuccessResult(fmt.Sprintf("Cancelling redrive (%d messages moved)", output.ApproximateNumberOfMessagesMoved))
+}
@@ -0,0 +1,138 @@
+package movetasks
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/sqs"
+	"github.com/aws/aws-sdk-go-v2/service/sqs/types"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+)
+
+// FilterQueueArn is the filter holding the source (dead-letter) queue ARN.
+const FilterQueueArn = "QueueArn"
+
+// Task statuses
+const (
+	StatusRunning    = "RUNNING"
+	StatusCompleted  = "COMPLETED"
+	StatusCancelling = "CANCELLING"
+	StatusCancelled  = "CANCELLED"
+	StatusFailed     = "FAILED"
+)
+
+// MoveTaskDAO provides data access for the message move (redrive) tasks of
+// a dead-letter queue
+type MoveTaskDAO struct {
+	dao.BaseDAO
+	client *sqs.Client
+}
+
+// NewMoveTaskDAO creates a new MoveTaskDAO
+func NewMoveTaskDAO(ctx context.Context) (dao.DAO, error) {
+	cfg, err := appaws.NewConfig(ctx)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new sqs/move-tasks dao")
+	}
+	return &MoveTaskDAO{
+		BaseDAO: dao.NewBaseDAO("sqs", "move-tasks"),
+		client:  sqs.NewFromConfig(cfg),
+	}, nil
+}
+
+// List returns the most recent move tasks, newest first. The API keeps
+// the last 10.
+func (d *MoveTaskDAO) List(ctx context.Context) ([]dao.Resource, error) {
+	queueArn := dao.GetFilterFromContext(ctx, FilterQueueArn)
+	if queueArn == "" {
+		return nil, fmt.Errorf("%s required: navigate from sqs/queues using 'r' key", FilterQueueArn)
+	}
+
+	output, err := d.client.ListMessageMoveTasks(ctx, &sqs.ListMessageMoveTasksInput{
+		SourceArn:  &queueArn,
+		MaxResults: appaws.Int32Ptr(10),
+	})
+	if err != nil {
+		return nil, apperrors.Wrapf(err, "list message move tasks for %s", queueArn)
+	}
+
+	resources := make([]dao.Resource, 0, len(output.Results))
+	for _, task := range output.Results {
+		resources = append(resources, NewMoveTaskResource(task))
+	}
+	return resources, nil
+}
+
+func (d *MoveTaskDAO) Get(ctx context.Context, id string) (dao.Resource, error) {
+	return nil, fmt.Errorf("get by ID not supported for move tasks")
+}
+
+func (d *MoveTaskDAO) Delete(ctx context.Context, id string) error {
+	return fmt.Errorf("delete not supported for move tasks: use the Cancel action")
+}
+
+func (d *MoveTaskDAO) Supports(op dao.Operation) bool {
+	return op == dao.OpList
+}
+
+// MoveTaskResource wraps a message move task
+type MoveTaskResource struct {
+	dao.BaseResource
+	Item types.ListMessageMoveTasksResultEntry
+}
+
+// NewMoveTaskResource creates a new MoveTaskResource. Only running tasks
+// have a task handle, so tasks are identified by their start time.
+func NewMoveTaskResource(task types.ListMessageMoveTasksResultEntry) *MoveTaskResource {
+	id := strconv.FormatInt(task.StartedTimestamp, 10)
+	return &MoveTaskResource{
+		BaseResource: dao.BaseResource{
+			ID:   id,
+			Name: id,
+			ARN:  appaws.Str(task.SourceArn),
+			Data: task,
+		},
+		Item: task,
+	}
+}
+
+// Status returns the task status
+func (r *MoveTaskResource) Status() string {
+	return appaws.Str(r.Item.Status)
+}
+
+// StartedTime returns when the task started
+func (r *MoveTaskResource) StartedTime() time.Time {
+	if r.Item.StartedTimestamp == 0 {
+		return time.Time{}
+	}
+	return time.UnixMilli(r.Item.StartedTimestamp)
+}
+
+// Destination returns the destination queue ARN, or "" when messages go
+// back to their source queues
+func (r *MoveTaskResource) Destination() string {
+	return appaws.Str(r.Item.DestinationArn)
+}
+
+// Progress returns moved messages out of the total to move, such as "40/100"
+func (r *MoveTaskResource) Progress() string {
+	moved := r.Item.ApproximateNumberOfMessagesMoved
+	if r.Item.ApproximateNumberOfMessagesToMove == nil {
+		return strconv.FormatInt(moved, 10)
+	}
+	return fmt.Sprintf("%d/%d", moved, *r.Item.ApproximateNumberOfMessagesToMove)
+}
+
+// Age returns the time since the task started
+func (r *MoveTaskResource) Age() time.Duration {
+	if t := r.StartedTime(); !t.IsZero() {
+		return time.Since(t)
+	}
+	return 0
+}
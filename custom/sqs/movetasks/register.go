@@ -0,0 +1,20 @@
+package movetasks
+
+import (
+	"context"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/registry"
+	"github.com/clawscli/claws/internal/render"
+)
+
+func init() {
+	registry.Global.RegisterCustom("sqs", "move-tasks", registry.Entry{
+		DAOFactory: func(ctx context.Context) (dao.DAO, error) {
+			return NewMoveTaskDAO(ctx)
+		},
+		RendererFactory: func() render.Renderer {
+			return NewMoveTaskRenderer()
+		},
+	})
+}
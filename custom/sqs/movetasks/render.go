@@ -0,0 +1,129 @@
+package movetasks
+
+import (
+	"fmt"
+	"strings"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/render"
+)
+
+// MoveTaskRenderer renders SQS message move tasks
+type MoveTaskRenderer struct {
+	render.BaseRenderer
+}
+
+// NewMoveTaskRenderer creates a new MoveTaskRenderer
+func NewMoveTaskRenderer() render.Renderer {
+	return &MoveTaskRenderer{
+		BaseRenderer: render.BaseRenderer{
+			Service:  "sqs",
+			Resource: "move-tasks",
+			Cols: []render.Column{
+				{Name: "STARTED", Width: 20, Getter: getStarted, Priority: 0},
+				{Name: "STATUS", Width: 12, Getter: getStatus, Priority: 1},
+				{Name: "MOVED", Width: 14, Getter: getProgress, Priority: 2},
+				{Name: "DESTINATION", Width: 30, Getter: getDestination, Priority: 3},
+				{Name: "RATE", Width: 8, Getter: getRate, Priority: 4},
+			},
+		},
+	}
+}
+
+func getStarted(r dao.Resource) string {
+	if t, ok := r.(*MoveTaskResource); ok && !t.StartedTime().IsZero() {
+		return t.StartedTime().Format("2006-01-02 15:04:05")
+	}
+	return ""
+}
+
+func getStatus(r dao.Resource) string {
+	if t, ok := r.(*MoveTaskResource); ok {
+		return t.Status()
+	}
+	return ""
+}
+
+func getProgress(r dao.Resource) string {
+	if t, ok := r.(*MoveTaskResource); ok {
+		return t.Progress()
+	}
+	return ""
+}
+
+func getDestination(r dao.Resource) string {
+	if t, ok := r.(*MoveTaskResource); ok {
+		return destinationName(t.Destination())
+	}
+	return ""
+}
+
+func getRate(r dao.Resource) string {
+	if t, ok := r.(*MoveTaskResource); ok && t.Item.MaxNumberOfMessagesPerSecond != nil {
+		return fmt.Sprintf("%d/s", *t.Item.MaxNumberOfMessagesPerSecond)
+	}
+	return ""
+}
+
+// destinationName returns the queue name from a destination ARN
+func destinationName(arn string) string {
+	if arn == "" {
+		return "(source queues)"
+	}
+	return arn[strings.LastIndex(arn, ":")+1:]
+}
+
+// RenderDetail renders detailed move task information
+func (r *MoveTaskRenderer) RenderDetail(resource dao.Resource) string {
+	t, ok := resource.(*MoveTaskResource)
+	if !ok {
+		return ""
+	}
+
+	d := render.NewDetailBuilder()
+
+	d.Title("SQS Message Move Task", t.GetID())
+
+	d.Section("Basic Information")
+	d.Field("Status", t.Status())
+	d.Field("Source Queue", appaws.Str(t.Item.SourceArn))
+	if dest := t.Destination(); dest != "" {
+		d.Field("Destination Queue", dest)
+	} else {
+		d.Field("Destination Queue", "Original source queues")
+	}
+	d.Field("Messages Moved", t.Progress())
+	if t.Item.MaxNumberOfMessagesPerSecond != nil {
+		d.Field("Max Rate", fmt.Sprintf("%d messages/s", *t.Item.MaxNumberOfMessagesPerSecond))
+	}
+	if handle := appaws.Str(t.Item.TaskHandle); handle != "" {
+		d.Field("Task Handle", handle)
+	}
+	if reason := appaws.Str(t.Item.FailureReason); reason != "" {
+		d.Field("Failure Reason", reason)
+	}
+
+	if started := t.StartedTime(); !started.IsZero() {
+		d.Section("Timestamps")
+		d.Field("Started", started.Format("2006-01-02 15:04:05"))
+		d.Field("Age", render.FormatAge(started))
+	}
+
+	return d.String()
+}
+
+// RenderSummary returns summary fields for the header panel
+func (r *MoveTaskRenderer) RenderSummary(resource dao.Resource) []render.SummaryField {
+	t, ok := resource.(*MoveTaskResource)
+	if !ok {
+		return r.BaseRenderer.RenderSummary(resource)
+	}
+
+	return []render.SummaryField{
+		{Label: "Status", Value: t.Status()},
+		{Label: "Moved", Value: t.Progress()},
+		{Label: "Destination", Value: destinationName(t.Destination())},
+		{Label: "Started", Value: getStarted(t)},
+	}
+}
@@ -0,0 +1,59 @@
+package movetasks
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sqs/types"
+
+	"github.com/clawscli/claws/internal/action"
+)
+
+func TestNewMoveTaskResource(t *testing.T) {
+	task := NewMoveTaskResource(types.ListMessageMoveTasksResultEntry{
+		SourceArn:                         aws.String("arn:aws:sqs:us-east-1:123456789012:my-dlq"),
+		Status:                            aws.String(StatusRunning),
+		StartedTimestamp:                  1705314600000,
+		ApproximateNumberOfMessagesMoved:  40,
+		ApproximateNumberOfMessagesToMove: aws.Int64(100),
+		TaskHandle:                        aws.String("handle"),
+	})
+
+	if task.GetID() != "1705314600000" || task.Status() != StatusRunning {
+		t.Errorf("id = %q, status = %q", task.GetID(), task.Status())
+	}
+	if got := task.Progress(); got != "40/100" {
+		t.Errorf("Progress() = %q, want 40/100", got)
+	}
+	if got := getDestination(task); got != "(source queues)" {
+		t.Errorf("destination = %q", got)
+	}
+
+	done := NewMoveTaskResource(types.ListMessageMoveTasksResultEntry{
+		Status:                           aws.String(StatusCompleted),
+		DestinationArn:                   aws.String("arn:aws:sqs:us-east-1:123456789012:main"),
+		ApproximateNumberOfMessagesMoved: 7,
+	})
+	if got := done.Progress(); got != "7" {
+		t.Errorf("Progress() = %q, want 7", got)
+	}
+	if got := getDestination(done); got != "main" {
+		t.Errorf("destination = %q, want main", got)
+	}
+}
+
+func TestMoveTaskActions_CancelOnlyRunning(t *testing.T) {
+	running := NewMoveTaskResource(types.ListMessageMoveTasksResultEntry{Status: aws.String(StatusRunning)})
+	done := NewMoveTaskResource(types.ListMessageMoveTasksResultEntry{Status: aws.String(StatusCompleted)})
+
+	for _, act := range action.Global.Get("sqs", "move-tasks") {
+		if act.Operation != "CancelMessageMoveTask" {
+			continue
+		}
+		if !act.Filter(running) || act.Filter(done) {
+			t.Error("Cancel should only apply to running tasks")
+		}
+		return
+	}
+	t.Fatal("Cancel action not registered")
+}
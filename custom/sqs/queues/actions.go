@@ -28,6 +28,39 @@ func init() {
 			Type:      action.ActionTypeAPI,
 			Operation: "SendTestMessage",
 			Confirm:   action.ConfirmSimple,
+			Input: []action.Field{
+				{
+					Name:     "body",
+					Label:    "Message body",
+					Type:     action.FieldString,
+					Required: true,
+					Default:  func(dao.Resource) string { return testMessageBody },
+				},
+				{
+					Name:     "group",
+					Label:    "Message group ID",
+					Type:     action.FieldString,
+					Required: true,
+					Default:  func(dao.Resource) string { return testMessageGroup },
+					Filter:   isFIFOQueue,
+				},
+				{
+					Name:   "delay",
+					Label:  "Delay",
+					Type:   action.FieldDuration,
+					Help:   "Up to 15m; empty for the queue's default",
+					Min:    action.Bound(0),
+					Max:    action.Bound(900),
+					Filter: func(r dao.Resource) bool { return !isFIFOQueue(r) },
+				},
+			},
+		},
+		{
+			Name:      "Start DLQ Redrive",
+			Shortcut:  "R",
+			Type:      action.ActionTypeAPI,
+			Operation: "StartMessageMoveTask",
+			Confirm:   action.ConfirmSimple,
 		},
 		{
 			Name:      "Delete",
@@ -48,7 +81,9 @@ func executeQueueAction(ctx context.Context, act action.Action, resource dao.Res
 	case "PurgeQueue":
 		return executePurgeQueue(ctx, resource)
 	case "SendTestMessage":
-		return executeSendTestMessage(ctx, resource)
+		return executeSendTestMessage(ctx, resource, act.Values)
+	case "StartMessageMoveTask":
+		return executeStartMessageMoveTask(ctx, resource)
 	case "DeleteQueue":
 		return executeDeleteQueue(ctx, resource)
 	default:
@@ -93,7 +128,20 @@ func executePurgeQueue(ctx context.Context, resource dao.Resource) action.Action
 	}
 }
 
-func executeSendTestMessage(ctx context.Context, resource dao.Resource) action.ActionResult {
+// Defaults of the Send Test Message input
+const (
+	testMessageBody  = `{"test": true, "source": "claws"}`
+	testMessageGroup = "claws-test"
+)
+
+// isFIFOQueue reports whether resource is a FIFO queue, which needs a
+// message group ID and takes no per-message delay
+func isFIFOQueue(resource dao.Resource) bool {
+	queue, ok := resource.(*QueueResource)
+	return ok && queue.IsFIFO()
+}
+
+func executeSendTestMessage(ctx context.Context, resource dao.Resource, values action.Values) action.ActionResult {
 	queue, ok := resource.(*QueueResource)
 	if !ok {
 		return action.InvalidResourceResult()
@@ -106,7 +154,7 @@ func executeSendTestMessage(ctx context.Context, resource dao.Resource) action.A
 
 	queueUrl := queue.URL
 	queueName := queue.GetName()
-	messageBody := `{"test": true, "source": "claws"}`
+	messageBody := values.String("body")
 
 	input := &sqs.SendMessageInput{
 		QueueUrl:    &queueUrl,
@@ -115,10 +163,16 @@ func executeSendTestMessage(ctx context.Context, resource dao.Resource) action.A
 
 	// Add message group ID for FIFO queues
 	if queue.IsFIFO() {
-		groupId := "claws-test"
+		groupId := values.String("group")
 		dedupId := fmt.Sprintf("claws-test-%d", time.Now().UnixNano())
 		input.MessageGroupId = &groupId
 		input.MessageDeduplicationId = &dedupId
+	} else if delay := values.String("delay"); delay != "" {
+		d, err := values.Duration("delay")
+		if err != nil {
+			return action.ActionResult{Success: false, Error: fmt.Errorf("parse delay: %w", err)}
+		}
+		input.DelaySeconds = int32(d / time.Second)
 	}
 
 	output, err := client.SendMessage(ctx, input)
@@ -132,6 +186,33 @@ func executeSendTestMessage(ctx context.Context, resource dao.Resource) action.A
 	}
 }
 
+// executeStartMessageMoveTask moves the messages of a dead-letter queue back
+// to the queues they came from
+func executeStartMessageMoveTask(ctx context.Context, resource dao.Resource) action.ActionResult {
+	queue, ok := resource.(*QueueResource)
+	if !ok {
+		return action.InvalidResourceResult()
+	}
+
+	client, err := getSQSClient(ctx)
+	if err != nil {
+		return action.ActionResult{Success: false, Error: err}
+	}
+
+	queueArn := queue.GetARN()
+	_, err = client.StartMessageMoveTask(ctx, &sqs.StartMessageMoveTaskInput{
+		SourceArn: &queueArn,
+	})
+	if err != nil {
+		return action.ActionResult{Success: false, Error: fmt.Errorf("start message move task: %w", err)}
+	}
+
+	return action.ActionResult{
+		Success: true,
+		Message: fmt.Sprintf("Started redrive from %s to its source queues (press r to follow progress)", queue.GetName()),
+	}
+}
+
 func executeDeleteQueue(ctx context.Context, resource dao.Resource) action.ActionResult {
 	queue, ok := resource.(*QueueResource)
 	if !ok {
@@ -2,6 +2,8 @@ package queues
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/service/sqs"
@@ -13,6 +15,9 @@ import (
 	"github.com/clawscli/claws/internal/log"
 )
 
+// FilterQueueArn limits the list to one queue, such as a dead-letter queue
+const FilterQueueArn = "QueueArn"
+
 // QueueDAO provides data access for SQS queues
 type QueueDAO struct {
 	dao.BaseDAO
@@ -32,6 +37,14 @@ func NewQueueDAO(ctx context.Context) (dao.DAO, error) {
 }
 
 func (d *QueueDAO) List(ctx context.Context) ([]dao.Resource, error) {
+	if queueArn := dao.GetFilterFromContext(ctx, FilterQueueArn); queueArn != "" {
+		queue, err := d.getByArn(ctx, queueArn)
+		if err != nil {
+			return nil, err
+		}
+		return []dao.Resource{queue}, nil
+	}
+
 	queueUrls, err := appaws.Paginate(ctx, func(token *string) ([]string, *string, error) {
 		output, err := d.client.ListQueues(ctx, &sqs.ListQueuesInput{
 			NextToken: token,
@@ -93,6 +106,22 @@ func (d *QueueDAO) Get(ctx context.Context, id string) (dao.Resource, error) {
 	return NewQueueResource(queueUrl, output.Attributes), nil
 }
 
+// getByArn returns the queue with ARN arn:aws:sqs:region:account:name
+func (d *QueueDAO) getByArn(ctx context.Context, queueArn string) (dao.Resource, error) {
+	parts := strings.Split(queueArn, ":")
+	if len(parts) != 6 {
+		return nil, fmt.Errorf("invalid queue ARN: %s", queueArn)
+	}
+	output, err := d.client.GetQueueUrl(ctx, &sqs.GetQueueUrlInput{
+		QueueName:              &parts[5],
+		QueueOwnerAWSAccountId: &parts[4],
+	})
+	if err != nil {
+		return nil, apperrors.Wrapf(err, "get queue URL for %s", queueArn)
+	}
+	return d.Get(ctx, appaws.Str(output.QueueUrl))
+}
+
 func (d *QueueDAO) Delete(ctx context.Context, id string) error {
 	queueUrl := id
 	if !strings.HasPrefix(id, "https://") {
@@ -248,3 +277,16 @@ func (r *QueueResource) DeadLetterTargetArn() string {
 	}
 	return ""
 }
+
+// RedriveTarget returns the dead-letter queue ARN and max receive count
+// from the redrive policy, or "" when the queue has no dead-letter queue.
+func (r *QueueResource) RedriveTarget() (string, int) {
+	var policy struct {
+		DeadLetterTargetArn string `json:"deadLetterTargetArn"`
+		MaxReceiveCount     int    `json:"maxReceiveCount"`
+	}
+	if err := json.Unmarshal([]byte(r.RedrivePolicy()), &policy); err != nil {
+		return "", 0
+	}
+	return policy.DeadLetterTargetArn, policy.MaxReceiveCount
+}
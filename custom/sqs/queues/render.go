@@ -7,10 +7,14 @@ import (
 	"strings"
 	"time"
 
+	"github.com/clawscli/claws/internal/config"
 	"github.com/clawscli/claws/internal/dao"
 	"github.com/clawscli/claws/internal/render"
 )
 
+// Ensure QueueRenderer implements render.Navigator
+var _ render.Navigator = (*QueueRenderer)(nil)
+
 // QueueRenderer renders SQS queues
 type QueueRenderer struct {
 	render.BaseRenderer
@@ -299,3 +303,44 @@ func (r *QueueRenderer) RenderSummary(resource dao.Resource) []render.SummaryFie
 
 	return fields
 }
+
+// Navigations returns navigation shortcuts for SQS queues. Peeking at
+// messages receives them, so it is not offered in read-only mode.
+func (r *QueueRenderer) Navigations(resource dao.Resource) []render.Navigation {
+	q, ok := resource.(*QueueResource)
+	if !ok {
+		return nil
+	}
+
+	var navs []render.Navigation
+	if !config.Global().ReadOnly() {
+		navs = append(navs, render.Navigation{
+			Key:         "v",
+			Label:       "Messages",
+			Service:     "sqs",
+			Resource:    "messages",
+			FilterField: "QueueUrl",
+			FilterValue: q.URL,
+		})
+	}
+	navs = append(navs, render.Navigation{
+		Key:         "r",
+		Label:       "Redrive Tasks",
+		Service:     "sqs",
+		Resource:    "move-tasks",
+		FilterField: "QueueArn",
+		FilterValue: q.GetARN(),
+		AutoReload:  true,
+	})
+	if dlq, _ := q.RedriveTarget(); dlq != "" {
+		navs = append(navs, render.Navigation{
+			Key:         "q",
+			Label:       "Dead-letter Queue",
+			Service:     "sqs",
+			Resource:    "queues",
+			FilterField: FilterQueueArn,
+			FilterValue: dlq,
+		})
+	}
+	return navs
+}
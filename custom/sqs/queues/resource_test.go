@@ -2,6 +2,8 @@ package queues
 
 import (
 	"testing"
+
+	"github.com/clawscli/claws/internal/config"
 )
 
 func TestNewQueueResource(t *testing.T) {
@@ -96,3 +98,47 @@ func TestQueueResource_MissingAttributes(t *testing.T) {
 		})
 	}
 }
+
+func TestQueueRenderer_Navigations(t *testing.T) {
+	queueUrl := "https://sqs.us-east-1.amazonaws.com/123456789012/my-queue"
+	withDLQ := NewQueueResource(queueUrl, map[string]string{
+		"QueueArn":      "arn:aws:sqs:us-east-1:123456789012:my-queue",
+		"RedrivePolicy": `{"deadLetterTargetArn":"arn:aws:sqs:us-east-1:123456789012:my-dlq","maxReceiveCount":3}`,
+	})
+
+	dlq, maxReceives := withDLQ.RedriveTarget()
+	if dlq != "arn:aws:sqs:us-east-1:123456789012:my-dlq" || maxReceives != 3 {
+		t.Errorf("RedriveTarget() = %q, %d", dlq, maxReceives)
+	}
+
+	r := NewQueueRenderer().(*QueueRenderer)
+	navs := r.Navigations(withDLQ)
+	keys := map[string]string{}
+	for _, nav := range navs {
+		keys[nav.Key] = nav.Resource + " " + nav.FilterValue
+	}
+	if keys["v"] != "messages "+queueUrl {
+		t.Errorf("v navigation = %q", keys["v"])
+	}
+	if keys["q"] != "queues "+dlq {
+		t.Errorf("q navigation = %q", keys["q"])
+	}
+
+	plain := NewQueueResource(queueUrl, map[string]string{})
+	if dlq, _ := plain.RedriveTarget(); dlq != "" {
+		t.Errorf("RedriveTarget() without policy = %q", dlq)
+	}
+	for _, nav := range r.Navigations(plain) {
+		if nav.Key == "q" {
+			t.Error("queue without redrive policy should have no DLQ navigation")
+		}
+	}
+
+	config.Global().SetReadOnly(true)
+	defer config.Global().SetReadOnly(false)
+	for _, nav := range r.Navigations(plain) {
+		if nav.Key == "v" {
+			t.Error("peeking at messages should not be offered in read-only mode")
+		}
+	}
+}
@@ -0,0 +1,16 @@
+package commands
+
+import (
+	"github.com/clawscli/claws/internal/action"
+)
+
+func init() {
+	action.Global.Register("ssm", "commands", []action.Action{
+		{
+			Name:     "View Output",
+			Shortcut: "o",
+			Type:     action.ActionTypeView,
+			Target:   action.TargetCommandOutput,
+		},
+	})
+}
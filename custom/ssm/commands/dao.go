@@ -0,0 +1,140 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	"github.com/aws/aws-sdk-go-v2/service/ssm/types"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+	"github.com/clawscli/claws/internal/runcmd"
+)
+
+// CommandDAO provides data access for SSM Run Command history
+type CommandDAO struct {
+	dao.BaseDAO
+	client *ssm.Client
+}
+
+// NewCommandDAO creates a new CommandDAO
+func NewCommandDAO(ctx context.Context) (dao.DAO, error) {
+	cfg, err := appaws.NewConfig(ctx)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new ssm/commands dao")
+	}
+	return &CommandDAO{
+		BaseDAO: dao.NewBaseDAO("ssm", "commands"),
+		client:  ssm.NewFromConfig(cfg),
+	}, nil
+}
+
+// List returns recent commands (first page only).
+// For paginated access, use ListPage instead.
+func (d *CommandDAO) List(ctx context.Context) ([]dao.Resource, error) {
+	resources, _, err := d.ListPage(ctx, 50, "")
+	return resources, err
+}
+
+// ListPage returns a page of commands sent in the last 30 days, newest
+// first.
+// Implements dao.PaginatedDAO interface.
+func (d *CommandDAO) ListPage(ctx context.Context, pageSize int, pageToken string) ([]dao.Resource, string, error) {
+	maxResults := int32(min(pageSize, 50)) // AWS API max
+
+	input := &ssm.ListCommandsInput{
+		MaxResults: &maxResults,
+	}
+	if pageToken != "" {
+		input.NextToken = &pageToken
+	}
+
+	output, err := d.client.ListCommands(ctx, input)
+	if err != nil {
+		return nil, "", apperrors.Wrap(err, "list commands")
+	}
+
+	resources := make([]dao.Resource, len(output.Commands))
+	for i, cmd := range output.Commands {
+		resources[i] = NewCommandResource(cmd)
+	}
+
+	return resources, appaws.Str(output.NextToken), nil
+}
+
+func (d *CommandDAO) Get(ctx context.Context, id string) (dao.Resource, error) {
+	output, err := d.client.ListCommands(ctx, &ssm.ListCommandsInput{CommandId: &id})
+	if err != nil {
+		return nil, apperrors.Wrapf(err, "get command %s", id)
+	}
+	if len(output.Commands) == 0 {
+		return nil, fmt.Errorf("command not found: %s", id)
+	}
+	return NewCommandResource(output.Commands[0]), nil
+}
+
+func (d *CommandDAO) Delete(ctx context.Context, id string) error {
+	return fmt.Errorf("delete not supported for commands")
+}
+
+func (d *CommandDAO) Supports(op dao.Operation) bool {
+	return op == dao.OpList || op == dao.OpGet
+}
+
+// CommandResource wraps an SSM Run Command
+type CommandResource struct {
+	dao.BaseResource
+	Item types.Command
+}
+
+// NewCommandResource creates a new CommandResource
+func NewCommandResource(cmd types.Command) *CommandResource {
+	return &CommandResource{
+		BaseResource: dao.BaseResource{
+			ID:   appaws.Str(cmd.CommandId),
+			Name: appaws.Str(cmd.DocumentName),
+			Data: cmd,
+		},
+		Item: cmd,
+	}
+}
+
+// Document returns the document name
+func (r *CommandResource) Document() string {
+	return appaws.Str(r.Item.DocumentName)
+}
+
+// Status returns the command status
+func (r *CommandResource) Status() string {
+	return string(r.Item.Status)
+}
+
+// Commands returns the "commands" parameter, one line per command
+func (r *CommandResource) Commands() []string {
+	return r.Item.Parameters["commands"]
+}
+
+// Targets describes what the command was sent to: instance IDs or tag
+// targets.
+func (r *CommandResource) Targets() string {
+	if len(r.Item.InstanceIds) > 0 {
+		return strings.Join(r.Item.InstanceIds, ", ")
+	}
+	var targets []string
+	for _, t := range r.Item.Targets {
+		targets = append(targets, appaws.Str(t.Key)+"="+strings.Join(t.Values, ","))
+	}
+	return strings.Join(targets, "; ")
+}
+
+// CommandTarget implements runcmd.TargetProvider
+func (r *CommandResource) CommandTarget() runcmd.Target {
+	return runcmd.Target{
+		CommandID:   r.GetID(),
+		Document:    r.Document(),
+		InstanceIDs: r.Item.InstanceIds,
+	}
+}
@@ -0,0 +1,152 @@
+package commands
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"charm.land/lipgloss/v2"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/render"
+)
+
+// CommandRenderer renders SSM Run Command history
+type CommandRenderer struct {
+	render.BaseRenderer
+}
+
+// NewCommandRenderer creates a new CommandRenderer
+func NewCommandRenderer() render.Renderer {
+	return &CommandRenderer{
+		BaseRenderer: render.BaseRenderer{
+			Service:  "ssm",
+			Resource: "commands",
+			Cols: []render.Column{
+				{Name: "COMMAND ID", Width: 38, Getter: func(r dao.Resource) string { return r.GetID() }},
+				{Name: "DOCUMENT", Width: 26, Getter: getDocument},
+				{Name: "STATUS", Width: 12, Getter: getStatus},
+				{Name: "TARGETS", Width: 8, Getter: countGetter(func(c *CommandResource) int32 { return c.Item.TargetCount })},
+				{Name: "DONE", Width: 6, Getter: countGetter(func(c *CommandResource) int32 { return c.Item.CompletedCount })},
+				{Name: "ERRORS", Width: 7, Getter: countGetter(func(c *CommandResource) int32 { return c.Item.ErrorCount })},
+				{Name: "COMMAND", Width: 40, Getter: getCommand},
+				{Name: "REQUESTED", Width: 10, Getter: getRequested},
+			},
+		},
+	}
+}
+
+func getDocument(r dao.Resource) string {
+	if cmd, ok := r.(*CommandResource); ok {
+		return cmd.Document()
+	}
+	return ""
+}
+
+func getStatus(r dao.Resource) string {
+	if cmd, ok := r.(*CommandResource); ok {
+		return cmd.Status()
+	}
+	return ""
+}
+
+func countGetter(count func(*CommandResource) int32) func(dao.Resource) string {
+	return func(r dao.Resource) string {
+		if cmd, ok := r.(*CommandResource); ok {
+			return fmt.Sprintf("%d", count(cmd))
+		}
+		return ""
+	}
+}
+
+func getCommand(r dao.Resource) string {
+	if cmd, ok := r.(*CommandResource); ok {
+		return strings.Join(cmd.Commands(), "; ")
+	}
+	return ""
+}
+
+func getRequested(r dao.Resource) string {
+	if cmd, ok := r.(*CommandResource); ok && cmd.Item.RequestedDateTime != nil {
+		return render.FormatAge(*cmd.Item.RequestedDateTime)
+	}
+	return ""
+}
+
+// statusStyle colours a command status
+func statusStyle(status string) lipgloss.Style {
+	switch status {
+	case "Success":
+		return render.SuccessStyle()
+	case "Failed", "Cancelled", "TimedOut":
+		return render.DangerStyle()
+	case "Pending", "InProgress", "Cancelling":
+		return render.WarningStyle()
+	default:
+		return render.DefaultStyle()
+	}
+}
+
+// RenderDetail renders detailed command information
+func (r *CommandRenderer) RenderDetail(resource dao.Resource) string {
+	cmd, ok := resource.(*CommandResource)
+	if !ok {
+		return ""
+	}
+
+	d := render.NewDetailBuilder()
+
+	d.Title("SSM Command", cmd.GetID())
+
+	d.Section("Command")
+	d.Field("Document", cmd.Document())
+	d.FieldIf("Document Version", cmd.Item.DocumentVersion)
+	d.FieldStyled("Status", cmd.Status(), statusStyle(cmd.Status()))
+	d.FieldIf("Status Details", cmd.Item.StatusDetails)
+	d.FieldIf("Comment", cmd.Item.Comment)
+	if targets := cmd.Targets(); targets != "" {
+		d.Field("Sent To", targets)
+	}
+
+	d.Section("Progress")
+	d.Field("Targets", fmt.Sprintf("%d", cmd.Item.TargetCount))
+	d.Field("Completed", fmt.Sprintf("%d", cmd.Item.CompletedCount))
+	if cmd.Item.ErrorCount > 0 {
+		d.FieldStyled("Errors", fmt.Sprintf("%d", cmd.Item.ErrorCount), render.DangerStyle())
+	}
+	if cmd.Item.DeliveryTimedOutCount > 0 {
+		d.FieldStyled("Delivery Timed Out", fmt.Sprintf("%d", cmd.Item.DeliveryTimedOutCount), render.DangerStyle())
+	}
+
+	if lines := cmd.Commands(); len(lines) > 0 {
+		d.Section("Commands")
+		for _, line := range lines {
+			d.Line(line)
+		}
+	}
+
+	d.Section("Timestamps")
+	if cmd.Item.RequestedDateTime != nil {
+		d.Field("Requested", cmd.Item.RequestedDateTime.Format(time.RFC3339))
+	}
+	if cmd.Item.ExpiresAfter != nil {
+		d.Field("Expires After", cmd.Item.ExpiresAfter.Format(time.RFC3339))
+	}
+
+	return d.String()
+}
+
+// RenderSummary returns summary fields for the header panel
+func (r *CommandRenderer) RenderSummary(resource dao.Resource) []render.SummaryField {
+	cmd, ok := resource.(*CommandResource)
+	if !ok {
+		return r.BaseRenderer.RenderSummary(resource)
+	}
+	return []render.SummaryField{
+		{Label: "Command", Value: cmd.GetID()},
+		{Label: "Document", Value: cmd.Document()},
+		{Label: "Status", Value: cmd.Status(), Style: statusStyle(cmd.Status())},
+		{Label: "Progress", Value: fmt.Sprintf("%d/%d completed, %d errors", cmd.Item.CompletedCount, cmd.Item.TargetCount, cmd.Item.ErrorCount)},
+		{Label: "Targets", Value: cmd.Targets()},
+	}
+}
@@ -0,0 +1,56 @@
+package commands
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm/types"
+)
+
+func TestNewCommandResource(t *testing.T) {
+	requested := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	cmd := NewCommandResource(types.Command{
+		CommandId:         aws.String("cmd-1"),
+		DocumentName:      aws.String("AWS-RunShellScript"),
+		Status:            types.CommandStatusSuccess,
+		InstanceIds:       []string{"i-1", "i-2"},
+		Parameters:        map[string][]string{"commands": {"uptime", "df -h"}},
+		TargetCount:       2,
+		CompletedCount:    2,
+		RequestedDateTime: &requested,
+	})
+
+	if cmd.GetID() != "cmd-1" || cmd.Document() != "AWS-RunShellScript" || cmd.Status() != "Success" {
+		t.Errorf("id = %q, document = %q, status = %q", cmd.GetID(), cmd.Document(), cmd.Status())
+	}
+	if got := cmd.Targets(); got != "i-1, i-2" {
+		t.Errorf("Targets() = %q", got)
+	}
+	if got := getCommand(cmd); got != "uptime; df -h" {
+		t.Errorf("getCommand() = %q", got)
+	}
+
+	target := cmd.CommandTarget()
+	if target.CommandID != "cmd-1" || target.Document != "AWS-RunShellScript" || len(target.InstanceIDs) != 2 {
+		t.Errorf("CommandTarget() = %+v", target)
+	}
+
+	detail := NewCommandRenderer().RenderDetail(cmd)
+	for _, want := range []string{"cmd-1", "uptime", "df -h"} {
+		if !strings.Contains(detail, want) {
+			t.Errorf("detail missing %q:\n%s", want, detail)
+		}
+	}
+}
+
+func TestTargets_TagTargets(t *testing.T) {
+	cmd := NewCommandResource(types.Command{
+		CommandId: aws.String("cmd-2"),
+		Targets:   []types.Target{{Key: aws.String("tag:Role"), Values: []string{"web", "api"}}},
+	})
+	if got := cmd.Targets(); got != "tag:Role=web,api" {
+		t.Errorf("Targets() = %q", got)
+	}
+}
@@ -16,14 +16,15 @@ func init() {
 		{
 			Name:     "View Value",
 			Shortcut: "v",
-			Type:     action.ActionTypeExec,
-			Command:  `aws ssm get-parameter --name "${ID}" --with-decryption --query 'Parameter.Value' --output text | less -R`,
+			Type:     action.ActionTypeView,
+			Target:   action.TargetSecretValue,
+			Confirm:  action.ConfirmSimple,
 		},
 		{
-			Name:     "View History",
-			Shortcut: "h",
-			Type:     action.ActionTypeExec,
-			Command:  `aws ssm get-parameter-history --name "${ID}" --with-decryption | less -R`,
+			Name:     "Put New Version",
+			Shortcut: "p",
+			Type:     action.ActionTypeView,
+			Target:   action.TargetPutValue,
 		},
 		{
 			Name:      "Delete",
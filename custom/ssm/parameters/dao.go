@@ -10,6 +10,7 @@ import (
 	appaws "github.com/clawscli/claws/internal/aws"
 	"github.com/clawscli/claws/internal/dao"
 	apperrors "github.com/clawscli/claws/internal/errors"
+	"github.com/clawscli/claws/internal/secretvalue"
 )
 
 // ParameterDAO provides data access for SSM Parameter Store
@@ -166,3 +167,8 @@ func (r *ParameterResource) LastModifiedUser() string {
 func (r *ParameterResource) DataType() string {
 	return appaws.Str(r.Item.DataType)
 }
+
+// SecretValueTarget implements secretvalue.Provider
+func (r *ParameterResource) SecretValueTarget() secretvalue.Target {
+	return secretvalue.Target{Kind: secretvalue.KindParameter, ID: r.GetID(), Name: r.GetName()}
+}
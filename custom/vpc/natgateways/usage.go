@@ -0,0 +1,21 @@
+package natgateways
+
+import (
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/usage"
+)
+
+func init() {
+	usage.Global.Register("vpc", "subnets", usage.Finder{
+		Service:  "vpc",
+		Resource: "nat-gateways",
+		Relation: "subnet",
+		Refs: func(r dao.Resource) []string {
+			if ngw, ok := r.(*NatGatewayResource); ok {
+				return []string{appaws.Str(ngw.Item.SubnetId)}
+			}
+			return nil
+		},
+	})
+}
@@ -205,5 +205,6 @@ func (r *SubnetRenderer) Navigations(resource dao.Resource) []render.Navigation
 	return []render.Navigation{
 		{Key: "v", Label: "VPC", Service: "vpc", Resource: "vpcs", FilterField: "VpcId", FilterValue: vpcId},
 		{Key: "e", Label: "Instances", Service: "ec2", Resource: "instances", FilterField: "SubnetId", FilterValue: subnetId},
+		{Key: "n", Label: "ENIs", Service: "ec2", Resource: "network-interfaces", FilterField: "SubnetId", FilterValue: subnetId},
 	}
 }
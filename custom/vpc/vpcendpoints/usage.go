@@ -0,0 +1,35 @@
+package vpcendpoints
+
+import (
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/usage"
+)
+
+func init() {
+	finder := func(relation string, refs func(*VpcEndpointResource) []string) usage.Finder {
+		return usage.Finder{
+			Service:  "vpc",
+			Resource: "endpoints",
+			Relation: relation,
+			Refs: func(r dao.Resource) []string {
+				if ep, ok := r.(*VpcEndpointResource); ok {
+					return refs(ep)
+				}
+				return nil
+			},
+		}
+	}
+
+	usage.Global.Register("vpc", "subnets", finder("endpoint subnet", func(ep *VpcEndpointResource) []string {
+		return ep.Item.SubnetIds
+	}))
+	usage.Global.Register("ec2", "security-groups", finder("security group", func(ep *VpcEndpointResource) []string {
+		var ids []string
+		for _, g := range ep.Item.Groups {
+			if g.GroupId != nil {
+				ids = append(ids, *g.GroupId)
+			}
+		}
+		return ids
+	}))
+}
@@ -47,6 +47,35 @@ const (
 	ActionTypeView ActionType = "view"
 )
 
+// View targets for ActionTypeView actions, opened by the action menu.
+const (
+	TargetLogs           = "logs"            // Log viewer, last hour
+	TargetLogs24h        = "logs:24h"        // Log viewer, last 24 hours
+	TargetLogsFollow     = "logs:follow"     // Log viewer in follow (live tail) mode
+	TargetPreview        = "preview"         // Content preview (text, JSON, CSV)
+	TargetItems          = "items"           // DynamoDB item explorer
+	TargetInvoke         = "invoke"          // Lambda invoke dialog
+	TargetExecution      = "execution"       // Step Functions execution history
+	TargetStartExecution = "start-execution" // Step Functions start execution with input
+	TargetTemplate       = "template"        // CloudFormation stack template and parameters
+	TargetPolicy         = "policy"          // IAM policy documents, effective permissions and simulator
+	TargetReach          = "reach"           // Security group, ACL and route reachability check
+	TargetTaskDefDiff    = "taskdef-diff"    // ECS task definition revision diff
+	TargetDesiredCount   = "desired-count"   // ECS service desired count input
+	TargetSecretValue    = "secret-value"    // Masked secret or parameter value with versions
+	TargetPutValue       = "put-value"       // New secret or parameter version from an editor
+	TargetRunCommand     = "run-command"     // SSM Run Command form for instances
+	TargetCommandOutput  = "command-output"  // SSM Run Command per-instance status and output
+	TargetConsoleOutput  = "console-output"  // EC2 instance serial console output
+	TargetPortForward    = "port-forward"    // SSM port forward through a bastion instance
+)
+
+// Object content operations, for resources such as S3 objects
+const (
+	OperationDownloadObject   = "DownloadObject"
+	OperationPresignObjectURL = "PresignObjectURL"
+)
+
 type ConfirmLevel int
 
 const (
@@ -60,10 +89,14 @@ const (
 	ActionNameSSOLogin = "SSO Login"
 	ActionNameLogin    = "Login" // :login command - console login
 
-	// Read-only safe exec actions (read-only operations)
+	// Log viewer actions
 	ActionNameTailLogs      = "Tail Logs"
 	ActionNameViewRecent1h  = "View Recent (1h)"
 	ActionNameViewRecent24h = "View Recent (24h)"
+
+	// Console actions, offered for every resource type with a console page
+	ActionNameOpenConsole = "Open in Console"
+	ActionNameCopyConsole = "Copy Console Link"
 )
 
 type Action struct {
@@ -93,6 +126,19 @@ type Action struct {
 	// If nil, defaults to resource.GetID().
 	// Use when the action operates on a different identifier (e.g., Name vs ARN).
 	ConfirmToken func(resource dao.Resource) string
+
+	// Input declares values the action asks for before it runs, rendered by
+	// the action menu as a form. Only API actions take input.
+	Input []Field
+
+	// Values holds the entered input, set by the action menu before the
+	// executor is called. ExecuteWithDAO fills in defaults when nil.
+	Values Values
+
+	// UsesMarked passes the resource marked in the browser, when it is not
+	// the selected one, to the action in its context (dao.MarkedFromContext).
+	// Other actions never see the mark.
+	UsesMarked bool
 }
 
 // ActionResult represents the result of an action
@@ -143,11 +189,17 @@ func SuccessResultWithFollowUp(message string, followUp any) ActionResult {
 // ExecutorFunc is a function that executes an action on a resource
 type ExecutorFunc func(ctx context.Context, action Action, resource dao.Resource) ActionResult
 
+// CommonProvider returns actions offered for a resource type in addition to
+// its own, or nil if none apply.
+type CommonProvider func(service, resource string) []Action
+
 // Registry holds actions for resources
 type Registry struct {
 	mu        sync.RWMutex
 	actions   map[string][]Action     // key: service/resource
 	executors map[string]ExecutorFunc // key: service/resource
+	common    []CommonProvider
+	readOnly  map[string]map[string]bool // key: service/resource, value: allowed operations
 }
 
 // NewRegistry creates a new action registry
@@ -155,20 +207,32 @@ func NewRegistry() *Registry {
 	return &Registry{
 		actions:   make(map[string][]Action),
 		executors: make(map[string]ExecutorFunc),
+		readOnly:  make(map[string]map[string]bool),
 	}
 }
 
 // ReadOnlyAllowlist defines API operations allowed in read-only mode.
-// - View actions: always allowed (navigation only)
+// - View actions: allowed unless the Target is in ReadOnlyDeniedViews
 // - Exec actions: allowed only if Name is in ReadOnlyExecAllowlist
-// - API actions: allowed only if Operation is in this list
+// - API actions: allowed only if Operation is in this list or in Registry.AllowInReadOnly
 //
 // Security rationale for each allowed operation:
 var ReadOnlyAllowlist = map[string]bool{
 	// DetectStackDrift: Triggers analysis only, no stack modifications
 	"DetectStackDrift": true,
+	// StartImageScan: Scans an ECR image for vulnerabilities, the image is not modified
+	"StartImageScan": true,
+	// StartLifecyclePolicyPreview: Dry run of an ECR lifecycle policy, no images expire
+	"StartLifecyclePolicyPreview": true,
 	// InvokeFunctionDryRun: Validation mode, function is not actually invoked
 	"InvokeFunctionDryRun": true,
+	// OpenInConsole/CopyConsoleLink: Build a console URL, no resource changes
+	OperationOpenConsole: true,
+	OperationCopyConsole: true,
+	// DownloadObject: Reads object content into a new local file
+	OperationDownloadObject: true,
+	// PresignObjectURL: Signs a time-limited GET URL, no resource changes
+	OperationPresignObjectURL: true,
 }
 
 // ReadOnlyExecAllowlist defines exec actions allowed in read-only mode.
@@ -182,17 +246,26 @@ var ReadOnlyExecAllowlist = map[string]bool{
 	ActionNameSSOLogin: true,
 	// Login: Opens browser for console login, no resource changes
 	ActionNameLogin: true,
-	// Log viewing: Read-only CloudWatch Logs access
-	ActionNameTailLogs:      true,
-	ActionNameViewRecent1h:  true,
-	ActionNameViewRecent24h: true,
+}
+
+// ReadOnlyDeniedViews defines view targets denied in read-only mode: views
+// whose purpose is to modify a resource, and the secret value view, which
+// shows plaintext like the get-secret-value exec action it replaced. The
+// views also check read-only mode before opening or writing.
+var ReadOnlyDeniedViews = map[string]bool{
+	TargetStartExecution: true,
+	TargetDesiredCount:   true,
+	TargetSecretValue:    true,
+	TargetPutValue:       true,
+	TargetRunCommand:     true,
+	TargetPortForward:    true,
 }
 
 // IsAllowedInReadOnly returns whether the action can be executed in read-only mode.
 func IsAllowedInReadOnly(act Action) bool {
 	switch act.Type {
 	case ActionTypeView:
-		return true
+		return !ReadOnlyDeniedViews[act.Target]
 	case ActionTypeExec:
 		return ReadOnlyExecAllowlist[act.Name]
 	case ActionTypeAPI:
@@ -245,12 +318,29 @@ func (r *Registry) Register(service, resource string, actions []Action) {
 	r.actions[key] = actions
 }
 
-// Get returns actions for a resource type
+// RegisterCommon registers a provider of actions offered for every resource
+// type, listed after the type's own actions.
+func (r *Registry) RegisterCommon(provider CommonProvider) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.common = append(r.common, provider)
+}
+
+// Get returns actions for a resource type, followed by any common actions
 func (r *Registry) Get(service, resource string) []Action {
 	r.mu.RLock()
 	defer r.mu.RUnlock()
 	key := fmt.Sprintf("%s/%s", service, resource)
-	return r.actions[key]
+	actions := r.actions[key]
+	if len(r.common) == 0 {
+		return actions
+	}
+
+	actions = append([]Action(nil), actions...)
+	for _, provider := range r.common {
+		actions = append(actions, provider(service, resource)...)
+	}
+	return actions
 }
 
 // RegisterExecutor registers an executor for a resource type
@@ -269,6 +359,35 @@ func (r *Registry) GetExecutor(service, resource string) ExecutorFunc {
 	return r.executors[key]
 }
 
+// AllowInReadOnly allows API operations of one resource type in read-only
+// mode, in addition to ReadOnlyAllowlist. Plugins declare their read-only
+// actions here, so they cannot allow operations of other types.
+func (r *Registry) AllowInReadOnly(service, resource string, operations ...string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	key := fmt.Sprintf("%s/%s", service, resource)
+	if r.readOnly[key] == nil {
+		r.readOnly[key] = make(map[string]bool)
+	}
+	for _, op := range operations {
+		r.readOnly[key][op] = true
+	}
+}
+
+// IsAllowedInReadOnly returns whether the action of a resource type can be
+// executed in read-only mode.
+func (r *Registry) IsAllowedInReadOnly(act Action, service, resource string) bool {
+	if IsAllowedInReadOnly(act) {
+		return true
+	}
+	if act.Type != ActionTypeAPI {
+		return false
+	}
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.readOnly[fmt.Sprintf("%s/%s", service, resource)][act.Operation]
+}
+
 // RegisterExecutor is a convenience function to register with the global registry
 func RegisterExecutor(service, resource string, executor ExecutorFunc) {
 	Global.RegisterExecutor(service, resource, executor)
@@ -292,17 +411,28 @@ func ExecuteWithDAO(ctx context.Context, action Action, resource dao.Resource, s
 
 	// Defense-in-depth: UI (NewActionMenu) already filters actions, but re-check here
 	// to prevent direct API calls or future code paths from bypassing read-only protection.
-	if config.Global().ReadOnly() && !IsAllowedInReadOnly(action) {
+	if config.Global().ReadOnly() && !Global.IsAllowedInReadOnly(action, service, resourceType) {
 		log.Info("read-only denied action", "action", action.Name, "type", action.Type)
 		return ActionResult{Success: false, Error: ErrReadOnlyDenied}
 	}
 
+	if len(action.Input) > 0 {
+		if action.Values == nil {
+			action.Values = DefaultValues(action, resource)
+		}
+		if err := ValidateValues(action, resource, action.Values); err != nil {
+			return ActionResult{Success: false, Error: err}
+		}
+	}
+
 	var result ActionResult
 	switch action.Type {
 	case ActionTypeExec:
 		result = executeExec(ctx, action, resource)
 	case ActionTypeAPI:
-		if executor := Global.GetExecutor(service, resourceType); executor != nil {
+		if isConsoleOperation(action.Operation) {
+			result = executeConsole(ctx, action, resource, service, resourceType)
+		} else if executor := Global.GetExecutor(service, resourceType); executor != nil {
 			result = executor(ctx, action, resource)
 		} else {
 			result = ActionResult{Success: false, Error: fmt.Errorf("no executor registered for %s/%s", service, resourceType)}
@@ -311,13 +441,23 @@ func ExecuteWithDAO(ctx context.Context, action Action, resource dao.Resource, s
 		result = ActionResult{Success: false, Error: fmt.Errorf("unknown action type: %s", action.Type)}
 	}
 
+	logResult(action, result)
+	return result
+}
+
+// Record logs a write made by a view, such as an edited value being saved,
+// the way ExecuteWithDAO logs API actions.
+func Record(act Action, service, resourceType, resourceID string, result ActionResult) {
+	log.Info("executing action", "action", act.Name, "type", act.Type, "service", service, "resourceType", resourceType, "resourceID", resourceID)
+	logResult(act, result)
+}
+
+func logResult(act Action, result ActionResult) {
 	if result.Success {
-		log.Info("action completed", "action", action.Name, "success", true)
+		log.Info("action completed", "action", act.Name, "success", true)
 	} else {
-		log.Error("action failed", "action", action.Name, "error", result.Error)
+		log.Error("action failed", "action", act.Name, "error", result.Error)
 	}
-
-	return result
 }
 
 func executeExec(ctx context.Context, action Action, resource dao.Resource) ActionResult {
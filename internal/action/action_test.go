@@ -255,6 +255,8 @@ func TestIsAllowedInReadOnly(t *testing.T) {
 		want bool
 	}{
 		{"view type allowed", Action{Type: ActionTypeView}, true},
+		{"write view denied", Action{Type: ActionTypeView, Target: TargetPutValue}, false},
+		{"secret value view denied", Action{Type: ActionTypeView, Target: TargetSecretValue}, false},
 		{"exec allowlisted", Action{Type: ActionTypeExec, Name: ActionNameLogin}, true},
 		{"exec not allowlisted", Action{Type: ActionTypeExec, Name: "SomeExec"}, false},
 		{"api allowlisted", Action{Type: ActionTypeAPI, Operation: "DetectStackDrift"}, true},
@@ -275,6 +277,7 @@ func TestReadOnlyAllowlist(t *testing.T) {
 	expected := []string{
 		"DetectStackDrift",     // CloudFormation: read-only drift detection
 		"InvokeFunctionDryRun", // Lambda: validation only
+		"StartImageScan",       // ECR: vulnerability scan only
 	}
 
 	for _, op := range expected {
@@ -1192,3 +1195,56 @@ func TestExecWithHeader_SetIO(t *testing.T) {
 		t.Error("SetStderr did not set stderr")
 	}
 }
+
+func TestRegistry_CommonActions(t *testing.T) {
+	r := NewRegistry()
+	r.Register("ec2", "instances", []Action{{Name: "Stop", Shortcut: "S"}})
+	r.RegisterCommon(func(service, _ string) []Action {
+		if service != "ec2" {
+			return nil
+		}
+		return []Action{{Name: "Common", Shortcut: "O"}}
+	})
+
+	got := r.Get("ec2", "instances")
+	if len(got) != 2 || got[0].Name != "Stop" || got[1].Name != "Common" {
+		t.Errorf("Get() = %+v, want own actions followed by common", got)
+	}
+	if got := r.Get("s3", "buckets"); len(got) != 0 {
+		t.Errorf("Get() for unsupported service = %+v, want none", got)
+	}
+
+	// Common actions must not leak into the registered slice
+	if got := r.Get("ec2", "instances"); len(got) != 2 {
+		t.Errorf("repeated Get() = %d actions, want 2", len(got))
+	}
+}
+
+func TestConsoleActions(t *testing.T) {
+	actions := Global.Get("ec2", "instances")
+	var copyAction *Action
+	for i, a := range actions {
+		if a.Operation == OperationCopyConsole {
+			copyAction = &actions[i]
+		}
+	}
+	if copyAction == nil {
+		t.Fatal("console actions should be offered for ec2/instances")
+	}
+	if !IsAllowedInReadOnly(*copyAction) {
+		t.Error("console actions should be allowed in read-only mode")
+	}
+
+	res := &dao.BaseResource{ID: "i-123", ARN: "arn:aws:ec2:us-east-1:123456789012:instance/i-123"}
+	result := ExecuteWithDAO(context.Background(), *copyAction, res, "ec2", "instances")
+	if !result.Success {
+		t.Fatalf("copy console link failed: %v", result.Error)
+	}
+	if result.FollowUpMsg == nil {
+		t.Error("copy console link should return a clipboard message")
+	}
+
+	if got := Global.Get("unknown", "things"); len(got) != 0 {
+		t.Errorf("unknown service should have no console actions, got %+v", got)
+	}
+}
@@ -0,0 +1,89 @@
+package action
+
+import (
+	"context"
+	"fmt"
+
+	tea "charm.land/bubbletea/v2"
+
+	"github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/config"
+	"github.com/clawscli/claws/internal/console"
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/log"
+)
+
+// Console action operations
+const (
+	OperationOpenConsole = "OpenInConsole"
+	OperationCopyConsole = "CopyConsoleLink"
+)
+
+func init() {
+	Global.RegisterCommon(consoleActions)
+}
+
+// consoleActions offers console actions for every service with a console page.
+func consoleActions(service, _ string) []Action {
+	if !console.Supports(service) {
+		return nil
+	}
+	return []Action{
+		{
+			Name:      ActionNameOpenConsole,
+			Shortcut:  "O",
+			Type:      ActionTypeAPI,
+			Operation: OperationOpenConsole,
+		},
+		{
+			Name:      ActionNameCopyConsole,
+			Shortcut:  "Y",
+			Type:      ActionTypeAPI,
+			Operation: OperationCopyConsole,
+		},
+	}
+}
+
+func isConsoleOperation(operation string) bool {
+	return operation == OperationOpenConsole || operation == OperationCopyConsole
+}
+
+// executeConsole opens the resource's console page, or copies its link.
+// Opening signs in through federation for SSO profiles, and falls back to
+// copying the URL when no browser is available.
+func executeConsole(ctx context.Context, action Action, resource dao.Resource, service, resourceType string) ActionResult {
+	if profile := dao.GetResourceProfile(resource); profile != "" {
+		ctx = aws.WithSelectionOverride(ctx, config.ProfileSelectionFromID(profile))
+	}
+
+	link := console.URL(service, resourceType, resource, aws.GetRegionFromContext(ctx))
+	if link == "" {
+		return FailResult(fmt.Errorf("no console page for %s/%s", service, resourceType))
+	}
+
+	if action.Operation == OperationCopyConsole {
+		return CopyResult(link, "Console link copied to clipboard")
+	}
+
+	if console.NeedsFederation(ctx) {
+		federated, err := console.FederatedURL(ctx, link)
+		if err != nil {
+			return FailResultf(err, "console sign-in")
+		}
+		link = federated
+	}
+
+	if !console.HasBrowser() {
+		return CopyResult(link, "No browser available; console URL copied to clipboard")
+	}
+	if err := console.OpenBrowser(link); err != nil {
+		log.Debug("open browser failed, copying URL", "error", err)
+		return CopyResult(link, "Could not open browser; console URL copied to clipboard")
+	}
+	return SuccessResult("Opened in browser")
+}
+
+// CopyResult copies text to the terminal clipboard (OSC 52).
+func CopyResult(text, message string) ActionResult {
+	return SuccessResultWithFollowUp(message, tea.SetClipboard(text)())
+}
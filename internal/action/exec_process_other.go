@@ -0,0 +1,17 @@
+//go:build !unix
+
+package action
+
+import (
+	"os/exec"
+)
+
+func setProcessGroup(*exec.Cmd) {}
+
+// StopExec stops a command started with ExecWithHeader.Start.
+func StopExec(cmd *exec.Cmd) error {
+	if cmd.Process == nil {
+		return nil
+	}
+	return cmd.Process.Kill()
+}
@@ -202,6 +202,33 @@ func (e *ExecWithHeader) Run() error {
 	return err
 }
 
+// Start starts the command in the background instead of taking over the
+// terminal, for long-running sessions such as port forwards. Output goes to
+// the writers set with SetStdout and SetStderr. The command runs in its own
+// process group so StopExec also stops the processes it spawns.
+func (e *ExecWithHeader) Start() (*exec.Cmd, error) {
+	if config.Global().ReadOnly() && !IsExecAllowedInReadOnly(e.ActionName) {
+		return nil, ErrReadOnlyDenied
+	}
+	if e.Command == "" {
+		return nil, ErrEmptyCommand
+	}
+
+	cmd := exec.Command("/bin/sh", "-c", e.Command)
+	cmd.Stdin = e.stdin
+	cmd.Stdout = e.stdout
+	cmd.Stderr = e.stderr
+	if !e.SkipAWSEnv {
+		setAWSEnv(cmd, e.Region)
+	}
+	setProcessGroup(cmd)
+
+	if err := cmd.Start(); err != nil {
+		return nil, err
+	}
+	return cmd, nil
+}
+
 func (e *ExecWithHeader) buildHeader(_ int) string {
 	profileDisplay := config.Global().Selection().DisplayName()
 	region := e.Region
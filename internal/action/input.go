@@ -0,0 +1,221 @@
+package action
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/clawscli/claws/internal/dao"
+)
+
+// FieldType is the kind of value an input field takes.
+type FieldType string
+
+const (
+	FieldString   FieldType = "string"
+	FieldInt      FieldType = "int"
+	FieldEnum     FieldType = "enum"     // one of Field.Options
+	FieldJSON     FieldType = "json"     // a JSON document
+	FieldDuration FieldType = "duration" // a Go duration such as "30s" or "5m"
+)
+
+// ErrMissingInput is returned when a required input field is empty.
+var ErrMissingInput = errors.New("value required")
+
+// Field is one value an action asks for before it runs. The action menu
+// renders the fields of Action.Input as a form and passes the entered
+// values to the executor in Action.Values.
+type Field struct {
+	Name     string // key in Values
+	Label    string // shown in the form; defaults to Name
+	Type     FieldType
+	Help     string   // one-line hint shown under the field
+	Options  []string // allowed values of a FieldEnum
+	Required bool
+
+	// Min and Max bound FieldInt values and FieldDuration values in
+	// seconds, when set.
+	Min *int64
+	Max *int64
+
+	// Default returns the initial value for the resource. If nil, the field
+	// starts empty, or with the first option of a FieldEnum.
+	Default func(resource dao.Resource) string
+
+	// Filter returns true if the field applies to the resource.
+	// If nil, the field is always shown.
+	Filter func(resource dao.Resource) bool
+
+	// Check validates a non-empty value against the resource, after the
+	// type and bounds checks, e.g. against its current configuration.
+	Check func(resource dao.Resource, value string) error
+}
+
+// Bound returns a pointer to n, for Field.Min and Field.Max.
+func Bound(n int64) *int64 {
+	return &n
+}
+
+// DisplayLabel returns the label shown for the field.
+func (f Field) DisplayLabel() string {
+	if f.Label != "" {
+		return f.Label
+	}
+	return f.Name
+}
+
+// DefaultValue returns the field's initial value for the resource.
+func (f Field) DefaultValue(resource dao.Resource) string {
+	if f.Default != nil {
+		return f.Default(resource)
+	}
+	if f.Type == FieldEnum && len(f.Options) > 0 {
+		return f.Options[0]
+	}
+	return ""
+}
+
+// Validate checks a value entered for the field.
+func (f Field) Validate(value string) error {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		if f.Required {
+			return fmt.Errorf("%s: %w", f.DisplayLabel(), ErrMissingInput)
+		}
+		return nil
+	}
+
+	switch f.Type {
+	case FieldInt:
+		n, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return fmt.Errorf("%s: %q is not a whole number", f.DisplayLabel(), value)
+		}
+		return f.checkBounds(n, func(n int64) string { return strconv.FormatInt(n, 10) })
+	case FieldDuration:
+		d, err := time.ParseDuration(value)
+		if err != nil {
+			return fmt.Errorf("%s: %q is not a duration such as 30s or 5m", f.DisplayLabel(), value)
+		}
+		return f.checkBounds(int64(d/time.Second), func(n int64) string {
+			return (time.Duration(n) * time.Second).String()
+		})
+	case FieldEnum:
+		if !slices.Contains(f.Options, value) {
+			return fmt.Errorf("%s: must be one of %s", f.DisplayLabel(), strings.Join(f.Options, ", "))
+		}
+	case FieldJSON:
+		if !json.Valid([]byte(value)) {
+			return fmt.Errorf("%s: invalid JSON", f.DisplayLabel())
+		}
+	}
+	return nil
+}
+
+// ValidateFor checks a value entered for the field on the resource,
+// including the field's Check.
+func (f Field) ValidateFor(resource dao.Resource, value string) error {
+	if err := f.Validate(value); err != nil {
+		return err
+	}
+	value = strings.TrimSpace(value)
+	if f.Check == nil || value == "" {
+		return nil
+	}
+	if err := f.Check(resource, value); err != nil {
+		return fmt.Errorf("%s: %w", f.DisplayLabel(), err)
+	}
+	return nil
+}
+
+func (f Field) checkBounds(n int64, format func(int64) string) error {
+	if f.Min != nil && n < *f.Min {
+		return fmt.Errorf("%s: must be at least %s", f.DisplayLabel(), format(*f.Min))
+	}
+	if f.Max != nil && n > *f.Max {
+		return fmt.Errorf("%s: must be at most %s", f.DisplayLabel(), format(*f.Max))
+	}
+	return nil
+}
+
+// InputFields returns the action's input fields that apply to the resource.
+func InputFields(act Action, resource dao.Resource) []Field {
+	var fields []Field
+	for _, f := range act.Input {
+		if f.Filter == nil || f.Filter(resource) {
+			fields = append(fields, f)
+		}
+	}
+	return fields
+}
+
+// DefaultValues returns the initial values of the action's input fields
+// for the resource.
+func DefaultValues(act Action, resource dao.Resource) Values {
+	values := make(Values)
+	for _, f := range InputFields(act, resource) {
+		values[f.Name] = f.DefaultValue(resource)
+	}
+	return values
+}
+
+// ValidateValues checks values against the action's input fields,
+// returning the first error.
+func ValidateValues(act Action, resource dao.Resource, values Values) error {
+	for _, f := range InputFields(act, resource) {
+		if err := f.ValidateFor(resource, values[f.Name]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Values holds the validated input of an action, keyed by Field.Name.
+type Values map[string]string
+
+// String returns the trimmed value of the field.
+func (v Values) String(name string) string {
+	return strings.TrimSpace(v[name])
+}
+
+// Int returns the value of a FieldInt field, or 0 if it is empty.
+func (v Values) Int(name string) (int64, error) {
+	s := v.String(name)
+	if s == "" {
+		return 0, nil
+	}
+	return strconv.ParseInt(s, 10, 64)
+}
+
+// Duration returns the value of a FieldDuration field, or 0 if it is empty.
+func (v Values) Duration(name string) (time.Duration, error) {
+	s := v.String(name)
+	if s == "" {
+		return 0, nil
+	}
+	return time.ParseDuration(s)
+}
+
+// JSON returns the value of a FieldJSON field, or nil if it is empty.
+func (v Values) JSON(name string) json.RawMessage {
+	s := v.String(name)
+	if s == "" {
+		return nil
+	}
+	return json.RawMessage(s)
+}
+
+// Summary formats the values for a confirmation prompt, in field order.
+func (v Values) Summary(fields []Field) string {
+	parts := make([]string, 0, len(fields))
+	for _, f := range fields {
+		if s := v.String(f.Name); s != "" {
+			parts = append(parts, fmt.Sprintf("%s: %s", f.DisplayLabel(), s))
+		}
+	}
+	return strings.Join(parts, "\n")
+}
@@ -0,0 +1,143 @@
+package action
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/clawscli/claws/internal/dao"
+)
+
+func TestField_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		field   Field
+		value   string
+		wantErr bool
+	}{
+		{"string", Field{Name: "body", Type: FieldString}, "hello", false},
+		{"empty optional", Field{Name: "body", Type: FieldString}, "", false},
+		{"empty required", Field{Name: "body", Type: FieldString, Required: true}, "  ", true},
+		{"int", Field{Name: "n", Type: FieldInt}, "42", false},
+		{"int not a number", Field{Name: "n", Type: FieldInt}, "4.2", true},
+		{"int below min", Field{Name: "n", Type: FieldInt, Min: Bound(1)}, "0", true},
+		{"int above max", Field{Name: "n", Type: FieldInt, Max: Bound(10)}, "11", true},
+		{"int within bounds", Field{Name: "n", Type: FieldInt, Min: Bound(1), Max: Bound(10)}, "10", false},
+		{"enum", Field{Name: "mode", Type: FieldEnum, Options: []string{"a", "b"}}, "b", false},
+		{"enum unknown", Field{Name: "mode", Type: FieldEnum, Options: []string{"a", "b"}}, "c", true},
+		{"json", Field{Name: "payload", Type: FieldJSON}, `{"a": [1, 2]}`, false},
+		{"json invalid", Field{Name: "payload", Type: FieldJSON}, `{"a":`, true},
+		{"duration", Field{Name: "delay", Type: FieldDuration}, "90s", false},
+		{"duration invalid", Field{Name: "delay", Type: FieldDuration}, "90", true},
+		{"duration above max", Field{Name: "delay", Type: FieldDuration, Max: Bound(900)}, "16m", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.field.Validate(tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Validate(%q) error = %v, wantErr %v", tt.value, err, tt.wantErr)
+			}
+		})
+	}
+
+	err := Field{Name: "body", Label: "Message Body", Required: true}.Validate("")
+	if !errors.Is(err, ErrMissingInput) || err.Error() != "Message Body: value required" {
+		t.Errorf("Validate() error = %v, want the label and ErrMissingInput", err)
+	}
+}
+
+func TestField_ValidateFor(t *testing.T) {
+	f := Field{
+		Name: "count",
+		Type: FieldInt,
+		Check: func(resource dao.Resource, value string) error {
+			if value < resource.GetID() {
+				return errors.New("below current")
+			}
+			return nil
+		},
+	}
+	res := &dao.BaseResource{ID: "5"}
+	if err := f.ValidateFor(res, "7"); err != nil {
+		t.Errorf("ValidateFor(7) error = %v", err)
+	}
+	if err := f.ValidateFor(res, "3"); err == nil {
+		t.Error("ValidateFor(3) should fail the check")
+	}
+	if err := f.ValidateFor(res, "x"); err == nil {
+		t.Error("ValidateFor(x) should fail the type check")
+	}
+	if err := f.ValidateFor(res, ""); err != nil {
+		t.Errorf("ValidateFor() of an empty optional value error = %v", err)
+	}
+}
+
+func TestDefaultValues(t *testing.T) {
+	act := Action{Input: []Field{
+		{Name: "name", Default: func(r dao.Resource) string { return r.GetName() }},
+		{Name: "mode", Type: FieldEnum, Options: []string{"fast", "slow"}},
+		{Name: "empty"},
+		{Name: "hidden", Filter: func(dao.Resource) bool { return false }},
+	}}
+	values := DefaultValues(act, &mockResource{name: "orders"})
+
+	if values["name"] != "orders" || values["mode"] != "fast" || values["empty"] != "" {
+		t.Errorf("DefaultValues() = %v", values)
+	}
+	if _, ok := values["hidden"]; ok {
+		t.Error("DefaultValues() should skip fields filtered out for the resource")
+	}
+}
+
+func TestValues(t *testing.T) {
+	values := Values{"n": " 5 ", "delay": "1m30s", "payload": `{"a":1}`}
+
+	if n, err := values.Int("n"); err != nil || n != 5 {
+		t.Errorf("Int() = %d, %v", n, err)
+	}
+	if n, err := values.Int("missing"); err != nil || n != 0 {
+		t.Errorf("Int() of a missing value = %d, %v", n, err)
+	}
+	if d, err := values.Duration("delay"); err != nil || d != 90*time.Second {
+		t.Errorf("Duration() = %v, %v", d, err)
+	}
+	if string(values.JSON("payload")) != `{"a":1}` || values.JSON("missing") != nil {
+		t.Errorf("JSON() = %s", values.JSON("payload"))
+	}
+
+	fields := []Field{{Name: "n", Label: "Count"}, {Name: "missing"}, {Name: "delay"}}
+	if got := values.Summary(fields); got != "Count: 5\ndelay: 1m30s" {
+		t.Errorf("Summary() = %q", got)
+	}
+}
+
+func TestExecuteWithDAO_Input(t *testing.T) {
+	var got Values
+	Global.RegisterExecutor("input", "resource", func(_ context.Context, act Action, _ dao.Resource) ActionResult {
+		got = act.Values
+		return SuccessResult("done")
+	})
+	act := Action{
+		Type:      ActionTypeAPI,
+		Operation: "Scale",
+		Input: []Field{{
+			Name:    "count",
+			Type:    FieldInt,
+			Min:     Bound(1),
+			Default: func(dao.Resource) string { return "3" },
+		}},
+	}
+
+	result := ExecuteWithDAO(context.Background(), act, &mockResource{}, "input", "resource")
+	if !result.Success || got.String("count") != "3" {
+		t.Errorf("ExecuteWithDAO() = %+v with values %v, want the defaults passed", result, got)
+	}
+
+	got = nil
+	act.Values = Values{"count": "0"}
+	result = ExecuteWithDAO(context.Background(), act, &mockResource{}, "input", "resource")
+	if result.Success || got != nil {
+		t.Error("ExecuteWithDAO() should reject invalid values without calling the executor")
+	}
+}
@@ -139,7 +139,7 @@ func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				// Navigate to the command result
 				if nav.ClearStack {
 					// Go home - clear the stack
-					a.viewStack = nil
+					a.clearStack()
 				} else if a.currentView != nil {
 					a.viewStack = append(a.viewStack, a.currentView)
 				}
@@ -174,8 +174,7 @@ func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	case tea.MouseClickMsg:
 		// Mouse back button navigates back (same as esc/backspace)
 		if msg.Button == tea.MouseBackward && len(a.viewStack) > 0 {
-			a.currentView = a.viewStack[len(a.viewStack)-1]
-			a.viewStack = a.viewStack[:len(a.viewStack)-1]
+			a.popView()
 			return a, a.currentView.SetSize(a.width, a.height-2)
 		}
 
@@ -194,20 +193,28 @@ func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			}
 			// Otherwise, go back
 			if len(a.viewStack) > 0 {
-				a.currentView = a.viewStack[len(a.viewStack)-1]
-				a.viewStack = a.viewStack[:len(a.viewStack)-1]
+				a.popView()
 				return a, a.currentView.SetSize(a.width, a.height-2)
 			}
 			return a, nil
 		}
 
+		// Text inputs get every key but ctrl+c, so typing "q" or ":" into a
+		// filter or query does not quit or open command mode
+		if ic, ok := a.currentView.(view.InputCapture); ok && ic.HasActiveInput() && msg.String() != "ctrl+c" {
+			model, cmd := a.currentView.Update(msg)
+			if v, ok := model.(view.View); ok {
+				a.currentView = v
+			}
+			return a, cmd
+		}
+
 		switch {
 		case key.Matches(msg, a.keys.Quit):
 			switch a.currentView.(type) {
-			case *view.DetailView, *view.DiffView:
+			case *view.DetailView, *view.DiffView, *view.LogView, *view.ContentView:
 				if len(a.viewStack) > 0 {
-					a.currentView = a.viewStack[len(a.viewStack)-1]
-					a.viewStack = a.viewStack[:len(a.viewStack)-1]
+					a.popView()
 					return a, a.currentView.SetSize(a.width, a.height-2)
 				}
 			}
@@ -232,6 +239,11 @@ func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				a.commandInput.SetTagProvider(nil)
 				a.commandInput.SetDiffProvider(nil)
 			}
+			if p, ok := a.currentView.(view.LogGroupProvider); ok {
+				a.commandInput.SetLogGroupProvider(p)
+			} else {
+				a.commandInput.SetLogGroupProvider(nil)
+			}
 			return a, a.commandInput.Activate()
 
 		case key.Matches(msg, a.keys.Region):
@@ -264,7 +276,7 @@ func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	case view.NavigateMsg:
 		log.Debug("navigating", "clearStack", msg.ClearStack, "stackDepth", len(a.viewStack))
 		if msg.ClearStack {
-			a.viewStack = nil
+			a.clearStack()
 		} else if a.currentView != nil {
 			a.viewStack = append(a.viewStack, a.currentView)
 		}
@@ -323,8 +335,7 @@ func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		log.Info("regions changed", "regions", msg.Regions)
 		// Pop views until we find a refreshable one (ResourceBrowser or ServiceBrowser)
 		for len(a.viewStack) > 0 {
-			a.currentView = a.viewStack[len(a.viewStack)-1]
-			a.viewStack = a.viewStack[:len(a.viewStack)-1]
+			a.popView()
 			if r, ok := a.currentView.(view.Refreshable); ok && r.CanRefresh() {
 				return a, tea.Batch(
 					a.currentView.SetSize(a.width, a.height-2),
@@ -345,8 +356,7 @@ func (a *App) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			log.Debug("failed to refresh profile config", "error", err)
 		}
 		for len(a.viewStack) > 0 {
-			a.currentView = a.viewStack[len(a.viewStack)-1]
-			a.viewStack = a.viewStack[:len(a.viewStack)-1]
+			a.popView()
 
 			if _, ok := a.currentView.(*view.ProfileSelector); ok {
 				continue
@@ -465,6 +475,28 @@ func (a *App) renderWarnings() string {
 	)
 }
 
+// popView closes the current view and returns to the previous one.
+func (a *App) popView() {
+	closeView(a.currentView)
+	a.currentView = a.viewStack[len(a.viewStack)-1]
+	a.viewStack = a.viewStack[:len(a.viewStack)-1]
+}
+
+// clearStack closes the current view and every view on the stack.
+func (a *App) clearStack() {
+	closeView(a.currentView)
+	for _, v := range a.viewStack {
+		closeView(v)
+	}
+	a.viewStack = nil
+}
+
+func closeView(v view.View) {
+	if c, ok := v.(view.Closer); ok {
+		c.Close()
+	}
+}
+
 func (a *App) handleModalUpdate(msg tea.Msg) (tea.Model, tea.Cmd) {
 	switch msg := msg.(type) {
 	case view.HideModalMsg:
@@ -475,7 +507,7 @@ func (a *App) handleModalUpdate(msg tea.Msg) (tea.Model, tea.Cmd) {
 		a.modal = nil
 		log.Debug("modal navigate", "clearStack", msg.ClearStack, "stackDepth", len(a.viewStack))
 		if msg.ClearStack {
-			a.viewStack = nil
+			a.clearStack()
 		} else if a.currentView != nil {
 			a.viewStack = append(a.viewStack, a.currentView)
 		}
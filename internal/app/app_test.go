@@ -128,6 +128,37 @@ func TestEscInFilterMode(t *testing.T) {
 	}
 }
 
+func TestKeysInFilterMode(t *testing.T) {
+	app := New(context.Background(), registry.New())
+	app.width = 100
+	app.height = 50
+
+	resourceBrowser := &MockView{name: "ResourceBrowser", hasInput: true}
+	app.currentView = resourceBrowser
+
+	// Typing q or : into a filter must not quit or open command mode
+	for _, msg := range []tea.KeyPressMsg{{Text: "q", Code: 'q'}, {Text: ":", Code: ':'}, {Text: "R", Code: 'R'}} {
+		_, cmd := app.Update(msg)
+		if cmd != nil {
+			if _, quit := cmd().(tea.QuitMsg); quit {
+				t.Fatalf("%q quit while the view had active input", msg.String())
+			}
+		}
+		if app.commandMode || app.currentView != resourceBrowser {
+			t.Fatalf("%q was handled by the app while the view had active input", msg.String())
+		}
+	}
+
+	// ctrl+c still quits
+	_, cmd := app.Update(tea.KeyPressMsg{Code: 'c', Mod: tea.ModCtrl})
+	if cmd == nil {
+		t.Fatal("ctrl+c should quit with active input")
+	}
+	if _, quit := cmd().(tea.QuitMsg); !quit {
+		t.Error("ctrl+c should quit with active input")
+	}
+}
+
 func TestNavigationFlow(t *testing.T) {
 	ctx := context.Background()
 	reg := registry.New()
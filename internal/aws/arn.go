@@ -205,6 +205,7 @@ var arnToRegistryType = map[string]string{
 	"ec2/nat-gateway":                   "nat-gateways",
 	"ec2/vpc-endpoint":                  "endpoints",
 	"ec2/transit-gateway":               "transit-gateways",
+	"ec2/network-interface":             "network-interfaces",
 	"lambda/function":                   "functions",
 	"ecs/cluster":                       "clusters",
 	"ecs/service":                       "services",
@@ -303,6 +304,13 @@ func (a *ARN) ExtractParentFilter() (key, value string) {
 			return "DetectorId", a.ResourceID[:idx]
 		}
 
+	// ECS services and tasks: service/cluster-name/service-name (new ARN format)
+	case "ecs/service", "ecs/task":
+		// ResourceID = "cluster-name/name"; old-format ARNs omit the cluster
+		if idx := strings.Index(a.ResourceID, "/"); idx != -1 {
+			return "ClusterName", a.ResourceID[:idx]
+		}
+
 	// Glue tables: table/database-name/table-name
 	case "glue/table":
 		// ResourceID = "database-name/table-name"
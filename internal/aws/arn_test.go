@@ -443,6 +443,18 @@ func TestARN_ExtractParentFilter(t *testing.T) {
 			wantKey:   "DetectorId",
 			wantValue: "abc123def456",
 		},
+		{
+			name:      "ECS service",
+			arn:       "arn:aws:ecs:us-east-1:123456789012:service/my-cluster/my-service",
+			wantKey:   "ClusterName",
+			wantValue: "my-cluster",
+		},
+		{
+			name:      "ECS service old format",
+			arn:       "arn:aws:ecs:us-east-1:123456789012:service/my-service",
+			wantKey:   "",
+			wantValue: "",
+		},
 		{
 			name:      "Glue table",
 			arn:       "arn:aws:glue:us-east-1:123456789012:table/my-database/my-table",
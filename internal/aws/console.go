@@ -0,0 +1,255 @@
+package aws
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+// embeddedARNPattern matches an ARN embedded in a console URL (CloudFormation
+// stacks, SNS topics, Step Functions, IAM policies, ...).
+var embeddedARNPattern = regexp.MustCompile(`arn:aws[a-z-]*:[a-z0-9-]+:[a-z0-9-]*:[a-z0-9]*:[^?&#;\s]+`)
+
+// consoleRegionHost matches the region prefix of a regional console host.
+var consoleRegionHost = regexp.MustCompile(`^[a-z]{2}(-[a-z]+)+-[0-9]$`)
+
+// consoleHosts maps AWS console host suffixes to their partition.
+var consoleHosts = []struct {
+	suffix    string
+	partition string
+}{
+	{"console.aws.amazon.com", "aws"},
+	{"console.amazonaws-us-gov.com", "aws-us-gov"},
+	{"console.amazonaws.cn", "aws-cn"},
+}
+
+// ec2ConsoleKeys maps EC2/VPC console fragment parameters to ARN resource types.
+var ec2ConsoleKeys = map[string]string{
+	"instanceid":         "instance",
+	"groupid":            "security-group",
+	"volumeid":           "volume",
+	"snapshotid":         "snapshot",
+	"imageid":            "image",
+	"networkinterfaceid": "network-interface",
+	"vpcid":              "vpc",
+	"subnetid":           "subnet",
+	"routetableid":       "route-table",
+	"natgatewayid":       "nat-gateway",
+	"internetgatewayid":  "internet-gateway",
+}
+
+// IsConsoleURL reports whether s looks like an AWS Management Console URL.
+func IsConsoleURL(s string) bool {
+	u, err := url.Parse(strings.TrimSpace(s))
+	if err != nil || u.Scheme != "https" {
+		return false
+	}
+	return consolePartition(u.Host) != ""
+}
+
+// ParseConsoleURL converts an AWS Management Console URL for a single resource
+// into an ARN. The account ID is empty unless the URL contains it (e.g. an
+// embedded ARN or SQS queue URL). Returns nil if the URL is not recognized.
+func ParseConsoleURL(raw string) *ARN {
+	u, err := url.Parse(strings.TrimSpace(raw))
+	if err != nil || u.Scheme != "https" {
+		return nil
+	}
+	partition := consolePartition(u.Host)
+	if partition == "" {
+		return nil
+	}
+
+	// Many console pages embed the resource ARN directly
+	if decoded, err := url.QueryUnescape(raw); err == nil {
+		if m := embeddedARNPattern.FindString(decoded); m != "" {
+			if a := ParseARN(m); a != nil {
+				return a
+			}
+		}
+	}
+
+	region := consoleRegion(u)
+	path := strings.Trim(u.Path, "/")
+	service, rest, _ := strings.Cut(path, "/")
+	fragment := u.Fragment
+	params := fragmentParams(fragment)
+
+	build := func(arnService, region, account, resource string) *ARN {
+		return ParseARN(fmt.Sprintf("arn:%s:%s:%s:%s:%s", partition, arnService, region, account, resource))
+	}
+
+	switch service {
+	case "ec2", "vpc", "vpcconsole":
+		for _, kv := range params {
+			if resType, ok := ec2ConsoleKeys[strings.ToLower(kv[0])]; ok && kv[1] != "" {
+				return build("ec2", region, "", resType+"/"+kv[1])
+			}
+		}
+
+	case "lambda":
+		if name := segmentAfter(fragment, "functions"); name != "" {
+			return build("lambda", region, "", "function:"+name)
+		}
+
+	case "s3":
+		if name := segmentAfter(rest, "buckets"); name != "" {
+			return build("s3", "", "", name)
+		}
+		if name := segmentAfter(rest, "object"); name != "" {
+			return build("s3", "", "", name)
+		}
+
+	case "iam", "iamv2":
+		for _, kind := range []string{"roles", "users", "groups"} {
+			name := segmentAfter(fragment, kind)
+			if name == "details" {
+				name = segmentAfter(fragment, "details")
+			}
+			if name != "" {
+				return build("iam", "", "", strings.TrimSuffix(kind, "s")+"/"+name)
+			}
+		}
+
+	case "rds":
+		if id := paramValue(params, "id"); id != "" {
+			if paramValue(params, "is-cluster") == "true" {
+				return build("rds", region, "", "cluster:"+id)
+			}
+			return build("rds", region, "", "db:"+id)
+		}
+
+	case "ecs":
+		cluster := segmentAfter(rest, "clusters")
+		if cluster == "" {
+			cluster = segmentAfter(fragment, "clusters")
+		}
+		if cluster == "" {
+			break
+		}
+		if svc := segmentAfter(rest, "services"); svc != "" {
+			return build("ecs", region, "", "service/"+cluster+"/"+svc)
+		}
+		if task := segmentAfter(rest, "tasks"); task != "" {
+			return build("ecs", region, "", "task/"+cluster+"/"+task)
+		}
+		return build("ecs", region, "", "cluster/"+cluster)
+
+	case "cloudwatch":
+		// #logsV2:log-groups/log-group/$252Faws$252Flambda$252Ffn/log-events/...
+		if _, group, ok := strings.Cut(fragment, "log-groups/log-group/"); ok {
+			group, _, _ = strings.Cut(group, "/")
+			group, _, _ = strings.Cut(group, "?")
+			if name := unescapeConsole(group); name != "" {
+				return build("logs", region, "", "log-group:"+name)
+			}
+		}
+
+	case "sqs":
+		// #/queues/https://sqs.us-east-1.amazonaws.com/123456789012/queue-name
+		if _, queueURL, ok := strings.Cut(fragment, "/queues/"); ok {
+			parts := strings.Split(strings.TrimPrefix(strings.TrimPrefix(queueURL, "https://"), "http://"), "/")
+			if len(parts) >= 3 && parts[2] != "" {
+				return build("sqs", region, parts[1], parts[2])
+			}
+		}
+
+	case "dynamodb", "dynamodbv2":
+		if name := paramValue(params, "name"); name != "" {
+			return build("dynamodb", region, "", "table/"+name)
+		}
+		if name := paramValue(params, "selected"); name != "" {
+			return build("dynamodb", region, "", "table/"+name)
+		}
+
+	case "secretsmanager":
+		if name := u.Query().Get("name"); name != "" {
+			return build("secretsmanager", region, "", "secret:"+name)
+		}
+
+	case "kms":
+		if id := segmentAfter(fragment, "keys"); id != "" {
+			return build("kms", region, "", "key/"+id)
+		}
+	}
+
+	return nil
+}
+
+func consolePartition(host string) string {
+	for _, h := range consoleHosts {
+		if host == h.suffix || strings.HasSuffix(host, "."+h.suffix) {
+			return h.partition
+		}
+	}
+	return ""
+}
+
+// consoleRegion returns the region from the "region" query parameter (in the
+// URL or its fragment) or from a regional console host like
+// "us-west-2.console.aws.amazon.com".
+func consoleRegion(u *url.URL) string {
+	if r := u.Query().Get("region"); r != "" {
+		return r
+	}
+	if r := paramValue(fragmentParams(u.Fragment), "region"); r != "" {
+		return r
+	}
+	for _, h := range consoleHosts {
+		if prefix, ok := strings.CutSuffix(u.Host, "."+h.suffix); ok && consoleRegionHost.MatchString(prefix) {
+			return prefix
+		}
+	}
+	return ""
+}
+
+// fragmentParams extracts key=value pairs from a console URL fragment such as
+// "InstanceDetails:instanceId=i-123" or "database:id=mydb;is-cluster=false".
+func fragmentParams(fragment string) [][2]string {
+	var params [][2]string
+	for _, part := range strings.FieldsFunc(fragment, func(r rune) bool {
+		return r == ':' || r == ';' || r == '&' || r == '?'
+	}) {
+		if k, v, ok := strings.Cut(part, "="); ok {
+			params = append(params, [2]string{k, v})
+		}
+	}
+	return params
+}
+
+func paramValue(params [][2]string, key string) string {
+	for _, kv := range params {
+		if strings.EqualFold(kv[0], key) {
+			return kv[1]
+		}
+	}
+	return ""
+}
+
+// segmentAfter returns the path segment following the first segment equal to
+// name, with any query string removed.
+func segmentAfter(path, name string) string {
+	segments := strings.Split(path, "/")
+	for i, s := range segments {
+		if s == name && i+1 < len(segments) {
+			seg, _, _ := strings.Cut(segments[i+1], "?")
+			return seg
+		}
+	}
+	return ""
+}
+
+// unescapeConsole decodes the console's "$25"-escaped fragment encoding,
+// where "/" appears as "$252F".
+func unescapeConsole(s string) string {
+	s = strings.ReplaceAll(s, "$", "%")
+	for range 2 {
+		decoded, err := url.PathUnescape(s)
+		if err != nil {
+			break
+		}
+		s = decoded
+	}
+	return s
+}
@@ -0,0 +1,120 @@
+package aws
+
+import "testing"
+
+func TestParseConsoleURL(t *testing.T) {
+	tests := []struct {
+		name    string
+		url     string
+		wantARN string
+	}{
+		{
+			name:    "EC2 instance",
+			url:     "https://us-east-1.console.aws.amazon.com/ec2/home?region=us-east-1#InstanceDetails:instanceId=i-0abc123",
+			wantARN: "arn:aws:ec2:us-east-1::instance/i-0abc123",
+		},
+		{
+			name:    "security group",
+			url:     "https://console.aws.amazon.com/ec2/home?region=eu-west-1#SecurityGroup:groupId=sg-123",
+			wantARN: "arn:aws:ec2:eu-west-1::security-group/sg-123",
+		},
+		{
+			name:    "VPC from regional host",
+			url:     "https://ap-northeast-1.console.aws.amazon.com/vpcconsole/home#VpcDetails:VpcId=vpc-123",
+			wantARN: "arn:aws:ec2:ap-northeast-1::vpc/vpc-123",
+		},
+		{
+			name:    "Lambda function",
+			url:     "https://us-east-1.console.aws.amazon.com/lambda/home?region=us-east-1#/functions/my-fn?tab=code",
+			wantARN: "arn:aws:lambda:us-east-1::function:my-fn",
+		},
+		{
+			name:    "S3 bucket",
+			url:     "https://s3.console.aws.amazon.com/s3/buckets/my-bucket?region=us-east-1&tab=objects",
+			wantARN: "arn:aws:s3:::my-bucket",
+		},
+		{
+			name:    "IAM role",
+			url:     "https://us-east-1.console.aws.amazon.com/iam/home#/roles/details/MyRole?section=permissions",
+			wantARN: "arn:aws:iam:::role/MyRole",
+		},
+		{
+			name:    "IAM managed policy embedded ARN",
+			url:     "https://us-east-1.console.aws.amazon.com/iam/home#/policies/details/arn%3Aaws%3Aiam%3A%3Aaws%3Apolicy%2FAdministratorAccess?section=permissions",
+			wantARN: "arn:aws:iam::aws:policy/AdministratorAccess",
+		},
+		{
+			name:    "RDS cluster",
+			url:     "https://us-east-1.console.aws.amazon.com/rds/home?region=us-east-1#database:id=my-cluster;is-cluster=true",
+			wantARN: "arn:aws:rds:us-east-1::cluster:my-cluster",
+		},
+		{
+			name:    "ECS service",
+			url:     "https://us-east-1.console.aws.amazon.com/ecs/v2/clusters/prod/services/api/health?region=us-east-1",
+			wantARN: "arn:aws:ecs:us-east-1::service/prod/api",
+		},
+		{
+			name:    "CloudFormation stack",
+			url:     "https://us-east-1.console.aws.amazon.com/cloudformation/home?region=us-east-1#/stacks/stackinfo?stackId=arn%3Aaws%3Acloudformation%3Aus-east-1%3A123456789012%3Astack%2Fmy-stack%2Fabc-123",
+			wantARN: "arn:aws:cloudformation:us-east-1:123456789012:stack/my-stack/abc-123",
+		},
+		{
+			name:    "log group",
+			url:     "https://us-east-1.console.aws.amazon.com/cloudwatch/home?region=us-east-1#logsV2:log-groups/log-group/$252Faws$252Flambda$252Fmy-fn/log-events",
+			wantARN: "arn:aws:logs:us-east-1::log-group:/aws/lambda/my-fn",
+		},
+		{
+			name:    "SQS queue",
+			url:     "https://us-east-1.console.aws.amazon.com/sqs/v3/home?region=us-east-1#/queues/https%3A%2F%2Fsqs.us-east-1.amazonaws.com%2F123456789012%2Fmy-queue",
+			wantARN: "arn:aws:sqs:us-east-1:123456789012:my-queue",
+		},
+		{
+			name:    "DynamoDB table",
+			url:     "https://us-east-1.console.aws.amazon.com/dynamodbv2/home?region=us-east-1#table?name=orders",
+			wantARN: "arn:aws:dynamodb:us-east-1::table/orders",
+		},
+		{
+			name:    "GovCloud partition",
+			url:     "https://console.amazonaws-us-gov.com/lambda/home?region=us-gov-west-1#/functions/fn",
+			wantARN: "arn:aws-us-gov:lambda:us-gov-west-1::function:fn",
+		},
+		{
+			name: "not a console URL",
+			url:  "https://example.com/ec2/home#InstanceDetails:instanceId=i-1",
+		},
+		{
+			name: "unrecognized page",
+			url:  "https://us-east-1.console.aws.amazon.com/billing/home",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ParseConsoleURL(tt.url)
+			if tt.wantARN == "" {
+				if got != nil {
+					t.Errorf("ParseConsoleURL() = %q, want nil", got.Raw)
+				}
+				return
+			}
+			if got == nil {
+				t.Fatalf("ParseConsoleURL() = nil, want %q", tt.wantARN)
+			}
+			if got.Raw != tt.wantARN {
+				t.Errorf("ParseConsoleURL() = %q, want %q", got.Raw, tt.wantARN)
+			}
+		})
+	}
+}
+
+func TestIsConsoleURL(t *testing.T) {
+	if !IsConsoleURL("https://us-east-1.console.aws.amazon.com/ec2/home") {
+		t.Error("regional console URL should be recognized")
+	}
+	if IsConsoleURL("https://aws.amazon.com/ec2/") {
+		t.Error("marketing site should not be a console URL")
+	}
+	if IsConsoleURL("ec2/instances") {
+		t.Error("plain command should not be a console URL")
+	}
+}
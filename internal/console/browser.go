@@ -0,0 +1,63 @@
+package console
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"runtime"
+)
+
+// lookPath and getenv are replaced in tests.
+var (
+	lookPath = exec.LookPath
+	getenv   = os.Getenv
+	goos     = runtime.GOOS
+)
+
+// browserCommand returns the command used to open a URL, or nil when no
+// browser is available (e.g. a headless host or an SSH session without a
+// display).
+func browserCommand() []string {
+	if b := getenv("BROWSER"); b != "" {
+		return []string{b}
+	}
+
+	switch goos {
+	case "darwin":
+		if getenv("SSH_CONNECTION") != "" {
+			return nil
+		}
+		return []string{"open"}
+	case "windows":
+		return []string{"rundll32", "url.dll,FileProtocolHandler"}
+	default:
+		if getenv("DISPLAY") == "" && getenv("WAYLAND_DISPLAY") == "" {
+			return nil
+		}
+		for _, opener := range []string{"xdg-open", "wslview"} {
+			if _, err := lookPath(opener); err == nil {
+				return []string{opener}
+			}
+		}
+		return nil
+	}
+}
+
+// HasBrowser reports whether OpenBrowser can open a URL on this host.
+func HasBrowser() bool {
+	return browserCommand() != nil
+}
+
+// OpenBrowser opens url in the user's browser without waiting for it to exit.
+func OpenBrowser(url string) error {
+	args := browserCommand()
+	if args == nil {
+		return fmt.Errorf("no browser available")
+	}
+	cmd := exec.Command(args[0], append(args[1:], url)...)
+	if err := cmd.Start(); err != nil {
+		return fmt.Errorf("open browser: %w", err)
+	}
+	go func() { _ = cmd.Wait() }()
+	return nil
+}
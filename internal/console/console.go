@@ -0,0 +1,378 @@
+// Package console builds AWS Management Console deep links for resources and
+// opens them, signing in through the federation endpoint for SSO profiles.
+package console
+
+import (
+	"net/url"
+	"strings"
+
+	"github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/config"
+	"github.com/clawscli/claws/internal/dao"
+)
+
+// ref carries the resource fields a link builder may need.
+type ref struct {
+	id     string
+	name   string
+	arn    *aws.ARN
+	rawARN string
+	region string
+	res    dao.Resource
+}
+
+// builder returns a console path (relative to the console host, without the
+// region parameter) for a resource, or "" if no deep link can be built.
+type builder func(r ref) string
+
+// services maps each registered service to its console landing page. Every
+// registered service must have an entry; resource types without a deep link
+// fall back to this page.
+var services = map[string]string{
+	"accessanalyzer":    "access-analyzer/home#/analyzers",
+	"acm":               "acm/home#/certificates/list",
+	"apigateway":        "apigateway/main/apis",
+	"apprunner":         "apprunner/home#/services",
+	"appsync":           "appsync/home#/apis",
+	"athena":            "athena/home#/workgroups",
+	"autoscaling":       "ec2/home#AutoScalingGroups:",
+	"backup":            "backup/home#/backupvaults",
+	"batch":             "batch/home#jobs",
+	"bedrock":           "bedrock/home#/overview",
+	"bedrock-agent":     "bedrock/home#/agents",
+	"bedrock-agentcore": "bedrock-agentcore/home#/",
+	"budgets":           "costmanagement/home#/budgets",
+	"cloudformation":    "cloudformation/home#/stacks",
+	"cloudfront":        "cloudfront/v4/home#/distributions",
+	"cloudtrail":        "cloudtrail/home#/trails",
+	"cloudwatch":        "cloudwatch/home#alarmsV2:",
+	"codebuild":         "codesuite/codebuild/projects",
+	"codepipeline":      "codesuite/codepipeline/pipelines",
+	"cognito":           "cognito/v2/idp/user-pools",
+	"computeoptimizer":  "compute-optimizer/home#/dashboard",
+	"config":            "config/home#/rules",
+	"costexplorer":      "costmanagement/home#/cost-explorer",
+	"datasync":          "datasync/home#/tasks",
+	"detective":         "detective/home#/",
+	"directconnect":     "directconnect/v2/home#/connections",
+	"dynamodb":          "dynamodbv2/home#tables",
+	"ec2":               "ec2/home#Instances:",
+	"ecr":               "ecr/private-registry/repositories",
+	"ecs":               "ecs/v2/clusters",
+	"elasticache":       "elasticache/home#/",
+	"elbv2":             "ec2/home#LoadBalancers:",
+	"emr":               "emr/home#/clusters",
+	"eventbridge":       "events/home#/rules",
+	"fms":               "wafv2/fmsv2/policies",
+	"glue":              "glue/home#/v2/getting-started",
+	"guardduty":         "guardduty/home#/findings",
+	"health":            "health/home#/account/dashboard/open-issues",
+	"iam":               "iam/home#/home",
+	"inspector2":        "inspector/v2/home#/findings",
+	"kinesis":           "kinesis/home#/streams/list",
+	"kms":               "kms/home#/kms/keys",
+	"lambda":            "lambda/home#/functions",
+	"license-manager":   "license-manager/home#/dashboard",
+	"macie":             "macie/home#/summary",
+	"network-firewall":  "vpcconsole/home#NetworkFirewalls:",
+	"opensearch":        "aos/home#opensearch/domains",
+	"organizations":     "organizations/v2/home/accounts",
+	"rds":               "rds/home#databases:",
+	"redshift":          "redshiftv2/home#clusters",
+	"risp":              "costmanagement/home#/savings-plans/overview",
+	"route53":           "route53/v2/hostedzones",
+	"s3":                "s3/buckets",
+	"s3vectors":         "s3/vector-buckets",
+	"sagemaker":         "sagemaker/home#/dashboard",
+	"secretsmanager":    "secretsmanager/listsecrets",
+	"securityhub":       "securityhub/home#/findings",
+	"service-quotas":    "servicequotas/home/services",
+	"sfn":               "states/home#/statemachines",
+	"sns":               "sns/v3/home#/topics",
+	"sqs":               "sqs/v3/home#/queues",
+	"ssm":               "systems-manager/parameters",
+	"transcribe":        "transcribe/home#jobs",
+	"transfer":          "transfer/home#/servers",
+	"trustedadvisor":    "trustedadvisor/home#/dashboard",
+	"vpc":               "vpcconsole/home#vpcs:",
+	"wafv2":             "wafv2/homev2/web-acls",
+	"xray":              "xray/home#/groups",
+}
+
+// globalServices are served from the partition's global console host and
+// take no region parameter.
+var globalServices = map[string]bool{
+	"budgets":        true,
+	"cloudfront":     true,
+	"costexplorer":   true,
+	"iam":            true,
+	"organizations":  true,
+	"risp":           true,
+	"route53":        true,
+	"trustedadvisor": true,
+}
+
+// links maps service/resource to a deep-link builder.
+var links = map[string]builder{
+	"acm/certificates":      func(r ref) string { return "acm/home#/certificates/" + r.arnShortID() },
+	"apigateway/http-apis":  func(r ref) string { return "apigateway/main/develop/routes?api=" + r.id },
+	"apigateway/rest-apis":  func(r ref) string { return "apigateway/main/apis/" + r.id + "/resources" },
+	"athena/workgroups":     func(r ref) string { return "athena/home#/workgroups/details/" + r.name },
+	"autoscaling/groups":    func(r ref) string { return "ec2/home#AutoScalingGroupDetails:id=" + r.name },
+	"backup/plans":          func(r ref) string { return "backup/home#/backupplan/details/" + r.id },
+	"backup/vaults":         func(r ref) string { return "backup/home#/backupvaults/details/" + r.name },
+	"cloudformation/stacks": func(r ref) string { return "cloudformation/home#/stacks/stackinfo?stackId=" + url.QueryEscape(r.id) },
+	"cloudfront/distributions": func(r ref) string {
+		return "cloudfront/v4/home#/distributions/" + r.id
+	},
+	"cloudtrail/trails":      func(r ref) string { return "cloudtrail/home#/trails/" + r.rawARN },
+	"cloudwatch/alarms":      func(r ref) string { return "cloudwatch/home#alarmsV2:alarm/" + url.PathEscape(r.name) },
+	"cloudwatch/log-groups":  func(r ref) string { return logsPath(r.id, "") },
+	"cloudwatch/log-streams": logStreamLink,
+	"codebuild/projects":     func(r ref) string { return "codesuite/codebuild/projects/" + r.name },
+	"codepipeline/pipelines": func(r ref) string { return "codesuite/codepipeline/pipelines/" + r.name + "/view" },
+	"cognito/user-pools":     func(r ref) string { return "cognito/v2/idp/user-pools/" + r.id + "/users" },
+	"dynamodb/tables":        func(r ref) string { return "dynamodbv2/home#table?name=" + r.name },
+	"ec2/capacity-reservations": func(r ref) string {
+		return "ec2/home#CapacityReservationDetails:crId=" + r.id
+	},
+	"ec2/elastic-ips":        func(r ref) string { return "ec2/home#ElasticIpDetails:AllocationId=" + r.id },
+	"ec2/images":             func(r ref) string { return "ec2/home#ImageDetails:imageId=" + r.id },
+	"ec2/instances":          func(r ref) string { return "ec2/home#InstanceDetails:instanceId=" + r.id },
+	"ec2/key-pairs":          func(r ref) string { return "ec2/home#KeyPairs:search=" + r.name },
+	"ec2/launch-templates":   func(r ref) string { return "ec2/home#LaunchTemplateDetails:launchTemplateId=" + r.id },
+	"ec2/network-interfaces": func(r ref) string { return "ec2/home#NetworkInterface:networkInterfaceId=" + r.id },
+	"ec2/security-groups":    func(r ref) string { return "ec2/home#SecurityGroup:groupId=" + r.id },
+	"ec2/snapshots":          func(r ref) string { return "ec2/home#SnapshotDetails:snapshotId=" + r.id },
+	"ec2/volumes":            func(r ref) string { return "ec2/home#VolumeDetails:volumeId=" + r.id },
+	"ecr/repositories": func(r ref) string {
+		if r.arn == nil {
+			return ""
+		}
+		return "ecr/repositories/private/" + r.arn.AccountID + "/" + r.name
+	},
+	"ecs/clusters":           func(r ref) string { return "ecs/v2/clusters/" + r.name + "/services" },
+	"ecs/services":           ecsLink("services", "health"),
+	"ecs/tasks":              ecsLink("tasks", "configuration"),
+	"elbv2/load-balancers":   func(r ref) string { return "ec2/home#LoadBalancer:loadBalancerArn=" + r.rawARN },
+	"elbv2/target-groups":    func(r ref) string { return "ec2/home#TargetGroup:targetGroupArn=" + r.rawARN },
+	"eventbridge/buses":      func(r ref) string { return "events/home#/eventbus/" + r.name },
+	"eventbridge/rules":      func(r ref) string { return "events/home#/rules/" + r.name },
+	"glue/crawlers":          func(r ref) string { return "glue/home#/v2/data-catalog/crawlers/view/" + r.name },
+	"glue/databases":         func(r ref) string { return "glue/home#/v2/data-catalog/databases/view/" + r.name },
+	"glue/jobs":              func(r ref) string { return "gluestudio/home#/editor/job/" + r.name + "/details" },
+	"iam/groups":             func(r ref) string { return "iam/home#/groups/details/" + r.name },
+	"iam/instance-profiles":  func(r ref) string { return "iam/home#/roles" },
+	"iam/policies":           func(r ref) string { return "iam/home#/policies/details/" + url.QueryEscape(r.rawARN) },
+	"iam/roles":              func(r ref) string { return "iam/home#/roles/details/" + r.name },
+	"iam/users":              func(r ref) string { return "iam/home#/users/details/" + r.name },
+	"kinesis/streams":        func(r ref) string { return "kinesis/home#/streams/details/" + r.name + "/monitoring" },
+	"kms/keys":               func(r ref) string { return "kms/home#/kms/keys/" + r.id },
+	"lambda/functions":       func(r ref) string { return "lambda/home#/functions/" + r.name },
+	"opensearch/domains":     func(r ref) string { return "aos/home#opensearch/domains/" + r.name },
+	"organizations/accounts": func(r ref) string { return "organizations/v2/home/accounts/" + r.id },
+	"rds/instances":          func(r ref) string { return "rds/home#database:id=" + r.id + ";is-cluster=false" },
+	"rds/snapshots":          func(r ref) string { return "rds/home#db-snapshot:id=" + r.id },
+	"redshift/clusters":      func(r ref) string { return "redshiftv2/home#cluster-details?cluster=" + r.id },
+	"route53/hosted-zones": func(r ref) string {
+		return "route53/v2/hostedzones#ListRecordSets/" + strings.TrimPrefix(r.id, "/hostedzone/")
+	},
+	"s3/buckets":              func(r ref) string { return "s3/buckets/" + r.name },
+	"s3/objects":              s3ObjectLink,
+	"s3/object-versions":      s3ObjectLink,
+	"sagemaker/endpoints":     func(r ref) string { return "sagemaker/home#/endpoints/" + r.name },
+	"sagemaker/models":        func(r ref) string { return "sagemaker/home#/models/" + r.name },
+	"sagemaker/notebooks":     func(r ref) string { return "sagemaker/home#/notebook-instances/" + r.name },
+	"sagemaker/training-jobs": func(r ref) string { return "sagemaker/home#/jobs/" + r.name },
+	"secretsmanager/secrets":  func(r ref) string { return "secretsmanager/secret?name=" + url.QueryEscape(r.name) },
+	"sfn/executions":          func(r ref) string { return "states/home#/v2/executions/details/" + r.rawARN },
+	"sfn/state-machines":      func(r ref) string { return "states/home#/statemachines/view/" + url.QueryEscape(r.rawARN) },
+	"sns/subscriptions":       func(r ref) string { return "sns/v3/home#/subscription/" + r.rawARN },
+	"sns/topics":              func(r ref) string { return "sns/v3/home#/topic/" + r.rawARN },
+	"sqs/queues":              sqsLink,
+	"ssm/parameters":          func(r ref) string { return "systems-manager/parameters/" + url.PathEscape(r.name) + "/description" },
+	"transfer/servers":        func(r ref) string { return "transfer/home#/servers/" + r.id },
+	"vpc/endpoints":           func(r ref) string { return "vpcconsole/home#EndpointDetails:vpcEndpointId=" + r.id },
+	"vpc/internet-gateways":   func(r ref) string { return "vpcconsole/home#InternetGateway:internetGatewayId=" + r.id },
+	"vpc/nat-gateways":        func(r ref) string { return "vpcconsole/home#NatGatewayDetails:natGatewayId=" + r.id },
+	"vpc/route-tables":        func(r ref) string { return "vpcconsole/home#RouteTableDetails:RouteTableId=" + r.id },
+	"vpc/subnets":             func(r ref) string { return "vpcconsole/home#SubnetDetails:subnetId=" + r.id },
+	"vpc/tgw-attachments": func(r ref) string {
+		return "vpcconsole/home#TransitGatewayAttachmentDetails:transitGatewayAttachmentId=" + r.id
+	},
+	"vpc/transit-gateways": func(r ref) string { return "vpcconsole/home#TransitGatewayDetails:transitGatewayId=" + r.id },
+	"vpc/vpcs":             func(r ref) string { return "vpcconsole/home#VpcDetails:VpcId=" + r.id },
+	"wafv2/web-acls":       func(r ref) string { return "wafv2/homev2/web-acls" },
+	"network-firewall/firewalls": func(r ref) string {
+		return "vpcconsole/home#NetworkFirewallDetails:arn=" + r.rawARN
+	},
+}
+
+// Supports reports whether the console has a page for service.
+func Supports(service string) bool {
+	_, ok := services[service]
+	return ok
+}
+
+// URL returns the console URL for a resource of the given type in region.
+// Resource types without a deep link get the service's landing page.
+// Returns "" for services with no console mapping.
+func URL(service, resType string, res dao.Resource, region string) string {
+	landing, ok := services[service]
+	if !ok {
+		return ""
+	}
+
+	r := newRef(res, region)
+	path := landing
+	if b, ok := links[service+"/"+resType]; ok && res != nil {
+		if p := b(r); p != "" {
+			path = p
+		}
+	}
+
+	partition := "aws"
+	if r.arn != nil && r.arn.Partition != "" {
+		partition = r.arn.Partition
+	}
+
+	if globalServices[service] {
+		return "https://" + host(partition, "") + "/" + path
+	}
+	return "https://" + host(partition, r.region) + "/" + withRegion(path, r.region)
+}
+
+func newRef(res dao.Resource, region string) ref {
+	r := ref{region: region}
+	if res == nil {
+		if r.region == "" {
+			r.region = config.Global().Region()
+		}
+		return r
+	}
+	inner := dao.UnwrapResource(res)
+	r.res = inner
+	r.id = inner.GetID()
+	r.name = inner.GetName()
+	if r.name == "" {
+		r.name = r.id
+	}
+	r.rawARN = inner.GetARN()
+	r.arn = aws.ParseARN(r.rawARN)
+	if r.region == "" {
+		r.region = dao.GetResourceRegion(res)
+	}
+	if r.region == "" && r.arn != nil {
+		r.region = r.arn.Region
+	}
+	if r.region == "" {
+		r.region = config.Global().Region()
+	}
+	return r
+}
+
+func (r ref) arnShortID() string {
+	if r.arn == nil {
+		return r.id
+	}
+	return r.arn.ShortID()
+}
+
+// host returns the console host for a partition. Commercial regions have
+// regional hosts; other partitions use a single host.
+func host(partition, region string) string {
+	switch partition {
+	case "aws-us-gov":
+		return "console.amazonaws-us-gov.com"
+	case "aws-cn":
+		return "console.amazonaws.cn"
+	default:
+		if region == "" {
+			return "console.aws.amazon.com"
+		}
+		return region + ".console.aws.amazon.com"
+	}
+}
+
+// withRegion inserts the region query parameter ahead of any fragment.
+func withRegion(path, region string) string {
+	if region == "" {
+		return path
+	}
+	base, fragment, hasFragment := strings.Cut(path, "#")
+	sep := "?"
+	if strings.Contains(base, "?") {
+		sep = "&"
+	}
+	base += sep + "region=" + url.QueryEscape(region)
+	if hasFragment {
+		return base + "#" + fragment
+	}
+	return base
+}
+
+// logsPath builds a CloudWatch Logs console path. The logs console escapes
+// path components twice, with "%" written as "$25".
+func logsPath(group, stream string) string {
+	p := "cloudwatch/home#logsV2:log-groups/log-group/" + consoleEscape(group)
+	if stream != "" {
+		p += "/log-events/" + consoleEscape(stream)
+	}
+	return p
+}
+
+func consoleEscape(s string) string {
+	return strings.ReplaceAll(url.QueryEscape(url.QueryEscape(s)), "%", "$")
+}
+
+func logStreamLink(r ref) string {
+	group := ""
+	if p, ok := r.res.(interface{ LogGroupName() string }); ok {
+		group = p.LogGroupName()
+	}
+	if group == "" && r.arn != nil {
+		// log-group:NAME:log-stream:STREAM
+		group, _, _ = strings.Cut(r.arn.ResourceID, ":log-stream:")
+	}
+	if group == "" {
+		return ""
+	}
+	return logsPath(group, r.name)
+}
+
+// ecsLink builds ECS service/task links; the cluster comes from the ARN
+// (service/cluster/name, task/cluster/id).
+func ecsLink(kind, tab string) builder {
+	return func(r ref) string {
+		if r.arn == nil {
+			return ""
+		}
+		cluster, _, ok := strings.Cut(r.arn.ResourceID, "/")
+		if !ok {
+			return ""
+		}
+		return "ecs/v2/clusters/" + cluster + "/" + kind + "/" + r.arn.ShortID() + "/" + tab
+	}
+}
+
+// s3ObjectLink opens an object's page, or a folder's listing, from its
+// "arn:aws:s3:::bucket/key" ARN.
+func s3ObjectLink(r ref) string {
+	_, path, ok := strings.Cut(r.rawARN, ":::")
+	if !ok {
+		return ""
+	}
+	bucket, key, _ := strings.Cut(path, "/")
+	if key == "" || strings.HasSuffix(key, "/") {
+		return "s3/buckets/" + bucket + "?prefix=" + url.QueryEscape(key)
+	}
+	return "s3/object/" + bucket + "?prefix=" + url.QueryEscape(key)
+}
+
+func sqsLink(r ref) string {
+	if r.arn == nil || r.region == "" {
+		return ""
+	}
+	queueURL := "https://sqs." + r.region + ".amazonaws.com/" + r.arn.AccountID + "/" + r.name
+	return "sqs/v3/home#/queues/" + url.QueryEscape(queueURL)
+}
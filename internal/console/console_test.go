@@ -0,0 +1,298 @@
+package console
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/config"
+	"github.com/clawscli/claws/internal/dao"
+)
+
+func TestURL(t *testing.T) {
+	tests := []struct {
+		name     string
+		service  string
+		resType  string
+		res      dao.Resource
+		region   string
+		expected string
+	}{
+		{
+			name:     "EC2 instance",
+			service:  "ec2",
+			resType:  "instances",
+			res:      &dao.BaseResource{ID: "i-123", ARN: "arn:aws:ec2:us-east-1:123456789012:instance/i-123"},
+			region:   "us-east-1",
+			expected: "https://us-east-1.console.aws.amazon.com/ec2/home?region=us-east-1#InstanceDetails:instanceId=i-123",
+		},
+		{
+			name:     "region from ARN",
+			service:  "lambda",
+			resType:  "functions",
+			res:      &dao.BaseResource{ID: "fn", Name: "fn", ARN: "arn:aws:lambda:eu-west-1:123456789012:function:fn"},
+			expected: "https://eu-west-1.console.aws.amazon.com/lambda/home?region=eu-west-1#/functions/fn",
+		},
+		{
+			name:     "wrapped resource region",
+			service:  "ec2",
+			resType:  "volumes",
+			res:      dao.WrapWithRegion(&dao.BaseResource{ID: "vol-1"}, "ap-northeast-1"),
+			expected: "https://ap-northeast-1.console.aws.amazon.com/ec2/home?region=ap-northeast-1#VolumeDetails:volumeId=vol-1",
+		},
+		{
+			name:     "global service",
+			service:  "iam",
+			resType:  "roles",
+			res:      &dao.BaseResource{ID: "AROA", Name: "MyRole", ARN: "arn:aws:iam::123456789012:role/MyRole"},
+			region:   "us-east-1",
+			expected: "https://console.aws.amazon.com/iam/home#/roles/details/MyRole",
+		},
+		{
+			name:     "fragment query",
+			service:  "dynamodb",
+			resType:  "tables",
+			res:      &dao.BaseResource{ID: "orders", Name: "orders"},
+			region:   "us-west-2",
+			expected: "https://us-west-2.console.aws.amazon.com/dynamodbv2/home?region=us-west-2#table?name=orders",
+		},
+		{
+			name:     "log group escaping",
+			service:  "cloudwatch",
+			resType:  "log-groups",
+			res:      &dao.BaseResource{ID: "/aws/lambda/fn", Name: "fn"},
+			region:   "us-east-1",
+			expected: "https://us-east-1.console.aws.amazon.com/cloudwatch/home?region=us-east-1#logsV2:log-groups/log-group/$252Faws$252Flambda$252Ffn",
+		},
+		{
+			name:     "ECS service cluster from ARN",
+			service:  "ecs",
+			resType:  "services",
+			res:      &dao.BaseResource{ID: "api", Name: "api", ARN: "arn:aws:ecs:us-east-1:123456789012:service/prod/api"},
+			expected: "https://us-east-1.console.aws.amazon.com/ecs/v2/clusters/prod/services/api/health?region=us-east-1",
+		},
+		{
+			name:     "GovCloud partition",
+			service:  "lambda",
+			resType:  "functions",
+			res:      &dao.BaseResource{ID: "fn", Name: "fn", ARN: "arn:aws-us-gov:lambda:us-gov-west-1:123456789012:function:fn"},
+			expected: "https://console.amazonaws-us-gov.com/lambda/home?region=us-gov-west-1#/functions/fn",
+		},
+		{
+			name:     "S3 object",
+			service:  "s3",
+			resType:  "objects",
+			res:      &dao.BaseResource{ID: "logs/2024/app.log", ARN: "arn:aws:s3:::logs/2024/app.log"},
+			region:   "us-east-1",
+			expected: "https://us-east-1.console.aws.amazon.com/s3/object/logs?prefix=2024%2Fapp.log&region=us-east-1",
+		},
+		{
+			name:     "S3 folder",
+			service:  "s3",
+			resType:  "objects",
+			res:      &dao.BaseResource{ID: "logs/2024/", ARN: "arn:aws:s3:::logs/2024/"},
+			region:   "us-east-1",
+			expected: "https://us-east-1.console.aws.amazon.com/s3/buckets/logs?prefix=2024%2F&region=us-east-1",
+		},
+		{
+			name:     "landing page fallback",
+			service:  "guardduty",
+			resType:  "detectors",
+			res:      &dao.BaseResource{ID: "abc"},
+			region:   "us-east-1",
+			expected: "https://us-east-1.console.aws.amazon.com/guardduty/home?region=us-east-1#/findings",
+		},
+		{
+			name:    "unknown service",
+			service: "nope",
+			resType: "things",
+			res:     &dao.BaseResource{ID: "x"},
+			region:  "us-east-1",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := URL(tt.service, tt.resType, tt.res, tt.region); got != tt.expected {
+				t.Errorf("URL() = %q, want %q", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestURL_RoundTrip(t *testing.T) {
+	// Links built here should be recognized by the console URL parser
+	res := &dao.BaseResource{ID: "i-abc", ARN: "arn:aws:ec2:us-east-1:123456789012:instance/i-abc"}
+	arn := aws.ParseConsoleURL(URL("ec2", "instances", res, ""))
+	if arn == nil || arn.ResourceID != "i-abc" || arn.Region != "us-east-1" {
+		t.Errorf("ParseConsoleURL(URL()) = %+v", arn)
+	}
+}
+
+func TestSignInToken(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("Action") != "getSigninToken" {
+			t.Errorf("Action = %q", r.URL.Query().Get("Action"))
+		}
+		if !strings.Contains(r.URL.Query().Get("Session"), `"sessionToken":"token"`) {
+			t.Errorf("Session = %q", r.URL.Query().Get("Session"))
+		}
+		_, _ = w.Write([]byte(`{"SigninToken":"abc"}`))
+	}))
+	defer srv.Close()
+
+	token, err := signinToken(context.Background(), srv.URL, "AKIA", "secret", "token")
+	if err != nil {
+		t.Fatalf("signinToken() error = %v", err)
+	}
+	if token != "abc" {
+		t.Errorf("signinToken() = %q, want abc", token)
+	}
+
+	failing := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+	}))
+	defer failing.Close()
+	if _, err := signinToken(context.Background(), failing.URL, "AKIA", "secret", "token"); err == nil {
+		t.Error("expected error for failed sign-in token request")
+	}
+}
+
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(r *http.Request) (*http.Response, error) {
+	return f(r)
+}
+
+func TestFederatedURL(t *testing.T) {
+	t.Setenv("AWS_ACCESS_KEY_ID", "AKIA")
+	t.Setenv("AWS_SECRET_ACCESS_KEY", "secret")
+	t.Setenv("AWS_SESSION_TOKEN", "token")
+	t.Setenv("AWS_REGION", "us-gov-west-1")
+
+	orig := httpClient
+	defer func() { httpClient = orig }()
+	var requests []*url.URL
+	httpClient = &http.Client{Transport: roundTripFunc(func(r *http.Request) (*http.Response, error) {
+		requests = append(requests, r.URL)
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Status:     "200 OK",
+			Header:     make(http.Header),
+			Body:       io.NopCloser(strings.NewReader(`{"SigninToken":"signin-abc"}`)),
+		}, nil
+	})}
+
+	ctx := aws.WithSelectionOverride(context.Background(), config.EnvOnly())
+	destination := "https://console.amazonaws-us-gov.com/ec2/home?region=us-gov-west-1"
+	got, err := FederatedURL(ctx, destination)
+	if err != nil {
+		t.Fatalf("FederatedURL() error = %v", err)
+	}
+
+	if len(requests) != 1 {
+		t.Fatalf("sign-in token requests = %d, want 1", len(requests))
+	}
+	tokenReq := requests[0]
+	if tokenReq.Host != "signin.amazonaws-us-gov.com" || tokenReq.Query().Get("Action") != "getSigninToken" {
+		t.Errorf("token request = %s, want getSigninToken on the GovCloud endpoint", tokenReq)
+	}
+	for _, want := range []string{`"sessionId":"AKIA"`, `"sessionKey":"secret"`, `"sessionToken":"token"`} {
+		if !strings.Contains(tokenReq.Query().Get("Session"), want) {
+			t.Errorf("Session = %q, missing %s", tokenReq.Query().Get("Session"), want)
+		}
+	}
+
+	u, err := url.Parse(got)
+	if err != nil {
+		t.Fatalf("FederatedURL() = %q: %v", got, err)
+	}
+	q := u.Query()
+	if u.Host != "signin.amazonaws-us-gov.com" || q.Get("Action") != "login" || q.Get("Issuer") != federationIssuer {
+		t.Errorf("FederatedURL() = %q, want a login URL on the GovCloud endpoint", got)
+	}
+	if q.Get("Destination") != destination || q.Get("SigninToken") != "signin-abc" {
+		t.Errorf("Destination = %q, SigninToken = %q", q.Get("Destination"), q.Get("SigninToken"))
+	}
+
+	// Long-term keys cannot be federated, and no token is requested
+	t.Setenv("AWS_SESSION_TOKEN", "")
+	if _, err := FederatedURL(ctx, destination); err == nil || !strings.Contains(err.Error(), "temporary credentials") {
+		t.Errorf("FederatedURL() with static keys error = %v, want temporary credentials required", err)
+	}
+	if len(requests) != 1 {
+		t.Errorf("sign-in token requested for static keys")
+	}
+}
+
+func TestNeedsFederation(t *testing.T) {
+	orig := loadProfiles
+	defer func() { loadProfiles = orig }()
+	loadProfiles = func() ([]aws.ProfileInfo, error) {
+		return []aws.ProfileInfo{{Name: "sso", IsSSO: true}, {Name: "static"}}, nil
+	}
+
+	tests := []struct {
+		profile string
+		want    bool
+	}{
+		{"sso", true},
+		{"static", false},
+		{"missing", false},
+	}
+	for _, tt := range tests {
+		ctx := aws.WithSelectionOverride(context.Background(), config.NamedProfile(tt.profile))
+		if got := NeedsFederation(ctx); got != tt.want {
+			t.Errorf("NeedsFederation(%s) = %v, want %v", tt.profile, got, tt.want)
+		}
+	}
+}
+
+func TestPartitionOf(t *testing.T) {
+	tests := map[string]string{
+		"https://us-east-1.console.aws.amazon.com/ec2/home": "aws",
+		"https://console.amazonaws-us-gov.com/ec2/home":     "aws-us-gov",
+		"https://console.amazonaws.cn/ec2/home":             "aws-cn",
+		"://bad":                                            "aws",
+	}
+	for in, want := range tests {
+		if got := partitionOf(in); got != want {
+			t.Errorf("partitionOf(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func TestBrowserCommand(t *testing.T) {
+	origLook, origEnv, origOS := lookPath, getenv, goos
+	defer func() { lookPath, getenv, goos = origLook, origEnv, origOS }()
+
+	env := map[string]string{}
+	getenv = func(k string) string { return env[k] }
+	lookPath = func(string) (string, error) { return "/usr/bin/xdg-open", nil }
+
+	goos = "linux"
+	if HasBrowser() {
+		t.Error("linux without a display should have no browser")
+	}
+	env["DISPLAY"] = ":0"
+	if cmd := browserCommand(); len(cmd) != 1 || cmd[0] != "xdg-open" {
+		t.Errorf("browserCommand() = %v, want xdg-open", cmd)
+	}
+
+	env["BROWSER"] = "firefox"
+	if cmd := browserCommand(); len(cmd) != 1 || cmd[0] != "firefox" {
+		t.Errorf("browserCommand() = %v, want $BROWSER", cmd)
+	}
+
+	delete(env, "BROWSER")
+	goos = "darwin"
+	env["SSH_CONNECTION"] = "1.2.3.4 22"
+	if HasBrowser() {
+		t.Error("darwin over SSH should have no browser")
+	}
+}
@@ -0,0 +1,144 @@
+package console
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/config"
+	apperrors "github.com/clawscli/claws/internal/errors"
+)
+
+// federationIssuer identifies claws to the sign-in endpoint.
+const federationIssuer = "claws"
+
+// signinEndpoints maps partitions to their federation endpoint.
+var signinEndpoints = map[string]string{
+	"aws":        "https://signin.aws.amazon.com/federation",
+	"aws-us-gov": "https://signin.amazonaws-us-gov.com/federation",
+	"aws-cn":     "https://signin.amazonaws.cn/federation",
+}
+
+var httpClient = &http.Client{Timeout: 15 * time.Second}
+
+// loadProfiles is replaced in tests.
+var loadProfiles = aws.LoadProfiles
+
+// NeedsFederation reports whether the profile in use for ctx is an SSO
+// profile, whose console sessions must go through a federated sign-in URL
+// to land in the right account.
+func NeedsFederation(ctx context.Context) bool {
+	sel := config.Global().Selection()
+	if ctxSel, ok := aws.GetSelectionFromContext(ctx); ok {
+		sel = ctxSel
+	}
+	if !sel.IsNamedProfile() {
+		return false
+	}
+
+	profiles, err := loadProfiles()
+	if err != nil {
+		return false
+	}
+	for _, p := range profiles {
+		if p.Name == sel.ProfileName {
+			return p.IsSSO
+		}
+	}
+	return false
+}
+
+// FederatedURL exchanges the temporary credentials of the profile in use for
+// ctx for a console sign-in token, and returns a sign-in URL that opens
+// destination. The URL is valid for 15 minutes.
+func FederatedURL(ctx context.Context, destination string) (string, error) {
+	cfg, err := aws.NewConfig(ctx)
+	if err != nil {
+		return "", err
+	}
+	creds, err := cfg.Credentials.Retrieve(ctx)
+	if err != nil {
+		return "", apperrors.Wrap(err, "retrieve credentials")
+	}
+	if creds.SessionToken == "" {
+		return "", fmt.Errorf("console sign-in requires temporary credentials")
+	}
+
+	endpoint := signinEndpoints[partitionOf(destination)]
+	token, err := signinToken(ctx, endpoint, creds.AccessKeyID, creds.SecretAccessKey, creds.SessionToken)
+	if err != nil {
+		return "", err
+	}
+
+	q := url.Values{}
+	q.Set("Action", "login")
+	q.Set("Issuer", federationIssuer)
+	q.Set("Destination", destination)
+	q.Set("SigninToken", token)
+	return endpoint + "?" + q.Encode(), nil
+}
+
+func signinToken(ctx context.Context, endpoint, accessKeyID, secretAccessKey, sessionToken string) (string, error) {
+	session, err := json.Marshal(map[string]string{
+		"sessionId":    accessKeyID,
+		"sessionKey":   secretAccessKey,
+		"sessionToken": sessionToken,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	q := url.Values{}
+	q.Set("Action", "getSigninToken")
+	q.Set("Session", string(session))
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint+"?"+q.Encode(), nil)
+	if err != nil {
+		return "", err
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return "", apperrors.Wrap(err, "get sign-in token")
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 64*1024))
+	if err != nil {
+		return "", apperrors.Wrap(err, "read sign-in token")
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("get sign-in token: %s", resp.Status)
+	}
+
+	var out struct {
+		SigninToken string `json:"SigninToken"`
+	}
+	if err := json.Unmarshal(body, &out); err != nil {
+		return "", apperrors.Wrap(err, "parse sign-in token")
+	}
+	if out.SigninToken == "" {
+		return "", fmt.Errorf("get sign-in token: empty token")
+	}
+	return out.SigninToken, nil
+}
+
+// partitionOf returns the partition of a console URL.
+func partitionOf(consoleURL string) string {
+	u, err := url.Parse(consoleURL)
+	if err != nil {
+		return "aws"
+	}
+	switch {
+	case u.Host == "console.amazonaws-us-gov.com":
+		return "aws-us-gov"
+	case u.Host == "console.amazonaws.cn":
+		return "aws-cn"
+	default:
+		return "aws"
+	}
+}
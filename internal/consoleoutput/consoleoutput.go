@@ -0,0 +1,109 @@
+// Package consoleoutput reads an EC2 instance's serial console output for
+// the console output view: the boot log used to diagnose failed status
+// checks.
+package consoleoutput
+
+import (
+	"context"
+	"encoding/base64"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	apperrors "github.com/clawscli/claws/internal/errors"
+)
+
+// Target identifies the instance whose console output is shown.
+type Target struct {
+	InstanceID string
+	Name       string
+}
+
+// Provider is implemented by resources with console output.
+type Provider interface {
+	ConsoleTarget() Target
+}
+
+// Client is the subset of the EC2 API used by this package.
+type Client interface {
+	GetConsoleOutput(ctx context.Context, params *ec2.GetConsoleOutputInput, optFns ...func(*ec2.Options)) (*ec2.GetConsoleOutputOutput, error)
+}
+
+// NewClient creates an EC2 client for the current profile and region.
+func NewClient(ctx context.Context) (Client, error) {
+	cfg, err := appaws.NewConfig(ctx)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new ec2 client")
+	}
+	return ec2.NewFromConfig(cfg), nil
+}
+
+// Output is an instance's decoded console output.
+type Output struct {
+	Text      string
+	Timestamp time.Time
+	Latest    bool // the most recent output, not the buffered output from the last boot
+}
+
+// Lines returns the output split into lines.
+func (o Output) Lines() []string {
+	if o.Text == "" {
+		return nil
+	}
+	return strings.Split(strings.TrimRight(o.Text, "\n"), "\n")
+}
+
+// Load returns the instance's console output. The most recent output is
+// only available on Nitro instances; other instances return the output
+// buffered at their last boot or stop.
+func Load(ctx context.Context, c Client, t Target) (Output, error) {
+	output, err := c.GetConsoleOutput(ctx, &ec2.GetConsoleOutputInput{
+		InstanceId: &t.InstanceID,
+		Latest:     appaws.BoolPtr(true),
+	})
+	latest := true
+	if apperrors.GetErrorCode(err) == "UnsupportedOperation" {
+		latest = false
+		output, err = c.GetConsoleOutput(ctx, &ec2.GetConsoleOutputInput{InstanceId: &t.InstanceID})
+	}
+	if err != nil {
+		return Output{}, apperrors.Wrapf(err, "get console output of %s", t.InstanceID)
+	}
+
+	out := Output{Latest: latest}
+	if output.Timestamp != nil {
+		out.Timestamp = *output.Timestamp
+	}
+	if encoded := appaws.Str(output.Output); encoded != "" {
+		data, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return Output{}, apperrors.Wrapf(err, "decode console output of %s", t.InstanceID)
+		}
+		out.Text = Clean(string(data))
+	}
+	return out, nil
+}
+
+// escapes matches terminal escape sequences: CSI (colors, cursor moves)
+// and OSC (titles).
+var escapes = regexp.MustCompile(`\x1b\[[0-9;?]*[ -/]*[@-~]|\x1b\][^\x07\x1b]*(\x07|\x1b\\)|\x1b[@-Z\\-_]`)
+
+// Clean makes console output safe to show in the TUI: escape sequences and
+// carriage returns are dropped and other control characters except tabs
+// removed.
+func Clean(s string) string {
+	s = escapes.ReplaceAllString(s, "")
+	s = strings.ReplaceAll(s, "\r\n", "\n")
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r == '\n' || r == '\t':
+			return r
+		case r < 0x20 || r == 0x7f:
+			return -1
+		}
+		return r
+	}, s)
+}
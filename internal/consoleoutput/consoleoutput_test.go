@@ -0,0 +1,70 @@
+package consoleoutput
+
+import (
+	"context"
+	"encoding/base64"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/smithy-go"
+)
+
+type fakeClient struct {
+	nitro bool
+	calls []*ec2.GetConsoleOutputInput
+}
+
+func (f *fakeClient) GetConsoleOutput(_ context.Context, in *ec2.GetConsoleOutputInput, _ ...func(*ec2.Options)) (*ec2.GetConsoleOutputOutput, error) {
+	f.calls = append(f.calls, in)
+	if aws.ToBool(in.Latest) && !f.nitro {
+		return nil, &smithy.GenericAPIError{Code: "UnsupportedOperation", Message: "latest is not supported"}
+	}
+	ts := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	text := "\x1b[32m[  OK  ]\x1b[0m Started sshd.\r\nKernel panic - not syncing\r\n"
+	return &ec2.GetConsoleOutputOutput{
+		Output:    aws.String(base64.StdEncoding.EncodeToString([]byte(text))),
+		Timestamp: &ts,
+	}, nil
+}
+
+func TestLoad(t *testing.T) {
+	c := &fakeClient{nitro: true}
+	out, err := Load(context.Background(), c, Target{InstanceID: "i-1"})
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if !out.Latest || len(c.calls) != 1 {
+		t.Errorf("latest = %v, calls = %d", out.Latest, len(c.calls))
+	}
+	lines := out.Lines()
+	if len(lines) != 2 || lines[0] != "[  OK  ] Started sshd." || lines[1] != "Kernel panic - not syncing" {
+		t.Errorf("Lines() = %q", lines)
+	}
+}
+
+func TestLoad_FallsBackWithoutLatest(t *testing.T) {
+	c := &fakeClient{}
+	out, err := Load(context.Background(), c, Target{InstanceID: "i-1"})
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if out.Latest || len(c.calls) != 2 || c.calls[1].Latest != nil {
+		t.Errorf("latest = %v, calls = %+v", out.Latest, c.calls)
+	}
+}
+
+func TestClean(t *testing.T) {
+	tests := map[string]string{
+		"plain\ttext":                 "plain\ttext",
+		"\x1b]0;title\x07boot":        "boot",
+		"a\x00b\x08c":                 "abc",
+		"\x1b[1;31mred\x1b[m\r\nnext": "red\nnext",
+	}
+	for in, want := range tests {
+		if got := Clean(in); got != want {
+			t.Errorf("Clean(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
@@ -208,3 +208,45 @@ func GetFilterFromContext(ctx context.Context, key string) string {
 	}
 	return ""
 }
+
+type markedContextKey struct{}
+
+// WithMarked adds the resource marked in the browser to the context, so
+// actions declaring action.Action.UsesMarked can include it.
+func WithMarked(ctx context.Context, res Resource) context.Context {
+	return context.WithValue(ctx, markedContextKey{}, res)
+}
+
+// MarkedFromContext returns the marked resource, or nil
+func MarkedFromContext(ctx context.Context) Resource {
+	res, _ := ctx.Value(markedContextKey{}).(Resource)
+	return res
+}
+
+// ListAll retrieves resources from a DAO, following pages for PaginatedDAO
+// implementations until exhausted or maxPages is reached (0 = no limit).
+// Plain DAOs fall back to a single List call.
+func ListAll(ctx context.Context, d DAO, pageSize, maxPages int) ([]Resource, error) {
+	pagDAO, ok := d.(PaginatedDAO)
+	if !ok {
+		return d.List(ctx)
+	}
+
+	var all []Resource
+	token := ""
+	for page := 0; maxPages == 0 || page < maxPages; page++ {
+		resources, next, err := pagDAO.ListPage(ctx, pageSize, token)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, resources...)
+		if next == "" {
+			break
+		}
+		token = next
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+	}
+	return all, nil
+}
@@ -88,6 +88,16 @@ func TestGetFilterFromContext_NotFound(t *testing.T) {
 	}
 }
 
+func TestWithMarked(t *testing.T) {
+	if MarkedFromContext(context.Background()) != nil {
+		t.Error("MarkedFromContext() without a mark should be nil")
+	}
+	marked := &BaseResource{ID: "i-123"}
+	if got := MarkedFromContext(WithMarked(context.Background(), marked)); got != marked {
+		t.Errorf("MarkedFromContext() = %v, want %v", got, marked)
+	}
+}
+
 func TestWithFilter_MultipleFilters(t *testing.T) {
 	ctx := context.Background()
 	ctx = WithFilter(ctx, "VpcId", "vpc-123")
@@ -420,3 +430,56 @@ func TestRegionalResourcePreservesData(t *testing.T) {
 		}
 	}
 }
+
+type pagedDAO struct {
+	BaseDAO
+	pages [][]Resource
+	calls int
+}
+
+func (d *pagedDAO) List(_ context.Context) ([]Resource, error) { return nil, nil }
+func (d *pagedDAO) Get(_ context.Context, _ string) (Resource, error) {
+	return nil, nil
+}
+func (d *pagedDAO) Delete(_ context.Context, _ string) error { return nil }
+
+func (d *pagedDAO) ListPage(_ context.Context, _ int, token string) ([]Resource, string, error) {
+	d.calls++
+	idx := 0
+	if token != "" {
+		idx = int(token[0] - '0')
+	}
+	next := ""
+	if idx+1 < len(d.pages) {
+		next = string(rune('0' + idx + 1))
+	}
+	return d.pages[idx], next, nil
+}
+
+func TestListAll(t *testing.T) {
+	d := &pagedDAO{
+		BaseDAO: NewBaseDAO("svc", "res"),
+		pages: [][]Resource{
+			{&BaseResource{ID: "a"}, &BaseResource{ID: "b"}},
+			{&BaseResource{ID: "c"}},
+			{&BaseResource{ID: "d"}},
+		},
+	}
+
+	all, err := ListAll(context.Background(), d, 2, 0)
+	if err != nil {
+		t.Fatalf("ListAll() error = %v", err)
+	}
+	if len(all) != 4 || d.calls != 3 {
+		t.Errorf("ListAll() = %d resources in %d calls, want 4 in 3", len(all), d.calls)
+	}
+
+	d.calls = 0
+	all, err = ListAll(context.Background(), d, 2, 2)
+	if err != nil {
+		t.Fatalf("ListAll() error = %v", err)
+	}
+	if len(all) != 3 || d.calls != 2 {
+		t.Errorf("ListAll(maxPages=2) = %d resources in %d calls, want 3 in 2", len(all), d.calls)
+	}
+}
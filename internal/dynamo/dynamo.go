@@ -0,0 +1,336 @@
+// Package dynamo reads and writes DynamoDB items for the item explorer:
+// Query on a table or index key, paged Scan with a filter, and conversion
+// between items and plain or DynamoDB JSON.
+package dynamo
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	apperrors "github.com/clawscli/claws/internal/errors"
+)
+
+// DefaultLimit is the number of items fetched per Fetch call.
+const DefaultLimit = 100
+
+// maxCalls bounds the Query or Scan calls per Fetch, since a selective
+// filter can return many empty pages.
+const maxCalls = 20
+
+// Item is a DynamoDB item.
+type Item = map[string]types.AttributeValue
+
+// Key is a key attribute and its scalar type: "S", "N" or "B".
+type Key struct {
+	Name string
+	Type string
+}
+
+// Index is the key schema of a table or one of its secondary indexes.
+type Index struct {
+	Name         string // empty for the table itself
+	Kind         string // "", "GSI" or "LSI"
+	PartitionKey Key
+	SortKey      Key // Name is empty without a sort key
+}
+
+// Label returns the index name and kind, or "table" for the table.
+func (i Index) Label() string {
+	if i.Name == "" {
+		return "table"
+	}
+	return i.Name + " (" + i.Kind + ")"
+}
+
+// Table describes a table's key schema.
+type Table struct {
+	Name    string
+	Key     Index   // the table's primary key
+	Indexes []Index // secondary indexes
+}
+
+// Provider is implemented by resources whose items can be explored.
+type Provider interface {
+	ItemTable() Table
+}
+
+// TableFromDescription builds the key schema of a described table.
+func TableFromDescription(desc types.TableDescription) Table {
+	attrTypes := make(map[string]string, len(desc.AttributeDefinitions))
+	for _, def := range desc.AttributeDefinitions {
+		attrTypes[appaws.Str(def.AttributeName)] = string(def.AttributeType)
+	}
+	index := func(name, kind string, schema []types.KeySchemaElement) Index {
+		idx := Index{Name: name, Kind: kind}
+		for _, el := range schema {
+			key := Key{Name: appaws.Str(el.AttributeName), Type: attrTypes[appaws.Str(el.AttributeName)]}
+			if el.KeyType == types.KeyTypeHash {
+				idx.PartitionKey = key
+			} else {
+				idx.SortKey = key
+			}
+		}
+		return idx
+	}
+
+	t := Table{
+		Name: appaws.Str(desc.TableName),
+		Key:  index("", "", desc.KeySchema),
+	}
+	for _, gsi := range desc.GlobalSecondaryIndexes {
+		t.Indexes = append(t.Indexes, index(appaws.Str(gsi.IndexName), "GSI", gsi.KeySchema))
+	}
+	for _, lsi := range desc.LocalSecondaryIndexes {
+		t.Indexes = append(t.Indexes, index(appaws.Str(lsi.IndexName), "LSI", lsi.KeySchema))
+	}
+	return t
+}
+
+// AllIndexes returns the table's primary key followed by its secondary
+// indexes.
+func (t Table) AllIndexes() []Index {
+	return append([]Index{t.Key}, t.Indexes...)
+}
+
+// Index returns the index with name, or the table's key for "".
+func (t Table) Index(name string) (Index, bool) {
+	for _, idx := range t.AllIndexes() {
+		if idx.Name == name {
+			return idx, true
+		}
+	}
+	return Index{}, false
+}
+
+// ItemKey returns the primary key attributes of item.
+func (t Table) ItemKey(item Item) (Item, error) {
+	key := Item{}
+	for _, k := range []Key{t.Key.PartitionKey, t.Key.SortKey} {
+		if k.Name == "" {
+			continue
+		}
+		v, ok := item[k.Name]
+		if !ok {
+			return nil, fmt.Errorf("item has no key attribute %q", k.Name)
+		}
+		key[k.Name] = v
+	}
+	return key, nil
+}
+
+// NewItem returns an item with the table's key attributes set to empty
+// values, as a template for a new item.
+func (t Table) NewItem() Item {
+	item := Item{}
+	for _, k := range []Key{t.Key.PartitionKey, t.Key.SortKey} {
+		switch {
+		case k.Name == "":
+		case k.Type == "N":
+			item[k.Name] = &types.AttributeValueMemberN{Value: "0"}
+		case k.Type == "B":
+			item[k.Name] = &types.AttributeValueMemberB{Value: []byte{}}
+		default:
+			item[k.Name] = &types.AttributeValueMemberS{Value: ""}
+		}
+	}
+	return item
+}
+
+// Client is the subset of the DynamoDB API used by this package.
+type Client interface {
+	Query(ctx context.Context, params *dynamodb.QueryInput, optFns ...func(*dynamodb.Options)) (*dynamodb.QueryOutput, error)
+	Scan(ctx context.Context, params *dynamodb.ScanInput, optFns ...func(*dynamodb.Options)) (*dynamodb.ScanOutput, error)
+	PutItem(ctx context.Context, params *dynamodb.PutItemInput, optFns ...func(*dynamodb.Options)) (*dynamodb.PutItemOutput, error)
+	DeleteItem(ctx context.Context, params *dynamodb.DeleteItemInput, optFns ...func(*dynamodb.Options)) (*dynamodb.DeleteItemOutput, error)
+}
+
+// NewClient creates a DynamoDB client for the profile and region in ctx.
+func NewClient(ctx context.Context) (*dynamodb.Client, error) {
+	cfg, err := appaws.NewConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return dynamodb.NewFromConfig(cfg), nil
+}
+
+// Sort key conditions for Request.SortOp
+var SortOps = []string{"", "=", "<", "<=", ">", ">=", "begins_with", "between"}
+
+// Request selects items. It is a Query when PartitionValue is set and a
+// Scan otherwise.
+type Request struct {
+	Index          string // index name; empty for the table
+	PartitionValue string
+	SortOp         string // one of SortOps; empty for no sort key condition
+	SortValue      string
+	SortValue2     string // upper bound for "between"
+	Filter         string // see parseFilter
+	Limit          int    // stop after this many items (0: DefaultLimit)
+	StartKey       Item   // continue after this key
+}
+
+// IsQuery reports whether r runs a Query rather than a Scan.
+func (r Request) IsQuery() bool {
+	return r.PartitionValue != ""
+}
+
+// Page is a batch of items. LastKey is set when more items may follow.
+type Page struct {
+	Items   []Item
+	LastKey Item
+	Scanned int32 // items read before the filter
+}
+
+// Fetch runs r against table, reading pages until Limit items match or
+// the results end.
+func Fetch(ctx context.Context, client Client, table Table, r Request) (Page, error) {
+	idx, ok := table.Index(r.Index)
+	if !ok {
+		return Page{}, fmt.Errorf("unknown index: %s", r.Index)
+	}
+	limit := r.Limit
+	if limit <= 0 {
+		limit = DefaultLimit
+	}
+
+	b := newExprBuilder()
+	var keyCond string
+	if r.IsQuery() {
+		var err error
+		if keyCond, err = b.keyCondition(idx, r); err != nil {
+			return Page{}, err
+		}
+	} else if r.SortOp != "" {
+		return Page{}, fmt.Errorf("a sort key condition needs a partition key value")
+	}
+	filter, err := parseFilter(r.Filter, b)
+	if err != nil {
+		return Page{}, err
+	}
+
+	var page Page
+	startKey := r.StartKey
+	for range maxCalls {
+		var (
+			items   []Item
+			lastKey Item
+			scanned int32
+		)
+		want := int32(limit - len(page.Items))
+		if r.IsQuery() {
+			input := &dynamodb.QueryInput{
+				TableName:                 &table.Name,
+				KeyConditionExpression:    &keyCond,
+				ExpressionAttributeNames:  b.names,
+				ExpressionAttributeValues: b.values,
+				ExclusiveStartKey:         startKey,
+				Limit:                     &want,
+			}
+			if idx.Name != "" {
+				input.IndexName = &idx.Name
+			}
+			if filter != "" {
+				input.FilterExpression = &filter
+			}
+			output, err := client.Query(ctx, input)
+			if err != nil {
+				return page, apperrors.Wrapf(err, "query %s", table.Name)
+			}
+			items, lastKey, scanned = output.Items, output.LastEvaluatedKey, output.ScannedCount
+		} else {
+			input := &dynamodb.ScanInput{
+				TableName:         &table.Name,
+				ExclusiveStartKey: startKey,
+				Limit:             &want,
+			}
+			if idx.Name != "" {
+				input.IndexName = &idx.Name
+			}
+			if filter != "" {
+				input.FilterExpression = &filter
+				input.ExpressionAttributeNames = b.names
+				input.ExpressionAttributeValues = b.values
+			}
+			output, err := client.Scan(ctx, input)
+			if err != nil {
+				return page, apperrors.Wrapf(err, "scan %s", table.Name)
+			}
+			items, lastKey, scanned = output.Items, output.LastEvaluatedKey, output.ScannedCount
+		}
+
+		page.Items = append(page.Items, items...)
+		page.Scanned += scanned
+		page.LastKey = lastKey
+		startKey = lastKey
+		if len(lastKey) == 0 || len(page.Items) >= limit {
+			break
+		}
+	}
+	return page, nil
+}
+
+// PutItem writes item to table, replacing any item with the same key.
+func PutItem(ctx context.Context, client Client, table Table, item Item) error {
+	if _, err := table.ItemKey(item); err != nil {
+		return err
+	}
+	_, err := client.PutItem(ctx, &dynamodb.PutItemInput{
+		TableName: &table.Name,
+		Item:      item,
+	})
+	if err != nil {
+		return apperrors.Wrapf(err, "put item in %s", table.Name)
+	}
+	return nil
+}
+
+// DeleteItem deletes the item with item's primary key from table.
+func DeleteItem(ctx context.Context, client Client, table Table, item Item) error {
+	key, err := table.ItemKey(item)
+	if err != nil {
+		return err
+	}
+	_, err = client.DeleteItem(ctx, &dynamodb.DeleteItemInput{
+		TableName: &table.Name,
+		Key:       key,
+	})
+	if err != nil {
+		return apperrors.Wrapf(err, "delete item from %s", table.Name)
+	}
+	return nil
+}
+
+// Columns returns the attribute names to show for items: the table's key
+// attributes first, then the others by how many items have them.
+func Columns(table Table, items []Item) []string {
+	counts := map[string]int{}
+	for _, item := range items {
+		for name := range item {
+			counts[name]++
+		}
+	}
+
+	var cols []string
+	for _, k := range []Key{table.Key.PartitionKey, table.Key.SortKey} {
+		if k.Name != "" {
+			cols = append(cols, k.Name)
+			delete(counts, k.Name)
+		}
+	}
+	rest := make([]string, 0, len(counts))
+	for name := range counts {
+		rest = append(rest, name)
+	}
+	sort.Slice(rest, func(i, j int) bool {
+		if counts[rest[i]] != counts[rest[j]] {
+			return counts[rest[i]] > counts[rest[j]]
+		}
+		return rest[i] < rest[j]
+	})
+	return append(cols, rest...)
+}
@@ -0,0 +1,296 @@
+package dynamo
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+)
+
+type fakeClient struct {
+	queryIn  []dynamodb.QueryInput
+	scanIn   []dynamodb.ScanInput
+	pages    []dynamodb.ScanOutput
+	putIn    *dynamodb.PutItemInput
+	deleteIn *dynamodb.DeleteItemInput
+}
+
+func (f *fakeClient) page() dynamodb.ScanOutput {
+	n := len(f.queryIn) + len(f.scanIn) - 1
+	if n < len(f.pages) {
+		return f.pages[n]
+	}
+	return dynamodb.ScanOutput{}
+}
+
+func (f *fakeClient) Query(_ context.Context, in *dynamodb.QueryInput, _ ...func(*dynamodb.Options)) (*dynamodb.QueryOutput, error) {
+	f.queryIn = append(f.queryIn, *in)
+	p := f.page()
+	return &dynamodb.QueryOutput{Items: p.Items, LastEvaluatedKey: p.LastEvaluatedKey, ScannedCount: p.ScannedCount}, nil
+}
+
+func (f *fakeClient) Scan(_ context.Context, in *dynamodb.ScanInput, _ ...func(*dynamodb.Options)) (*dynamodb.ScanOutput, error) {
+	f.scanIn = append(f.scanIn, *in)
+	p := f.page()
+	return &p, nil
+}
+
+func (f *fakeClient) PutItem(_ context.Context, in *dynamodb.PutItemInput, _ ...func(*dynamodb.Options)) (*dynamodb.PutItemOutput, error) {
+	f.putIn = in
+	return &dynamodb.PutItemOutput{}, nil
+}
+
+func (f *fakeClient) DeleteItem(_ context.Context, in *dynamodb.DeleteItemInput, _ ...func(*dynamodb.Options)) (*dynamodb.DeleteItemOutput, error) {
+	f.deleteIn = in
+	return &dynamodb.DeleteItemOutput{}, nil
+}
+
+func s(v string) types.AttributeValue { return &types.AttributeValueMemberS{Value: v} }
+func n(v string) types.AttributeValue { return &types.AttributeValueMemberN{Value: v} }
+
+var testTable = TableFromDescription(types.TableDescription{
+	TableName: aws.String("orders"),
+	AttributeDefinitions: []types.AttributeDefinition{
+		{AttributeName: aws.String("pk"), AttributeType: types.ScalarAttributeTypeS},
+		{AttributeName: aws.String("sk"), AttributeType: types.ScalarAttributeTypeN},
+		{AttributeName: aws.String("status"), AttributeType: types.ScalarAttributeTypeS},
+	},
+	KeySchema: []types.KeySchemaElement{
+		{AttributeName: aws.String("pk"), KeyType: types.KeyTypeHash},
+		{AttributeName: aws.String("sk"), KeyType: types.KeyTypeRange},
+	},
+	GlobalSecondaryIndexes: []types.GlobalSecondaryIndexDescription{
+		{IndexName: aws.String("by-status"), KeySchema: []types.KeySchemaElement{
+			{AttributeName: aws.String("status"), KeyType: types.KeyTypeHash},
+		}},
+	},
+})
+
+func TestTableFromDescription(t *testing.T) {
+	if testTable.Key.PartitionKey != (Key{"pk", "S"}) || testTable.Key.SortKey != (Key{"sk", "N"}) {
+		t.Errorf("Key = %+v", testTable.Key)
+	}
+	idx, ok := testTable.Index("by-status")
+	if !ok || idx.Kind != "GSI" || idx.PartitionKey.Name != "status" || idx.SortKey.Name != "" {
+		t.Errorf("Index(by-status) = %+v, %v", idx, ok)
+	}
+	if got := len(testTable.AllIndexes()); got != 2 {
+		t.Errorf("AllIndexes() has %d entries, want 2", got)
+	}
+}
+
+func TestFetch_Query(t *testing.T) {
+	client := &fakeClient{pages: []dynamodb.ScanOutput{{Items: []Item{{"pk": s("a"), "sk": n("1")}}, ScannedCount: 1}}}
+	page, err := Fetch(context.Background(), client, testTable, Request{
+		PartitionValue: "a",
+		SortOp:         "between",
+		SortValue:      "1",
+		SortValue2:     "9",
+		Filter:         `total > 10`,
+	})
+	if err != nil {
+		t.Fatalf("Fetch() error = %v", err)
+	}
+	if len(page.Items) != 1 || len(client.queryIn) != 1 || len(client.scanIn) != 0 {
+		t.Fatalf("got %d items from %d queries and %d scans", len(page.Items), len(client.queryIn), len(client.scanIn))
+	}
+	in := client.queryIn[0]
+	if got := aws.ToString(in.KeyConditionExpression); got != "#n0 = :v0 AND #n1 BETWEEN :v1 AND :v2" {
+		t.Errorf("KeyConditionExpression = %q", got)
+	}
+	if got := aws.ToString(in.FilterExpression); got != "#n2 > :v3" {
+		t.Errorf("FilterExpression = %q", got)
+	}
+	if in.ExpressionAttributeNames["#n1"] != "sk" || in.ExpressionAttributeValues[":v2"].(*types.AttributeValueMemberN).Value != "9" {
+		t.Errorf("placeholders = %v %v", in.ExpressionAttributeNames, in.ExpressionAttributeValues)
+	}
+	if in.IndexName != nil {
+		t.Errorf("IndexName = %q, want nil for the table", *in.IndexName)
+	}
+}
+
+func TestFetch_QueryErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		req  Request
+	}{
+		{"number key", Request{PartitionValue: "a", SortOp: "=", SortValue: "x"}},
+		{"no sort key", Request{Index: "by-status", PartitionValue: "a", SortOp: "="}},
+		{"unknown index", Request{Index: "nope"}},
+		{"sort without partition", Request{SortOp: "="}},
+		{"bad filter", Request{Filter: "a ="}},
+	}
+	for _, tt := range tests {
+		if _, err := Fetch(context.Background(), &fakeClient{}, testTable, tt.req); err == nil {
+			t.Errorf("%s: expected error", tt.name)
+		}
+	}
+}
+
+func TestFetch_ScanPages(t *testing.T) {
+	client := &fakeClient{pages: []dynamodb.ScanOutput{
+		{ScannedCount: 5, LastEvaluatedKey: Item{"pk": s("e")}},
+		{Items: []Item{{"pk": s("f")}}, ScannedCount: 5, LastEvaluatedKey: Item{"pk": s("j")}},
+	}}
+	page, err := Fetch(context.Background(), client, testTable, Request{Index: "by-status", Limit: 1})
+	if err != nil {
+		t.Fatalf("Fetch() error = %v", err)
+	}
+	// The first page matched nothing, so a second one is read
+	if len(client.scanIn) != 2 || len(page.Items) != 1 || page.Scanned != 10 {
+		t.Fatalf("scans = %d, items = %d, scanned = %d", len(client.scanIn), len(page.Items), page.Scanned)
+	}
+	if got := aws.ToString(client.scanIn[0].IndexName); got != "by-status" {
+		t.Errorf("IndexName = %q", got)
+	}
+	if client.scanIn[0].ExpressionAttributeNames != nil || client.scanIn[0].FilterExpression != nil {
+		t.Error("a scan without filter should not send expressions")
+	}
+	if client.scanIn[1].ExclusiveStartKey["pk"].(*types.AttributeValueMemberS).Value != "e" {
+		t.Error("second scan should continue after the first page")
+	}
+	if page.LastKey["pk"].(*types.AttributeValueMemberS).Value != "j" {
+		t.Errorf("LastKey = %v", page.LastKey)
+	}
+}
+
+func TestParseFilter(t *testing.T) {
+	tests := []struct {
+		filter string
+		want   string
+	}{
+		{`status = "active"`, "#n0 = :v0"},
+		{`age>=21 and name begins_with Al`, "#n0 >= :v0 AND begins_with(#n1, :v1)"},
+		{`tags contains red AND deletedAt not_exists`, "contains(#n0, :v0) AND attribute_not_exists(#n1)"},
+		{`a != 1 and a <> 2`, "#n0 <> :v0 AND #n0 <> :v1"},
+		{``, ""},
+	}
+	for _, tt := range tests {
+		got, err := parseFilter(tt.filter, newExprBuilder())
+		if err != nil || got != tt.want {
+			t.Errorf("parseFilter(%q) = %q, %v; want %q", tt.filter, got, err, tt.want)
+		}
+	}
+
+	for _, bad := range []string{`a =`, `"a" = 1`, `a = 1 and`, `a ~ 1`, `a = "x`} {
+		if _, err := parseFilter(bad, newExprBuilder()); err == nil {
+			t.Errorf("parseFilter(%q) expected error", bad)
+		}
+	}
+}
+
+func TestFilterValue(t *testing.T) {
+	tests := []struct {
+		tok  token
+		want types.AttributeValue
+	}{
+		{token{text: "42"}, n("42")},
+		{token{text: "42", quoted: true}, s("42")},
+		{token{text: "true"}, &types.AttributeValueMemberBOOL{Value: true}},
+		{token{text: "null"}, &types.AttributeValueMemberNULL{Value: true}},
+		{token{text: "NaN"}, s("NaN")},
+	}
+	for _, tt := range tests {
+		if got := CellValue(filterValue(tt.tok)); got != CellValue(tt.want) {
+			t.Errorf("filterValue(%+v) = %s, want %s", tt.tok, got, CellValue(tt.want))
+		}
+	}
+}
+
+func TestJSONRoundTrip(t *testing.T) {
+	item := Item{
+		"pk":    s("a"),
+		"sk":    n("1.50"),
+		"tags":  &types.AttributeValueMemberSS{Value: []string{"x", "y"}},
+		"data":  &types.AttributeValueMemberB{Value: []byte("hi")},
+		"attrs": &types.AttributeValueMemberM{Value: Item{"ok": &types.AttributeValueMemberBOOL{Value: true}, "none": &types.AttributeValueMemberNULL{Value: true}}},
+		"list":  &types.AttributeValueMemberL{Value: []types.AttributeValue{n("2"), s("b")}},
+	}
+
+	dj, err := DynamoJSON(item)
+	if err != nil {
+		t.Fatalf("DynamoJSON() error = %v", err)
+	}
+	back, err := ParseItem(dj, true)
+	if err != nil {
+		t.Fatalf("ParseItem(DynamoDB JSON) error = %v", err)
+	}
+	again, _ := DynamoJSON(back)
+	if again != dj {
+		t.Errorf("DynamoDB JSON did not round-trip:\n%s\n%s", dj, again)
+	}
+
+	plain, err := PlainJSON(item)
+	if err != nil {
+		t.Fatalf("PlainJSON() error = %v", err)
+	}
+	if !strings.Contains(plain, `"sk": 1.50`) || !strings.Contains(plain, `"tags": [`) {
+		t.Errorf("PlainJSON() =\n%s", plain)
+	}
+	parsed, err := ParseItem(plain, false)
+	if err != nil {
+		t.Fatalf("ParseItem(plain) error = %v", err)
+	}
+	if got := CellValue(parsed["sk"]); got != "1.50" {
+		t.Errorf("sk = %s, want the number kept as written", got)
+	}
+	if _, ok := parsed["tags"].(*types.AttributeValueMemberL); !ok {
+		t.Errorf("plain JSON arrays should parse as lists, got %T", parsed["tags"])
+	}
+}
+
+func TestParseItem_Errors(t *testing.T) {
+	tests := []struct {
+		data       string
+		dynamoJSON bool
+	}{
+		{`[1]`, false},
+		{`null`, false},
+		{`{"a": {"S": 1}}`, true},
+		{`{"a": {"N": "x"}}`, true},
+		{`{"a": {"S": "x", "N": "1"}}`, true},
+		{`{"a": {"SS": []}}`, true},
+		{`{"a": "plain"}`, true},
+	}
+	for _, tt := range tests {
+		if _, err := ParseItem(tt.data, tt.dynamoJSON); err == nil {
+			t.Errorf("ParseItem(%s, %v) expected error", tt.data, tt.dynamoJSON)
+		}
+	}
+}
+
+func TestColumns(t *testing.T) {
+	items := []Item{
+		{"pk": s("a"), "sk": n("1"), "b": s("x"), "c": s("y")},
+		{"pk": s("b"), "sk": n("2"), "c": s("z"), "a": s("w")},
+	}
+	want := []string{"pk", "sk", "c", "a", "b"}
+	if got := Columns(testTable, items); !slices.Equal(got, want) {
+		t.Errorf("Columns() = %v, want %v", got, want)
+	}
+}
+
+func TestPutAndDeleteItem(t *testing.T) {
+	client := &fakeClient{}
+	item := Item{"pk": s("a"), "sk": n("1"), "total": n("5")}
+
+	if err := PutItem(context.Background(), client, testTable, Item{"pk": s("a")}); err == nil {
+		t.Error("PutItem() without the sort key should fail")
+	}
+	if err := PutItem(context.Background(), client, testTable, item); err != nil || len(client.putIn.Item) != 3 {
+		t.Errorf("PutItem() = %v, input %v", err, client.putIn)
+	}
+	if err := DeleteItem(context.Background(), client, testTable, item); err != nil {
+		t.Fatalf("DeleteItem() error = %v", err)
+	}
+	if key := client.deleteIn.Key; len(key) != 2 || key["total"] != nil {
+		t.Errorf("DeleteItem() key = %v, want only the key attributes", key)
+	}
+	if got := KeyString(testTable, item); got != "pk=a, sk=1" {
+		t.Errorf("KeyString() = %q", got)
+	}
+}
@@ -0,0 +1,246 @@
+package dynamo
+
+import (
+	"encoding/base64"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+)
+
+// numberPattern matches the numbers DynamoDB accepts.
+var numberPattern = regexp.MustCompile(`^[-+]?(\d+\.?\d*|\.\d+)([eE][-+]?\d+)?$`)
+
+// exprBuilder collects the attribute name and value placeholders of the
+// expressions in one request. The maps stay nil while empty, since the API
+// rejects empty ones.
+type exprBuilder struct {
+	names  map[string]string
+	values map[string]types.AttributeValue
+}
+
+func newExprBuilder() *exprBuilder {
+	return &exprBuilder{}
+}
+
+// name returns the placeholder for attribute name.
+func (b *exprBuilder) name(name string) string {
+	for p, n := range b.names {
+		if n == name {
+			return p
+		}
+	}
+	if b.names == nil {
+		b.names = map[string]string{}
+	}
+	p := fmt.Sprintf("#n%d", len(b.names))
+	b.names[p] = name
+	return p
+}
+
+// value returns a new placeholder for v.
+func (b *exprBuilder) value(v types.AttributeValue) string {
+	if b.values == nil {
+		b.values = map[string]types.AttributeValue{}
+	}
+	p := fmt.Sprintf(":v%d", len(b.values))
+	b.values[p] = v
+	return p
+}
+
+// keyCondition builds the KeyConditionExpression for r on idx.
+func (b *exprBuilder) keyCondition(idx Index, r Request) (string, error) {
+	pk, err := keyValue(idx.PartitionKey, r.PartitionValue)
+	if err != nil {
+		return "", err
+	}
+	cond := b.name(idx.PartitionKey.Name) + " = " + b.value(pk)
+	if r.SortOp == "" {
+		return cond, nil
+	}
+	if idx.SortKey.Name == "" {
+		return "", fmt.Errorf("%s has no sort key", idx.Label())
+	}
+
+	sk, err := keyValue(idx.SortKey, r.SortValue)
+	if err != nil {
+		return "", err
+	}
+	name := b.name(idx.SortKey.Name)
+	switch r.SortOp {
+	case "=", "<", "<=", ">", ">=":
+		return cond + " AND " + name + " " + r.SortOp + " " + b.value(sk), nil
+	case "begins_with":
+		return cond + " AND begins_with(" + name + ", " + b.value(sk) + ")", nil
+	case "between":
+		upper, err := keyValue(idx.SortKey, r.SortValue2)
+		if err != nil {
+			return "", err
+		}
+		return cond + " AND " + name + " BETWEEN " + b.value(sk) + " AND " + b.value(upper), nil
+	}
+	return "", fmt.Errorf("unknown sort key condition: %s", r.SortOp)
+}
+
+// keyValue converts the text s to the type of key.
+func keyValue(key Key, s string) (types.AttributeValue, error) {
+	if s == "" {
+		return nil, fmt.Errorf("value for %s is empty", key.Name)
+	}
+	switch key.Type {
+	case "N":
+		if !numberPattern.MatchString(s) {
+			return nil, fmt.Errorf("%s must be a number: %q", key.Name, s)
+		}
+		return &types.AttributeValueMemberN{Value: s}, nil
+	case "B":
+		data, err := base64.StdEncoding.DecodeString(s)
+		if err != nil {
+			return nil, fmt.Errorf("%s is binary and must be base64: %w", key.Name, err)
+		}
+		return &types.AttributeValueMemberB{Value: data}, nil
+	}
+	return &types.AttributeValueMemberS{Value: s}, nil
+}
+
+// token is a word of a filter; quoted words are always string values.
+type token struct {
+	text   string
+	quoted bool
+}
+
+// tokenize splits a filter into words, operators and quoted strings.
+func tokenize(s string) ([]token, error) {
+	var tokens []token
+	for i := 0; i < len(s); {
+		c := s[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n':
+			i++
+		case c == '"' || c == '\'':
+			end := strings.IndexByte(s[i+1:], c)
+			if end < 0 {
+				return nil, fmt.Errorf("unterminated quote in filter")
+			}
+			tokens = append(tokens, token{text: s[i+1 : i+1+end], quoted: true})
+			i += end + 2
+		case strings.IndexByte("=!<>", c) >= 0:
+			j := i + 1
+			for j < len(s) && strings.IndexByte("=<>", s[j]) >= 0 {
+				j++
+			}
+			tokens = append(tokens, token{text: s[i:j]})
+			i = j
+		default:
+			j := i
+			for j < len(s) && strings.IndexByte(" \t\n\"'=!<>", s[j]) < 0 {
+				j++
+			}
+			tokens = append(tokens, token{text: s[i:j]})
+			i = j
+		}
+	}
+	return tokens, nil
+}
+
+// parseFilter turns a filter into a FilterExpression. A filter is one or
+// more conditions joined by "and":
+//
+//	status = "active" and age >= 21
+//	name begins_with Al
+//	tags contains red
+//	deletedAt not_exists
+//
+// Comparisons are =, !=, <, <=, > and >=. Quoted values are strings;
+// unquoted ones are numbers, true, false or null when they parse as such,
+// and strings otherwise.
+func parseFilter(s string, b *exprBuilder) (string, error) {
+	tokens, err := tokenize(s)
+	if err != nil {
+		return "", err
+	}
+
+	var conds []string
+	for len(tokens) > 0 {
+		end := len(tokens)
+		for i, t := range tokens {
+			if !t.quoted && strings.EqualFold(t.text, "and") {
+				end = i
+				break
+			}
+		}
+		cond, err := parseCondition(tokens[:end], b)
+		if err != nil {
+			return "", err
+		}
+		conds = append(conds, cond)
+		if end == len(tokens) {
+			break
+		}
+		tokens = tokens[end+1:]
+		if len(tokens) == 0 {
+			return "", fmt.Errorf("filter ends with \"and\"")
+		}
+	}
+	return strings.Join(conds, " AND "), nil
+}
+
+func parseCondition(tokens []token, b *exprBuilder) (string, error) {
+	if len(tokens) == 0 || tokens[0].quoted {
+		return "", fmt.Errorf("filter condition must start with an attribute name")
+	}
+	name := b.name(tokens[0].text)
+	if len(tokens) == 2 {
+		switch strings.ToLower(tokens[1].text) {
+		case "exists":
+			return "attribute_exists(" + name + ")", nil
+		case "not_exists":
+			return "attribute_not_exists(" + name + ")", nil
+		}
+	}
+	if len(tokens) != 3 {
+		return "", fmt.Errorf("invalid filter condition: %s", joinTokens(tokens))
+	}
+
+	value := b.value(filterValue(tokens[2]))
+	switch op := strings.ToLower(tokens[1].text); op {
+	case "=", "<", "<=", ">", ">=":
+		return name + " " + op + " " + value, nil
+	case "!=", "<>":
+		return name + " <> " + value, nil
+	case "begins_with", "contains":
+		return op + "(" + name + ", " + value + ")", nil
+	default:
+		return "", fmt.Errorf("unknown filter operator: %s", tokens[1].text)
+	}
+}
+
+// filterValue converts a filter value to an attribute value.
+func filterValue(t token) types.AttributeValue {
+	if !t.quoted {
+		switch t.text {
+		case "true", "false":
+			return &types.AttributeValueMemberBOOL{Value: t.text == "true"}
+		case "null":
+			return &types.AttributeValueMemberNULL{Value: true}
+		}
+		if numberPattern.MatchString(t.text) {
+			return &types.AttributeValueMemberN{Value: t.text}
+		}
+	}
+	return &types.AttributeValueMemberS{Value: t.text}
+}
+
+func joinTokens(tokens []token) string {
+	words := make([]string, len(tokens))
+	for i, t := range tokens {
+		if t.quoted {
+			words[i] = strconv.Quote(t.text)
+		} else {
+			words[i] = t.text
+		}
+	}
+	return strings.Join(words, " ")
+}
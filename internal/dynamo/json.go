@@ -0,0 +1,352 @@
+package dynamo
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+)
+
+// PlainJSON formats item as ordinary JSON. Sets become arrays and binary
+// values base64 strings, so the result does not round-trip them.
+func PlainJSON(item Item) (string, error) {
+	return marshalIndent(plainMap(item))
+}
+
+// DynamoJSON formats item in DynamoDB JSON, with type descriptors such as
+// {"S": "text"}, as used by the AWS CLI.
+func DynamoJSON(item Item) (string, error) {
+	m := make(map[string]any, len(item))
+	for k, v := range item {
+		m[k] = dynamoValue(v)
+	}
+	return marshalIndent(m)
+}
+
+func marshalIndent(v any) (string, error) {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(v); err != nil {
+		return "", fmt.Errorf("format item: %w", err)
+	}
+	return strings.TrimSuffix(buf.String(), "\n"), nil
+}
+
+func plainMap(item Item) map[string]any {
+	m := make(map[string]any, len(item))
+	for k, v := range item {
+		m[k] = plainValue(v)
+	}
+	return m
+}
+
+func plainValue(av types.AttributeValue) any {
+	switch v := av.(type) {
+	case *types.AttributeValueMemberS:
+		return v.Value
+	case *types.AttributeValueMemberN:
+		return json.Number(v.Value)
+	case *types.AttributeValueMemberB:
+		return v.Value
+	case *types.AttributeValueMemberBOOL:
+		return v.Value
+	case *types.AttributeValueMemberNULL:
+		return nil
+	case *types.AttributeValueMemberL:
+		list := make([]any, len(v.Value))
+		for i, e := range v.Value {
+			list[i] = plainValue(e)
+		}
+		return list
+	case *types.AttributeValueMemberM:
+		return plainMap(v.Value)
+	case *types.AttributeValueMemberSS:
+		return v.Value
+	case *types.AttributeValueMemberNS:
+		nums := make([]json.Number, len(v.Value))
+		for i, n := range v.Value {
+			nums[i] = json.Number(n)
+		}
+		return nums
+	case *types.AttributeValueMemberBS:
+		return v.Value
+	}
+	return nil
+}
+
+func dynamoValue(av types.AttributeValue) any {
+	switch v := av.(type) {
+	case *types.AttributeValueMemberS:
+		return map[string]any{"S": v.Value}
+	case *types.AttributeValueMemberN:
+		return map[string]any{"N": v.Value}
+	case *types.AttributeValueMemberB:
+		return map[string]any{"B": v.Value}
+	case *types.AttributeValueMemberBOOL:
+		return map[string]any{"BOOL": v.Value}
+	case *types.AttributeValueMemberNULL:
+		return map[string]any{"NULL": true}
+	case *types.AttributeValueMemberL:
+		list := make([]any, len(v.Value))
+		for i, e := range v.Value {
+			list[i] = dynamoValue(e)
+		}
+		return map[string]any{"L": list}
+	case *types.AttributeValueMemberM:
+		m := make(map[string]any, len(v.Value))
+		for k, e := range v.Value {
+			m[k] = dynamoValue(e)
+		}
+		return map[string]any{"M": m}
+	case *types.AttributeValueMemberSS:
+		return map[string]any{"SS": v.Value}
+	case *types.AttributeValueMemberNS:
+		return map[string]any{"NS": v.Value}
+	case *types.AttributeValueMemberBS:
+		return map[string]any{"BS": v.Value}
+	}
+	return nil
+}
+
+// ParseItem parses an item from plain JSON, or from DynamoDB JSON when
+// dynamoJSON is set. Plain JSON has no sets or binary values; use DynamoDB
+// JSON for those.
+func ParseItem(data string, dynamoJSON bool) (Item, error) {
+	dec := json.NewDecoder(strings.NewReader(data))
+	dec.UseNumber()
+	var m map[string]any
+	if err := dec.Decode(&m); err != nil {
+		return nil, fmt.Errorf("parse item: %w", err)
+	}
+	if m == nil {
+		return nil, fmt.Errorf("parse item: not a JSON object")
+	}
+
+	item := make(Item, len(m))
+	for k, v := range m {
+		var (
+			av  types.AttributeValue
+			err error
+		)
+		if dynamoJSON {
+			av, err = fromDynamo(v)
+		} else {
+			av, err = fromPlain(v)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("attribute %s: %w", k, err)
+		}
+		item[k] = av
+	}
+	return item, nil
+}
+
+func fromPlain(v any) (types.AttributeValue, error) {
+	switch v := v.(type) {
+	case string:
+		return &types.AttributeValueMemberS{Value: v}, nil
+	case json.Number:
+		return &types.AttributeValueMemberN{Value: v.String()}, nil
+	case bool:
+		return &types.AttributeValueMemberBOOL{Value: v}, nil
+	case nil:
+		return &types.AttributeValueMemberNULL{Value: true}, nil
+	case []any:
+		list := make([]types.AttributeValue, len(v))
+		for i, e := range v {
+			av, err := fromPlain(e)
+			if err != nil {
+				return nil, err
+			}
+			list[i] = av
+		}
+		return &types.AttributeValueMemberL{Value: list}, nil
+	case map[string]any:
+		m := make(map[string]types.AttributeValue, len(v))
+		for k, e := range v {
+			av, err := fromPlain(e)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", k, err)
+			}
+			m[k] = av
+		}
+		return &types.AttributeValueMemberM{Value: m}, nil
+	}
+	return nil, fmt.Errorf("unsupported value %v", v)
+}
+
+func fromDynamo(v any) (types.AttributeValue, error) {
+	desc, ok := v.(map[string]any)
+	if !ok || len(desc) != 1 {
+		return nil, fmt.Errorf(`expected a type descriptor such as {"S": "text"}`)
+	}
+	for typ, val := range desc {
+		switch typ {
+		case "S":
+			s, ok := val.(string)
+			if !ok {
+				return nil, fmt.Errorf("S must be a string")
+			}
+			return &types.AttributeValueMemberS{Value: s}, nil
+		case "N":
+			n, err := numberString(val)
+			if err != nil {
+				return nil, err
+			}
+			return &types.AttributeValueMemberN{Value: n}, nil
+		case "B":
+			b, err := binaryValue(val)
+			if err != nil {
+				return nil, err
+			}
+			return &types.AttributeValueMemberB{Value: b}, nil
+		case "BOOL":
+			b, ok := val.(bool)
+			if !ok {
+				return nil, fmt.Errorf("BOOL must be true or false")
+			}
+			return &types.AttributeValueMemberBOOL{Value: b}, nil
+		case "NULL":
+			return &types.AttributeValueMemberNULL{Value: true}, nil
+		case "L":
+			list, ok := val.([]any)
+			if !ok {
+				return nil, fmt.Errorf("L must be an array")
+			}
+			out := make([]types.AttributeValue, len(list))
+			for i, e := range list {
+				av, err := fromDynamo(e)
+				if err != nil {
+					return nil, err
+				}
+				out[i] = av
+			}
+			return &types.AttributeValueMemberL{Value: out}, nil
+		case "M":
+			m, ok := val.(map[string]any)
+			if !ok {
+				return nil, fmt.Errorf("M must be an object")
+			}
+			out := make(map[string]types.AttributeValue, len(m))
+			for k, e := range m {
+				av, err := fromDynamo(e)
+				if err != nil {
+					return nil, fmt.Errorf("%s: %w", k, err)
+				}
+				out[k] = av
+			}
+			return &types.AttributeValueMemberM{Value: out}, nil
+		case "SS", "NS", "BS":
+			return setValue(typ, val)
+		default:
+			return nil, fmt.Errorf("unknown type %q", typ)
+		}
+	}
+	return nil, nil
+}
+
+func setValue(typ string, val any) (types.AttributeValue, error) {
+	list, ok := val.([]any)
+	if !ok || len(list) == 0 {
+		return nil, fmt.Errorf("%s must be a non-empty array", typ)
+	}
+	switch typ {
+	case "SS":
+		ss := make([]string, len(list))
+		for i, e := range list {
+			s, ok := e.(string)
+			if !ok {
+				return nil, fmt.Errorf("SS must contain strings")
+			}
+			ss[i] = s
+		}
+		return &types.AttributeValueMemberSS{Value: ss}, nil
+	case "NS":
+		ns := make([]string, len(list))
+		for i, e := range list {
+			n, err := numberString(e)
+			if err != nil {
+				return nil, err
+			}
+			ns[i] = n
+		}
+		return &types.AttributeValueMemberNS{Value: ns}, nil
+	default:
+		bs := make([][]byte, len(list))
+		for i, e := range list {
+			b, err := binaryValue(e)
+			if err != nil {
+				return nil, err
+			}
+			bs[i] = b
+		}
+		return &types.AttributeValueMemberBS{Value: bs}, nil
+	}
+}
+
+// numberString accepts numbers as strings, like the API, or as JSON numbers.
+func numberString(v any) (string, error) {
+	var s string
+	switch n := v.(type) {
+	case string:
+		s = n
+	case json.Number:
+		s = n.String()
+	}
+	if !numberPattern.MatchString(s) {
+		return "", fmt.Errorf("invalid number %v", v)
+	}
+	return s, nil
+}
+
+func binaryValue(v any) ([]byte, error) {
+	s, ok := v.(string)
+	if !ok {
+		return nil, fmt.Errorf("binary values must be base64 strings")
+	}
+	b, err := base64.StdEncoding.DecodeString(s)
+	if err != nil {
+		return nil, fmt.Errorf("invalid base64: %w", err)
+	}
+	return b, nil
+}
+
+// CellValue formats an attribute value for a table cell: scalars as text,
+// lists, maps and sets as compact JSON.
+func CellValue(av types.AttributeValue) string {
+	switch v := av.(type) {
+	case nil:
+		return ""
+	case *types.AttributeValueMemberS:
+		return v.Value
+	case *types.AttributeValueMemberN:
+		return v.Value
+	case *types.AttributeValueMemberB:
+		return fmt.Sprintf("<%d bytes>", len(v.Value))
+	case *types.AttributeValueMemberBOOL:
+		return fmt.Sprintf("%t", v.Value)
+	case *types.AttributeValueMemberNULL:
+		return "null"
+	}
+	data, err := json.Marshal(plainValue(av))
+	if err != nil {
+		return ""
+	}
+	return string(data)
+}
+
+// KeyString formats the primary key of item, such as "pk=a, sk=1".
+func KeyString(table Table, item Item) string {
+	var parts []string
+	for _, k := range []Key{table.Key.PartitionKey, table.Key.SortKey} {
+		if k.Name != "" {
+			parts = append(parts, k.Name+"="+CellValue(item[k.Name]))
+		}
+	}
+	return strings.Join(parts, ", ")
+}
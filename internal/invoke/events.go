@@ -0,0 +1,136 @@
+package invoke
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	apperrors "github.com/clawscli/claws/internal/errors"
+)
+
+// EventsFileEnv overrides the saved events file.
+const EventsFileEnv = "CLAWS_LAMBDA_EVENTS_FILE"
+
+// Event is a named test event.
+type Event struct {
+	Name    string
+	Payload string
+}
+
+// EventStore keeps named test events per function in a JSON file, keyed by
+// Target.Key. The file is read on every call, so events saved from another
+// instance show up without a restart.
+type EventStore struct {
+	path string
+}
+
+// NewEventStore returns a store backed by the file at path.
+func NewEventStore(path string) *EventStore {
+	return &EventStore{path: path}
+}
+
+// DefaultEventsPath returns the saved events file: $CLAWS_LAMBDA_EVENTS_FILE,
+// or "claws/lambda-events.json" under the user config directory.
+func DefaultEventsPath() string {
+	if path := os.Getenv(EventsFileEnv); path != "" {
+		return path
+	}
+	base, err := os.UserConfigDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(base, "claws", "lambda-events.json")
+}
+
+// eventsFile maps function keys to event names to payloads.
+type eventsFile map[string]map[string]json.RawMessage
+
+func (s *EventStore) load() (eventsFile, error) {
+	if s.path == "" {
+		return nil, fmt.Errorf("no location for saved events")
+	}
+	data, err := os.ReadFile(s.path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return eventsFile{}, nil
+		}
+		return nil, apperrors.Wrap(err, "read saved events")
+	}
+	f := eventsFile{}
+	if err := json.Unmarshal(data, &f); err != nil {
+		return nil, apperrors.Wrapf(err, "parse saved events %s", s.path)
+	}
+	return f, nil
+}
+
+func (s *EventStore) save(f eventsFile) error {
+	data, err := json.MarshalIndent(f, "", "  ")
+	if err != nil {
+		return apperrors.Wrap(err, "encode saved events")
+	}
+	if err := os.MkdirAll(filepath.Dir(s.path), 0o700); err != nil {
+		return apperrors.Wrap(err, "create saved events dir")
+	}
+	// Write to a temporary file first so a failed write keeps the old events
+	tmp := s.path + ".tmp"
+	if err := os.WriteFile(tmp, append(data, '\n'), 0o600); err != nil {
+		return apperrors.Wrap(err, "write saved events")
+	}
+	if err := os.Rename(tmp, s.path); err != nil {
+		return apperrors.Wrap(err, "write saved events")
+	}
+	return nil
+}
+
+// Events returns the saved events of the function, sorted by name.
+func (s *EventStore) Events(t Target) ([]Event, error) {
+	f, err := s.load()
+	if err != nil {
+		return nil, err
+	}
+	events := make([]Event, 0, len(f[t.Key()]))
+	for name, payload := range f[t.Key()] {
+		events = append(events, Event{Name: name, Payload: PrettyJSON(string(payload))})
+	}
+	sort.Slice(events, func(i, j int) bool { return events[i].Name < events[j].Name })
+	return events, nil
+}
+
+// Save stores the event for the function, replacing one with the same name.
+func (s *EventStore) Save(t Target, e Event) error {
+	e.Name = strings.TrimSpace(e.Name)
+	if e.Name == "" {
+		return fmt.Errorf("event name is required")
+	}
+	if err := ValidatePayload(e.Payload); err != nil {
+		return err
+	}
+	f, err := s.load()
+	if err != nil {
+		return err
+	}
+	if f[t.Key()] == nil {
+		f[t.Key()] = map[string]json.RawMessage{}
+	}
+	f[t.Key()][e.Name] = json.RawMessage(e.Payload)
+	return s.save(f)
+}
+
+// Delete removes the named event of the function.
+func (s *EventStore) Delete(t Target, name string) error {
+	f, err := s.load()
+	if err != nil {
+		return err
+	}
+	if _, ok := f[t.Key()][name]; !ok {
+		return nil
+	}
+	delete(f[t.Key()], name)
+	if len(f[t.Key()]) == 0 {
+		delete(f, t.Key())
+	}
+	return s.save(f)
+}
@@ -0,0 +1,213 @@
+// Package invoke runs Lambda functions for the invoke dialog: synchronous
+// invocations with a JSON payload and the decoded log tail, dry runs, the
+// qualifiers a function can be invoked with, and named test events saved
+// locally per function.
+package invoke
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/aws/aws-sdk-go-v2/service/lambda/types"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	apperrors "github.com/clawscli/claws/internal/errors"
+)
+
+// Latest is the qualifier of the unpublished function version.
+const Latest = "$LATEST"
+
+// Target identifies the function to invoke.
+type Target struct {
+	Name string
+	ARN  string
+}
+
+// Key returns the key saved events are stored under: the function ARN, or
+// its name when the ARN is unknown.
+func (t Target) Key() string {
+	if t.ARN != "" {
+		return t.ARN
+	}
+	return t.Name
+}
+
+// Provider is implemented by resources that can be invoked.
+type Provider interface {
+	InvokeTarget() Target
+}
+
+// Client is the subset of the Lambda API used by this package.
+type Client interface {
+	Invoke(ctx context.Context, params *lambda.InvokeInput, optFns ...func(*lambda.Options)) (*lambda.InvokeOutput, error)
+	ListVersionsByFunction(ctx context.Context, params *lambda.ListVersionsByFunctionInput, optFns ...func(*lambda.Options)) (*lambda.ListVersionsByFunctionOutput, error)
+	ListAliases(ctx context.Context, params *lambda.ListAliasesInput, optFns ...func(*lambda.Options)) (*lambda.ListAliasesOutput, error)
+}
+
+// NewClient creates a Lambda client for the current profile and region.
+func NewClient(ctx context.Context) (Client, error) {
+	cfg, err := appaws.NewConfig(ctx)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new lambda client")
+	}
+	return lambda.NewFromConfig(cfg), nil
+}
+
+// Qualifier is a version or alias the function can be invoked with.
+type Qualifier struct {
+	Name    string
+	Version string // for aliases, the version the alias points to
+	IsAlias bool
+}
+
+// Label returns the qualifier name, with the target version for aliases.
+func (q Qualifier) Label() string {
+	if q.IsAlias && q.Version != "" {
+		return q.Name + " → " + q.Version
+	}
+	return q.Name
+}
+
+// Qualifiers returns $LATEST, the published versions newest first, and the
+// function's aliases.
+func Qualifiers(ctx context.Context, client Client, t Target) ([]Qualifier, error) {
+	quals := []Qualifier{{Name: Latest}}
+
+	var versions []Qualifier
+	paginator := lambda.NewListVersionsByFunctionPaginator(client, &lambda.ListVersionsByFunctionInput{
+		FunctionName: &t.Name,
+	})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return quals, apperrors.Wrapf(err, "list versions of %s", t.Name)
+		}
+		for _, v := range output.Versions {
+			if version := appaws.Str(v.Version); version != Latest {
+				versions = append(versions, Qualifier{Name: version})
+			}
+		}
+	}
+	for i := len(versions) - 1; i >= 0; i-- {
+		quals = append(quals, versions[i])
+	}
+
+	aliases := lambda.NewListAliasesPaginator(client, &lambda.ListAliasesInput{
+		FunctionName: &t.Name,
+	})
+	for aliases.HasMorePages() {
+		output, err := aliases.NextPage(ctx)
+		if err != nil {
+			return quals, apperrors.Wrapf(err, "list aliases of %s", t.Name)
+		}
+		for _, a := range output.Aliases {
+			quals = append(quals, Qualifier{
+				Name:    appaws.Str(a.Name),
+				Version: appaws.Str(a.FunctionVersion),
+				IsAlias: true,
+			})
+		}
+	}
+	return quals, nil
+}
+
+// Request is a single invocation.
+type Request struct {
+	Qualifier string // empty or $LATEST for the unpublished version
+	Payload   string // JSON event, "{}" when empty
+	DryRun    bool   // validate parameters and permissions only
+}
+
+// Result is the outcome of an invocation.
+type Result struct {
+	DryRun          bool
+	StatusCode      int32
+	ExecutedVersion string
+	FunctionError   string // "Unhandled" or "Handled" when the function failed
+	Payload         []byte
+	LogTail         string // last 4 KB of the execution log
+	Duration        time.Duration
+}
+
+// Failed reports whether the function returned an error.
+func (r Result) Failed() bool {
+	return r.FunctionError != ""
+}
+
+// PrettyPayload returns the response payload indented when it is JSON.
+func (r Result) PrettyPayload() string {
+	return PrettyJSON(string(r.Payload))
+}
+
+// Invoke runs the function synchronously, requesting the log tail, or
+// validates the request for a dry run.
+func Invoke(ctx context.Context, client Client, t Target, req Request) (Result, error) {
+	payload := strings.TrimSpace(req.Payload)
+	if payload == "" {
+		payload = "{}"
+	}
+	if err := ValidatePayload(payload); err != nil {
+		return Result{}, err
+	}
+
+	input := &lambda.InvokeInput{
+		FunctionName: &t.Name,
+		Payload:      []byte(payload),
+	}
+	if req.Qualifier != "" && req.Qualifier != Latest {
+		input.Qualifier = &req.Qualifier
+	}
+	if req.DryRun {
+		input.InvocationType = types.InvocationTypeDryRun
+	} else {
+		input.InvocationType = types.InvocationTypeRequestResponse
+		input.LogType = types.LogTypeTail
+	}
+
+	start := time.Now()
+	output, err := client.Invoke(ctx, input)
+	if err != nil {
+		return Result{}, apperrors.Wrapf(err, "invoke function %s", t.Name)
+	}
+
+	result := Result{
+		DryRun:          req.DryRun,
+		StatusCode:      output.StatusCode,
+		ExecutedVersion: appaws.Str(output.ExecutedVersion),
+		FunctionError:   appaws.Str(output.FunctionError),
+		Payload:         output.Payload,
+		Duration:        time.Since(start),
+	}
+	if output.LogResult != nil {
+		tail, err := base64.StdEncoding.DecodeString(*output.LogResult)
+		if err != nil {
+			return result, apperrors.Wrap(err, "decode log result")
+		}
+		result.LogTail = string(tail)
+	}
+	return result, nil
+}
+
+// ValidatePayload returns an error unless payload is a single JSON value.
+func ValidatePayload(payload string) error {
+	var v any
+	if err := json.Unmarshal([]byte(payload), &v); err != nil {
+		return fmt.Errorf("payload is not valid JSON: %w", err)
+	}
+	return nil
+}
+
+// PrettyJSON formats JSON string with indentation
+func PrettyJSON(s string) string {
+	var buf bytes.Buffer
+	if err := json.Indent(&buf, []byte(s), "", "  "); err != nil {
+		return s
+	}
+	return buf.String()
+}
@@ -0,0 +1,168 @@
+package invoke
+
+import (
+	"context"
+	"encoding/base64"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/aws/aws-sdk-go-v2/service/lambda/types"
+)
+
+type fakeClient struct {
+	invokeIn *lambda.InvokeInput
+	output   lambda.InvokeOutput
+	versions []string
+	aliases  map[string]string
+}
+
+func (f *fakeClient) Invoke(_ context.Context, in *lambda.InvokeInput, _ ...func(*lambda.Options)) (*lambda.InvokeOutput, error) {
+	f.invokeIn = in
+	out := f.output
+	return &out, nil
+}
+
+func (f *fakeClient) ListVersionsByFunction(_ context.Context, _ *lambda.ListVersionsByFunctionInput, _ ...func(*lambda.Options)) (*lambda.ListVersionsByFunctionOutput, error) {
+	out := &lambda.ListVersionsByFunctionOutput{}
+	for _, v := range f.versions {
+		out.Versions = append(out.Versions, types.FunctionConfiguration{Version: aws.String(v)})
+	}
+	return out, nil
+}
+
+func (f *fakeClient) ListAliases(_ context.Context, _ *lambda.ListAliasesInput, _ ...func(*lambda.Options)) (*lambda.ListAliasesOutput, error) {
+	out := &lambda.ListAliasesOutput{}
+	for name, version := range f.aliases {
+		out.Aliases = append(out.Aliases, types.AliasConfiguration{Name: aws.String(name), FunctionVersion: aws.String(version)})
+	}
+	return out, nil
+}
+
+var testTarget = Target{Name: "orders", ARN: "arn:aws:lambda:us-east-1:123456789012:function:orders"}
+
+func TestQualifiers(t *testing.T) {
+	client := &fakeClient{versions: []string{Latest, "1", "2"}, aliases: map[string]string{"live": "2"}}
+	quals, err := Qualifiers(context.Background(), client, testTarget)
+	if err != nil {
+		t.Fatalf("Qualifiers() error = %v", err)
+	}
+	var labels []string
+	for _, q := range quals {
+		labels = append(labels, q.Label())
+	}
+	want := []string{Latest, "2", "1", "live → 2"}
+	if !slices.Equal(labels, want) {
+		t.Errorf("Qualifiers() = %v, want %v", labels, want)
+	}
+}
+
+func TestInvoke(t *testing.T) {
+	client := &fakeClient{output: lambda.InvokeOutput{
+		StatusCode:      200,
+		ExecutedVersion: aws.String("2"),
+		FunctionError:   aws.String("Unhandled"),
+		Payload:         []byte(`{"errorMessage":"boom"}`),
+		LogResult:       aws.String(base64.StdEncoding.EncodeToString([]byte("START RequestId: 1\nboom\n"))),
+	}}
+
+	result, err := Invoke(context.Background(), client, testTarget, Request{Qualifier: "live", Payload: `{"id": 1}`})
+	if err != nil {
+		t.Fatalf("Invoke() error = %v", err)
+	}
+	in := client.invokeIn
+	if aws.ToString(in.Qualifier) != "live" || in.InvocationType != types.InvocationTypeRequestResponse || in.LogType != types.LogTypeTail {
+		t.Errorf("InvokeInput = %+v", in)
+	}
+	if string(in.Payload) != `{"id": 1}` {
+		t.Errorf("Payload = %s", in.Payload)
+	}
+	if !result.Failed() || result.ExecutedVersion != "2" || result.LogTail != "START RequestId: 1\nboom\n" {
+		t.Errorf("Result = %+v", result)
+	}
+	if !strings.Contains(result.PrettyPayload(), `"errorMessage": "boom"`) {
+		t.Errorf("PrettyPayload() = %q", result.PrettyPayload())
+	}
+}
+
+func TestInvokeDryRun(t *testing.T) {
+	client := &fakeClient{output: lambda.InvokeOutput{StatusCode: 204}}
+
+	result, err := Invoke(context.Background(), client, testTarget, Request{Qualifier: Latest, DryRun: true})
+	if err != nil {
+		t.Fatalf("Invoke() error = %v", err)
+	}
+	in := client.invokeIn
+	if in.Qualifier != nil || in.InvocationType != types.InvocationTypeDryRun || in.LogType != "" {
+		t.Errorf("InvokeInput = %+v", in)
+	}
+	if string(in.Payload) != "{}" {
+		t.Errorf("empty payload sent as %q, want {}", in.Payload)
+	}
+	if !result.DryRun || result.StatusCode != 204 {
+		t.Errorf("Result = %+v", result)
+	}
+}
+
+func TestInvokeInvalidPayload(t *testing.T) {
+	client := &fakeClient{}
+	if _, err := Invoke(context.Background(), client, testTarget, Request{Payload: `{"id": `}); err == nil {
+		t.Error("Invoke() with invalid JSON should fail")
+	}
+	if client.invokeIn != nil {
+		t.Error("invalid payload should not be sent")
+	}
+}
+
+func TestEventStore(t *testing.T) {
+	store := NewEventStore(filepath.Join(t.TempDir(), "claws", "events.json"))
+	other := Target{Name: "other"}
+
+	events, err := store.Events(testTarget)
+	if err != nil || len(events) != 0 {
+		t.Fatalf("Events() on missing file = %v, %v", events, err)
+	}
+
+	for _, e := range []Event{
+		{Name: "order-created", Payload: `{"type":"created"}`},
+		{Name: "api", Payload: `{"path":"/"}`},
+	} {
+		if err := store.Save(testTarget, e); err != nil {
+			t.Fatalf("Save(%s) error = %v", e.Name, err)
+		}
+	}
+	if err := store.Save(other, Event{Name: "x", Payload: "[]"}); err != nil {
+		t.Fatalf("Save(other) error = %v", err)
+	}
+	if err := store.Save(testTarget, Event{Name: "bad", Payload: "{"}); err == nil {
+		t.Error("Save() with invalid JSON should fail")
+	}
+	if err := store.Save(testTarget, Event{Name: " ", Payload: "{}"}); err == nil {
+		t.Error("Save() without a name should fail")
+	}
+
+	events, err = store.Events(testTarget)
+	if err != nil {
+		t.Fatalf("Events() error = %v", err)
+	}
+	if len(events) != 2 || events[0].Name != "api" || events[1].Name != "order-created" {
+		t.Fatalf("Events() = %+v", events)
+	}
+	if events[1].Payload != "{\n  \"type\": \"created\"\n}" {
+		t.Errorf("Payload = %q", events[1].Payload)
+	}
+
+	if err := store.Delete(testTarget, "api"); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+	events, _ = store.Events(testTarget)
+	if len(events) != 1 || events[0].Name != "order-created" {
+		t.Errorf("Events() after delete = %+v", events)
+	}
+	if events, _ := store.Events(other); len(events) != 1 {
+		t.Errorf("other function events = %+v", events)
+	}
+}
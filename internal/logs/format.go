@@ -0,0 +1,68 @@
+package logs
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+)
+
+// maxJSONAttempts bounds how many opening brackets PrettyJSON tries as the
+// start of a JSON document.
+const maxJSONAttempts = 8
+
+// PrettyJSON indents a message that is, or ends with, a JSON object or
+// array. Text before the JSON (e.g. a Lambda runtime prefix such as
+// "2024-01-01T00:00:00Z <request-id> INFO") is kept on the first line.
+// It reports false when the message contains no JSON.
+func PrettyJSON(message string) (string, bool) {
+	trimmed := strings.TrimSpace(message)
+	if !strings.HasSuffix(trimmed, "}") && !strings.HasSuffix(trimmed, "]") {
+		return message, false
+	}
+	attempts := 0
+	for i := 0; i < len(trimmed) && attempts < maxJSONAttempts; i++ {
+		if trimmed[i] != '{' && trimmed[i] != '[' {
+			continue
+		}
+		attempts++
+		candidate := trimmed[i:]
+		if !json.Valid([]byte(candidate)) {
+			// Keep scanning so prefixes such as "[INFO] {...}" work
+			continue
+		}
+		var buf bytes.Buffer
+		if err := json.Indent(&buf, []byte(candidate), "", "  "); err != nil {
+			return message, false
+		}
+		if prefix := strings.TrimSpace(trimmed[:i]); prefix != "" {
+			return prefix + "\n" + buf.String(), true
+		}
+		return buf.String(), true
+	}
+	return message, false
+}
+
+// MatchIndexes returns the byte ranges of case-insensitive occurrences of
+// term in s.
+func MatchIndexes(s, term string) [][2]int {
+	if term == "" {
+		return nil
+	}
+	lower, lowerTerm := strings.ToLower(s), strings.ToLower(term)
+	if len(lower) != len(s) {
+		// Case folding changed byte lengths; match exactly so the
+		// ranges stay valid for s.
+		lower, lowerTerm = s, term
+	}
+
+	var matches [][2]int
+	for offset := 0; ; {
+		i := strings.Index(lower[offset:], lowerTerm)
+		if i < 0 {
+			return matches
+		}
+		start := offset + i
+		matches = append(matches, [2]int{start, start + len(lowerTerm)})
+		offset = start + len(lowerTerm)
+	}
+}
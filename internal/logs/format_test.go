@@ -0,0 +1,45 @@
+package logs
+
+import "testing"
+
+func TestPrettyJSON(t *testing.T) {
+	tests := []struct {
+		name   string
+		in     string
+		want   string
+		wantOK bool
+	}{
+		{"object", `{"level":"info","n":1}`, "{\n  \"level\": \"info\",\n  \"n\": 1\n}", true},
+		{"array", `[1,2]`, "[\n  1,\n  2\n]", true},
+		{"prefixed", `2024-01-01T00:00:00Z abc INFO {"a":true}`, "2024-01-01T00:00:00Z abc INFO\n{\n  \"a\": true\n}", true},
+		{"bracket prefix", `[INFO] {"a":1}`, "[INFO]\n{\n  \"a\": 1\n}", true},
+		{"plain", "START RequestId: abc", "START RequestId: abc", false},
+		{"broken", `{"a":`, `{"a":`, false},
+		{"trailing text", `{"a":1} done`, `{"a":1} done`, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := PrettyJSON(tt.in)
+			if got != tt.want || ok != tt.wantOK {
+				t.Errorf("PrettyJSON() = %q, %v; want %q, %v", got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestMatchIndexes(t *testing.T) {
+	got := MatchIndexes("Error: error ERROR", "error")
+	want := [][2]int{{0, 5}, {7, 12}, {13, 18}}
+	if len(got) != len(want) {
+		t.Fatalf("MatchIndexes() = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("MatchIndexes()[%d] = %v, want %v", i, got[i], want[i])
+		}
+	}
+
+	if got := MatchIndexes("abc", ""); got != nil {
+		t.Errorf("MatchIndexes(empty) = %v, want nil", got)
+	}
+}
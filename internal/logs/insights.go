@@ -0,0 +1,200 @@
+package logs
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs/types"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	apperrors "github.com/clawscli/claws/internal/errors"
+)
+
+// MaxInsightsGroups is the API limit for log groups in a single query.
+const MaxInsightsGroups = 50
+
+// InsightsClient is the subset of the CloudWatch Logs API used to run
+// Logs Insights queries.
+type InsightsClient interface {
+	StartQuery(ctx context.Context, params *cloudwatchlogs.StartQueryInput, optFns ...func(*cloudwatchlogs.Options)) (*cloudwatchlogs.StartQueryOutput, error)
+	GetQueryResults(ctx context.Context, params *cloudwatchlogs.GetQueryResultsInput, optFns ...func(*cloudwatchlogs.Options)) (*cloudwatchlogs.GetQueryResultsOutput, error)
+	StopQuery(ctx context.Context, params *cloudwatchlogs.StopQueryInput, optFns ...func(*cloudwatchlogs.Options)) (*cloudwatchlogs.StopQueryOutput, error)
+	DescribeQueryDefinitions(ctx context.Context, params *cloudwatchlogs.DescribeQueryDefinitionsInput, optFns ...func(*cloudwatchlogs.Options)) (*cloudwatchlogs.DescribeQueryDefinitionsOutput, error)
+}
+
+// InsightsQuery is a Logs Insights query over a time range.
+type InsightsQuery struct {
+	Groups []string
+	Query  string
+	Start  time.Time
+	End    time.Time // zero means now
+	Limit  int32     // 0: the query's own limit, or the service default
+}
+
+// StartQuery starts q and returns its query ID.
+func StartQuery(ctx context.Context, client InsightsClient, q InsightsQuery) (string, error) {
+	switch {
+	case q.Query == "":
+		return "", fmt.Errorf("query is empty")
+	case len(q.Groups) == 0:
+		return "", fmt.Errorf("no log groups selected")
+	case len(q.Groups) > MaxInsightsGroups:
+		return "", fmt.Errorf("too many log groups: %d (max %d)", len(q.Groups), MaxInsightsGroups)
+	}
+
+	end := q.End
+	if end.IsZero() {
+		end = time.Now()
+	}
+	input := &cloudwatchlogs.StartQueryInput{
+		LogGroupNames: q.Groups,
+		QueryString:   appaws.StringPtr(q.Query),
+		StartTime:     appaws.Int64Ptr(q.Start.Unix()),
+		EndTime:       appaws.Int64Ptr(end.Unix()),
+	}
+	if q.Limit > 0 {
+		input.Limit = appaws.Int32Ptr(q.Limit)
+	}
+
+	out, err := client.StartQuery(ctx, input)
+	if err != nil {
+		return "", apperrors.Wrap(err, "start query")
+	}
+	return appaws.Str(out.QueryId), nil
+}
+
+// QueryResult is the state of a running or finished query. Columns are in
+// the order fields first appear in the results; the internal @ptr field is
+// omitted.
+type QueryResult struct {
+	Status  types.QueryStatus
+	Columns []string
+	Rows    [][]string
+
+	RecordsMatched float64
+	RecordsScanned float64
+	BytesScanned   float64
+}
+
+// Done reports whether the query has stopped running.
+func (r QueryResult) Done() bool {
+	switch r.Status {
+	case types.QueryStatusScheduled, types.QueryStatusRunning, "":
+		return false
+	}
+	return true
+}
+
+// GetQueryResults returns the current results of a query.
+func GetQueryResults(ctx context.Context, client InsightsClient, queryID string) (QueryResult, error) {
+	out, err := client.GetQueryResults(ctx, &cloudwatchlogs.GetQueryResultsInput{
+		QueryId: appaws.StringPtr(queryID),
+	})
+	if err != nil {
+		return QueryResult{}, apperrors.Wrap(err, "get query results")
+	}
+
+	result := QueryResult{Status: out.Status}
+	if s := out.Statistics; s != nil {
+		result.RecordsMatched = s.RecordsMatched
+		result.RecordsScanned = s.RecordsScanned
+		result.BytesScanned = s.BytesScanned
+	}
+
+	index := map[string]int{}
+	for _, fields := range out.Results {
+		for _, f := range fields {
+			name := appaws.Str(f.Field)
+			if _, ok := index[name]; !ok && name != "@ptr" {
+				index[name] = len(result.Columns)
+				result.Columns = append(result.Columns, name)
+			}
+		}
+	}
+	for _, fields := range out.Results {
+		row := make([]string, len(result.Columns))
+		for _, f := range fields {
+			if i, ok := index[appaws.Str(f.Field)]; ok {
+				row[i] = appaws.Str(f.Value)
+			}
+		}
+		result.Rows = append(result.Rows, row)
+	}
+	return result, nil
+}
+
+// StopQuery stops a running query.
+func StopQuery(ctx context.Context, client InsightsClient, queryID string) error {
+	_, err := client.StopQuery(ctx, &cloudwatchlogs.StopQueryInput{
+		QueryId: appaws.StringPtr(queryID),
+	})
+	if err != nil {
+		return apperrors.Wrap(err, "stop query")
+	}
+	return nil
+}
+
+// QueryDefinition is a saved Logs Insights query.
+type QueryDefinition struct {
+	ID     string
+	Name   string
+	Query  string
+	Groups []string
+}
+
+// QueryDefinitions returns the saved queries in the account and region.
+func QueryDefinitions(ctx context.Context, client InsightsClient) ([]QueryDefinition, error) {
+	var defs []QueryDefinition
+	input := &cloudwatchlogs.DescribeQueryDefinitionsInput{}
+	for {
+		out, err := client.DescribeQueryDefinitions(ctx, input)
+		if err != nil {
+			return nil, apperrors.Wrap(err, "describe query definitions")
+		}
+		for _, d := range out.QueryDefinitions {
+			defs = append(defs, QueryDefinition{
+				ID:     appaws.Str(d.QueryDefinitionId),
+				Name:   appaws.Str(d.Name),
+				Query:  appaws.Str(d.QueryString),
+				Groups: d.LogGroupNames,
+			})
+		}
+		if out.NextToken == nil {
+			return defs, nil
+		}
+		input.NextToken = out.NextToken
+	}
+}
+
+// CSV formats the results as CSV with a header row.
+func (r QueryResult) CSV() string {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	_ = w.Write(r.Columns)
+	_ = w.WriteAll(r.Rows) // flushes; writes to a bytes.Buffer cannot fail
+	return buf.String()
+}
+
+// JSON formats the results as an array of objects keyed by column.
+func (r QueryResult) JSON() (string, error) {
+	records := make([]map[string]string, len(r.Rows))
+	for i, row := range r.Rows {
+		rec := make(map[string]string, len(r.Columns))
+		for j, col := range r.Columns {
+			if row[j] != "" {
+				rec[col] = row[j]
+			}
+		}
+		records[i] = rec
+	}
+	data, err := json.MarshalIndent(records, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return string(data) + "\n", nil
+}
@@ -0,0 +1,204 @@
+package logs
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs/types"
+)
+
+type fakeInsightsClient struct {
+	startIn  *cloudwatchlogs.StartQueryInput
+	results  cloudwatchlogs.GetQueryResultsOutput
+	stopped  string
+	defPages []cloudwatchlogs.DescribeQueryDefinitionsOutput
+}
+
+func (f *fakeInsightsClient) StartQuery(_ context.Context, in *cloudwatchlogs.StartQueryInput, _ ...func(*cloudwatchlogs.Options)) (*cloudwatchlogs.StartQueryOutput, error) {
+	f.startIn = in
+	return &cloudwatchlogs.StartQueryOutput{QueryId: aws.String("q-1")}, nil
+}
+
+func (f *fakeInsightsClient) GetQueryResults(_ context.Context, _ *cloudwatchlogs.GetQueryResultsInput, _ ...func(*cloudwatchlogs.Options)) (*cloudwatchlogs.GetQueryResultsOutput, error) {
+	return &f.results, nil
+}
+
+func (f *fakeInsightsClient) StopQuery(_ context.Context, in *cloudwatchlogs.StopQueryInput, _ ...func(*cloudwatchlogs.Options)) (*cloudwatchlogs.StopQueryOutput, error) {
+	f.stopped = aws.ToString(in.QueryId)
+	return &cloudwatchlogs.StopQueryOutput{}, nil
+}
+
+func (f *fakeInsightsClient) DescribeQueryDefinitions(_ context.Context, in *cloudwatchlogs.DescribeQueryDefinitionsInput, _ ...func(*cloudwatchlogs.Options)) (*cloudwatchlogs.DescribeQueryDefinitionsOutput, error) {
+	if in.NextToken == nil {
+		return &f.defPages[0], nil
+	}
+	return &f.defPages[1], nil
+}
+
+func field(name, value string) types.ResultField {
+	return types.ResultField{Field: aws.String(name), Value: aws.String(value)}
+}
+
+func TestStartQuery(t *testing.T) {
+	client := &fakeInsightsClient{}
+	start := time.Unix(1700000000, 0)
+	end := start.Add(time.Hour)
+
+	id, err := StartQuery(context.Background(), client, InsightsQuery{
+		Groups: []string{"/a", "/b"},
+		Query:  "fields @message",
+		Start:  start,
+		End:    end,
+		Limit:  50,
+	})
+	if err != nil {
+		t.Fatalf("StartQuery() error = %v", err)
+	}
+	if id != "q-1" {
+		t.Errorf("id = %q, want q-1", id)
+	}
+	in := client.startIn
+	if len(in.LogGroupNames) != 2 || aws.ToInt64(in.StartTime) != start.Unix() || aws.ToInt64(in.EndTime) != end.Unix() {
+		t.Errorf("unexpected input: groups=%v start=%d end=%d", in.LogGroupNames, aws.ToInt64(in.StartTime), aws.ToInt64(in.EndTime))
+	}
+	if aws.ToInt32(in.Limit) != 50 {
+		t.Errorf("Limit = %d, want 50", aws.ToInt32(in.Limit))
+	}
+}
+
+func TestStartQuery_Invalid(t *testing.T) {
+	client := &fakeInsightsClient{}
+	tooMany := make([]string, MaxInsightsGroups+1)
+	for i := range tooMany {
+		tooMany[i] = "/g"
+	}
+
+	tests := []struct {
+		name string
+		q    InsightsQuery
+	}{
+		{"empty query", InsightsQuery{Groups: []string{"/a"}}},
+		{"no groups", InsightsQuery{Query: "fields @message"}},
+		{"too many groups", InsightsQuery{Groups: tooMany, Query: "fields @message"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := StartQuery(context.Background(), client, tt.q); err == nil {
+				t.Error("expected error")
+			}
+		})
+	}
+	if client.startIn != nil {
+		t.Error("invalid queries should not be started")
+	}
+}
+
+func TestGetQueryResults(t *testing.T) {
+	client := &fakeInsightsClient{results: cloudwatchlogs.GetQueryResultsOutput{
+		Status: types.QueryStatusComplete,
+		Results: [][]types.ResultField{
+			{field("@timestamp", "t1"), field("@message", "hello"), field("@ptr", "p1")},
+			{field("@timestamp", "t2"), field("level", "ERROR"), field("@message", "boom")},
+		},
+		Statistics: &types.QueryStatistics{RecordsMatched: 2, RecordsScanned: 10, BytesScanned: 2048},
+	}}
+
+	r, err := GetQueryResults(context.Background(), client, "q-1")
+	if err != nil {
+		t.Fatalf("GetQueryResults() error = %v", err)
+	}
+	if !r.Done() {
+		t.Error("Done() = false for a complete query")
+	}
+	if got := strings.Join(r.Columns, ","); got != "@timestamp,@message,level" {
+		t.Errorf("Columns = %s", got)
+	}
+	if got := strings.Join(r.Rows[0], "|"); got != "t1|hello|" {
+		t.Errorf("Rows[0] = %s", got)
+	}
+	if got := strings.Join(r.Rows[1], "|"); got != "t2|boom|ERROR" {
+		t.Errorf("Rows[1] = %s", got)
+	}
+	if r.RecordsMatched != 2 || r.RecordsScanned != 10 || r.BytesScanned != 2048 {
+		t.Errorf("unexpected statistics: %+v", r)
+	}
+}
+
+func TestQueryResultDone(t *testing.T) {
+	for status, want := range map[types.QueryStatus]bool{
+		types.QueryStatusScheduled: false,
+		types.QueryStatusRunning:   false,
+		types.QueryStatusComplete:  true,
+		types.QueryStatusFailed:    true,
+		types.QueryStatusCancelled: true,
+		types.QueryStatusTimeout:   true,
+	} {
+		if got := (QueryResult{Status: status}).Done(); got != want {
+			t.Errorf("Done(%s) = %v, want %v", status, got, want)
+		}
+	}
+}
+
+func TestStopQuery(t *testing.T) {
+	client := &fakeInsightsClient{}
+	if err := StopQuery(context.Background(), client, "q-9"); err != nil {
+		t.Fatalf("StopQuery() error = %v", err)
+	}
+	if client.stopped != "q-9" {
+		t.Errorf("stopped = %q, want q-9", client.stopped)
+	}
+}
+
+func TestQueryDefinitions(t *testing.T) {
+	client := &fakeInsightsClient{defPages: []cloudwatchlogs.DescribeQueryDefinitionsOutput{
+		{
+			QueryDefinitions: []types.QueryDefinition{
+				{QueryDefinitionId: aws.String("d1"), Name: aws.String("errors"), QueryString: aws.String("filter level = 'ERROR'"), LogGroupNames: []string{"/a"}},
+			},
+			NextToken: aws.String("next"),
+		},
+		{
+			QueryDefinitions: []types.QueryDefinition{
+				{QueryDefinitionId: aws.String("d2"), Name: aws.String("latency"), QueryString: aws.String("stats avg(duration)")},
+			},
+		},
+	}}
+
+	defs, err := QueryDefinitions(context.Background(), client)
+	if err != nil {
+		t.Fatalf("QueryDefinitions() error = %v", err)
+	}
+	if len(defs) != 2 || defs[0].Name != "errors" || defs[1].ID != "d2" {
+		t.Fatalf("defs = %+v", defs)
+	}
+	if len(defs[0].Groups) != 1 || defs[0].Groups[0] != "/a" {
+		t.Errorf("defs[0].Groups = %v", defs[0].Groups)
+	}
+}
+
+func TestQueryResultExport(t *testing.T) {
+	r := QueryResult{
+		Columns: []string{"@timestamp", "@message"},
+		Rows:    [][]string{{"t1", `say "hi", ok`}, {"t2", ""}},
+	}
+
+	want := "@timestamp,@message\nt1,\"say \"\"hi\"\", ok\"\nt2,\n"
+	if got := r.CSV(); got != want {
+		t.Errorf("CSV() = %q, want %q", got, want)
+	}
+
+	data, err := r.JSON()
+	if err != nil {
+		t.Fatalf("JSON() error = %v", err)
+	}
+	if !strings.Contains(data, `"@message": "say \"hi\", ok"`) {
+		t.Errorf("JSON() = %s", data)
+	}
+	if strings.Count(data, "@message") != 1 {
+		t.Errorf("empty values should be omitted: %s", data)
+	}
+}
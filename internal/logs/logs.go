@@ -0,0 +1,289 @@
+// Package logs reads CloudWatch Logs events for the log viewer: historical
+// pages via FilterLogEvents and live events via StartLiveTail.
+package logs
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs/types"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	apperrors "github.com/clawscli/claws/internal/errors"
+)
+
+// DefaultSince is the initial time range when a target does not set one.
+const DefaultSince = time.Hour
+
+// maxStreams is the API limit for stream names in a single request.
+const maxStreams = 100
+
+// Target identifies the log events to show.
+type Target struct {
+	Group        string        // Log group name
+	Streams      []string      // Optional stream names, interleaved by time
+	StreamPrefix string        // Optional stream name prefix (ignored when Streams is set)
+	Title        string        // Optional title (defaults to the group name)
+	Since        time.Duration // Initial time range (default: DefaultSince)
+	Follow       bool          // Start in follow (live tail) mode
+
+	// Resolve computes the target when it needs an API call, such as reading
+	// an ECS task definition's log configuration. Called once when the viewer
+	// opens; its result replaces the target.
+	Resolve func(ctx context.Context) (Target, error)
+}
+
+// Provider is implemented by resources that have an associated log target.
+type Provider interface {
+	LogTarget() Target
+}
+
+// Label returns the title for the target.
+func (t Target) Label() string {
+	if t.Title != "" {
+		return t.Title
+	}
+	switch {
+	case len(t.Streams) == 1:
+		return t.Group + " › " + t.Streams[0]
+	case len(t.Streams) > 1:
+		return fmt.Sprintf("%s (%d streams)", t.Group, len(t.Streams))
+	case t.StreamPrefix != "":
+		return t.Group + " › " + t.StreamPrefix + "*"
+	default:
+		return t.Group
+	}
+}
+
+// Event is a single log event.
+type Event struct {
+	Time    time.Time
+	Stream  string
+	Message string
+}
+
+// Client is the subset of the CloudWatch Logs API used by this package.
+type Client interface {
+	FilterLogEvents(ctx context.Context, params *cloudwatchlogs.FilterLogEventsInput, optFns ...func(*cloudwatchlogs.Options)) (*cloudwatchlogs.FilterLogEventsOutput, error)
+	DescribeLogGroups(ctx context.Context, params *cloudwatchlogs.DescribeLogGroupsInput, optFns ...func(*cloudwatchlogs.Options)) (*cloudwatchlogs.DescribeLogGroupsOutput, error)
+	StartLiveTail(ctx context.Context, params *cloudwatchlogs.StartLiveTailInput, optFns ...func(*cloudwatchlogs.Options)) (*cloudwatchlogs.StartLiveTailOutput, error)
+}
+
+// NewClient creates a CloudWatch Logs client for the profile and region in ctx.
+func NewClient(ctx context.Context) (*cloudwatchlogs.Client, error) {
+	cfg, err := appaws.NewConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return cloudwatchlogs.NewFromConfig(cfg), nil
+}
+
+// Query selects a page of historical events.
+type Query struct {
+	Start     time.Time
+	End       time.Time // zero means now
+	Pattern   string    // CloudWatch Logs filter pattern
+	NextToken string
+	Limit     int // stop after this many events (0: DefaultLimit)
+}
+
+// DefaultLimit is the number of events fetched per Fetch call.
+const DefaultLimit = 5000
+
+// maxCalls bounds the FilterLogEvents calls per Fetch, since a sparse filter
+// can return many empty pages.
+const maxCalls = 50
+
+// Page is a batch of historical events. NextToken is set when more events
+// are available in the query's time range.
+type Page struct {
+	Events    []Event
+	NextToken string
+}
+
+// Fetch returns events for the target in time order, following
+// FilterLogEvents pages until the limit is reached.
+func Fetch(ctx context.Context, client Client, t Target, q Query) (Page, error) {
+	limit := q.Limit
+	if limit <= 0 {
+		limit = DefaultLimit
+	}
+
+	input := &cloudwatchlogs.FilterLogEventsInput{
+		LogGroupName: appaws.StringPtr(t.Group),
+		StartTime:    appaws.Int64Ptr(q.Start.UnixMilli()),
+	}
+	if !q.End.IsZero() {
+		input.EndTime = appaws.Int64Ptr(q.End.UnixMilli())
+	}
+	if q.Pattern != "" {
+		input.FilterPattern = appaws.StringPtr(q.Pattern)
+	}
+	if streams := limitStreams(t.Streams); len(streams) > 0 {
+		input.LogStreamNames = streams
+	} else if t.StreamPrefix != "" {
+		input.LogStreamNamePrefix = appaws.StringPtr(t.StreamPrefix)
+	}
+
+	var page Page
+	token := q.NextToken
+	for range maxCalls {
+		if token != "" {
+			input.NextToken = appaws.StringPtr(token)
+		}
+		out, err := client.FilterLogEvents(ctx, input)
+		if err != nil {
+			return Page{}, apperrors.Wrap(err, "filter log events")
+		}
+		for _, e := range out.Events {
+			page.Events = append(page.Events, Event{
+				Time:    time.UnixMilli(appaws.Int64(e.Timestamp)),
+				Stream:  appaws.Str(e.LogStreamName),
+				Message: strings.TrimRight(appaws.Str(e.Message), "\n"),
+			})
+		}
+
+		next := appaws.Str(out.NextToken)
+		if next == "" || next == token {
+			return page, nil
+		}
+		token = next
+		if len(page.Events) >= limit {
+			break
+		}
+	}
+	page.NextToken = token
+	return page, nil
+}
+
+// Batch is a group of live events, or the error that ended the session.
+type Batch struct {
+	Events []Event
+	Err    error
+}
+
+// Tail starts a live tail session. Batches are sent on the returned channel
+// until ctx is cancelled or the session ends, after which it is closed.
+// A session that ends with an error sends a final batch with Err set.
+func Tail(ctx context.Context, client Client, t Target, pattern string) (<-chan Batch, error) {
+	arn, err := groupARN(ctx, client, t.Group)
+	if err != nil {
+		return nil, err
+	}
+
+	input := &cloudwatchlogs.StartLiveTailInput{
+		LogGroupIdentifiers: []string{arn},
+	}
+	if pattern != "" {
+		input.LogEventFilterPattern = appaws.StringPtr(pattern)
+	}
+	if streams := limitStreams(t.Streams); len(streams) > 0 {
+		input.LogStreamNames = streams
+	} else if t.StreamPrefix != "" {
+		input.LogStreamNamePrefixes = []string{t.StreamPrefix}
+	}
+
+	stream, err := startLiveTail(ctx, client, input)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "start live tail")
+	}
+
+	ch := make(chan Batch)
+	go func() {
+		defer close(ch)
+		defer stream.Close()
+
+		send := func(b Batch) bool {
+			select {
+			case ch <- b:
+				return true
+			case <-ctx.Done():
+				return false
+			}
+		}
+
+		events := stream.Events()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case ev, ok := <-events:
+				if !ok {
+					if err := stream.Err(); err != nil && ctx.Err() == nil {
+						send(Batch{Err: apperrors.Wrap(err, "live tail")})
+					}
+					return
+				}
+				update, ok := ev.(*types.StartLiveTailResponseStreamMemberSessionUpdate)
+				if !ok || len(update.Value.SessionResults) == 0 {
+					continue
+				}
+				batch := Batch{Events: make([]Event, 0, len(update.Value.SessionResults))}
+				for _, e := range update.Value.SessionResults {
+					batch.Events = append(batch.Events, Event{
+						Time:    time.UnixMilli(appaws.Int64(e.Timestamp)),
+						Stream:  appaws.Str(e.LogStreamName),
+						Message: strings.TrimRight(appaws.Str(e.Message), "\n"),
+					})
+				}
+				if !send(batch) {
+					return
+				}
+			}
+		}
+	}()
+	return ch, nil
+}
+
+// tailStream is the event stream of a live tail session.
+type tailStream interface {
+	Events() <-chan types.StartLiveTailResponseStream
+	Close() error
+	Err() error
+}
+
+// startLiveTail is replaced in tests, since the SDK output cannot carry a
+// fake stream.
+var startLiveTail = func(ctx context.Context, client Client, input *cloudwatchlogs.StartLiveTailInput) (tailStream, error) {
+	out, err := client.StartLiveTail(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+	return out.GetStream(), nil
+}
+
+// groupARN looks up the ARN of a log group, which StartLiveTail requires.
+func groupARN(ctx context.Context, client Client, group string) (string, error) {
+	input := &cloudwatchlogs.DescribeLogGroupsInput{
+		LogGroupNamePrefix: appaws.StringPtr(group),
+	}
+	for {
+		out, err := client.DescribeLogGroups(ctx, input)
+		if err != nil {
+			return "", apperrors.Wrap(err, "describe log groups")
+		}
+		for _, g := range out.LogGroups {
+			if appaws.Str(g.LogGroupName) != group {
+				continue
+			}
+			if arn := appaws.Str(g.LogGroupArn); arn != "" {
+				return arn, nil
+			}
+			return strings.TrimSuffix(appaws.Str(g.Arn), ":*"), nil
+		}
+		if out.NextToken == nil {
+			return "", fmt.Errorf("log group %s not found", group)
+		}
+		input.NextToken = out.NextToken
+	}
+}
+
+func limitStreams(streams []string) []string {
+	if len(streams) > maxStreams {
+		return streams[:maxStreams]
+	}
+	return streams
+}
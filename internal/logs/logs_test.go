@@ -0,0 +1,194 @@
+package logs
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs/types"
+)
+
+type fakeClient struct {
+	pages     []cloudwatchlogs.FilterLogEventsOutput
+	filterIn  []cloudwatchlogs.FilterLogEventsInput
+	groups    []types.LogGroup
+	tailIn    *cloudwatchlogs.StartLiveTailInput
+	tailItems []types.StartLiveTailResponseStream
+	tailErr   error
+}
+
+func (f *fakeClient) FilterLogEvents(_ context.Context, in *cloudwatchlogs.FilterLogEventsInput, _ ...func(*cloudwatchlogs.Options)) (*cloudwatchlogs.FilterLogEventsOutput, error) {
+	f.filterIn = append(f.filterIn, *in)
+	i := 0
+	if in.NextToken != nil {
+		for n, p := range f.pages {
+			if p.NextToken != nil && *p.NextToken == *in.NextToken {
+				i = n + 1
+			}
+		}
+	}
+	return &f.pages[i], nil
+}
+
+func (f *fakeClient) DescribeLogGroups(_ context.Context, _ *cloudwatchlogs.DescribeLogGroupsInput, _ ...func(*cloudwatchlogs.Options)) (*cloudwatchlogs.DescribeLogGroupsOutput, error) {
+	return &cloudwatchlogs.DescribeLogGroupsOutput{LogGroups: f.groups}, nil
+}
+
+func (f *fakeClient) StartLiveTail(_ context.Context, _ *cloudwatchlogs.StartLiveTailInput, _ ...func(*cloudwatchlogs.Options)) (*cloudwatchlogs.StartLiveTailOutput, error) {
+	return nil, errors.New("not used")
+}
+
+type fakeStream struct {
+	ch  chan types.StartLiveTailResponseStream
+	err error
+}
+
+func (s *fakeStream) Events() <-chan types.StartLiveTailResponseStream { return s.ch }
+func (s *fakeStream) Close() error                                     { return nil }
+func (s *fakeStream) Err() error                                       { return s.err }
+
+// fakeLiveTail serves the client's tail items instead of calling the API.
+func fakeLiveTail(t *testing.T) {
+	t.Helper()
+	orig := startLiveTail
+	startLiveTail = func(_ context.Context, client Client, in *cloudwatchlogs.StartLiveTailInput) (tailStream, error) {
+		f := client.(*fakeClient)
+		f.tailIn = in
+		stream := &fakeStream{ch: make(chan types.StartLiveTailResponseStream, len(f.tailItems)), err: f.tailErr}
+		for _, item := range f.tailItems {
+			stream.ch <- item
+		}
+		close(stream.ch)
+		return stream, nil
+	}
+	t.Cleanup(func() { startLiveTail = orig })
+}
+
+func filtered(stream, msg string, ts int64) types.FilteredLogEvent {
+	return types.FilteredLogEvent{LogStreamName: aws.String(stream), Message: aws.String(msg), Timestamp: aws.Int64(ts)}
+}
+
+func TestFetch(t *testing.T) {
+	client := &fakeClient{pages: []cloudwatchlogs.FilterLogEventsOutput{
+		{Events: []types.FilteredLogEvent{filtered("a", "one\n", 1000)}, NextToken: aws.String("t1")},
+		{NextToken: aws.String("t2")}, // empty page while searching
+		{Events: []types.FilteredLogEvent{filtered("b", "two", 2000), filtered("a", "three", 3000)}},
+	}}
+
+	target := Target{Group: "/app", Streams: []string{"a", "b"}}
+	start := time.UnixMilli(500)
+	page, err := Fetch(context.Background(), client, target, Query{Start: start, Pattern: "ERROR"})
+	if err != nil {
+		t.Fatalf("Fetch() error = %v", err)
+	}
+	if len(page.Events) != 3 || page.NextToken != "" {
+		t.Fatalf("Fetch() = %d events, next %q; want 3, \"\"", len(page.Events), page.NextToken)
+	}
+	if page.Events[0].Message != "one" || page.Events[1].Stream != "b" || !page.Events[2].Time.Equal(time.UnixMilli(3000)) {
+		t.Errorf("Fetch() events = %+v", page.Events)
+	}
+
+	in := client.filterIn[0]
+	if aws.ToString(in.LogGroupName) != "/app" || aws.ToString(in.FilterPattern) != "ERROR" ||
+		aws.ToInt64(in.StartTime) != 500 || len(in.LogStreamNames) != 2 || in.EndTime != nil {
+		t.Errorf("FilterLogEvents input = %+v", in)
+	}
+}
+
+func TestFetch_Limit(t *testing.T) {
+	client := &fakeClient{pages: []cloudwatchlogs.FilterLogEventsOutput{
+		{Events: []types.FilteredLogEvent{filtered("a", "one", 1), filtered("a", "two", 2)}, NextToken: aws.String("t1")},
+		{Events: []types.FilteredLogEvent{filtered("a", "three", 3)}},
+	}}
+
+	page, err := Fetch(context.Background(), client, Target{Group: "/app", StreamPrefix: "web/"}, Query{Limit: 2})
+	if err != nil {
+		t.Fatalf("Fetch() error = %v", err)
+	}
+	if len(page.Events) != 2 || page.NextToken != "t1" {
+		t.Errorf("Fetch() = %d events, next %q; want 2, t1", len(page.Events), page.NextToken)
+	}
+	if aws.ToString(client.filterIn[0].LogStreamNamePrefix) != "web/" {
+		t.Errorf("LogStreamNamePrefix = %q, want web/", aws.ToString(client.filterIn[0].LogStreamNamePrefix))
+	}
+
+	page, err = Fetch(context.Background(), client, Target{Group: "/app"}, Query{NextToken: page.NextToken})
+	if err != nil || len(page.Events) != 1 || page.Events[0].Message != "three" {
+		t.Errorf("Fetch(next) = %+v, %v", page, err)
+	}
+}
+
+func TestTail(t *testing.T) {
+	fakeLiveTail(t)
+	client := &fakeClient{
+		groups: []types.LogGroup{
+			{LogGroupName: aws.String("/app-other"), LogGroupArn: aws.String("arn:aws:logs:us-east-1:123:log-group:/app-other")},
+			{LogGroupName: aws.String("/app"), Arn: aws.String("arn:aws:logs:us-east-1:123:log-group:/app:*")},
+		},
+		tailItems: []types.StartLiveTailResponseStream{
+			&types.StartLiveTailResponseStreamMemberSessionStart{},
+			&types.StartLiveTailResponseStreamMemberSessionUpdate{Value: types.LiveTailSessionUpdate{
+				SessionResults: []types.LiveTailSessionLogEvent{
+					{LogStreamName: aws.String("s1"), Message: aws.String("hello\n"), Timestamp: aws.Int64(1000)},
+				},
+			}},
+		},
+		tailErr: errors.New("session timed out"),
+	}
+
+	ch, err := Tail(context.Background(), client, Target{Group: "/app", Streams: []string{"s1"}}, "ERROR")
+	if err != nil {
+		t.Fatalf("Tail() error = %v", err)
+	}
+
+	var batches []Batch
+	for b := range ch {
+		batches = append(batches, b)
+	}
+	if len(batches) != 2 {
+		t.Fatalf("Tail() sent %d batches, want 2", len(batches))
+	}
+	if len(batches[0].Events) != 1 || batches[0].Events[0].Message != "hello" {
+		t.Errorf("first batch = %+v", batches[0])
+	}
+	if batches[1].Err == nil || !strings.Contains(batches[1].Err.Error(), "session timed out") {
+		t.Errorf("last batch error = %v, want session timed out", batches[1].Err)
+	}
+
+	in := client.tailIn
+	if len(in.LogGroupIdentifiers) != 1 || in.LogGroupIdentifiers[0] != "arn:aws:logs:us-east-1:123:log-group:/app" {
+		t.Errorf("LogGroupIdentifiers = %v", in.LogGroupIdentifiers)
+	}
+	if aws.ToString(in.LogEventFilterPattern) != "ERROR" || len(in.LogStreamNames) != 1 {
+		t.Errorf("StartLiveTail input = %+v", in)
+	}
+}
+
+func TestTail_GroupNotFound(t *testing.T) {
+	_, err := Tail(context.Background(), &fakeClient{}, Target{Group: "/missing"}, "")
+	if err == nil || !strings.Contains(err.Error(), "not found") {
+		t.Errorf("Tail() error = %v, want not found", err)
+	}
+}
+
+func TestTargetLabel(t *testing.T) {
+	tests := []struct {
+		target Target
+		want   string
+	}{
+		{Target{Group: "/app"}, "/app"},
+		{Target{Group: "/app", Title: "Lambda my-fn"}, "Lambda my-fn"},
+		{Target{Group: "/app", Streams: []string{"s1"}}, "/app › s1"},
+		{Target{Group: "/app", Streams: []string{"s1", "s2"}}, "/app (2 streams)"},
+		{Target{Group: "/app", StreamPrefix: "jr_1"}, "/app › jr_1*"},
+	}
+	for _, tt := range tests {
+		if got := tt.target.Label(); got != tt.want {
+			t.Errorf("Label() = %q, want %q", got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,94 @@
+package plugin
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/clawscli/claws/internal/action"
+	"github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/config"
+	"github.com/clawscli/claws/internal/dao"
+)
+
+const deleteActionName = "Delete"
+
+// operation returns the unique action operation for a plugin action.
+func operation(spec TypeSpec, name string) string {
+	return fmt.Sprintf("plugin:%s/%s:%s", spec.Service, spec.Resource, name)
+}
+
+// pluginActions converts the declared actions to registry actions, adding
+// Delete when the type supports it.
+func pluginActions(spec TypeSpec) []action.Action {
+	acts := make([]action.Action, 0, len(spec.Actions)+1)
+	for _, a := range spec.Actions {
+		op := operation(spec, a.Name)
+		acts = append(acts, action.Action{
+			Name:      a.Name,
+			Shortcut:  a.Shortcut,
+			Type:      action.ActionTypeAPI,
+			Operation: op,
+			Confirm:   confirmLevel(a.Confirm),
+		})
+	}
+	if spec.Delete {
+		acts = append(acts, action.Action{
+			Name:      deleteActionName,
+			Shortcut:  "D",
+			Type:      action.ActionTypeAPI,
+			Operation: operation(spec, strings.ToLower(deleteActionName)),
+			Confirm:   action.ConfirmDangerous,
+		})
+	}
+	return acts
+}
+
+func confirmLevel(s string) action.ConfirmLevel {
+	switch s {
+	case "simple":
+		return action.ConfirmSimple
+	case "dangerous":
+		return action.ConfirmDangerous
+	default:
+		return action.ConfirmNone
+	}
+}
+
+// newExecutor returns the action executor for a plugin-provided type.
+func newExecutor(p *Plugin, spec TypeSpec) action.ExecutorFunc {
+	return func(ctx context.Context, act action.Action, resource dao.Resource) action.ActionResult {
+		if region := dao.GetResourceRegion(resource); region != "" {
+			ctx = aws.WithRegionOverride(ctx, region)
+		}
+		if profile := dao.GetResourceProfile(resource); profile != "" {
+			ctx = aws.WithSelectionOverride(ctx, config.ProfileSelectionFromID(profile))
+		}
+
+		d := NewPluginDAO(p, spec)
+		id := dao.UnwrapResource(resource).GetID()
+
+		if spec.Delete && act.Operation == operation(spec, strings.ToLower(deleteActionName)) {
+			if err := d.Delete(ctx, id); err != nil {
+				return action.FailResult(err)
+			}
+			return action.SuccessResult(fmt.Sprintf("Deleted %s", id))
+		}
+
+		for _, a := range spec.Actions {
+			if act.Operation != operation(spec, a.Name) {
+				continue
+			}
+			resp, err := d.request(ctx, Request{Method: MethodAction, ID: id, Action: a.Name})
+			if err != nil {
+				return action.FailResult(err)
+			}
+			msg := resp.Message
+			if msg == "" {
+				msg = fmt.Sprintf("%s: %s", a.Name, id)
+			}
+			return action.SuccessResult(msg)
+		}
+		return action.UnknownOperationResult(act.Operation)
+	}
+}
@@ -0,0 +1,165 @@
+package plugin
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/log"
+)
+
+// PluginDAO lists, gets and deletes resources through a plugin.
+type PluginDAO struct {
+	dao.BaseDAO
+	plugin *Plugin
+	spec   TypeSpec
+}
+
+// NewPluginDAO creates a DAO for a plugin-provided resource type.
+func NewPluginDAO(p *Plugin, spec TypeSpec) *PluginDAO {
+	return &PluginDAO{
+		BaseDAO: dao.NewBaseDAO(spec.Service, spec.Resource),
+		plugin:  p,
+		spec:    spec,
+	}
+}
+
+// Supports reports delete support as declared by the plugin.
+func (d *PluginDAO) Supports(op dao.Operation) bool {
+	switch op {
+	case dao.OpList, dao.OpGet:
+		return true
+	case dao.OpDelete:
+		return d.spec.Delete
+	default:
+		return false
+	}
+}
+
+// List returns all resources, following the plugin's page tokens for up to
+// maxListPages pages.
+func (d *PluginDAO) List(ctx context.Context) ([]dao.Resource, error) {
+	var all []dao.Resource
+	token := ""
+	for range maxListPages {
+		page, next, err := d.ListPage(ctx, 0, token)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, page...)
+		if next == "" || next == token {
+			return all, nil
+		}
+		token = next
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+	}
+	log.Warn("plugin list truncated", "plugin", d.plugin.Name(), "type", d.spec.Service+"/"+d.spec.Resource, "pages", maxListPages)
+	return all, nil
+}
+
+// ListPage returns one page of resources.
+func (d *PluginDAO) ListPage(ctx context.Context, pageSize int, pageToken string) ([]dao.Resource, string, error) {
+	resp, err := d.request(ctx, Request{
+		Method:    MethodList,
+		Filters:   d.filters(ctx),
+		PageSize:  pageSize,
+		PageToken: pageToken,
+	})
+	if err != nil {
+		return nil, "", err
+	}
+
+	resources := make([]dao.Resource, 0, len(resp.Resources))
+	for _, data := range resp.Resources {
+		resources = append(resources, NewPluginResource(data))
+	}
+	return resources, resp.NextToken, nil
+}
+
+// Get returns a single resource by ID.
+func (d *PluginDAO) Get(ctx context.Context, id string) (dao.Resource, error) {
+	resp, err := d.request(ctx, Request{Method: MethodGet, ID: id})
+	if err != nil {
+		return nil, err
+	}
+	if resp.Resource == nil {
+		return nil, fmt.Errorf("%s/%s %s not found", d.spec.Service, d.spec.Resource, id)
+	}
+	return NewPluginResource(*resp.Resource), nil
+}
+
+// Delete deletes a resource by ID.
+func (d *PluginDAO) Delete(ctx context.Context, id string) error {
+	if !d.spec.Delete {
+		return fmt.Errorf("%s/%s does not support delete", d.spec.Service, d.spec.Resource)
+	}
+	_, err := d.request(ctx, Request{Method: MethodDelete, ID: id})
+	return err
+}
+
+func (d *PluginDAO) request(ctx context.Context, req Request) (*Response, error) {
+	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	defer cancel()
+
+	req.Service = d.spec.Service
+	req.Resource = d.spec.Resource
+	return d.plugin.call(ctx, req)
+}
+
+// filters collects the declared filter keys set in ctx by navigation.
+func (d *PluginDAO) filters(ctx context.Context) map[string]string {
+	var filters map[string]string
+	for _, key := range d.spec.Filters {
+		if v := dao.GetFilterFromContext(ctx, key); v != "" {
+			if filters == nil {
+				filters = make(map[string]string)
+			}
+			filters[key] = v
+		}
+	}
+	return filters
+}
+
+// PluginResource wraps a resource returned by a plugin.
+type PluginResource struct {
+	dao.BaseResource
+	Fields map[string]any
+}
+
+// NewPluginResource creates a resource from plugin data.
+func NewPluginResource(data ResourceData) *PluginResource {
+	name := data.Name
+	if name == "" {
+		name = data.ID
+	}
+	return &PluginResource{
+		BaseResource: dao.BaseResource{
+			ID:   data.ID,
+			Name: name,
+			ARN:  data.ARN,
+			Tags: data.Tags,
+			Data: data,
+		},
+		Fields: data.Fields,
+	}
+}
+
+// Field returns a field as a display string: id, name and arn refer to the
+// resource itself, anything else to its fields.
+func (r *PluginResource) Field(key string) string {
+	switch key {
+	case "id":
+		return r.ID
+	case "name":
+		return r.Name
+	case "arn":
+		return r.ARN
+	}
+	v, ok := r.Fields[key]
+	if !ok {
+		return ""
+	}
+	return formatValue(v)
+}
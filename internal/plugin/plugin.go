@@ -0,0 +1,302 @@
+// Package plugin loads external resource types from executables that speak a
+// JSON protocol over stdin/stdout.
+//
+// Each request runs the plugin once: claws writes a Request to its stdin and
+// reads a Response from its stdout. On startup every executable in the plugin
+// directory is asked to "describe" the resource types it provides, which are
+// then registered into the resource and action registries like native types.
+// See docs/plugins.md for the protocol.
+package plugin
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/clawscli/claws/internal/action"
+	"github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/config"
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/log"
+	"github.com/clawscli/claws/internal/registry"
+	"github.com/clawscli/claws/internal/render"
+)
+
+const (
+	// DirEnv overrides the plugin directory.
+	DirEnv = "CLAWS_PLUGIN_DIR"
+
+	describeTimeout = 5 * time.Second
+	requestTimeout  = 30 * time.Second
+	maxOutput       = 16 << 20
+
+	// maxListPages bounds the pages List follows, so a plugin that keeps
+	// returning new page tokens cannot loop forever.
+	maxListPages = 50
+)
+
+// Plugin is an external executable providing resource types.
+type Plugin struct {
+	Path  string
+	Types []TypeSpec
+}
+
+// Name returns the plugin's file name.
+func (p *Plugin) Name() string {
+	return filepath.Base(p.Path)
+}
+
+// DefaultDir returns the plugin directory: $CLAWS_PLUGIN_DIR, or "claws/plugins"
+// under the user config directory.
+func DefaultDir() string {
+	if dir := os.Getenv(DirEnv); dir != "" {
+		return dir
+	}
+	base, err := os.UserConfigDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(base, "claws", "plugins")
+}
+
+// Discover returns the executables in dir, sorted by name. A missing
+// directory yields no plugins.
+func Discover(dir string) ([]string, error) {
+	if dir == "" {
+		return nil, nil
+	}
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("read plugin dir: %w", err)
+	}
+
+	var paths []string
+	for _, e := range entries {
+		if e.IsDir() || strings.HasPrefix(e.Name(), ".") {
+			continue
+		}
+		path := filepath.Join(dir, e.Name())
+		info, err := os.Stat(path) // follow symlinks
+		if err != nil || !info.Mode().IsRegular() || !isExecutable(path, info) {
+			continue
+		}
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	return paths, nil
+}
+
+func isExecutable(path string, info os.FileInfo) bool {
+	if runtime.GOOS == "windows" {
+		switch strings.ToLower(filepath.Ext(path)) {
+		case ".exe", ".bat", ".cmd":
+			return true
+		}
+		return false
+	}
+	return info.Mode().Perm()&0o111 != 0
+}
+
+// Load discovers the plugins in dir, describes them concurrently, and
+// registers their resource types. Types that clash with an existing
+// registration are skipped. Per-plugin failures are logged and returned
+// without stopping other plugins from loading.
+func Load(ctx context.Context, dir string, reg *registry.Registry, actions *action.Registry) ([]*Plugin, []error) {
+	paths, err := Discover(dir)
+	if err != nil {
+		return nil, []error{err}
+	}
+
+	plugins := make([]*Plugin, len(paths))
+	errs := make([]error, len(paths))
+	var wg sync.WaitGroup
+	for i, path := range paths {
+		wg.Add(1)
+		go func(i int, path string) {
+			defer wg.Done()
+			plugins[i], errs[i] = Describe(ctx, path)
+		}(i, path)
+	}
+	wg.Wait()
+
+	var loaded []*Plugin
+	var failures []error
+	for i, p := range plugins {
+		if errs[i] != nil {
+			log.Warn("plugin describe failed", "plugin", paths[i], "error", errs[i])
+			failures = append(failures, errs[i])
+			continue
+		}
+		if err := Register(p, reg, actions); err != nil {
+			log.Warn("plugin registration incomplete", "plugin", p.Name(), "error", err)
+			failures = append(failures, err)
+		}
+		loaded = append(loaded, p)
+		log.Info("plugin loaded", "plugin", p.Name(), "types", len(p.Types))
+	}
+	return loaded, failures
+}
+
+// Describe runs the plugin's describe method and validates its type specs.
+func Describe(ctx context.Context, path string) (*Plugin, error) {
+	ctx, cancel := context.WithTimeout(ctx, describeTimeout)
+	defer cancel()
+
+	p := &Plugin{Path: path}
+	resp, err := p.call(ctx, Request{Method: MethodDescribe})
+	if err != nil {
+		return nil, err
+	}
+	if resp.ProtocolVersion != ProtocolVersion {
+		return nil, fmt.Errorf("plugin %s: unsupported protocol version %d (want %d)", p.Name(), resp.ProtocolVersion, ProtocolVersion)
+	}
+	for _, t := range resp.Types {
+		if err := t.Validate(); err != nil {
+			return nil, fmt.Errorf("plugin %s: %w", p.Name(), err)
+		}
+	}
+	p.Types = resp.Types
+	return p, nil
+}
+
+// Register adds the plugin's types to the resource and action registries.
+// Types of services registered before the plugin are skipped, so plugins
+// cannot add to, rename or recategorize native services.
+func Register(p *Plugin, reg *registry.Registry, actions *action.Registry) error {
+	existing := make(map[string]bool)
+	for _, svc := range reg.ListServices() {
+		existing[svc] = true
+	}
+
+	var errs []string
+	for _, t := range p.Types {
+		if existing[t.Service] {
+			errs = append(errs, fmt.Sprintf("%s/%s: service %s already registered", t.Service, t.Resource, t.Service))
+			continue
+		}
+		if _, exists := reg.Get(t.Service, t.Resource); exists {
+			errs = append(errs, fmt.Sprintf("%s/%s already registered", t.Service, t.Resource))
+			continue
+		}
+
+		spec := t
+		reg.RegisterCustom(spec.Service, spec.Resource, registry.Entry{
+			DAOFactory: func(ctx context.Context) (dao.DAO, error) {
+				return NewPluginDAO(p, spec), nil
+			},
+			RendererFactory: func() render.Renderer {
+				return NewPluginRenderer(spec)
+			},
+		})
+		if spec.DisplayName != "" {
+			reg.SetDisplayName(spec.Service, spec.DisplayName)
+		}
+		category := spec.Category
+		if category == "" {
+			category = "Plugins"
+		}
+		reg.AddToCategory(category, spec.Service)
+
+		if acts := pluginActions(spec); len(acts) > 0 {
+			actions.Register(spec.Service, spec.Resource, acts)
+			for _, a := range spec.Actions {
+				if a.ReadOnly {
+					actions.AllowInReadOnly(spec.Service, spec.Resource, operation(spec, a.Name))
+				}
+			}
+			actions.RegisterExecutor(spec.Service, spec.Resource, newExecutor(p, spec))
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("plugin %s: %s", p.Name(), strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+// call runs the plugin with req on stdin and decodes its response.
+// AWS profile and region are passed both in the request and in the
+// environment, so plugins can use the AWS SDK or CLI directly.
+func (p *Plugin) call(ctx context.Context, req Request) (*Response, error) {
+	req.Version = ProtocolVersion
+
+	sel := config.Global().Selection()
+	if ctxSel, ok := aws.GetSelectionFromContext(ctx); ok {
+		sel = ctxSel
+	}
+	region := aws.GetRegionFromContext(ctx)
+	if region == "" {
+		region = config.Global().Region()
+	}
+	if req.Method != MethodDescribe {
+		req.Profile = sel.ID()
+		req.Region = region
+	}
+
+	input, err := json.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+
+	cmd := exec.CommandContext(ctx, p.Path)
+	cmd.Stdin = bytes.NewReader(input)
+	cmd.Env = aws.BuildSubprocessEnv(nil, sel, region)
+	var stdout limitedBuffer
+	var stderr bytes.Buffer
+	stdout.limit = maxOutput
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		if msg := lastLine(stderr.String()); msg != "" {
+			return nil, fmt.Errorf("plugin %s %s: %w: %s", p.Name(), req.Method, err, msg)
+		}
+		return nil, fmt.Errorf("plugin %s %s: %w", p.Name(), req.Method, err)
+	}
+	if stdout.overflow {
+		return nil, fmt.Errorf("plugin %s %s: output exceeds %d bytes", p.Name(), req.Method, maxOutput)
+	}
+
+	var resp Response
+	if err := json.Unmarshal(stdout.Bytes(), &resp); err != nil {
+		return nil, fmt.Errorf("plugin %s %s: invalid response: %w", p.Name(), req.Method, err)
+	}
+	if resp.Error != "" {
+		return nil, fmt.Errorf("plugin %s %s: %s", p.Name(), req.Method, resp.Error)
+	}
+	return &resp, nil
+}
+
+func lastLine(s string) string {
+	lines := strings.Split(strings.TrimSpace(s), "\n")
+	return strings.TrimSpace(lines[len(lines)-1])
+}
+
+// limitedBuffer collects up to limit bytes and records overflow.
+type limitedBuffer struct {
+	bytes.Buffer
+	limit    int
+	overflow bool
+}
+
+func (b *limitedBuffer) Write(p []byte) (int, error) {
+	if b.Len()+len(p) > b.limit {
+		b.overflow = true
+		return len(p), nil
+	}
+	return b.Buffer.Write(p)
+}
+
+var _ io.Writer = (*limitedBuffer)(nil)
@@ -0,0 +1,418 @@
+package plugin
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/clawscli/claws/internal/action"
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/registry"
+)
+
+// fakePluginEnv makes the test binary behave as a plugin.
+const fakePluginEnv = "CLAWS_TEST_PLUGIN"
+
+func TestMain(m *testing.M) {
+	if mode := os.Getenv(fakePluginEnv); mode != "" {
+		runFakePlugin(mode)
+		os.Exit(0)
+	}
+	os.Exit(m.Run())
+}
+
+var fakeWidgets = []ResourceData{
+	{ID: "w-1", Name: "alpha", Tags: map[string]string{"team": "core"}, Fields: map[string]any{"state": "running", "owner": "o-1", "size": 3.0}},
+	{ID: "w-2", Name: "beta", Fields: map[string]any{"state": "stopped", "owner": "o-2", "meta": map[string]any{"zone": "a"}}},
+	{ID: "w-3", Name: "gamma", Fields: map[string]any{"state": "running", "owner": "o-1"}},
+}
+
+func runFakePlugin(mode string) {
+	var req Request
+	if err := json.NewDecoder(os.Stdin).Decode(&req); err != nil {
+		fmt.Fprintln(os.Stderr, "bad request:", err)
+		os.Exit(2)
+	}
+
+	var resp Response
+	switch {
+	case mode == "badjson":
+		fmt.Print("not json")
+		return
+	case mode == "fail":
+		fmt.Fprintln(os.Stderr, "boom")
+		os.Exit(1)
+	case req.Method == MethodList && mode == "endless":
+		var page int
+		fmt.Sscanf(req.PageToken, "%d", &page)
+		resp.Resources = []ResourceData{{ID: fmt.Sprint(page)}}
+		resp.NextToken = fmt.Sprint(page + 1)
+	case req.Method == MethodDescribe && mode == "collide":
+		resp.ProtocolVersion = ProtocolVersion
+		resp.Types = []TypeSpec{{Service: "testsvc", Resource: "widgets"}}
+	case req.Method == MethodDescribe:
+		resp.ProtocolVersion = ProtocolVersion
+		resp.Types = []TypeSpec{{
+			Service:     "testsvc",
+			Resource:    "widgets",
+			DisplayName: "Test Service",
+			Columns: []ColumnSpec{
+				{Name: "Id", Field: "id", Width: 10},
+				{Name: "State", Field: "state"},
+			},
+			Actions: []ActionSpec{
+				{Name: "Restart", Shortcut: "r", Confirm: "simple"},
+				{Name: "Inspect", Shortcut: "i", ReadOnly: true},
+			},
+			Navigations: []NavigationSpec{
+				{Key: "o", Label: "Owner", Service: "testsvc", Resource: "owners", FilterField: "OwnerId", ValueField: "owner"},
+			},
+			Filters: []string{"OwnerId"},
+			Delete:  true,
+		}}
+	case req.Method == MethodList:
+		var matched []ResourceData
+		for _, w := range fakeWidgets {
+			if owner := req.Filters["OwnerId"]; owner != "" && w.Fields["owner"] != owner {
+				continue
+			}
+			matched = append(matched, w)
+		}
+		// Two items per page
+		start := 0
+		if req.PageToken != "" {
+			fmt.Sscanf(req.PageToken, "%d", &start)
+		}
+		end := min(start+2, len(matched))
+		resp.Resources = matched[start:end]
+		if end < len(matched) {
+			resp.NextToken = fmt.Sprint(end)
+		}
+	case req.Method == MethodGet:
+		for _, w := range fakeWidgets {
+			if w.ID == req.ID {
+				resp.Resource = &w
+			}
+		}
+		if resp.Resource == nil {
+			resp.Error = "widget " + req.ID + " not found"
+		}
+	case req.Method == MethodDelete:
+		resp.Message = "deleted"
+	case req.Method == MethodAction:
+		resp.Message = fmt.Sprintf("%s %s in %s", req.Action, req.ID, req.Region)
+	default:
+		resp.Error = "unknown method " + req.Method
+	}
+	_ = json.NewEncoder(os.Stdout).Encode(resp)
+}
+
+// installFakePlugin links the test binary into a plugin directory.
+func installFakePlugin(t *testing.T, dir, name, mode string) string {
+	t.Helper()
+	t.Setenv(fakePluginEnv, mode)
+	exe, err := os.Executable()
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(dir, name)
+	if err := os.Symlink(exe, path); err != nil {
+		t.Skipf("symlink not supported: %v", err)
+	}
+	return path
+}
+
+func TestDiscover(t *testing.T) {
+	dir := t.TempDir()
+	installFakePlugin(t, dir, "widgets", "ok")
+	if err := os.WriteFile(filepath.Join(dir, "README"), []byte("docs"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, ".hidden"), []byte("x"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Mkdir(filepath.Join(dir, "sub"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+
+	paths, err := Discover(dir)
+	if err != nil {
+		t.Fatalf("Discover() error = %v", err)
+	}
+	if len(paths) != 1 || filepath.Base(paths[0]) != "widgets" {
+		t.Errorf("Discover() = %v, want [widgets]", paths)
+	}
+
+	if paths, err := Discover(filepath.Join(dir, "missing")); err != nil || paths != nil {
+		t.Errorf("Discover(missing) = %v, %v, want nil, nil", paths, err)
+	}
+}
+
+func TestLoad(t *testing.T) {
+	dir := t.TempDir()
+	installFakePlugin(t, dir, "widgets", "ok")
+
+	reg := registry.New()
+	actions := action.NewRegistry()
+	loaded, errs := Load(context.Background(), dir, reg, actions)
+	if len(errs) > 0 {
+		t.Fatalf("Load() errors = %v", errs)
+	}
+	if len(loaded) != 1 || loaded[0].Name() != "widgets" {
+		t.Fatalf("Load() = %v, want widgets plugin", loaded)
+	}
+
+	if !reg.HasResource("testsvc", "widgets") {
+		t.Fatal("testsvc/widgets not registered")
+	}
+	if got := reg.GetDisplayName("testsvc"); got != "Test Service" {
+		t.Errorf("GetDisplayName() = %q, want %q", got, "Test Service")
+	}
+	found := false
+	for _, cat := range reg.ListServicesByCategory() {
+		if cat.Name == "Plugins" && len(cat.Services) == 1 && cat.Services[0] == "testsvc" {
+			found = true
+		}
+	}
+	if !found {
+		t.Error("testsvc not listed in Plugins category")
+	}
+
+	acts := actions.Get("testsvc", "widgets")
+	var names []string
+	for _, a := range acts {
+		names = append(names, a.Name)
+	}
+	if got := strings.Join(names, ","); got != "Restart,Inspect,Delete" {
+		t.Errorf("actions = %s, want Restart,Inspect,Delete", got)
+	}
+	if acts[0].Confirm != action.ConfirmSimple || acts[2].Confirm != action.ConfirmDangerous {
+		t.Errorf("confirm levels = %v, %v", acts[0].Confirm, acts[2].Confirm)
+	}
+	if !actions.IsAllowedInReadOnly(acts[1], "testsvc", "widgets") || actions.IsAllowedInReadOnly(acts[0], "testsvc", "widgets") {
+		t.Error("only Inspect should be allowed in read-only mode")
+	}
+	if action.IsAllowedInReadOnly(acts[1]) || actions.IsAllowedInReadOnly(acts[1], "ec2", "instances") {
+		t.Error("plugin read-only actions should not be allowed outside their type")
+	}
+}
+
+func TestLoad_Failures(t *testing.T) {
+	tests := []struct {
+		mode string
+		want string
+	}{
+		{"badjson", "invalid response"},
+		{"fail", "boom"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.mode, func(t *testing.T) {
+			dir := t.TempDir()
+			installFakePlugin(t, dir, "broken", tt.mode)
+
+			loaded, errs := Load(context.Background(), dir, registry.New(), action.NewRegistry())
+			if len(loaded) != 0 {
+				t.Errorf("Load() loaded %d plugins, want 0", len(loaded))
+			}
+			if len(errs) != 1 || !strings.Contains(errs[0].Error(), tt.want) {
+				t.Errorf("Load() errors = %v, want %q", errs, tt.want)
+			}
+		})
+	}
+}
+
+func TestRegister_SkipsExisting(t *testing.T) {
+	reg := registry.New()
+	reg.RegisterCustom("testsvc", "widgets", registry.Entry{})
+
+	p := &Plugin{Path: "/plugins/dup", Types: []TypeSpec{{Service: "testsvc", Resource: "widgets", Delete: true}}}
+	actions := action.NewRegistry()
+	err := Register(p, reg, actions)
+	if err == nil || !strings.Contains(err.Error(), "already registered") {
+		t.Errorf("Register() error = %v, want already registered", err)
+	}
+	if len(actions.Get("testsvc", "widgets")) != 0 {
+		t.Error("actions registered for skipped type")
+	}
+}
+
+func TestRegister_SkipsNativeService(t *testing.T) {
+	reg := registry.New()
+	reg.RegisterCustom("ec2", "instances", registry.Entry{})
+
+	p := &Plugin{Path: "/plugins/shadow", Types: []TypeSpec{{Service: "ec2", Resource: "widgets", DisplayName: "Widgets"}}}
+	err := Register(p, reg, action.NewRegistry())
+	if err == nil || !strings.Contains(err.Error(), "service ec2 already registered") {
+		t.Errorf("Register() error = %v, want service already registered", err)
+	}
+	if _, ok := reg.Get("ec2", "widgets"); ok {
+		t.Error("plugin type registered under a native service")
+	}
+}
+
+func TestPluginDAO_ListPageLimit(t *testing.T) {
+	path := installFakePlugin(t, t.TempDir(), "endless", "endless")
+	p := &Plugin{Path: path}
+	d := NewPluginDAO(p, TypeSpec{Service: "testsvc", Resource: "widgets"})
+
+	all, err := d.List(context.Background())
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+	if len(all) != maxListPages {
+		t.Errorf("List() returned %d resources, want %d (one per page)", len(all), maxListPages)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := d.List(ctx); err == nil {
+		t.Error("List() should stop when ctx is cancelled")
+	}
+}
+
+func TestTypeSpecValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		spec    TypeSpec
+		wantErr bool
+	}{
+		{"valid", TypeSpec{Service: "catalog", Resource: "services"}, false},
+		{"upper service", TypeSpec{Service: "Catalog", Resource: "services"}, true},
+		{"slash resource", TypeSpec{Service: "catalog", Resource: "a/b"}, true},
+		{"unnamed action", TypeSpec{Service: "catalog", Resource: "services", Actions: []ActionSpec{{}}}, true},
+		{"bad confirm", TypeSpec{Service: "catalog", Resource: "services", Actions: []ActionSpec{{Name: "x", Confirm: "maybe"}}}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.spec.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func loadFakePlugin(t *testing.T) (*Plugin, TypeSpec) {
+	t.Helper()
+	path := installFakePlugin(t, t.TempDir(), "widgets", "ok")
+	p, err := Describe(context.Background(), path)
+	if err != nil {
+		t.Fatalf("Describe() error = %v", err)
+	}
+	return p, p.Types[0]
+}
+
+func TestPluginDAO(t *testing.T) {
+	p, spec := loadFakePlugin(t)
+	d := NewPluginDAO(p, spec)
+	ctx := context.Background()
+
+	all, err := d.List(ctx)
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+	if len(all) != 3 {
+		t.Errorf("List() returned %d resources, want 3 (across pages)", len(all))
+	}
+
+	page, next, err := d.ListPage(ctx, 2, "")
+	if err != nil || len(page) != 2 || next != "2" {
+		t.Errorf("ListPage() = %d resources, %q, %v", len(page), next, err)
+	}
+
+	filtered, err := d.List(dao.WithFilter(ctx, "OwnerId", "o-1"))
+	if err != nil || len(filtered) != 2 {
+		t.Errorf("List(OwnerId=o-1) = %d resources, %v, want 2", len(filtered), err)
+	}
+
+	res, err := d.Get(ctx, "w-2")
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if res.GetName() != "beta" {
+		t.Errorf("Get().GetName() = %q, want beta", res.GetName())
+	}
+
+	if _, err := d.Get(ctx, "w-9"); err == nil || !strings.Contains(err.Error(), "not found") {
+		t.Errorf("Get(missing) error = %v, want not found", err)
+	}
+
+	if !d.Supports(dao.OpDelete) {
+		t.Error("Supports(OpDelete) = false, want true")
+	}
+	if err := d.Delete(ctx, "w-1"); err != nil {
+		t.Errorf("Delete() error = %v", err)
+	}
+}
+
+func TestExecutor(t *testing.T) {
+	p, spec := loadFakePlugin(t)
+	exec := newExecutor(p, spec)
+	acts := pluginActions(spec)
+	res := dao.WrapWithRegion(NewPluginResource(fakeWidgets[0]), "eu-west-1")
+
+	result := exec(context.Background(), acts[0], res)
+	if !result.Success || result.Message != "Restart w-1 in eu-west-1" {
+		t.Errorf("Restart = %+v", result)
+	}
+
+	result = exec(context.Background(), acts[2], res)
+	if !result.Success || result.Message != "Deleted w-1" {
+		t.Errorf("Delete = %+v", result)
+	}
+
+	result = exec(context.Background(), action.Action{Operation: "plugin:testsvc/widgets:Unknown"}, res)
+	if result.Success {
+		t.Error("unknown operation succeeded")
+	}
+}
+
+func TestPluginRenderer(t *testing.T) {
+	_, spec := loadFakePlugin(t)
+	r := NewPluginRenderer(spec)
+
+	cols := r.Columns()
+	if len(cols) != 2 || cols[0].Name != "ID" || cols[1].Width != defaultColumnWidth {
+		t.Fatalf("Columns() = %+v", cols)
+	}
+
+	res := NewPluginResource(fakeWidgets[1])
+	row := r.RenderRow(res, cols)
+	if row[0] != "w-2" || row[1] != "stopped" {
+		t.Errorf("RenderRow() = %v", row)
+	}
+
+	detail := r.RenderDetail(res)
+	for _, want := range []string{"beta", "state", `{"zone":"a"}`} {
+		if !strings.Contains(detail, want) {
+			t.Errorf("RenderDetail() missing %q", want)
+		}
+	}
+
+	navs := r.Navigations(res)
+	if len(navs) != 1 || navs[0].FilterField != "OwnerId" || navs[0].FilterValue != "o-2" {
+		t.Errorf("Navigations() = %+v", navs)
+	}
+}
+
+func TestFormatValue(t *testing.T) {
+	tests := []struct {
+		in   any
+		want string
+	}{
+		{nil, ""},
+		{"x", "x"},
+		{true, "true"},
+		{3.0, "3"},
+		{1.5, "1.5"},
+		{[]any{"a", 1.0}, `["a",1]`},
+	}
+	for _, tt := range tests {
+		if got := formatValue(tt.in); got != tt.want {
+			t.Errorf("formatValue(%v) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,126 @@
+package plugin
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// ProtocolVersion is the plugin protocol version spoken by this build.
+const ProtocolVersion = 1
+
+// Request methods
+const (
+	MethodDescribe = "describe"
+	MethodList     = "list"
+	MethodGet      = "get"
+	MethodDelete   = "delete"
+	MethodAction   = "action"
+)
+
+// Request is written as a single JSON object to the plugin's stdin.
+type Request struct {
+	Version   int               `json:"version"`
+	Method    string            `json:"method"`
+	Service   string            `json:"service,omitempty"`
+	Resource  string            `json:"resource,omitempty"`
+	ID        string            `json:"id,omitempty"`
+	Action    string            `json:"action,omitempty"`
+	Profile   string            `json:"profile,omitempty"`
+	Region    string            `json:"region,omitempty"`
+	Filters   map[string]string `json:"filters,omitempty"`
+	PageSize  int               `json:"pageSize,omitempty"`
+	PageToken string            `json:"pageToken,omitempty"`
+}
+
+// Response is read as a single JSON object from the plugin's stdout.
+// A non-empty Error fails the request.
+type Response struct {
+	Error string `json:"error,omitempty"`
+
+	// describe
+	ProtocolVersion int        `json:"protocolVersion,omitempty"`
+	Types           []TypeSpec `json:"types,omitempty"`
+
+	// list
+	Resources []ResourceData `json:"resources,omitempty"`
+	NextToken string         `json:"nextToken,omitempty"`
+
+	// get
+	Resource *ResourceData `json:"resource,omitempty"`
+
+	// delete, action
+	Message string `json:"message,omitempty"`
+}
+
+// TypeSpec declares a resource type provided by a plugin.
+type TypeSpec struct {
+	Service     string           `json:"service"`
+	Resource    string           `json:"resource"`
+	DisplayName string           `json:"displayName,omitempty"` // service display name
+	Category    string           `json:"category,omitempty"`    // service browser category (default "Plugins")
+	Columns     []ColumnSpec     `json:"columns,omitempty"`
+	Actions     []ActionSpec     `json:"actions,omitempty"`
+	Navigations []NavigationSpec `json:"navigations,omitempty"`
+	Filters     []string         `json:"filters,omitempty"` // context filter keys forwarded on list
+	Delete      bool             `json:"delete,omitempty"`
+}
+
+// ColumnSpec declares a table column. Field is "id", "name", "arn" or a key
+// of the resource's fields.
+type ColumnSpec struct {
+	Name  string `json:"name"`
+	Field string `json:"field"`
+	Width int    `json:"width,omitempty"`
+}
+
+// ActionSpec declares a resource action. Confirm is "", "simple" or
+// "dangerous". ReadOnly actions stay available in read-only mode.
+type ActionSpec struct {
+	Name     string `json:"name"`
+	Shortcut string `json:"shortcut,omitempty"`
+	Confirm  string `json:"confirm,omitempty"`
+	ReadOnly bool   `json:"readOnly,omitempty"`
+}
+
+// NavigationSpec declares a navigation to a related resource type, filtered
+// by the value of one of this resource's fields.
+type NavigationSpec struct {
+	Key         string `json:"key"`
+	Label       string `json:"label"`
+	Service     string `json:"service"`
+	Resource    string `json:"resource"`
+	FilterField string `json:"filterField"`
+	ValueField  string `json:"valueField"`
+}
+
+// ResourceData is a resource as returned by a plugin.
+type ResourceData struct {
+	ID     string            `json:"id"`
+	Name   string            `json:"name,omitempty"`
+	ARN    string            `json:"arn,omitempty"`
+	Tags   map[string]string `json:"tags,omitempty"`
+	Fields map[string]any    `json:"fields,omitempty"`
+}
+
+var namePattern = regexp.MustCompile(`^[a-z0-9][a-z0-9-]*$`)
+
+// Validate checks that a type spec is usable.
+func (t TypeSpec) Validate() error {
+	if !namePattern.MatchString(t.Service) {
+		return fmt.Errorf("invalid service name %q", t.Service)
+	}
+	if !namePattern.MatchString(t.Resource) {
+		return fmt.Errorf("invalid resource name %q", t.Resource)
+	}
+	for _, a := range t.Actions {
+		if a.Name == "" {
+			return fmt.Errorf("%s/%s: action without name", t.Service, t.Resource)
+		}
+		switch a.Confirm {
+		case "", "simple", "dangerous":
+		default:
+			return fmt.Errorf("%s/%s: action %q: invalid confirm %q", t.Service, t.Resource, a.Name, a.Confirm)
+		}
+	}
+	return nil
+}
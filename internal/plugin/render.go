@@ -0,0 +1,148 @@
+package plugin
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/render"
+)
+
+const defaultColumnWidth = 20
+
+// PluginRenderer renders a plugin-provided resource type from its declared
+// columns and navigations.
+type PluginRenderer struct {
+	render.BaseRenderer
+	spec TypeSpec
+}
+
+// NewPluginRenderer creates a renderer for a plugin-provided resource type.
+// Types without declared columns show ID and name.
+func NewPluginRenderer(spec TypeSpec) *PluginRenderer {
+	specs := spec.Columns
+	if len(specs) == 0 {
+		specs = []ColumnSpec{
+			{Name: "ID", Field: "id", Width: 30},
+			{Name: "NAME", Field: "name", Width: 30},
+		}
+	}
+
+	cols := make([]render.Column, 0, len(specs))
+	for i, c := range specs {
+		field := c.Field
+		width := c.Width
+		if width <= 0 {
+			width = defaultColumnWidth
+		}
+		cols = append(cols, render.Column{
+			Name:     strings.ToUpper(c.Name),
+			Width:    width,
+			Getter:   func(r dao.Resource) string { return fieldOf(r, field) },
+			Priority: i,
+		})
+	}
+
+	return &PluginRenderer{
+		BaseRenderer: render.BaseRenderer{
+			Service:  spec.Service,
+			Resource: spec.Resource,
+			Cols:     cols,
+		},
+		spec: spec,
+	}
+}
+
+// RenderDetail renders all fields of the resource.
+func (r *PluginRenderer) RenderDetail(resource dao.Resource) string {
+	res, ok := dao.UnwrapResource(resource).(*PluginResource)
+	if !ok {
+		return ""
+	}
+
+	d := render.NewDetailBuilder()
+	d.Title(r.spec.Resource, res.GetName())
+
+	d.Section("Overview")
+	d.Field("ID", res.GetID())
+	if res.GetName() != res.GetID() {
+		d.Field("Name", res.GetName())
+	}
+	if res.GetARN() != "" {
+		d.Field("ARN", res.GetARN())
+	}
+
+	if len(res.Fields) > 0 {
+		d.Section("Fields")
+		keys := make([]string, 0, len(res.Fields))
+		for k := range res.Fields {
+			keys = append(keys, k)
+		}
+		slices.Sort(keys)
+		for _, k := range keys {
+			d.Field(k, res.Field(k))
+		}
+	}
+
+	d.Tags(res.GetTags())
+	return d.String()
+}
+
+// Navigations returns the declared navigations whose value field is set.
+func (r *PluginRenderer) Navigations(resource dao.Resource) []render.Navigation {
+	var navs []render.Navigation
+	for _, n := range r.spec.Navigations {
+		value := fieldOf(resource, n.ValueField)
+		if value == "" {
+			continue
+		}
+		navs = append(navs, render.Navigation{
+			Key:         n.Key,
+			Label:       n.Label,
+			Service:     n.Service,
+			Resource:    n.Resource,
+			FilterField: n.FilterField,
+			FilterValue: value,
+		})
+	}
+	return navs
+}
+
+func fieldOf(resource dao.Resource, field string) string {
+	if res, ok := dao.UnwrapResource(resource).(*PluginResource); ok {
+		return res.Field(field)
+	}
+	switch field {
+	case "id":
+		return resource.GetID()
+	case "name":
+		return resource.GetName()
+	case "arn":
+		return resource.GetARN()
+	}
+	return ""
+}
+
+// formatValue renders a JSON value for display. Nested values are shown
+// as compact JSON.
+func formatValue(v any) string {
+	switch v := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case bool:
+		return strconv.FormatBool(v)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	default:
+		b, err := json.Marshal(v)
+		if err != nil {
+			return fmt.Sprint(v)
+		}
+		return string(b)
+	}
+}
@@ -0,0 +1,317 @@
+package policy
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Effects
+const (
+	EffectAllow = "Allow"
+	EffectDeny  = "Deny"
+)
+
+// Statement is a parsed policy statement.
+type Statement struct {
+	Sid          string
+	Effect       string
+	Actions      []string
+	NotAction    bool // Actions lists NotAction
+	Resources    []string
+	NotResource  bool // Resources lists NotResource
+	Principals   []string
+	Conditional  bool
+	Source       string // name of the policy document
+	rawCondition json.RawMessage
+}
+
+// Condition returns the statement's condition block as compact JSON.
+func (s Statement) Condition() string {
+	return string(s.rawCondition)
+}
+
+// stringList is a policy element that is a string or a list of strings.
+type stringList []string
+
+func (l *stringList) UnmarshalJSON(b []byte) error {
+	var one string
+	if err := json.Unmarshal(b, &one); err == nil {
+		*l = []string{one}
+		return nil
+	}
+	var many []string
+	if err := json.Unmarshal(b, &many); err != nil {
+		return err
+	}
+	*l = many
+	return nil
+}
+
+type rawStatement struct {
+	Sid         string          `json:"Sid"`
+	Effect      string          `json:"Effect"`
+	Action      stringList      `json:"Action"`
+	NotAction   stringList      `json:"NotAction"`
+	Resource    stringList      `json:"Resource"`
+	NotResource stringList      `json:"NotResource"`
+	Principal   json.RawMessage `json:"Principal"`
+	Condition   json.RawMessage `json:"Condition"`
+}
+
+// ParseStatements parses the statements of a decoded policy document. The
+// Statement element may be a single object or a list.
+func ParseStatements(doc string) ([]Statement, error) {
+	var policy struct {
+		Statement json.RawMessage `json:"Statement"`
+	}
+	if err := json.Unmarshal([]byte(doc), &policy); err != nil {
+		return nil, fmt.Errorf("invalid policy document: %w", err)
+	}
+
+	var raws []rawStatement
+	if len(policy.Statement) > 0 && policy.Statement[0] == '{' {
+		var one rawStatement
+		if err := json.Unmarshal(policy.Statement, &one); err != nil {
+			return nil, fmt.Errorf("invalid policy statement: %w", err)
+		}
+		raws = []rawStatement{one}
+	} else if len(policy.Statement) > 0 {
+		if err := json.Unmarshal(policy.Statement, &raws); err != nil {
+			return nil, fmt.Errorf("invalid policy statement: %w", err)
+		}
+	}
+
+	statements := make([]Statement, 0, len(raws))
+	for _, r := range raws {
+		s := Statement{
+			Sid:          r.Sid,
+			Effect:       r.Effect,
+			Actions:      r.Action,
+			Resources:    r.Resource,
+			Principals:   principals(r.Principal),
+			Conditional:  len(r.Condition) > 0 && string(r.Condition) != "{}",
+			rawCondition: r.Condition,
+		}
+		if len(r.NotAction) > 0 {
+			s.Actions, s.NotAction = r.NotAction, true
+		}
+		if len(r.NotResource) > 0 {
+			s.Resources, s.NotResource = r.NotResource, true
+		}
+		statements = append(statements, s)
+	}
+	return statements, nil
+}
+
+// principals flattens a Principal element into "Type: value" entries.
+func principals(raw json.RawMessage) []string {
+	if len(raw) == 0 {
+		return nil
+	}
+	var wildcard string
+	if json.Unmarshal(raw, &wildcard) == nil {
+		return []string{wildcard}
+	}
+	var byType map[string]stringList
+	if json.Unmarshal(raw, &byType) != nil {
+		return nil
+	}
+	var out []string
+	for typ, values := range byType {
+		for _, v := range values {
+			out = append(out, typ+": "+v)
+		}
+	}
+	sort.Strings(out)
+	return out
+}
+
+// Grant is one action of a statement in the effective-permissions summary.
+type Grant struct {
+	Effect      string
+	Action      string
+	Resources   []string
+	NotResource bool
+	Conditional bool
+	Source      string
+}
+
+// ServiceGrants are the grants for one service prefix, denies first.
+type ServiceGrants struct {
+	Service string
+	Grants  []Grant
+}
+
+// Summarize aggregates statements by service and action. NotAction
+// statements are listed under "*" since they apply to every other action.
+func Summarize(statements []Statement) []ServiceGrants {
+	byService := make(map[string][]Grant)
+	for _, s := range statements {
+		actions := s.Actions
+		if s.NotAction {
+			actions = []string{"* except " + strings.Join(s.Actions, ", ")}
+		}
+		for _, a := range actions {
+			service := "*"
+			if i := strings.Index(a, ":"); i > 0 && !s.NotAction {
+				service = strings.ToLower(a[:i])
+			}
+			byService[service] = append(byService[service], Grant{
+				Effect:      s.Effect,
+				Action:      a,
+				Resources:   s.Resources,
+				NotResource: s.NotResource,
+				Conditional: s.Conditional,
+				Source:      s.Source,
+			})
+		}
+	}
+
+	services := make([]ServiceGrants, 0, len(byService))
+	for service, grants := range byService {
+		sort.SliceStable(grants, func(i, j int) bool {
+			if grants[i].Effect != grants[j].Effect {
+				return grants[i].Effect == EffectDeny
+			}
+			return strings.ToLower(grants[i].Action) < strings.ToLower(grants[j].Action)
+		})
+		services = append(services, ServiceGrants{Service: service, Grants: grants})
+	}
+	sort.Slice(services, func(i, j int) bool { return services[i].Service < services[j].Service })
+	return services
+}
+
+// Severity ranks a finding.
+type Severity int
+
+// Severities
+const (
+	SeverityLow Severity = iota
+	SeverityMedium
+	SeverityHigh
+)
+
+func (s Severity) String() string {
+	switch s {
+	case SeverityHigh:
+		return "HIGH"
+	case SeverityMedium:
+		return "MEDIUM"
+	default:
+		return "LOW"
+	}
+}
+
+// Finding is a broad grant worth reviewing.
+type Finding struct {
+	Severity Severity
+	Message  string
+	Source   string
+}
+
+// Findings flags wildcard grants in Allow statements: all actions, all
+// actions of a service, action patterns, NotAction, and iam:PassRole on
+// any role. Findings are ordered by severity.
+func Findings(statements []Statement) []Finding {
+	var findings []Finding
+	add := func(sev Severity, s Statement, format string, args ...any) {
+		msg := fmt.Sprintf(format, args...)
+		if s.Conditional {
+			msg += " (conditional)"
+		}
+		findings = append(findings, Finding{Severity: sev, Message: msg, Source: s.Source})
+	}
+
+	for _, s := range statements {
+		if s.Effect != EffectAllow {
+			continue
+		}
+		anyResource := !s.NotResource && contains(s.Resources, "*")
+		resources := strings.Join(s.Resources, ", ")
+		if s.NotResource {
+			resources = "all resources except " + resources
+		}
+
+		if s.NotAction {
+			add(SeverityHigh, s, "Allows all actions except %s on %s", strings.Join(s.Actions, ", "), resources)
+		}
+		for _, a := range s.Actions {
+			if s.NotAction {
+				break
+			}
+			switch {
+			case a == "*" && anyResource:
+				add(SeverityHigh, s, "Allows all actions on all resources (administrator access)")
+			case a == "*":
+				add(SeverityHigh, s, "Allows all actions on %s", resources)
+			case strings.HasSuffix(a, ":*"):
+				sev := SeverityMedium
+				if anyResource || strings.EqualFold(a, "iam:*") {
+					sev = SeverityHigh
+				}
+				add(sev, s, "Allows all %s actions on %s", a[:len(a)-2], resources)
+			case strings.ContainsAny(a, "*?"):
+				add(SeverityLow, s, "Action wildcard %s on %s", a, resources)
+			}
+		}
+		if anyResource && allowsAction(s, "iam:PassRole") {
+			add(SeverityHigh, s, "iam:PassRole on * allows passing any role to a service")
+		}
+	}
+
+	sort.SliceStable(findings, func(i, j int) bool { return findings[i].Severity > findings[j].Severity })
+	return findings
+}
+
+// allowsAction returns whether the statement's actions cover action.
+func allowsAction(s Statement, action string) bool {
+	matched := false
+	for _, pattern := range s.Actions {
+		if MatchAction(pattern, action) {
+			matched = true
+			break
+		}
+	}
+	return matched != s.NotAction
+}
+
+// MatchAction reports whether an IAM action pattern with * and ? wildcards
+// matches action, case-insensitively.
+func MatchAction(pattern, action string) bool {
+	return wildcardMatch(strings.ToLower(pattern), strings.ToLower(action))
+}
+
+func wildcardMatch(pattern, s string) bool {
+	for len(pattern) > 0 {
+		switch pattern[0] {
+		case '*':
+			for i := 0; i <= len(s); i++ {
+				if wildcardMatch(pattern[1:], s[i:]) {
+					return true
+				}
+			}
+			return false
+		case '?':
+			if len(s) == 0 {
+				return false
+			}
+		default:
+			if len(s) == 0 || s[0] != pattern[0] {
+				return false
+			}
+		}
+		pattern, s = pattern[1:], s[1:]
+	}
+	return len(s) == 0
+}
+
+func contains(list []string, v string) bool {
+	for _, s := range list {
+		if s == v {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,401 @@
+// Package policy reads the IAM policy documents of a role, user, group or
+// managed policy for the policy view: attached managed and inline policies
+// (including a user's group policies) and a role's trust policy, decoded
+// and parsed into statements, with an effective-permissions summary and
+// policy simulation.
+package policy
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	"github.com/aws/aws-sdk-go-v2/service/iam/types"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	apperrors "github.com/clawscli/claws/internal/errors"
+)
+
+// Kind is the kind of IAM entity whose policies are shown.
+type Kind string
+
+// Entity kinds
+const (
+	KindRole   Kind = "role"
+	KindUser   Kind = "user"
+	KindGroup  Kind = "group"
+	KindPolicy Kind = "policy" // a managed policy on its own
+)
+
+// Target identifies the entity whose policies are shown.
+type Target struct {
+	Kind Kind
+	Name string
+	ARN  string
+}
+
+// Provider is implemented by resources with IAM policies.
+type Provider interface {
+	PolicyTarget() Target
+}
+
+// Client is the subset of the IAM API used by this package.
+type Client interface {
+	GetRole(ctx context.Context, params *iam.GetRoleInput, optFns ...func(*iam.Options)) (*iam.GetRoleOutput, error)
+	ListAttachedRolePolicies(ctx context.Context, params *iam.ListAttachedRolePoliciesInput, optFns ...func(*iam.Options)) (*iam.ListAttachedRolePoliciesOutput, error)
+	ListRolePolicies(ctx context.Context, params *iam.ListRolePoliciesInput, optFns ...func(*iam.Options)) (*iam.ListRolePoliciesOutput, error)
+	GetRolePolicy(ctx context.Context, params *iam.GetRolePolicyInput, optFns ...func(*iam.Options)) (*iam.GetRolePolicyOutput, error)
+	ListAttachedUserPolicies(ctx context.Context, params *iam.ListAttachedUserPoliciesInput, optFns ...func(*iam.Options)) (*iam.ListAttachedUserPoliciesOutput, error)
+	ListUserPolicies(ctx context.Context, params *iam.ListUserPoliciesInput, optFns ...func(*iam.Options)) (*iam.ListUserPoliciesOutput, error)
+	GetUserPolicy(ctx context.Context, params *iam.GetUserPolicyInput, optFns ...func(*iam.Options)) (*iam.GetUserPolicyOutput, error)
+	ListGroupsForUser(ctx context.Context, params *iam.ListGroupsForUserInput, optFns ...func(*iam.Options)) (*iam.ListGroupsForUserOutput, error)
+	ListAttachedGroupPolicies(ctx context.Context, params *iam.ListAttachedGroupPoliciesInput, optFns ...func(*iam.Options)) (*iam.ListAttachedGroupPoliciesOutput, error)
+	ListGroupPolicies(ctx context.Context, params *iam.ListGroupPoliciesInput, optFns ...func(*iam.Options)) (*iam.ListGroupPoliciesOutput, error)
+	GetGroupPolicy(ctx context.Context, params *iam.GetGroupPolicyInput, optFns ...func(*iam.Options)) (*iam.GetGroupPolicyOutput, error)
+	GetPolicy(ctx context.Context, params *iam.GetPolicyInput, optFns ...func(*iam.Options)) (*iam.GetPolicyOutput, error)
+	GetPolicyVersion(ctx context.Context, params *iam.GetPolicyVersionInput, optFns ...func(*iam.Options)) (*iam.GetPolicyVersionOutput, error)
+	SimulatePrincipalPolicy(ctx context.Context, params *iam.SimulatePrincipalPolicyInput, optFns ...func(*iam.Options)) (*iam.SimulatePrincipalPolicyOutput, error)
+	SimulateCustomPolicy(ctx context.Context, params *iam.SimulateCustomPolicyInput, optFns ...func(*iam.Options)) (*iam.SimulateCustomPolicyOutput, error)
+}
+
+// NewClient creates an IAM client for the current profile.
+func NewClient(ctx context.Context) (Client, error) {
+	cfg, err := appaws.NewConfig(ctx)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new iam client")
+	}
+	return iam.NewFromConfig(cfg), nil
+}
+
+// Document is a decoded policy document.
+type Document struct {
+	Name       string
+	ARN        string // managed policies only
+	Source     string // e.g. "managed", "inline", "group admins: managed"
+	JSON       string // decoded and indented
+	Statements []Statement
+}
+
+// Policies are the policy documents of an entity.
+type Policies struct {
+	Target    Target
+	Documents []Document
+	Trust     *Document // roles only
+}
+
+// Statements returns the statements of all permission documents.
+func (p *Policies) Statements() []Statement {
+	var all []Statement
+	for _, d := range p.Documents {
+		all = append(all, d.Statements...)
+	}
+	return all
+}
+
+// Load reads the policy documents of t.
+func Load(ctx context.Context, client Client, t Target) (*Policies, error) {
+	l := &loader{client: client, managed: make(map[string]*Document)}
+	p := &Policies{Target: t}
+
+	var err error
+	switch t.Kind {
+	case KindRole:
+		err = l.role(ctx, p)
+	case KindUser:
+		err = l.user(ctx, p)
+	case KindGroup:
+		err = l.group(ctx, p, t.Name, "")
+	case KindPolicy:
+		var doc *Document
+		doc, err = l.managedPolicy(ctx, t.ARN, "managed")
+		if doc != nil {
+			p.Documents = append(p.Documents, *doc)
+		}
+	default:
+		err = fmt.Errorf("unsupported policy target: %s", t.Kind)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+type loader struct {
+	client  Client
+	managed map[string]*Document // managed policy documents by ARN
+}
+
+func (l *loader) role(ctx context.Context, p *Policies) error {
+	name := p.Target.Name
+	out, err := l.client.GetRole(ctx, &iam.GetRoleInput{RoleName: &name})
+	if err != nil {
+		return apperrors.Wrapf(err, "get role %s", name)
+	}
+	if doc := appaws.Str(out.Role.AssumeRolePolicyDocument); doc != "" {
+		trust, err := NewDocument("Trust policy", "trust", doc)
+		if err != nil {
+			return err
+		}
+		p.Trust = trust
+	}
+
+	attached := iam.NewListAttachedRolePoliciesPaginator(l.client, &iam.ListAttachedRolePoliciesInput{RoleName: &name})
+	for attached.HasMorePages() {
+		page, err := attached.NextPage(ctx)
+		if err != nil {
+			return apperrors.Wrapf(err, "list attached policies for role %s", name)
+		}
+		if err := l.addManaged(ctx, p, page.AttachedPolicies, "managed"); err != nil {
+			return err
+		}
+	}
+
+	inline := iam.NewListRolePoliciesPaginator(l.client, &iam.ListRolePoliciesInput{RoleName: &name})
+	for inline.HasMorePages() {
+		page, err := inline.NextPage(ctx)
+		if err != nil {
+			return apperrors.Wrapf(err, "list inline policies for role %s", name)
+		}
+		for _, policyName := range page.PolicyNames {
+			out, err := l.client.GetRolePolicy(ctx, &iam.GetRolePolicyInput{RoleName: &name, PolicyName: &policyName})
+			if err != nil {
+				return apperrors.Wrapf(err, "get inline policy %s", policyName)
+			}
+			if err := p.add(policyName, "inline", appaws.Str(out.PolicyDocument)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (l *loader) user(ctx context.Context, p *Policies) error {
+	name := p.Target.Name
+	attached := iam.NewListAttachedUserPoliciesPaginator(l.client, &iam.ListAttachedUserPoliciesInput{UserName: &name})
+	for attached.HasMorePages() {
+		page, err := attached.NextPage(ctx)
+		if err != nil {
+			return apperrors.Wrapf(err, "list attached policies for user %s", name)
+		}
+		if err := l.addManaged(ctx, p, page.AttachedPolicies, "managed"); err != nil {
+			return err
+		}
+	}
+
+	inline := iam.NewListUserPoliciesPaginator(l.client, &iam.ListUserPoliciesInput{UserName: &name})
+	for inline.HasMorePages() {
+		page, err := inline.NextPage(ctx)
+		if err != nil {
+			return apperrors.Wrapf(err, "list inline policies for user %s", name)
+		}
+		for _, policyName := range page.PolicyNames {
+			out, err := l.client.GetUserPolicy(ctx, &iam.GetUserPolicyInput{UserName: &name, PolicyName: &policyName})
+			if err != nil {
+				return apperrors.Wrapf(err, "get inline policy %s", policyName)
+			}
+			if err := p.add(policyName, "inline", appaws.Str(out.PolicyDocument)); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Group policies apply to the user too
+	groups := iam.NewListGroupsForUserPaginator(l.client, &iam.ListGroupsForUserInput{UserName: &name})
+	for groups.HasMorePages() {
+		page, err := groups.NextPage(ctx)
+		if err != nil {
+			return apperrors.Wrapf(err, "list groups for user %s", name)
+		}
+		for _, g := range page.Groups {
+			group := appaws.Str(g.GroupName)
+			if err := l.group(ctx, p, group, "group "+group+": "); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (l *loader) group(ctx context.Context, p *Policies, name, prefix string) error {
+	attached := iam.NewListAttachedGroupPoliciesPaginator(l.client, &iam.ListAttachedGroupPoliciesInput{GroupName: &name})
+	for attached.HasMorePages() {
+		page, err := attached.NextPage(ctx)
+		if err != nil {
+			return apperrors.Wrapf(err, "list attached policies for group %s", name)
+		}
+		if err := l.addManaged(ctx, p, page.AttachedPolicies, prefix+"managed"); err != nil {
+			return err
+		}
+	}
+
+	inline := iam.NewListGroupPoliciesPaginator(l.client, &iam.ListGroupPoliciesInput{GroupName: &name})
+	for inline.HasMorePages() {
+		page, err := inline.NextPage(ctx)
+		if err != nil {
+			return apperrors.Wrapf(err, "list inline policies for group %s", name)
+		}
+		for _, policyName := range page.PolicyNames {
+			out, err := l.client.GetGroupPolicy(ctx, &iam.GetGroupPolicyInput{GroupName: &name, PolicyName: &policyName})
+			if err != nil {
+				return apperrors.Wrapf(err, "get inline policy %s", policyName)
+			}
+			if err := p.add(policyName, prefix+"inline", appaws.Str(out.PolicyDocument)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (l *loader) addManaged(ctx context.Context, p *Policies, attached []types.AttachedPolicy, source string) error {
+	for _, a := range attached {
+		doc, err := l.managedPolicy(ctx, appaws.Str(a.PolicyArn), source)
+		if err != nil {
+			return err
+		}
+		p.Documents = append(p.Documents, *doc)
+	}
+	return nil
+}
+
+// managedPolicy reads the default version of a managed policy. Documents
+// are fetched once per load, since a user's groups often share policies.
+func (l *loader) managedPolicy(ctx context.Context, arn, source string) (*Document, error) {
+	if doc, ok := l.managed[arn]; ok {
+		d := *doc
+		d.Source = source
+		return &d, nil
+	}
+
+	out, err := l.client.GetPolicy(ctx, &iam.GetPolicyInput{PolicyArn: &arn})
+	if err != nil {
+		return nil, apperrors.Wrapf(err, "get policy %s", arn)
+	}
+	version, err := l.client.GetPolicyVersion(ctx, &iam.GetPolicyVersionInput{
+		PolicyArn: &arn,
+		VersionId: out.Policy.DefaultVersionId,
+	})
+	if err != nil {
+		return nil, apperrors.Wrapf(err, "get policy version %s", arn)
+	}
+	doc, err := NewDocument(appaws.Str(out.Policy.PolicyName), source, appaws.Str(version.PolicyVersion.Document))
+	if err != nil {
+		return nil, err
+	}
+	doc.ARN = arn
+	l.managed[arn] = doc
+	return doc, nil
+}
+
+func (p *Policies) add(name, source, encoded string) error {
+	doc, err := NewDocument(name, source, encoded)
+	if err != nil {
+		return err
+	}
+	p.Documents = append(p.Documents, *doc)
+	return nil
+}
+
+// NewDocument decodes a URL-encoded policy document as returned by IAM
+// and parses its statements.
+func NewDocument(name, source, encoded string) (*Document, error) {
+	decoded, err := url.QueryUnescape(encoded)
+	if err != nil {
+		decoded = encoded
+	}
+	statements, err := ParseStatements(decoded)
+	if err != nil {
+		return nil, fmt.Errorf("policy %s: %w", name, err)
+	}
+	for i := range statements {
+		statements[i].Source = name
+	}
+
+	var buf bytes.Buffer
+	pretty := decoded
+	if json.Indent(&buf, []byte(decoded), "", "  ") == nil {
+		pretty = buf.String()
+	}
+	return &Document{Name: name, Source: source, JSON: pretty, Statements: statements}, nil
+}
+
+// Decision is the result of simulating one action on one resource.
+type Decision struct {
+	Action   string
+	Resource string
+	Decision string // allowed, explicitDeny or implicitDeny
+	Matched  []string
+	Missing  []string // context keys the decision depends on
+}
+
+// Allowed returns whether the action is allowed.
+func (d Decision) Allowed() bool {
+	return d.Decision == string(types.PolicyEvaluationDecisionTypeAllowed)
+}
+
+// Simulate evaluates actions on resource against the policies of t, with
+// SimulatePrincipalPolicy for principals and SimulateCustomPolicy for a
+// managed policy on its own. actions is a list separated by commas or
+// spaces; an empty resource means "*".
+func Simulate(ctx context.Context, client Client, t Target, p *Policies, actions, resource string) ([]Decision, error) {
+	names := strings.FieldsFunc(actions, func(r rune) bool { return r == ',' || r == ' ' })
+	if len(names) == 0 {
+		return nil, fmt.Errorf("enter an action to simulate, e.g. s3:GetObject")
+	}
+	resource = strings.TrimSpace(resource)
+	if resource == "" {
+		resource = "*"
+	}
+
+	var results []types.EvaluationResult
+	if t.Kind == KindPolicy {
+		var docs []string
+		for _, d := range p.Documents {
+			docs = append(docs, d.JSON)
+		}
+		paginator := iam.NewSimulateCustomPolicyPaginator(client, &iam.SimulateCustomPolicyInput{
+			PolicyInputList: docs,
+			ActionNames:     names,
+			ResourceArns:    []string{resource},
+		})
+		for paginator.HasMorePages() {
+			page, err := paginator.NextPage(ctx)
+			if err != nil {
+				return nil, apperrors.Wrap(err, "simulate custom policy")
+			}
+			results = append(results, page.EvaluationResults...)
+		}
+	} else {
+		paginator := iam.NewSimulatePrincipalPolicyPaginator(client, &iam.SimulatePrincipalPolicyInput{
+			PolicySourceArn: &t.ARN,
+			ActionNames:     names,
+			ResourceArns:    []string{resource},
+		})
+		for paginator.HasMorePages() {
+			page, err := paginator.NextPage(ctx)
+			if err != nil {
+				return nil, apperrors.Wrapf(err, "simulate principal policy %s", t.Name)
+			}
+			results = append(results, page.EvaluationResults...)
+		}
+	}
+
+	decisions := make([]Decision, 0, len(results))
+	for _, r := range results {
+		d := Decision{
+			Action:   appaws.Str(r.EvalActionName),
+			Resource: appaws.Str(r.EvalResourceName),
+			Decision: string(r.EvalDecision),
+			Missing:  r.MissingContextValues,
+		}
+		for _, m := range r.MatchedStatements {
+			d.Matched = append(d.Matched, appaws.Str(m.SourcePolicyId))
+		}
+		decisions = append(decisions, d)
+	}
+	return decisions, nil
+}
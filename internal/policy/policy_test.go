@@ -0,0 +1,213 @@
+package policy
+
+import (
+	"context"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	"github.com/aws/aws-sdk-go-v2/service/iam/types"
+)
+
+const adminDoc = `{"Version":"2012-10-17","Statement":{"Effect":"Allow","Action":"*","Resource":"*"}}`
+
+const appDoc = `{"Version":"2012-10-17","Statement":[
+  {"Sid":"Read","Effect":"Allow","Action":["s3:GetObject","s3:List*"],"Resource":"arn:aws:s3:::data/*"},
+  {"Effect":"Allow","Action":"iam:PassRole","Resource":"*"},
+  {"Effect":"Allow","Action":"dynamodb:*","Resource":"arn:aws:dynamodb:us-east-1:1:table/t"},
+  {"Effect":"Deny","Action":"s3:DeleteObject","Resource":"*","Condition":{"Bool":{"aws:MultiFactorAuthPresent":"false"}}}
+]}`
+
+const trustDoc = `{"Statement":[{"Effect":"Allow","Principal":{"Service":["lambda.amazonaws.com","edgelambda.amazonaws.com"]},"Action":"sts:AssumeRole"}]}`
+
+func TestParseStatements(t *testing.T) {
+	statements, err := ParseStatements(adminDoc)
+	if err != nil || len(statements) != 1 || statements[0].Actions[0] != "*" {
+		t.Fatalf("single statement object: %+v, %v", statements, err)
+	}
+
+	statements, err = ParseStatements(trustDoc)
+	if err != nil {
+		t.Fatalf("ParseStatements() error = %v", err)
+	}
+	if got := statements[0].Principals; len(got) != 2 || got[0] != "Service: edgelambda.amazonaws.com" {
+		t.Errorf("Principals = %v", got)
+	}
+
+	if _, err := ParseStatements("{"); err == nil {
+		t.Error("expected error for invalid JSON")
+	}
+}
+
+func TestSummarizeAndFindings(t *testing.T) {
+	doc, err := NewDocument("app", "inline", url.QueryEscape(appDoc))
+	if err != nil {
+		t.Fatalf("NewDocument() error = %v", err)
+	}
+	if !strings.Contains(doc.JSON, "\n  \"Statement\"") {
+		t.Errorf("document should be URL-decoded and indented:\n%s", doc.JSON)
+	}
+
+	services := Summarize(doc.Statements)
+	var names []string
+	for _, s := range services {
+		names = append(names, s.Service)
+	}
+	if strings.Join(names, ",") != "dynamodb,iam,s3" {
+		t.Fatalf("services = %v", names)
+	}
+	s3 := services[2].Grants
+	if len(s3) != 3 || s3[0].Effect != EffectDeny || !s3[0].Conditional || s3[1].Action != "s3:GetObject" {
+		t.Errorf("s3 grants = %+v", s3)
+	}
+
+	findings := Findings(doc.Statements)
+	var messages []string
+	for _, f := range findings {
+		messages = append(messages, f.Severity.String()+" "+f.Message)
+	}
+	joined := strings.Join(messages, "\n")
+	for _, want := range []string{
+		"HIGH iam:PassRole on * allows passing any role",
+		"MEDIUM Allows all dynamodb actions",
+		"LOW Action wildcard s3:List*",
+	} {
+		if !strings.Contains(joined, want) {
+			t.Errorf("findings missing %q:\n%s", want, joined)
+		}
+	}
+	if findings[0].Severity != SeverityHigh {
+		t.Errorf("findings should be ordered by severity:\n%s", joined)
+	}
+
+	admin, _ := ParseStatements(adminDoc)
+	findings = Findings(admin)
+	if len(findings) != 2 || !strings.Contains(findings[0].Message, "administrator access") {
+		t.Errorf("admin findings = %+v", findings)
+	}
+}
+
+func TestMatchAction(t *testing.T) {
+	tests := []struct {
+		pattern, action string
+		want            bool
+	}{
+		{"*", "iam:PassRole", true},
+		{"iam:*", "iam:PassRole", true},
+		{"IAM:pass*", "iam:PassRole", true},
+		{"iam:Pass?ole", "iam:PassRole", true},
+		{"iam:Get*", "iam:PassRole", false},
+		{"s3:*", "iam:PassRole", false},
+	}
+	for _, tt := range tests {
+		if got := MatchAction(tt.pattern, tt.action); got != tt.want {
+			t.Errorf("MatchAction(%q, %q) = %v", tt.pattern, tt.action, got)
+		}
+	}
+
+	notAction := Statement{Effect: EffectAllow, Actions: []string{"s3:*"}, NotAction: true, Resources: []string{"*"}}
+	if !allowsAction(notAction, "iam:PassRole") {
+		t.Error("NotAction s3:* should cover iam:PassRole")
+	}
+}
+
+// fakeClient serves a user with one managed policy, attached both directly
+// and through a group, and one inline policy.
+type fakeClient struct {
+	Client
+	policyReads int
+	simulated   *iam.SimulatePrincipalPolicyInput
+}
+
+func (f *fakeClient) ListAttachedUserPolicies(_ context.Context, _ *iam.ListAttachedUserPoliciesInput, _ ...func(*iam.Options)) (*iam.ListAttachedUserPoliciesOutput, error) {
+	return &iam.ListAttachedUserPoliciesOutput{AttachedPolicies: []types.AttachedPolicy{
+		{PolicyName: aws.String("Admin"), PolicyArn: aws.String("arn:aws:iam::aws:policy/Admin")},
+	}}, nil
+}
+
+func (f *fakeClient) ListUserPolicies(_ context.Context, _ *iam.ListUserPoliciesInput, _ ...func(*iam.Options)) (*iam.ListUserPoliciesOutput, error) {
+	return &iam.ListUserPoliciesOutput{PolicyNames: []string{"app"}}, nil
+}
+
+func (f *fakeClient) GetUserPolicy(_ context.Context, in *iam.GetUserPolicyInput, _ ...func(*iam.Options)) (*iam.GetUserPolicyOutput, error) {
+	return &iam.GetUserPolicyOutput{PolicyName: in.PolicyName, PolicyDocument: aws.String(url.QueryEscape(appDoc))}, nil
+}
+
+func (f *fakeClient) ListGroupsForUser(_ context.Context, _ *iam.ListGroupsForUserInput, _ ...func(*iam.Options)) (*iam.ListGroupsForUserOutput, error) {
+	return &iam.ListGroupsForUserOutput{Groups: []types.Group{{GroupName: aws.String("admins")}}}, nil
+}
+
+func (f *fakeClient) ListAttachedGroupPolicies(_ context.Context, _ *iam.ListAttachedGroupPoliciesInput, _ ...func(*iam.Options)) (*iam.ListAttachedGroupPoliciesOutput, error) {
+	return &iam.ListAttachedGroupPoliciesOutput{AttachedPolicies: []types.AttachedPolicy{
+		{PolicyName: aws.String("Admin"), PolicyArn: aws.String("arn:aws:iam::aws:policy/Admin")},
+	}}, nil
+}
+
+func (f *fakeClient) ListGroupPolicies(_ context.Context, _ *iam.ListGroupPoliciesInput, _ ...func(*iam.Options)) (*iam.ListGroupPoliciesOutput, error) {
+	return &iam.ListGroupPoliciesOutput{}, nil
+}
+
+func (f *fakeClient) GetPolicy(_ context.Context, in *iam.GetPolicyInput, _ ...func(*iam.Options)) (*iam.GetPolicyOutput, error) {
+	f.policyReads++
+	return &iam.GetPolicyOutput{Policy: &types.Policy{PolicyName: aws.String("Admin"), Arn: in.PolicyArn, DefaultVersionId: aws.String("v3")}}, nil
+}
+
+func (f *fakeClient) GetPolicyVersion(_ context.Context, _ *iam.GetPolicyVersionInput, _ ...func(*iam.Options)) (*iam.GetPolicyVersionOutput, error) {
+	return &iam.GetPolicyVersionOutput{PolicyVersion: &types.PolicyVersion{Document: aws.String(url.QueryEscape(adminDoc))}}, nil
+}
+
+func (f *fakeClient) SimulatePrincipalPolicy(_ context.Context, in *iam.SimulatePrincipalPolicyInput, _ ...func(*iam.Options)) (*iam.SimulatePrincipalPolicyOutput, error) {
+	f.simulated = in
+	var results []types.EvaluationResult
+	for _, a := range in.ActionNames {
+		results = append(results, types.EvaluationResult{
+			EvalActionName:    aws.String(a),
+			EvalResourceName:  aws.String(in.ResourceArns[0]),
+			EvalDecision:      types.PolicyEvaluationDecisionTypeAllowed,
+			MatchedStatements: []types.Statement{{SourcePolicyId: aws.String("Admin")}},
+		})
+	}
+	return &iam.SimulatePrincipalPolicyOutput{EvaluationResults: results}, nil
+}
+
+func TestLoadUser(t *testing.T) {
+	client := &fakeClient{}
+	target := Target{Kind: KindUser, Name: "alice", ARN: "arn:aws:iam::1:user/alice"}
+	p, err := Load(context.Background(), client, target)
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+
+	var sources []string
+	for _, d := range p.Documents {
+		sources = append(sources, d.Name+" ("+d.Source+")")
+	}
+	if got := strings.Join(sources, ", "); got != "Admin (managed), app (inline), Admin (group admins: managed)" {
+		t.Errorf("documents = %s", got)
+	}
+	if client.policyReads != 1 {
+		t.Errorf("managed policy read %d times, want 1", client.policyReads)
+	}
+	if p.Trust != nil {
+		t.Error("users have no trust policy")
+	}
+	if len(p.Statements()) != 6 {
+		t.Errorf("Statements() = %d, want 6", len(p.Statements()))
+	}
+
+	decisions, err := Simulate(context.Background(), client, target, p, "s3:GetObject, s3:PutObject", "")
+	if err != nil {
+		t.Fatalf("Simulate() error = %v", err)
+	}
+	if len(decisions) != 2 || !decisions[0].Allowed() || decisions[0].Resource != "*" || decisions[0].Matched[0] != "Admin" {
+		t.Errorf("decisions = %+v", decisions)
+	}
+	if aws.ToString(client.simulated.PolicySourceArn) != target.ARN {
+		t.Errorf("simulated principal = %v", aws.ToString(client.simulated.PolicySourceArn))
+	}
+	if _, err := Simulate(context.Background(), client, target, p, " ", ""); err == nil {
+		t.Error("Simulate() without actions should fail")
+	}
+}
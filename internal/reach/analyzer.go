@@ -0,0 +1,106 @@
+package reach
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	apperrors "github.com/clawscli/claws/internal/errors"
+	"github.com/clawscli/claws/internal/log"
+)
+
+// Analysis is the state of a VPC Reachability Analyzer run.
+type Analysis struct {
+	ID           string
+	PathID       string
+	Status       string
+	Message      string
+	PathFound    bool
+	Hops         []string // forward path components, in order
+	Explanations []string // why the path was not found
+}
+
+// Done reports whether the analysis has finished.
+func (a *Analysis) Done() bool {
+	return a.Status != string(types.AnalysisStatusRunning)
+}
+
+// Analyze creates a Network Insights path from from to to and starts an
+// analysis of it. The path is left in the account so the analysis can be
+// revisited in the console, unless the analysis fails to start.
+func Analyze(ctx context.Context, client Client, from, to Endpoint, protocol string, port int32) (*Analysis, error) {
+	path, err := client.CreateNetworkInsightsPath(ctx, &ec2.CreateNetworkInsightsPathInput{
+		Source:          appaws.StringPtr(from.ID),
+		Destination:     appaws.StringPtr(to.ID),
+		Protocol:        types.Protocol(protocol),
+		DestinationPort: appaws.Int32Ptr(port),
+	})
+	if err != nil {
+		return nil, apperrors.Wrapf(err, "create network insights path %s → %s", from.ID, to.ID)
+	}
+	pathID := appaws.Str(path.NetworkInsightsPath.NetworkInsightsPathId)
+
+	out, err := client.StartNetworkInsightsAnalysis(ctx, &ec2.StartNetworkInsightsAnalysisInput{
+		NetworkInsightsPathId: appaws.StringPtr(pathID),
+	})
+	if err != nil {
+		if _, derr := client.DeleteNetworkInsightsPath(ctx, &ec2.DeleteNetworkInsightsPathInput{
+			NetworkInsightsPathId: appaws.StringPtr(pathID),
+		}); derr != nil {
+			log.Warn("failed to delete network insights path", "path", pathID, "error", derr)
+		}
+		return nil, apperrors.Wrapf(err, "start network insights analysis for %s", pathID)
+	}
+	return newAnalysis(*out.NetworkInsightsAnalysis), nil
+}
+
+// GetAnalysis returns the current state of an analysis.
+func GetAnalysis(ctx context.Context, client Client, id string) (*Analysis, error) {
+	out, err := client.DescribeNetworkInsightsAnalyses(ctx, &ec2.DescribeNetworkInsightsAnalysesInput{
+		NetworkInsightsAnalysisIds: []string{id},
+	})
+	if err != nil {
+		return nil, apperrors.Wrapf(err, "describe network insights analysis %s", id)
+	}
+	if len(out.NetworkInsightsAnalyses) == 0 {
+		return nil, fmt.Errorf("network insights analysis not found: %s", id)
+	}
+	return newAnalysis(out.NetworkInsightsAnalyses[0]), nil
+}
+
+func newAnalysis(a types.NetworkInsightsAnalysis) *Analysis {
+	res := &Analysis{
+		ID:        appaws.Str(a.NetworkInsightsAnalysisId),
+		PathID:    appaws.Str(a.NetworkInsightsPathId),
+		Status:    string(a.Status),
+		Message:   appaws.Str(a.StatusMessage),
+		PathFound: appaws.Bool(a.NetworkPathFound),
+	}
+	for _, c := range a.ForwardPathComponents {
+		res.Hops = append(res.Hops, componentLabel(c.Component))
+	}
+	for _, e := range a.Explanations {
+		text := appaws.Str(e.ExplanationCode)
+		if e.Component != nil {
+			text += ": " + componentLabel(e.Component)
+		}
+		if d := appaws.Str(e.Direction); d != "" {
+			text += " (" + d + ")"
+		}
+		res.Explanations = append(res.Explanations, text)
+	}
+	return res
+}
+
+func componentLabel(c *types.AnalysisComponent) string {
+	if c == nil {
+		return "?"
+	}
+	if name := appaws.Str(c.Name); name != "" && name != appaws.Str(c.Id) {
+		return fmt.Sprintf("%s (%s)", appaws.Str(c.Id), name)
+	}
+	return appaws.Str(c.Id)
+}
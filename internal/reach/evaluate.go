@@ -0,0 +1,283 @@
+package reach
+
+import (
+	"cmp"
+	"fmt"
+	"net/netip"
+	"slices"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+)
+
+// ephemeralPort is the port return traffic is checked on. Linux uses
+// 32768-60999 as its ephemeral range; ACLs usually allow 1024-65535.
+const ephemeralPort = 32768
+
+// Verdict is the outcome of one check.
+type Verdict string
+
+// Check verdicts
+const (
+	Allowed Verdict = "allowed"
+	Denied  Verdict = "denied"
+	Unknown Verdict = "unknown" // cannot be decided locally
+	Skipped Verdict = "skipped" // does not apply to this path
+)
+
+// Check is one step of the path.
+type Check struct {
+	Step    string
+	Verdict Verdict
+	Detail  string
+}
+
+// Result is the outcome of a local evaluation.
+type Result struct {
+	From, To Endpoint
+	Protocol string
+	Port     int32
+	Checks   []Check
+
+	// FromSubnet and ToSubnet describe the endpoints' subnets.
+	FromSubnet, ToSubnet string
+}
+
+// Reachable reports whether no check denies the traffic.
+func (r Result) Reachable() bool {
+	return !slices.ContainsFunc(r.Checks, func(c Check) bool { return c.Verdict == Denied })
+}
+
+// Conclusive reports whether every check could be decided locally.
+func (r Result) Conclusive() bool {
+	return !slices.ContainsFunc(r.Checks, func(c Check) bool { return c.Verdict == Unknown })
+}
+
+// Evaluate checks whether from can open a connection to to on protocol
+// (tcp or udp) and port: the route out of from's subnet, from's security
+// group egress rules and its subnet's outbound ACL, to's subnet's inbound
+// ACL and security group ingress rules, and the route and ACLs of the
+// return traffic. Security groups are stateful so return traffic is not
+// checked against them; ACLs do not apply within a subnet.
+func Evaluate(n *Network, from, to Endpoint, protocol string, port int32) Result {
+	res := Result{
+		From: from, To: to, Protocol: protocol, Port: port,
+		FromSubnet: n.subnetLabel(from.SubnetID), ToSubnet: n.subnetLabel(to.SubnetID),
+	}
+	sameSubnet := from.SubnetID == to.SubnetID
+
+	res.Checks = append(res.Checks,
+		n.checkRoute("Route "+from.SubnetID, from, to),
+		n.checkSecurityGroups("Egress "+from.ID, from, to, protocol, port, true),
+	)
+	if sameSubnet {
+		res.Checks = append(res.Checks, Check{Step: "Network ACLs", Verdict: Skipped, Detail: "both endpoints are in " + from.SubnetID})
+	} else {
+		res.Checks = append(res.Checks,
+			n.checkACL("ACL out "+from.SubnetID, from.SubnetID, to.IP, protocol, port, true),
+			n.checkACL("ACL in "+to.SubnetID, to.SubnetID, from.IP, protocol, port, false),
+		)
+	}
+	res.Checks = append(res.Checks, n.checkSecurityGroups("Ingress "+to.ID, to, from, protocol, port, false))
+
+	res.Checks = append(res.Checks, n.checkRoute("Return route "+to.SubnetID, to, from))
+	if !sameSubnet {
+		res.Checks = append(res.Checks,
+			n.checkACL("Return ACL out "+to.SubnetID, to.SubnetID, from.IP, protocol, ephemeralPort, true),
+			n.checkACL("Return ACL in "+from.SubnetID, from.SubnetID, to.IP, protocol, ephemeralPort, false),
+		)
+	}
+	return res
+}
+
+// checkRoute finds the most specific route in src's subnet for dst's IP.
+func (n *Network) checkRoute(step string, src, dst Endpoint) Check {
+	c := Check{Step: step}
+	rt, ok := n.routeTable(src.SubnetID, src.VpcID)
+	if !ok {
+		c.Verdict, c.Detail = Unknown, "no route table found for "+src.SubnetID
+		return c
+	}
+	ip, err := netip.ParseAddr(dst.IP)
+	if err != nil {
+		c.Verdict, c.Detail = Unknown, fmt.Sprintf("invalid address %q", dst.IP)
+		return c
+	}
+
+	var best *types.Route
+	bestBits := -1
+	for i, r := range rt.Routes {
+		prefix, err := netip.ParsePrefix(appaws.Str(r.DestinationCidrBlock))
+		if err != nil || !prefix.Contains(ip) || prefix.Bits() <= bestBits {
+			continue
+		}
+		best, bestBits = &rt.Routes[i], prefix.Bits()
+	}
+	table := appaws.Str(rt.RouteTableId)
+	if best == nil {
+		c.Verdict, c.Detail = Denied, fmt.Sprintf("%s has no route to %s", table, dst.IP)
+		return c
+	}
+
+	target := routeTarget(*best)
+	c.Detail = fmt.Sprintf("%s: %s → %s", table, appaws.Str(best.DestinationCidrBlock), target)
+	switch {
+	case best.State == types.RouteStateBlackhole:
+		c.Verdict, c.Detail = Denied, c.Detail+" (blackhole)"
+	case target == "local":
+		c.Verdict = Allowed
+		if src.VpcID != dst.VpcID {
+			c.Verdict, c.Detail = Denied, c.Detail+" (address overlaps the local VPC)"
+		}
+	case strings.HasPrefix(target, "pcx-"), strings.HasPrefix(target, "tgw-"):
+		c.Verdict = Allowed
+		if strings.HasPrefix(target, "tgw-") {
+			c.Verdict, c.Detail = Unknown, c.Detail+" (transit gateway route tables not evaluated)"
+		}
+	case strings.HasPrefix(target, "igw-"), strings.HasPrefix(target, "nat-"):
+		c.Verdict, c.Detail = Denied, c.Detail+" (private address sent to the internet)"
+	default:
+		c.Verdict, c.Detail = Unknown, c.Detail+" (through an appliance or gateway)"
+	}
+	return c
+}
+
+func routeTarget(r types.Route) string {
+	for _, id := range []*string{
+		r.GatewayId, r.NatGatewayId, r.TransitGatewayId, r.VpcPeeringConnectionId,
+		r.NetworkInterfaceId, r.InstanceId, r.LocalGatewayId, r.CarrierGatewayId,
+		r.EgressOnlyInternetGatewayId, r.CoreNetworkArn,
+	} {
+		if s := appaws.Str(id); s != "" {
+			return s
+		}
+	}
+	return "unknown"
+}
+
+// checkSecurityGroups checks self's egress (or ingress) rules for a rule
+// matching peer's address or one of its security groups.
+func (n *Network) checkSecurityGroups(step string, self, peer Endpoint, protocol string, port int32, egress bool) Check {
+	c := Check{Step: step, Verdict: Denied}
+	direction, prep := "ingress", "from"
+	if egress {
+		direction, prep = "egress", "to"
+	}
+	c.Detail = fmt.Sprintf("no %s rule allows %s/%d %s %s", direction, protocol, port, prep, peer.IP)
+
+	ip, _ := netip.ParseAddr(peer.IP)
+	for _, id := range self.SecurityGroups {
+		sg, ok := n.SecurityGroups[id]
+		if !ok {
+			c.Verdict, c.Detail = Unknown, id+" not loaded"
+			continue
+		}
+		perms := sg.IpPermissions
+		if egress {
+			perms = sg.IpPermissionsEgress
+		}
+		for _, p := range perms {
+			if !protocolMatches(appaws.Str(p.IpProtocol), protocol) || !portInRange(port, p.FromPort, p.ToPort) {
+				continue
+			}
+			for _, r := range p.IpRanges {
+				if prefix, err := netip.ParsePrefix(appaws.Str(r.CidrIp)); err == nil && prefix.Contains(ip) {
+					return Check{Step: step, Verdict: Allowed, Detail: fmt.Sprintf("%s allows %s %s %s", groupLabel(sg), permLabel(p), prep, prefix)}
+				}
+			}
+			for _, pair := range p.UserIdGroupPairs {
+				if slices.Contains(peer.SecurityGroups, appaws.Str(pair.GroupId)) {
+					return Check{Step: step, Verdict: Allowed, Detail: fmt.Sprintf("%s allows %s %s %s", groupLabel(sg), permLabel(p), prep, appaws.Str(pair.GroupId))}
+				}
+			}
+			for _, pl := range p.PrefixListIds {
+				c.Verdict = Unknown
+				c.Detail = fmt.Sprintf("%s allows %s %s %s (prefix list not resolved)", groupLabel(sg), permLabel(p), prep, appaws.Str(pl.PrefixListId))
+			}
+		}
+	}
+	return c
+}
+
+// checkACL evaluates the subnet's ACL entries in rule number order; the
+// first entry matching peerIP, protocol and port decides.
+func (n *Network) checkACL(step, subnetID, peerIP, protocol string, port int32, egress bool) Check {
+	c := Check{Step: step}
+	acl, ok := n.networkACL(subnetID)
+	if !ok {
+		c.Verdict, c.Detail = Unknown, "no network ACL found for "+subnetID
+		return c
+	}
+	ip, _ := netip.ParseAddr(peerIP)
+
+	var entries []types.NetworkAclEntry
+	for _, e := range acl.Entries {
+		if appaws.Bool(e.Egress) == egress {
+			entries = append(entries, e)
+		}
+	}
+	slices.SortFunc(entries, func(a, b types.NetworkAclEntry) int {
+		return cmp.Compare(appaws.Int32(a.RuleNumber), appaws.Int32(b.RuleNumber))
+	})
+
+	for _, e := range entries {
+		prefix, err := netip.ParsePrefix(appaws.Str(e.CidrBlock))
+		if err != nil || !prefix.Contains(ip) || !protocolMatches(appaws.Str(e.Protocol), protocol) {
+			continue
+		}
+		if e.PortRange != nil && !portInRange(port, e.PortRange.From, e.PortRange.To) {
+			continue
+		}
+		rule := fmt.Sprintf("%d", appaws.Int32(e.RuleNumber))
+		if appaws.Int32(e.RuleNumber) == 32767 {
+			rule = "*"
+		}
+		c.Detail = fmt.Sprintf("%s rule %s %ss %s/%d %s", appaws.Str(acl.NetworkAclId), rule, e.RuleAction, protocol, port, prefix)
+		c.Verdict = Denied
+		if e.RuleAction == types.RuleActionAllow {
+			c.Verdict = Allowed
+		}
+		return c
+	}
+	c.Verdict, c.Detail = Denied, appaws.Str(acl.NetworkAclId)+" has no matching rule"
+	return c
+}
+
+// protocolNumbers maps protocol names to the numbers ACLs use.
+var protocolNumbers = map[string]string{"tcp": "6", "udp": "17", "icmp": "1"}
+
+func protocolMatches(ruleProtocol, protocol string) bool {
+	if ruleProtocol == "-1" || ruleProtocol == protocol {
+		return true
+	}
+	n := protocolNumbers[protocol]
+	return n != "" && (ruleProtocol == n || protocolNumbers[ruleProtocol] == n)
+}
+
+func portInRange(port int32, from, to *int32) bool {
+	if from == nil || to == nil || appaws.Int32(from) == -1 {
+		return true
+	}
+	return *from <= port && port <= *to
+}
+
+func groupLabel(sg types.SecurityGroup) string {
+	if name := appaws.Str(sg.GroupName); name != "" {
+		return fmt.Sprintf("%s (%s)", appaws.Str(sg.GroupId), name)
+	}
+	return appaws.Str(sg.GroupId)
+}
+
+func permLabel(p types.IpPermission) string {
+	proto := appaws.Str(p.IpProtocol)
+	if proto == "-1" {
+		return "all traffic"
+	}
+	from, to := appaws.Int32(p.FromPort), appaws.Int32(p.ToPort)
+	if from == to {
+		return fmt.Sprintf("%s/%d", proto, from)
+	}
+	return fmt.Sprintf("%s/%d-%d", proto, from, to)
+}
@@ -0,0 +1,214 @@
+// Package reach answers "can A reach B on port P" between two instances or
+// network interfaces for the reachability view. The path is evaluated
+// locally from the security groups, subnets and route tables the resource
+// DAOs return plus the subnets' network ACLs; a VPC Reachability Analyzer
+// path can also be run for a definitive answer.
+package reach
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+	"github.com/clawscli/claws/internal/registry"
+)
+
+// Endpoint is one side of a reachability check.
+type Endpoint struct {
+	ID             string // instance or network interface ID
+	Name           string
+	IP             string // primary private IPv4 address
+	SubnetID       string
+	VpcID          string
+	SecurityGroups []string
+}
+
+// Label returns the ID with the name, if any.
+func (e Endpoint) Label() string {
+	if e.Name != "" && e.Name != e.ID {
+		return fmt.Sprintf("%s (%s)", e.ID, e.Name)
+	}
+	return e.ID
+}
+
+// Provider is implemented by resources that can be a reachability endpoint.
+type Provider interface {
+	ReachEndpoint() Endpoint
+}
+
+// Client is the subset of the EC2 API used by this package.
+type Client interface {
+	DescribeNetworkAcls(ctx context.Context, params *ec2.DescribeNetworkAclsInput, optFns ...func(*ec2.Options)) (*ec2.DescribeNetworkAclsOutput, error)
+	CreateNetworkInsightsPath(ctx context.Context, params *ec2.CreateNetworkInsightsPathInput, optFns ...func(*ec2.Options)) (*ec2.CreateNetworkInsightsPathOutput, error)
+	StartNetworkInsightsAnalysis(ctx context.Context, params *ec2.StartNetworkInsightsAnalysisInput, optFns ...func(*ec2.Options)) (*ec2.StartNetworkInsightsAnalysisOutput, error)
+	DeleteNetworkInsightsPath(ctx context.Context, params *ec2.DeleteNetworkInsightsPathInput, optFns ...func(*ec2.Options)) (*ec2.DeleteNetworkInsightsPathOutput, error)
+	DescribeNetworkInsightsAnalyses(ctx context.Context, params *ec2.DescribeNetworkInsightsAnalysesInput, optFns ...func(*ec2.Options)) (*ec2.DescribeNetworkInsightsAnalysesOutput, error)
+}
+
+// NewClient creates an EC2 client for the current profile.
+func NewClient(ctx context.Context) (Client, error) {
+	cfg, err := appaws.NewConfig(ctx)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new ec2 client")
+	}
+	return ec2.NewFromConfig(cfg), nil
+}
+
+// Resolve looks up an instance (i-...) or network interface (eni-...)
+// through its DAO.
+func Resolve(ctx context.Context, reg *registry.Registry, id string) (Endpoint, error) {
+	id = strings.TrimSpace(id)
+	var resource string
+	switch {
+	case strings.HasPrefix(id, "i-"):
+		resource = "instances"
+	case strings.HasPrefix(id, "eni-"):
+		resource = "network-interfaces"
+	default:
+		return Endpoint{}, fmt.Errorf("expected an instance (i-...) or network interface (eni-...) ID, got %q", id)
+	}
+
+	d, err := reg.GetDAO(ctx, "ec2", resource)
+	if err != nil {
+		return Endpoint{}, err
+	}
+	r, err := d.Get(ctx, id)
+	if err != nil {
+		return Endpoint{}, err
+	}
+	p, ok := dao.UnwrapResource(r).(Provider)
+	if !ok {
+		return Endpoint{}, fmt.Errorf("%s cannot be a reachability endpoint", id)
+	}
+	e := p.ReachEndpoint()
+	if e.IP == "" || e.SubnetID == "" {
+		return Endpoint{}, fmt.Errorf("%s has no private IP address in a subnet", id)
+	}
+	return e, nil
+}
+
+// Network holds the configuration evaluated between two endpoints.
+type Network struct {
+	SecurityGroups map[string]types.SecurityGroup
+	Subnets        map[string]types.Subnet
+	RouteTables    []types.RouteTable
+	NetworkACLs    []types.NetworkAcl
+}
+
+// LoadNetwork reads the security groups, subnets, route tables and
+// network ACLs of both endpoints.
+func LoadNetwork(ctx context.Context, reg *registry.Registry, client Client, from, to Endpoint) (*Network, error) {
+	n := &Network{
+		SecurityGroups: make(map[string]types.SecurityGroup),
+		Subnets:        make(map[string]types.Subnet),
+	}
+
+	sgDAO, err := reg.GetDAO(ctx, "ec2", "security-groups")
+	if err != nil {
+		return nil, err
+	}
+	for _, id := range append(slices.Clone(from.SecurityGroups), to.SecurityGroups...) {
+		if _, ok := n.SecurityGroups[id]; ok {
+			continue
+		}
+		r, err := sgDAO.Get(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if sg, ok := r.Raw().(types.SecurityGroup); ok {
+			n.SecurityGroups[id] = sg
+		}
+	}
+
+	subnetDAO, err := reg.GetDAO(ctx, "vpc", "subnets")
+	if err != nil {
+		return nil, err
+	}
+	subnetIDs := []string{from.SubnetID}
+	if to.SubnetID != from.SubnetID {
+		subnetIDs = append(subnetIDs, to.SubnetID)
+	}
+	for _, id := range subnetIDs {
+		r, err := subnetDAO.Get(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if subnet, ok := r.Raw().(types.Subnet); ok {
+			n.Subnets[id] = subnet
+		}
+	}
+
+	rtDAO, err := reg.GetDAO(ctx, "vpc", "route-tables")
+	if err != nil {
+		return nil, err
+	}
+	tables, err := rtDAO.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range tables {
+		if rt, ok := r.Raw().(types.RouteTable); ok {
+			if vpc := appaws.Str(rt.VpcId); vpc == from.VpcID || vpc == to.VpcID {
+				n.RouteTables = append(n.RouteTables, rt)
+			}
+		}
+	}
+
+	out, err := client.DescribeNetworkAcls(ctx, &ec2.DescribeNetworkAclsInput{
+		Filters: []types.Filter{{Name: appaws.StringPtr("association.subnet-id"), Values: subnetIDs}},
+	})
+	if err != nil {
+		return nil, apperrors.Wrap(err, "describe network acls")
+	}
+	n.NetworkACLs = out.NetworkAcls
+
+	return n, nil
+}
+
+// routeTable returns the table associated with the subnet, or the VPC's
+// main route table.
+func (n *Network) routeTable(subnetID, vpcID string) (types.RouteTable, bool) {
+	var main *types.RouteTable
+	for i, rt := range n.RouteTables {
+		for _, a := range rt.Associations {
+			if appaws.Str(a.SubnetId) == subnetID {
+				return rt, true
+			}
+			if appaws.Bool(a.Main) && appaws.Str(rt.VpcId) == vpcID {
+				main = &n.RouteTables[i]
+			}
+		}
+	}
+	if main != nil {
+		return *main, true
+	}
+	return types.RouteTable{}, false
+}
+
+// networkACL returns the network ACL associated with the subnet.
+func (n *Network) networkACL(subnetID string) (types.NetworkAcl, bool) {
+	for _, acl := range n.NetworkACLs {
+		for _, a := range acl.Associations {
+			if appaws.Str(a.SubnetId) == subnetID {
+				return acl, true
+			}
+		}
+	}
+	return types.NetworkAcl{}, false
+}
+
+// subnetLabel returns the subnet ID with its CIDR block and zone.
+func (n *Network) subnetLabel(id string) string {
+	subnet, ok := n.Subnets[id]
+	if !ok {
+		return id
+	}
+	return fmt.Sprintf("%s %s (%s)", id, appaws.Str(subnet.CidrBlock), appaws.Str(subnet.AvailabilityZone))
+}
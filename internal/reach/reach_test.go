@@ -0,0 +1,207 @@
+package reach
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+)
+
+func testNetwork() *Network {
+	allowAll := []types.NetworkAclEntry{
+		{RuleNumber: aws.Int32(100), Protocol: aws.String("-1"), CidrBlock: aws.String("0.0.0.0/0"), RuleAction: types.RuleActionAllow, Egress: aws.Bool(false)},
+		{RuleNumber: aws.Int32(100), Protocol: aws.String("-1"), CidrBlock: aws.String("0.0.0.0/0"), RuleAction: types.RuleActionAllow, Egress: aws.Bool(true)},
+		{RuleNumber: aws.Int32(32767), Protocol: aws.String("-1"), CidrBlock: aws.String("0.0.0.0/0"), RuleAction: types.RuleActionDeny, Egress: aws.Bool(false)},
+		{RuleNumber: aws.Int32(32767), Protocol: aws.String("-1"), CidrBlock: aws.String("0.0.0.0/0"), RuleAction: types.RuleActionDeny, Egress: aws.Bool(true)},
+	}
+	return &Network{
+		SecurityGroups: map[string]types.SecurityGroup{
+			"sg-web": {
+				GroupId: aws.String("sg-web"), GroupName: aws.String("web"),
+				IpPermissionsEgress: []types.IpPermission{{IpProtocol: aws.String("-1"), IpRanges: []types.IpRange{{CidrIp: aws.String("0.0.0.0/0")}}}},
+			},
+			"sg-db": {
+				GroupId: aws.String("sg-db"), GroupName: aws.String("db"),
+				IpPermissions: []types.IpPermission{{
+					IpProtocol: aws.String("tcp"), FromPort: aws.Int32(5432), ToPort: aws.Int32(5432),
+					UserIdGroupPairs: []types.UserIdGroupPair{{GroupId: aws.String("sg-web")}},
+				}},
+			},
+		},
+		RouteTables: []types.RouteTable{{
+			RouteTableId: aws.String("rtb-main"), VpcId: aws.String("vpc-1"),
+			Associations: []types.RouteTableAssociation{{Main: aws.Bool(true)}},
+			Routes: []types.Route{
+				{DestinationCidrBlock: aws.String("10.0.0.0/16"), GatewayId: aws.String("local")},
+				{DestinationCidrBlock: aws.String("0.0.0.0/0"), GatewayId: aws.String("igw-1")},
+			},
+		}},
+		NetworkACLs: []types.NetworkAcl{
+			{NetworkAclId: aws.String("acl-open"), Entries: allowAll, Associations: []types.NetworkAclAssociation{{SubnetId: aws.String("subnet-a")}}},
+			{
+				NetworkAclId: aws.String("acl-db"),
+				Associations: []types.NetworkAclAssociation{{SubnetId: aws.String("subnet-b")}},
+				Entries: append([]types.NetworkAclEntry{
+					{RuleNumber: aws.Int32(90), Protocol: aws.String("6"), CidrBlock: aws.String("10.0.1.0/24"), RuleAction: types.RuleActionDeny, Egress: aws.Bool(false), PortRange: &types.PortRange{From: aws.Int32(22), To: aws.Int32(22)}},
+				}, allowAll...),
+			},
+		},
+	}
+}
+
+var (
+	web = Endpoint{ID: "i-web", IP: "10.0.1.10", SubnetID: "subnet-a", VpcID: "vpc-1", SecurityGroups: []string{"sg-web"}}
+	db  = Endpoint{ID: "i-db", IP: "10.0.2.20", SubnetID: "subnet-b", VpcID: "vpc-1", SecurityGroups: []string{"sg-db"}}
+)
+
+func verdicts(r Result) map[string]Verdict {
+	m := make(map[string]Verdict)
+	for _, c := range r.Checks {
+		m[strings.Fields(c.Step)[0]+" "+strings.Fields(c.Step)[1]] = c.Verdict
+	}
+	return m
+}
+
+func TestEvaluate(t *testing.T) {
+	n := testNetwork()
+
+	res := Evaluate(n, web, db, "tcp", 5432)
+	if !res.Reachable() || !res.Conclusive() {
+		t.Errorf("web → db:5432 should be reachable: %+v", res.Checks)
+	}
+	if len(res.Checks) != 8 {
+		t.Errorf("got %d checks, want 8", len(res.Checks))
+	}
+
+	res = Evaluate(n, web, db, "tcp", 6379)
+	if res.Reachable() {
+		t.Errorf("web → db:6379 should be denied by the db group: %+v", res.Checks)
+	}
+	if v := verdicts(res)["Ingress i-db"]; v != Denied {
+		t.Errorf("ingress verdict = %s, want denied", v)
+	}
+
+	res = Evaluate(n, db, web, "tcp", 5432)
+	if res.Reachable() {
+		t.Errorf("db → web should be denied: db has no egress rules")
+	}
+
+	// The db subnet ACL denies SSH from the web subnet before allowing all.
+	n.SecurityGroups["sg-db"].IpPermissions[0].FromPort = aws.Int32(0)
+	n.SecurityGroups["sg-db"].IpPermissions[0].ToPort = aws.Int32(65535)
+	res = Evaluate(n, web, db, "tcp", 22)
+	if v := verdicts(res)["ACL in"]; v != Denied {
+		t.Errorf("ACL in verdict = %s, want denied: %+v", v, res.Checks)
+	}
+}
+
+func TestEvaluateSameSubnet(t *testing.T) {
+	n := testNetwork()
+	peer := db
+	peer.SubnetID = web.SubnetID
+	res := Evaluate(n, web, peer, "tcp", 5432)
+	if !res.Reachable() {
+		t.Errorf("same-subnet path should be reachable: %+v", res.Checks)
+	}
+	if v := verdicts(res)["Network ACLs"]; v != Skipped {
+		t.Errorf("ACLs should be skipped within a subnet, got %s", v)
+	}
+}
+
+func TestEvaluateRoutes(t *testing.T) {
+	n := testNetwork()
+	other := Endpoint{ID: "i-other", IP: "172.16.0.5", SubnetID: "subnet-c", VpcID: "vpc-2", SecurityGroups: []string{"sg-db"}}
+
+	res := Evaluate(n, web, other, "tcp", 5432)
+	if c := res.Checks[0]; c.Verdict != Denied || !strings.Contains(c.Detail, "igw-1") {
+		t.Errorf("route to another VPC through the internet gateway should be denied: %+v", c)
+	}
+
+	n.RouteTables[0].Routes = append(n.RouteTables[0].Routes, types.Route{
+		DestinationCidrBlock: aws.String("172.16.0.0/16"), VpcPeeringConnectionId: aws.String("pcx-1"),
+	})
+	res = Evaluate(n, web, other, "tcp", 5432)
+	if c := res.Checks[0]; c.Verdict != Allowed || !strings.Contains(c.Detail, "pcx-1") {
+		t.Errorf("longest prefix should pick the peering route: %+v", c)
+	}
+
+	n.RouteTables[0].Routes[2].State = types.RouteStateBlackhole
+	res = Evaluate(n, web, other, "tcp", 5432)
+	if c := res.Checks[0]; c.Verdict != Denied {
+		t.Errorf("blackhole route should be denied: %+v", c)
+	}
+}
+
+func TestProtocolMatches(t *testing.T) {
+	tests := []struct {
+		rule, protocol string
+		want           bool
+	}{
+		{"-1", "tcp", true},
+		{"tcp", "tcp", true},
+		{"6", "tcp", true},
+		{"17", "tcp", false},
+		{"udp", "udp", true},
+		{"50", "tcp", false},
+	}
+	for _, tt := range tests {
+		if got := protocolMatches(tt.rule, tt.protocol); got != tt.want {
+			t.Errorf("protocolMatches(%q, %q) = %v, want %v", tt.rule, tt.protocol, got, tt.want)
+		}
+	}
+}
+
+func TestNewAnalysis(t *testing.T) {
+	a := newAnalysis(types.NetworkInsightsAnalysis{
+		NetworkInsightsAnalysisId: aws.String("nia-1"),
+		Status:                    types.AnalysisStatusSucceeded,
+		NetworkPathFound:          aws.Bool(false),
+		Explanations: []types.Explanation{{
+			ExplanationCode: aws.String("ENI_SG_RULES_MISMATCH"),
+			Component:       &types.AnalysisComponent{Id: aws.String("sg-db"), Name: aws.String("db")},
+			Direction:       aws.String("ingress"),
+		}},
+	})
+	if !a.Done() || a.PathFound {
+		t.Errorf("analysis = %+v", a)
+	}
+	if len(a.Explanations) != 1 || a.Explanations[0] != "ENI_SG_RULES_MISMATCH: sg-db (db) (ingress)" {
+		t.Errorf("explanations = %v", a.Explanations)
+	}
+}
+
+// failingStartClient creates paths but cannot start analyses.
+type failingStartClient struct {
+	Client
+	deleted []string
+}
+
+func (c *failingStartClient) CreateNetworkInsightsPath(context.Context, *ec2.CreateNetworkInsightsPathInput, ...func(*ec2.Options)) (*ec2.CreateNetworkInsightsPathOutput, error) {
+	return &ec2.CreateNetworkInsightsPathOutput{
+		NetworkInsightsPath: &types.NetworkInsightsPath{NetworkInsightsPathId: aws.String("nip-1")},
+	}, nil
+}
+
+func (c *failingStartClient) StartNetworkInsightsAnalysis(context.Context, *ec2.StartNetworkInsightsAnalysisInput, ...func(*ec2.Options)) (*ec2.StartNetworkInsightsAnalysisOutput, error) {
+	return nil, errors.New("limit exceeded")
+}
+
+func (c *failingStartClient) DeleteNetworkInsightsPath(_ context.Context, in *ec2.DeleteNetworkInsightsPathInput, _ ...func(*ec2.Options)) (*ec2.DeleteNetworkInsightsPathOutput, error) {
+	c.deleted = append(c.deleted, aws.ToString(in.NetworkInsightsPathId))
+	return &ec2.DeleteNetworkInsightsPathOutput{}, nil
+}
+
+func TestAnalyze_StartFailureDeletesPath(t *testing.T) {
+	client := &failingStartClient{}
+	_, err := Analyze(context.Background(), client, Endpoint{ID: "i-web"}, Endpoint{ID: "i-db"}, "tcp", 5432)
+	if err == nil || !strings.Contains(err.Error(), "limit exceeded") {
+		t.Fatalf("Analyze() error = %v, want the start failure", err)
+	}
+	if len(client.deleted) != 1 || client.deleted[0] != "nip-1" {
+		t.Errorf("deleted paths = %v, want nip-1", client.deleted)
+	}
+}
@@ -73,6 +73,7 @@ func defaultAliases() map[string]string {
 		"cfn":           "cloudformation",
 		"cf":            "cloudformation",
 		"sg":            "ec2/security-groups",
+		"eni":           "ec2/network-interfaces",
 		"asg":           "autoscaling",
 		"cw":            "cloudwatch",
 		"logs":          "cloudwatch/log-groups",
@@ -261,6 +262,33 @@ func (r *Registry) GetDisplayName(service string) string {
 	return service
 }
 
+// SetDisplayName sets the display name for a service.
+// Used for services registered at runtime, such as plugins.
+func (r *Registry) SetDisplayName(service, name string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.displayNames[service] = name
+}
+
+// AddToCategory adds a service to a category, creating the category
+// at the end of the list if it does not exist yet.
+func (r *Registry) AddToCategory(category, service string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for i, cat := range r.categories {
+		if cat.Name != category {
+			continue
+		}
+		if !slices.Contains(cat.Services, service) {
+			r.categories[i].Services = append(cat.Services, service)
+		}
+		return
+	}
+	r.categories = append(r.categories, ServiceCategory{Name: category, Services: []string{service}})
+}
+
 // ResolveAlias resolves an alias to service (and optionally resource)
 // Returns (service, resource, found)
 func (r *Registry) ResolveAlias(input string) (string, string, bool) {
@@ -450,6 +478,8 @@ func (r *Registry) ListResources(service string) []string {
 // and should only be accessed via navigation from their parent resource.
 // Format: "service/resource"
 var subResourceSet = map[string]struct{}{
+	"cloudformation/change-sets":       {},
+	"cloudformation/drifts":            {},
 	"cloudformation/events":            {},
 	"cloudformation/outputs":           {},
 	"cloudformation/resources":         {},
@@ -490,6 +520,18 @@ var subResourceSet = map[string]struct{}{
 	"license-manager/grants":           {},
 	"appsync/data-sources":             {},
 	"redshift/snapshots":               {},
+	"s3/objects":                       {},
+	"s3/object-versions":               {},
+	"sqs/messages":                     {},
+	"sqs/move-tasks":                   {},
+	"ec2/security-group-rules":         {},
+	"ecr/image-findings":               {},
+	"ecr/lifecycle-preview":            {},
+	"ecs/deployments":                  {},
+	"ecs/service-events":               {},
+	"rds/log-files":                    {},
+	"rds/events":                       {},
+	"rds/parameters":                   {},
 }
 
 // isSubResource returns true if the resource is only accessible via navigation
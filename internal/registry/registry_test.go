@@ -2,6 +2,7 @@ package registry
 
 import (
 	"context"
+	"slices"
 	"testing"
 
 	"github.com/clawscli/claws/internal/dao"
@@ -442,3 +443,39 @@ func TestRegistry_ListServicesByCategory(t *testing.T) {
 		t.Error("ListServicesByCategory() should include Compute category")
 	}
 }
+
+func TestRegistry_SetDisplayName(t *testing.T) {
+	reg := New()
+
+	reg.SetDisplayName("catalog", "Service Catalog")
+	if got := reg.GetDisplayName("catalog"); got != "Service Catalog" {
+		t.Errorf("GetDisplayName() = %q, want %q", got, "Service Catalog")
+	}
+}
+
+func TestRegistry_AddToCategory(t *testing.T) {
+	reg := New()
+
+	reg.RegisterCustom("catalog", "services", Entry{})
+	reg.AddToCategory("Plugins", "catalog")
+	reg.AddToCategory("Plugins", "catalog")
+	reg.RegisterCustom("eks-internal", "addons", Entry{})
+	reg.AddToCategory("Compute", "eks-internal")
+
+	var plugins, compute []string
+	for _, cat := range reg.ListServicesByCategory() {
+		switch cat.Name {
+		case "Plugins":
+			plugins = cat.Services
+		case "Compute":
+			compute = cat.Services
+		}
+	}
+
+	if len(plugins) != 1 || plugins[0] != "catalog" {
+		t.Errorf("Plugins category = %v, want [catalog]", plugins)
+	}
+	if !slices.Contains(compute, "eks-internal") {
+		t.Errorf("Compute category = %v, want eks-internal included", compute)
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"charm.land/lipgloss/v2"
 
 	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/logs"
 	"github.com/clawscli/claws/internal/ui"
 )
 
@@ -36,6 +37,7 @@ type Navigation struct {
 	FilterValue    string        // Value to filter by (extracted from current resource)
 	AutoReload     bool          // Enable auto-reload for this navigation
 	ReloadInterval time.Duration // Auto-reload interval (default: 3s)
+	Logs           *logs.Target  // Open the log viewer instead of a resource list
 }
 
 // Renderer defines the interface for rendering resources in table format
@@ -0,0 +1,198 @@
+// Package runcmd sends SSM Run Command documents to instances and reads
+// the per-instance results for the command output view: each invocation's
+// status, exit code and stdout/stderr.
+package runcmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	"github.com/aws/aws-sdk-go-v2/service/ssm/types"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	apperrors "github.com/clawscli/claws/internal/errors"
+)
+
+// Default documents for running shell commands
+const (
+	DocumentShell      = "AWS-RunShellScript"
+	DocumentPowerShell = "AWS-RunPowerShellScript"
+)
+
+// MaxInstances bounds the instances a command is sent to by ID.
+const MaxInstances = 50
+
+// Instance is an instance a command can be sent to.
+type Instance struct {
+	ID      string
+	Name    string
+	Windows bool
+}
+
+// Provider is implemented by resources commands can be sent to.
+type Provider interface {
+	RunInstance() Instance
+}
+
+// Target identifies a sent command whose output is shown.
+type Target struct {
+	CommandID   string
+	Document    string
+	InstanceIDs []string // known before the invocations are listed
+}
+
+// TargetProvider is implemented by resources with command output.
+type TargetProvider interface {
+	CommandTarget() Target
+}
+
+// Client is the subset of the SSM API used by this package.
+type Client interface {
+	SendCommand(ctx context.Context, params *ssm.SendCommandInput, optFns ...func(*ssm.Options)) (*ssm.SendCommandOutput, error)
+	ListCommandInvocations(ctx context.Context, params *ssm.ListCommandInvocationsInput, optFns ...func(*ssm.Options)) (*ssm.ListCommandInvocationsOutput, error)
+	GetCommandInvocation(ctx context.Context, params *ssm.GetCommandInvocationInput, optFns ...func(*ssm.Options)) (*ssm.GetCommandInvocationOutput, error)
+}
+
+// NewClient creates an SSM client for the current profile and region.
+func NewClient(ctx context.Context) (Client, error) {
+	cfg, err := appaws.NewConfig(ctx)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new ssm client")
+	}
+	return ssm.NewFromConfig(cfg), nil
+}
+
+// DefaultDocument returns the shell document for the instances: PowerShell
+// when they all run Windows.
+func DefaultDocument(instances []Instance) string {
+	if len(instances) > 0 && !slices.ContainsFunc(instances, func(i Instance) bool { return !i.Windows }) {
+		return DocumentPowerShell
+	}
+	return DocumentShell
+}
+
+// ParseInstanceIDs parses instance IDs separated by commas or spaces,
+// dropping duplicates.
+func ParseInstanceIDs(s string) ([]string, error) {
+	var ids []string
+	for _, id := range strings.FieldsFunc(s, func(r rune) bool { return r == ',' || r == ' ' || r == '\n' }) {
+		if !strings.HasPrefix(id, "i-") && !strings.HasPrefix(id, "mi-") {
+			return nil, fmt.Errorf("%q is not an instance ID", id)
+		}
+		if !slices.Contains(ids, id) {
+			ids = append(ids, id)
+		}
+	}
+	if len(ids) == 0 {
+		return nil, fmt.Errorf("no instances to run on")
+	}
+	if len(ids) > MaxInstances {
+		return nil, fmt.Errorf("at most %d instances can be targeted by ID", MaxInstances)
+	}
+	return ids, nil
+}
+
+// Send runs the command lines with document on the instances and returns
+// the sent command.
+func Send(ctx context.Context, c Client, document, commands string, ids []string) (Target, error) {
+	document = strings.TrimSpace(document)
+	if document == "" {
+		document = DocumentShell
+	}
+	if strings.TrimSpace(commands) == "" {
+		return Target{}, fmt.Errorf("command is empty")
+	}
+	output, err := c.SendCommand(ctx, &ssm.SendCommandInput{
+		DocumentName: &document,
+		InstanceIds:  ids,
+		Parameters:   map[string][]string{"commands": strings.Split(strings.TrimRight(commands, "\n"), "\n")},
+		Comment:      appaws.StringPtr("sent from claws"),
+	})
+	if err != nil {
+		return Target{}, apperrors.Wrapf(err, "send command %s", document)
+	}
+	if output.Command == nil {
+		return Target{}, fmt.Errorf("send command %s: no command returned", document)
+	}
+	return Target{CommandID: appaws.Str(output.Command.CommandId), Document: document, InstanceIDs: ids}, nil
+}
+
+// Invocation is a command's result on one instance.
+type Invocation struct {
+	InstanceID   string
+	InstanceName string
+	Status       string
+	StatusDetail string
+	ResponseCode int32
+	Stdout       string
+	Stderr       string
+}
+
+// Done reports whether the invocation has finished.
+func (i Invocation) Done() bool {
+	switch types.CommandInvocationStatus(i.Status) {
+	case types.CommandInvocationStatusSuccess, types.CommandInvocationStatusFailed,
+		types.CommandInvocationStatusCancelled, types.CommandInvocationStatusTimedOut:
+		return true
+	}
+	return false
+}
+
+// AllDone reports whether every invocation has finished.
+func AllDone(invocations []Invocation) bool {
+	return len(invocations) > 0 && !slices.ContainsFunc(invocations, func(i Invocation) bool { return !i.Done() })
+}
+
+// Load returns the command's invocation on each instance, in instance
+// order. Instances the command has not reached yet are Pending.
+func Load(ctx context.Context, c Client, t Target) ([]Invocation, error) {
+	ids := slices.Clone(t.InstanceIDs)
+	names := map[string]string{}
+	var token *string
+	for {
+		output, err := c.ListCommandInvocations(ctx, &ssm.ListCommandInvocationsInput{
+			CommandId: &t.CommandID,
+			NextToken: token,
+		})
+		if err != nil {
+			return nil, apperrors.Wrapf(err, "list invocations of command %s", t.CommandID)
+		}
+		for _, inv := range output.CommandInvocations {
+			id := appaws.Str(inv.InstanceId)
+			if !slices.Contains(ids, id) {
+				ids = append(ids, id)
+			}
+			names[id] = appaws.Str(inv.InstanceName)
+		}
+		if output.NextToken == nil {
+			break
+		}
+		token = output.NextToken
+	}
+
+	invocations := make([]Invocation, len(ids))
+	for i, id := range ids {
+		invocations[i] = Invocation{InstanceID: id, InstanceName: names[id], Status: string(types.CommandInvocationStatusPending)}
+		output, err := c.GetCommandInvocation(ctx, &ssm.GetCommandInvocationInput{
+			CommandId:  &t.CommandID,
+			InstanceId: &id,
+		})
+		if err != nil {
+			var notYet *types.InvocationDoesNotExist
+			if errors.As(err, &notYet) {
+				continue
+			}
+			return nil, apperrors.Wrapf(err, "get invocation of command %s on %s", t.CommandID, id)
+		}
+		invocations[i].Status = string(output.Status)
+		invocations[i].StatusDetail = appaws.Str(output.StatusDetails)
+		invocations[i].ResponseCode = output.ResponseCode
+		invocations[i].Stdout = appaws.Str(output.StandardOutputContent)
+		invocations[i].Stderr = appaws.Str(output.StandardErrorContent)
+	}
+	return invocations, nil
+}
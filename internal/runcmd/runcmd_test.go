@@ -0,0 +1,96 @@
+package runcmd
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	"github.com/aws/aws-sdk-go-v2/service/ssm/types"
+)
+
+type fakeClient struct {
+	sent *ssm.SendCommandInput
+}
+
+func (f *fakeClient) SendCommand(_ context.Context, in *ssm.SendCommandInput, _ ...func(*ssm.Options)) (*ssm.SendCommandOutput, error) {
+	f.sent = in
+	return &ssm.SendCommandOutput{Command: &types.Command{CommandId: aws.String("cmd-1")}}, nil
+}
+
+func (f *fakeClient) ListCommandInvocations(_ context.Context, _ *ssm.ListCommandInvocationsInput, _ ...func(*ssm.Options)) (*ssm.ListCommandInvocationsOutput, error) {
+	return &ssm.ListCommandInvocationsOutput{CommandInvocations: []types.CommandInvocation{
+		{InstanceId: aws.String("i-1"), InstanceName: aws.String("web-1")},
+	}}, nil
+}
+
+func (f *fakeClient) GetCommandInvocation(_ context.Context, in *ssm.GetCommandInvocationInput, _ ...func(*ssm.Options)) (*ssm.GetCommandInvocationOutput, error) {
+	if *in.InstanceId == "i-2" {
+		return nil, &types.InvocationDoesNotExist{}
+	}
+	return &ssm.GetCommandInvocationOutput{
+		Status:                types.CommandInvocationStatusSuccess,
+		ResponseCode:          0,
+		StandardOutputContent: aws.String("active (running)\n"),
+	}, nil
+}
+
+func TestParseInstanceIDs(t *testing.T) {
+	ids, err := ParseInstanceIDs("i-1, i-2 i-1\nmi-3")
+	if err != nil {
+		t.Fatalf("ParseInstanceIDs() error = %v", err)
+	}
+	if len(ids) != 3 || ids[0] != "i-1" || ids[2] != "mi-3" {
+		t.Errorf("ParseInstanceIDs() = %v", ids)
+	}
+	for _, bad := range []string{"", " , ", "web-1"} {
+		if _, err := ParseInstanceIDs(bad); err == nil {
+			t.Errorf("ParseInstanceIDs(%q) should fail", bad)
+		}
+	}
+}
+
+func TestDefaultDocument(t *testing.T) {
+	if got := DefaultDocument([]Instance{{ID: "i-1", Windows: true}}); got != DocumentPowerShell {
+		t.Errorf("DefaultDocument(windows) = %q", got)
+	}
+	if got := DefaultDocument([]Instance{{ID: "i-1", Windows: true}, {ID: "i-2"}}); got != DocumentShell {
+		t.Errorf("DefaultDocument(mixed) = %q", got)
+	}
+}
+
+func TestSend(t *testing.T) {
+	c := &fakeClient{}
+	target, err := Send(context.Background(), c, "", "systemctl status foo\nuptime\n", []string{"i-1", "i-2"})
+	if err != nil {
+		t.Fatalf("Send() error = %v", err)
+	}
+	if target.CommandID != "cmd-1" || target.Document != DocumentShell {
+		t.Errorf("Send() = %+v", target)
+	}
+	if got := c.sent.Parameters["commands"]; len(got) != 2 || got[1] != "uptime" {
+		t.Errorf("commands = %v", got)
+	}
+	if _, err := Send(context.Background(), c, "", "  ", []string{"i-1"}); err == nil {
+		t.Error("Send() with an empty command should fail")
+	}
+}
+
+func TestLoad(t *testing.T) {
+	invocations, err := Load(context.Background(), &fakeClient{}, Target{CommandID: "cmd-1", InstanceIDs: []string{"i-1", "i-2"}})
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if len(invocations) != 2 {
+		t.Fatalf("Load() = %+v", invocations)
+	}
+	if inv := invocations[0]; inv.InstanceName != "web-1" || inv.Status != "Success" || !inv.Done() || inv.Stdout == "" {
+		t.Errorf("invocation on i-1 = %+v", inv)
+	}
+	if inv := invocations[1]; inv.Status != "Pending" || inv.Done() {
+		t.Errorf("invocation on i-2 = %+v, want pending", inv)
+	}
+	if AllDone(invocations) {
+		t.Error("AllDone() = true with a pending invocation")
+	}
+}
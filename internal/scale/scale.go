@@ -0,0 +1,74 @@
+// Package scale sets the desired task count of an ECS service for the
+// desired count view.
+package scale
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/ecs"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	apperrors "github.com/clawscli/claws/internal/errors"
+)
+
+// MaxDesiredCount bounds the count accepted from input, guarding against
+// a mistyped extra digit.
+const MaxDesiredCount = 1000
+
+// Target identifies the service to scale and its current desired count.
+type Target struct {
+	Cluster string
+	Service string
+	Desired int32
+}
+
+// Provider is implemented by resources whose desired count can be set.
+type Provider interface {
+	ScaleTarget() Target
+}
+
+// Client is the subset of the ECS API used by this package.
+type Client interface {
+	UpdateService(ctx context.Context, params *ecs.UpdateServiceInput, optFns ...func(*ecs.Options)) (*ecs.UpdateServiceOutput, error)
+}
+
+// NewClient creates an ECS client for the current profile and region.
+func NewClient(ctx context.Context) (Client, error) {
+	cfg, err := appaws.NewConfig(ctx)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new ecs client")
+	}
+	return ecs.NewFromConfig(cfg), nil
+}
+
+// ParseCount parses a desired count typed by the user.
+func ParseCount(s string) (int32, error) {
+	n, err := strconv.Atoi(strings.TrimSpace(s))
+	if err != nil {
+		return 0, fmt.Errorf("desired count must be a whole number")
+	}
+	if n < 0 || n > MaxDesiredCount {
+		return 0, fmt.Errorf("desired count must be between 0 and %d", MaxDesiredCount)
+	}
+	return int32(n), nil
+}
+
+// SetDesired sets the desired count of the service and returns the count
+// the service reports afterwards.
+func SetDesired(ctx context.Context, client Client, t Target, desired int32) (int32, error) {
+	output, err := client.UpdateService(ctx, &ecs.UpdateServiceInput{
+		Cluster:      &t.Cluster,
+		Service:      &t.Service,
+		DesiredCount: &desired,
+	})
+	if err != nil {
+		return 0, apperrors.Wrapf(err, "update service %s", t.Service)
+	}
+	if output.Service == nil {
+		return desired, nil
+	}
+	return output.Service.DesiredCount, nil
+}
@@ -0,0 +1,54 @@
+package scale
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/ecs"
+	"github.com/aws/aws-sdk-go-v2/service/ecs/types"
+)
+
+func TestParseCount(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    int32
+		wantErr bool
+	}{
+		{"3", 3, false},
+		{" 0 ", 0, false},
+		{"1000", 1000, false},
+		{"1001", 0, true},
+		{"-1", 0, true},
+		{"2.5", 0, true},
+		{"", 0, true},
+	}
+	for _, tt := range tests {
+		got, err := ParseCount(tt.in)
+		if (err != nil) != tt.wantErr || got != tt.want {
+			t.Errorf("ParseCount(%q) = %d, %v; want %d, error %v", tt.in, got, err, tt.want, tt.wantErr)
+		}
+	}
+}
+
+type fakeClient struct {
+	input *ecs.UpdateServiceInput
+}
+
+func (c *fakeClient) UpdateService(_ context.Context, in *ecs.UpdateServiceInput, _ ...func(*ecs.Options)) (*ecs.UpdateServiceOutput, error) {
+	c.input = in
+	return &ecs.UpdateServiceOutput{Service: &types.Service{DesiredCount: *in.DesiredCount}}, nil
+}
+
+func TestSetDesired(t *testing.T) {
+	client := &fakeClient{}
+	got, err := SetDesired(context.Background(), client, Target{Cluster: "prod", Service: "web", Desired: 2}, 5)
+	if err != nil {
+		t.Fatalf("SetDesired() error = %v", err)
+	}
+	if got != 5 {
+		t.Errorf("SetDesired() = %d, want 5", got)
+	}
+	if *client.input.Cluster != "prod" || *client.input.Service != "web" {
+		t.Errorf("UpdateService input = %+v", client.input)
+	}
+}
@@ -0,0 +1,100 @@
+package search
+
+import "reflect"
+
+const (
+	// maxAddressDepth bounds how deep Addresses walks nested structs.
+	maxAddressDepth = 3
+	// maxAddressSliceLen bounds how many slice elements are inspected.
+	maxAddressSliceLen = 50
+)
+
+// addressFields are struct field names (as used by the AWS SDK) whose string
+// values are network addresses or DNS names worth matching.
+var addressFields = map[string]bool{
+	"Address":          true,
+	"CarrierIp":        true,
+	"CidrBlock":        true,
+	"DNSName":          true,
+	"DnsName":          true,
+	"DomainName":       true,
+	"Endpoint":         true,
+	"IpAddress":        true,
+	"Ipv6Address":      true,
+	"PrivateDnsName":   true,
+	"PrivateIp":        true,
+	"PrivateIpAddress": true,
+	"PublicDnsName":    true,
+	"PublicIp":         true,
+	"PublicIpAddress":  true,
+}
+
+// Addresses collects address-like string values from an AWS SDK struct,
+// descending into nested structs and slices (e.g. an RDS Endpoint.Address or
+// an ENI's PrivateIpAddresses).
+func Addresses(raw any) []string {
+	if raw == nil {
+		return nil
+	}
+	var out []string
+	seen := make(map[string]bool)
+	collectAddresses(reflect.ValueOf(raw), 0, func(s string) {
+		if s != "" && !seen[s] {
+			seen[s] = true
+			out = append(out, s)
+		}
+	})
+	return out
+}
+
+func collectAddresses(v reflect.Value, depth int, emit func(string)) {
+	if depth > maxAddressDepth {
+		return
+	}
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return
+		}
+		v = v.Elem()
+	}
+
+	switch v.Kind() {
+	case reflect.Struct:
+		t := v.Type()
+		for i := 0; i < v.NumField(); i++ {
+			sf := t.Field(i)
+			if !sf.IsExported() {
+				continue
+			}
+			fv := v.Field(i)
+			if addressFields[sf.Name] {
+				if s, ok := stringValue(fv); ok {
+					emit(s)
+					continue
+				}
+			}
+			collectAddresses(fv, depth+1, emit)
+		}
+	case reflect.Slice, reflect.Array:
+		n := v.Len()
+		if n > maxAddressSliceLen {
+			n = maxAddressSliceLen
+		}
+		for i := 0; i < n; i++ {
+			collectAddresses(v.Index(i), depth, emit)
+		}
+	}
+}
+
+func stringValue(v reflect.Value) (string, bool) {
+	if v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return "", true
+		}
+		v = v.Elem()
+	}
+	if v.Kind() == reflect.String {
+		return v.String(), true
+	}
+	return "", false
+}
@@ -0,0 +1,92 @@
+package search
+
+import (
+	"sync"
+	"time"
+
+	"github.com/clawscli/claws/internal/config"
+	"github.com/clawscli/claws/internal/dao"
+)
+
+// DefaultCacheTTL is how long listings are reused by DefaultCache.
+const DefaultCacheTTL = 5 * time.Minute
+
+// DefaultCache is shared by searches in the current session.
+var DefaultCache = NewCache(DefaultCacheTTL)
+
+type cacheEntry struct {
+	resources []dao.Resource
+	expires   time.Time
+}
+
+// Cache stores resource listings per target for a fixed TTL.
+// A nil *Cache is valid and caches nothing.
+//
+// Targets without a profile or region are keyed by the current ones, so
+// listings cached before a profile or region switch are not reused.
+type Cache struct {
+	mu      sync.Mutex
+	ttl     time.Duration
+	entries map[Target]cacheEntry
+	now     func() time.Time
+}
+
+// NewCache creates a cache with the given TTL.
+func NewCache(ttl time.Duration) *Cache {
+	return &Cache{
+		ttl:     ttl,
+		entries: make(map[Target]cacheEntry),
+		now:     time.Now,
+	}
+}
+
+// Get returns the cached listing for t if present and not expired.
+func (c *Cache) Get(t Target) ([]dao.Resource, bool) {
+	if c == nil {
+		return nil, false
+	}
+	t = cacheKey(t)
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e, ok := c.entries[t]
+	if !ok {
+		return nil, false
+	}
+	if c.now().After(e.expires) {
+		delete(c.entries, t)
+		return nil, false
+	}
+	return e.resources, true
+}
+
+// Put stores the listing for t.
+func (c *Cache) Put(t Target, resources []dao.Resource) {
+	if c == nil {
+		return
+	}
+	t = cacheKey(t)
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries[t] = cacheEntry{resources: resources, expires: c.now().Add(c.ttl)}
+}
+
+// Clear removes all cached listings.
+func (c *Cache) Clear() {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries = make(map[Target]cacheEntry)
+}
+
+// cacheKey fills in the current profile and region where t has none.
+func cacheKey(t Target) Target {
+	if t.Profile == "" {
+		t.Profile = config.Global().Selection().ID()
+	}
+	if t.Region == "" {
+		t.Region = config.Global().Region()
+	}
+	return t
+}
@@ -0,0 +1,324 @@
+// Package search implements omnisearch: matching a query against the ID,
+// name, ARN and address fields of every listable resource type in scope.
+//
+// Targets are listed through their registered DAOs with bounded concurrency,
+// and listings are cached so repeated queries do not hit AWS again.
+package search
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/config"
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/log"
+	"github.com/clawscli/claws/internal/registry"
+)
+
+const (
+	// DefaultConcurrency bounds how many targets are listed at once.
+	DefaultConcurrency = 8
+	// targetTimeout bounds listing of a single target.
+	targetTimeout = 30 * time.Second
+	// listPageSize and maxListPages bound listing of paginated DAOs.
+	listPageSize = 100
+	maxListPages = 10
+)
+
+// excluded lists resource types skipped when no explicit scope is given:
+// findings, events, recommendations and cost data are not addressable
+// resources and are often expensive to list.
+var excluded = map[string]struct{}{
+	"bedrock/foundation-models":        {},
+	"cloudtrail/events":                {},
+	"computeoptimizer/recommendations": {},
+	"computeoptimizer/summary":         {},
+	"costexplorer/anomalies":           {},
+	"costexplorer/costs":               {},
+	"costexplorer/monitors":            {},
+	"health/events":                    {},
+	"inspector2/findings":              {},
+	"macie/findings":                   {},
+	"risp/reserved-instances":          {},
+	"risp/savings-plans":               {},
+	"securityhub/findings":             {},
+	"service-quotas/services":          {},
+	"trustedadvisor/recommendations":   {},
+}
+
+// Target is a single resource type listed in one profile/region.
+// Empty Profile/Region mean the current default.
+type Target struct {
+	Service  string
+	Resource string
+	Profile  string
+	Region   string
+}
+
+func (t Target) String() string {
+	s := t.Service + "/" + t.Resource
+	if t.Region != "" {
+		s = t.Region + ":" + s
+	}
+	if t.Profile != "" {
+		s = t.Profile + "/" + s
+	}
+	return s
+}
+
+// Hit is a resource matching the query.
+type Hit struct {
+	Service      string
+	ResourceType string
+	Field        string // "id", "name", "arn" or "address"
+	Value        string // the matched value
+	Exact        bool
+	Resource     dao.Resource
+}
+
+// Batch carries the hits (or error) for one target.
+type Batch struct {
+	Target Target
+	Hits   []Hit
+	Err    error
+}
+
+// Scope resolves scope entries ("ec2", "ec2/instances", aliases like "sg")
+// into service/resource pairs. An empty scope means every top-level resource
+// type except the excluded set.
+func Scope(reg *registry.Registry, entries []string) ([][2]string, error) {
+	var pairs [][2]string
+	seen := make(map[[2]string]bool)
+	add := func(svc, res string) {
+		p := [2]string{svc, res}
+		if !seen[p] {
+			seen[p] = true
+			pairs = append(pairs, p)
+		}
+	}
+
+	if len(entries) == 0 {
+		for _, svc := range reg.ListServices() {
+			for _, res := range reg.ListResources(svc) {
+				if _, skip := excluded[svc+"/"+res]; !skip {
+					add(svc, res)
+				}
+			}
+		}
+		return pairs, nil
+	}
+
+	for _, entry := range entries {
+		parts := strings.SplitN(entry, "/", 2)
+		svc, res := parts[0], ""
+		if len(parts) == 2 {
+			res = parts[1]
+		}
+		if resolvedSvc, resolvedRes, ok := reg.ResolveAlias(svc); ok {
+			svc = resolvedSvc
+			if res == "" {
+				res = resolvedRes
+			}
+		}
+		if res != "" {
+			if _, ok := reg.Get(svc, res); !ok {
+				return nil, fmt.Errorf("unknown resource type: %s/%s", svc, res)
+			}
+			add(svc, res)
+			continue
+		}
+		resources := reg.ListResources(svc)
+		if len(resources) == 0 {
+			return nil, fmt.Errorf("unknown service: %s", svc)
+		}
+		for _, r := range resources {
+			add(svc, r)
+		}
+	}
+	return pairs, nil
+}
+
+// Targets expands service/resource pairs across the given profile selections
+// and regions. With a single profile and region the targets carry no override.
+func Targets(pairs [][2]string, selections []config.ProfileSelection, regions []string) []Target {
+	multiProfile := len(selections) > 1
+	multiRegion := len(regions) > 1
+
+	var targets []Target
+	for _, p := range pairs {
+		switch {
+		case multiProfile:
+			for _, sel := range selections {
+				for _, region := range regions {
+					targets = append(targets, Target{Service: p[0], Resource: p[1], Profile: sel.ID(), Region: region})
+				}
+			}
+		case multiRegion:
+			for _, region := range regions {
+				targets = append(targets, Target{Service: p[0], Resource: p[1], Region: region})
+			}
+		default:
+			targets = append(targets, Target{Service: p[0], Resource: p[1]})
+		}
+	}
+	return targets
+}
+
+// Run lists every target with bounded concurrency and sends a Batch for each
+// one to out as soon as it completes. out is closed when all targets are done
+// or ctx is cancelled. A nil cache disables caching.
+func Run(ctx context.Context, reg *registry.Registry, query string, targets []Target, cache *Cache, concurrency int, out chan<- Batch) {
+	defer close(out)
+
+	if concurrency <= 0 {
+		concurrency = DefaultConcurrency
+	}
+	query = strings.TrimSpace(query)
+
+	sem := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	seen := make(map[string]bool)
+
+	for _, t := range targets {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			wg.Wait()
+			return
+		}
+		wg.Add(1)
+		go func(t Target) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			resources, err := list(ctx, reg, t, cache)
+			batch := Batch{Target: t, Err: err}
+			if err == nil {
+				mu.Lock()
+				for _, res := range resources {
+					hit, ok := Match(res, query)
+					if !ok {
+						continue
+					}
+					// Global services (IAM, S3, ...) return the same resources in every region
+					key := dedupeKey(t, res)
+					if seen[key] {
+						continue
+					}
+					seen[key] = true
+					hit.Service = t.Service
+					hit.ResourceType = t.Resource
+					batch.Hits = append(batch.Hits, hit)
+				}
+				mu.Unlock()
+			}
+
+			select {
+			case out <- batch:
+			case <-ctx.Done():
+			}
+		}(t)
+	}
+	wg.Wait()
+}
+
+func dedupeKey(t Target, res dao.Resource) string {
+	id := res.GetARN()
+	if id == "" {
+		id = t.Region + ":" + res.GetID()
+	}
+	return t.Profile + "|" + t.Service + "/" + t.Resource + "|" + id
+}
+
+func list(ctx context.Context, reg *registry.Registry, t Target, cache *Cache) ([]dao.Resource, error) {
+	if cached, ok := cache.Get(t); ok {
+		return cached, nil
+	}
+
+	listCtx, cancel := context.WithTimeout(ctx, targetTimeout)
+	defer cancel()
+
+	if t.Profile != "" {
+		listCtx = aws.WithSelectionOverride(listCtx, config.ProfileSelectionFromID(t.Profile))
+	}
+	if t.Region != "" {
+		listCtx = aws.WithRegionOverride(listCtx, t.Region)
+	}
+
+	d, err := reg.GetDAO(listCtx, t.Service, t.Resource)
+	if err != nil {
+		return nil, err
+	}
+	resources, err := dao.ListAll(listCtx, d, listPageSize, maxListPages)
+	if err != nil {
+		log.Debug("search target failed", "target", t.String(), "error", err)
+		return nil, err
+	}
+
+	if t.Profile != "" {
+		accountID := config.Global().GetAccountIDForProfile(t.Profile)
+		for i, res := range resources {
+			resources[i] = dao.WrapWithProfile(dao.UnwrapResource(res), t.Profile, accountID, t.Region)
+		}
+	}
+
+	cache.Put(t, resources)
+	return resources, nil
+}
+
+// Match reports whether res matches query (case-insensitive). Exact matches
+// on any field are preferred over substring matches; fields are checked in
+// the order ID, name, ARN, addresses.
+func Match(res dao.Resource, query string) (Hit, bool) {
+	if query == "" || res == nil {
+		return Hit{}, false
+	}
+
+	type field struct{ name, value string }
+	fields := []field{
+		{"id", res.GetID()},
+		{"name", res.GetName()},
+		{"arn", res.GetARN()},
+	}
+	for _, addr := range Addresses(dao.UnwrapResource(res).Raw()) {
+		fields = append(fields, field{"address", addr})
+	}
+
+	q := strings.ToLower(query)
+	for _, f := range fields {
+		if f.value != "" && strings.EqualFold(f.value, query) {
+			return Hit{Field: f.name, Value: f.value, Exact: true, Resource: res}, true
+		}
+	}
+	for _, f := range fields {
+		if f.value != "" && strings.Contains(strings.ToLower(f.value), q) {
+			return Hit{Field: f.name, Value: f.value, Resource: res}, true
+		}
+	}
+	return Hit{}, false
+}
+
+// SortHits orders hits with exact matches first, then by service, type and name.
+func SortHits(hits []Hit) {
+	slices.SortStableFunc(hits, func(a, b Hit) int {
+		if a.Exact != b.Exact {
+			if a.Exact {
+				return -1
+			}
+			return 1
+		}
+		if c := strings.Compare(a.Service, b.Service); c != 0 {
+			return c
+		}
+		if c := strings.Compare(a.ResourceType, b.ResourceType); c != 0 {
+			return c
+		}
+		return strings.Compare(a.Resource.GetName(), b.Resource.GetName())
+	})
+}
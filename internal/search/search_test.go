@@ -0,0 +1,303 @@
+package search
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/clawscli/claws/internal/config"
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/registry"
+)
+
+type endpoint struct {
+	Address *string
+	Port    *int32
+}
+
+type privateIP struct {
+	PrivateIpAddress *string
+}
+
+type fakeRaw struct {
+	InstanceType       string
+	PrivateIpAddress   *string
+	Endpoint           *endpoint
+	PrivateIpAddresses []privateIP
+}
+
+func strPtr(s string) *string { return &s }
+
+type fakeDAO struct {
+	dao.BaseDAO
+	resources []dao.Resource
+	err       error
+	calls     int
+}
+
+func (d *fakeDAO) List(_ context.Context) ([]dao.Resource, error) {
+	d.calls++
+	return d.resources, d.err
+}
+
+func (d *fakeDAO) Get(_ context.Context, _ string) (dao.Resource, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (d *fakeDAO) Delete(_ context.Context, _ string) error { return nil }
+
+func register(reg *registry.Registry, service, resource string, d *fakeDAO) {
+	d.BaseDAO = dao.NewBaseDAO(service, resource)
+	reg.RegisterCustom(service, resource, registry.Entry{
+		DAOFactory: func(_ context.Context) (dao.DAO, error) { return d, nil },
+	})
+}
+
+func TestAddresses(t *testing.T) {
+	raw := fakeRaw{
+		InstanceType:     "t3.micro",
+		PrivateIpAddress: strPtr("10.0.1.5"),
+		Endpoint:         &endpoint{Address: strPtr("db.example.com")},
+		PrivateIpAddresses: []privateIP{
+			{PrivateIpAddress: strPtr("10.0.1.5")},
+			{PrivateIpAddress: strPtr("10.0.1.6")},
+		},
+	}
+
+	got := Addresses(raw)
+	want := []string{"10.0.1.5", "db.example.com", "10.0.1.6"}
+	if len(got) != len(want) {
+		t.Fatalf("Addresses() = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("Addresses()[%d] = %q, want %q", i, got[i], want[i])
+		}
+	}
+
+	if Addresses(nil) != nil {
+		t.Error("Addresses(nil) should be nil")
+	}
+	if len(Addresses("plain string")) != 0 {
+		t.Error("Addresses(non-struct) should be empty")
+	}
+}
+
+func TestMatch(t *testing.T) {
+	res := &dao.BaseResource{
+		ID:   "i-0abc123",
+		Name: "web-server",
+		ARN:  "arn:aws:ec2:us-east-1:123456789012:instance/i-0abc123",
+		Data: fakeRaw{PrivateIpAddress: strPtr("10.0.1.5")},
+	}
+
+	tests := []struct {
+		query     string
+		wantOK    bool
+		wantField string
+		wantExact bool
+	}{
+		{"i-0abc123", true, "id", true},
+		{"WEB-SERVER", true, "name", true},
+		{"web", true, "name", false},
+		{"123456789012", true, "arn", false},
+		{"10.0.1.5", true, "address", true},
+		{"10.0.1", true, "address", false},
+		{"nomatch", false, "", false},
+		{"", false, "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			hit, ok := Match(res, tt.query)
+			if ok != tt.wantOK {
+				t.Fatalf("Match(%q) ok = %v, want %v", tt.query, ok, tt.wantOK)
+			}
+			if !ok {
+				return
+			}
+			if hit.Field != tt.wantField || hit.Exact != tt.wantExact {
+				t.Errorf("Match(%q) = {%s exact=%v}, want {%s exact=%v}", tt.query, hit.Field, hit.Exact, tt.wantField, tt.wantExact)
+			}
+		})
+	}
+}
+
+func TestCache(t *testing.T) {
+	c := NewCache(time.Minute)
+	now := time.Now()
+	c.now = func() time.Time { return now }
+
+	target := Target{Service: "ec2", Resource: "instances"}
+	if _, ok := c.Get(target); ok {
+		t.Fatal("empty cache should miss")
+	}
+
+	c.Put(target, []dao.Resource{&dao.BaseResource{ID: "a"}})
+	if got, ok := c.Get(target); !ok || len(got) != 1 {
+		t.Fatalf("Get() = %v, %v; want 1 resource", got, ok)
+	}
+
+	now = now.Add(2 * time.Minute)
+	if _, ok := c.Get(target); ok {
+		t.Error("expired entry should miss")
+	}
+
+	c.Put(target, nil)
+	c.Clear()
+	if _, ok := c.Get(target); ok {
+		t.Error("cleared cache should miss")
+	}
+
+	var nilCache *Cache
+	nilCache.Put(target, nil)
+	if _, ok := nilCache.Get(target); ok {
+		t.Error("nil cache should always miss")
+	}
+}
+
+func TestCache_ProfileAndRegionSwitch(t *testing.T) {
+	cfg := config.Global()
+	origRegions, origSelections := cfg.Regions(), cfg.Selections()
+	defer func() {
+		cfg.SetRegions(origRegions)
+		cfg.SetSelections(origSelections)
+	}()
+
+	c := NewCache(time.Minute)
+	target := Target{Service: "ec2", Resource: "instances"}
+
+	cfg.SetSelection(config.NamedProfile("dev"))
+	cfg.SetRegion("us-east-1")
+	c.Put(target, []dao.Resource{&dao.BaseResource{ID: "a"}})
+
+	cfg.SetRegion("eu-west-1")
+	if _, ok := c.Get(target); ok {
+		t.Error("listing should not be reused after a region switch")
+	}
+	cfg.SetRegion("us-east-1")
+	cfg.SetSelection(config.NamedProfile("prod"))
+	if _, ok := c.Get(target); ok {
+		t.Error("listing should not be reused after a profile switch")
+	}
+	cfg.SetSelection(config.NamedProfile("dev"))
+	if _, ok := c.Get(target); !ok {
+		t.Error("listing should be reused for the same profile and region")
+	}
+}
+
+func TestScope(t *testing.T) {
+	reg := registry.New()
+	register(reg, "ec2", "instances", &fakeDAO{})
+	register(reg, "ec2", "security-groups", &fakeDAO{})
+	register(reg, "lambda", "functions", &fakeDAO{})
+	register(reg, "costexplorer", "costs", &fakeDAO{})
+
+	all, err := Scope(reg, nil)
+	if err != nil {
+		t.Fatalf("Scope(nil) error = %v", err)
+	}
+	if len(all) != 3 {
+		t.Errorf("Scope(nil) = %v, want 3 pairs (excluded types skipped)", all)
+	}
+
+	scoped, err := Scope(reg, []string{"sg", "lambda", "ec2/security-groups"})
+	if err != nil {
+		t.Fatalf("Scope() error = %v", err)
+	}
+	if len(scoped) != 2 || scoped[0] != [2]string{"ec2", "security-groups"} || scoped[1] != [2]string{"lambda", "functions"} {
+		t.Errorf("Scope() = %v", scoped)
+	}
+
+	explicit, err := Scope(reg, []string{"costexplorer/costs"})
+	if err != nil || len(explicit) != 1 {
+		t.Errorf("explicit scope should include excluded types: %v, %v", explicit, err)
+	}
+
+	if _, err := Scope(reg, []string{"nope"}); err == nil {
+		t.Error("unknown service should error")
+	}
+	if _, err := Scope(reg, []string{"ec2/nope"}); err == nil {
+		t.Error("unknown resource should error")
+	}
+}
+
+func TestTargets(t *testing.T) {
+	pairs := [][2]string{{"ec2", "instances"}}
+
+	single := Targets(pairs, []config.ProfileSelection{config.SDKDefault()}, []string{"us-east-1"})
+	if len(single) != 1 || single[0].Region != "" || single[0].Profile != "" {
+		t.Errorf("single scope targets = %+v", single)
+	}
+
+	multiRegion := Targets(pairs, []config.ProfileSelection{config.SDKDefault()}, []string{"us-east-1", "eu-west-1"})
+	if len(multiRegion) != 2 || multiRegion[1].Region != "eu-west-1" || multiRegion[1].Profile != "" {
+		t.Errorf("multi-region targets = %+v", multiRegion)
+	}
+
+	multiProfile := Targets(pairs, []config.ProfileSelection{config.NamedProfile("a"), config.NamedProfile("b")}, []string{"us-east-1"})
+	if len(multiProfile) != 2 || multiProfile[0].Profile == "" || multiProfile[0].Region != "us-east-1" {
+		t.Errorf("multi-profile targets = %+v", multiProfile)
+	}
+}
+
+func TestRun(t *testing.T) {
+	reg := registry.New()
+	instances := &fakeDAO{resources: []dao.Resource{
+		&dao.BaseResource{ID: "i-1", Name: "web", Data: fakeRaw{PrivateIpAddress: strPtr("10.0.1.5")}},
+		&dao.BaseResource{ID: "i-2", Name: "db"},
+	}}
+	functions := &fakeDAO{resources: []dao.Resource{
+		&dao.BaseResource{ID: "web-handler", Name: "web-handler"},
+	}}
+	broken := &fakeDAO{err: errors.New("access denied")}
+	register(reg, "ec2", "instances", instances)
+	register(reg, "lambda", "functions", functions)
+	register(reg, "sqs", "queues", broken)
+
+	targets := []Target{
+		{Service: "ec2", Resource: "instances"},
+		{Service: "lambda", Resource: "functions"},
+		{Service: "sqs", Resource: "queues"},
+	}
+	cache := NewCache(time.Minute)
+
+	run := func(query string) ([]Hit, int) {
+		out := make(chan Batch)
+		go Run(context.Background(), reg, query, targets, cache, 2, out)
+		var hits []Hit
+		errs := 0
+		for b := range out {
+			if b.Err != nil {
+				errs++
+			}
+			hits = append(hits, b.Hits...)
+		}
+		SortHits(hits)
+		return hits, errs
+	}
+
+	hits, errs := run("web")
+	if errs != 1 {
+		t.Errorf("errors = %d, want 1", errs)
+	}
+	if len(hits) != 2 {
+		t.Fatalf("hits = %d, want 2", len(hits))
+	}
+	if hits[0].Service != "ec2" || hits[0].ResourceType != "instances" || !hits[0].Exact {
+		t.Errorf("first hit = %+v, want exact ec2/instances", hits[0])
+	}
+
+	hits, _ = run("10.0.1.5")
+	if len(hits) != 1 || hits[0].Field != "address" {
+		t.Errorf("address search = %+v", hits)
+	}
+
+	if instances.calls != 1 || functions.calls != 1 {
+		t.Errorf("cached targets listed again: instances=%d functions=%d", instances.calls, functions.calls)
+	}
+	if broken.calls != 2 {
+		t.Errorf("failed targets should not be cached: calls=%d", broken.calls)
+	}
+}
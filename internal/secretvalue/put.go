@@ -0,0 +1,92 @@
+package secretvalue
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	"github.com/aws/aws-sdk-go-v2/service/ssm/types"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	apperrors "github.com/clawscli/claws/internal/errors"
+)
+
+// LoadCurrent returns the current version of t: the AWSCURRENT version of
+// a secret, or the latest version of a parameter with its settings.
+func LoadCurrent(ctx context.Context, c Client, t Target) (Version, error) {
+	if t.Kind == KindParameter {
+		versions, err := loadParameter(ctx, c, t, true)
+		if err != nil {
+			return Version{}, err
+		}
+		if len(versions) == 0 {
+			return Version{}, fmt.Errorf("parameter %s has no versions", t.Name)
+		}
+		return versions[0], nil
+	}
+
+	output, err := c.GetSecretValue(ctx, &secretsmanager.GetSecretValueInput{SecretId: &t.ID})
+	if err != nil {
+		return Version{}, apperrors.Wrapf(err, "get value of secret %s", t.Name)
+	}
+	v := Version{ID: appaws.Str(output.VersionId), Stages: output.VersionStages, Loaded: true}
+	if output.CreatedDate != nil {
+		v.Created = *output.CreatedDate
+	}
+	if output.SecretString == nil {
+		v.Binary = true
+	} else {
+		v.Value = *output.SecretString
+	}
+	return v, nil
+}
+
+// Put stores value as a new version of t and returns the new version's
+// label. A secret's new version becomes AWSCURRENT and keeps the secret's
+// KMS key; a parameter's keeps the type, tier, KMS key, data type, allowed
+// pattern and description of current.
+func Put(ctx context.Context, c Client, t Target, current Version, value string) (string, error) {
+	if t.Kind == KindParameter {
+		return putParameter(ctx, c, t, current.Settings, value)
+	}
+	if current.Binary {
+		return "", fmt.Errorf("secret %s has a binary value, which cannot be edited here", t.Name)
+	}
+	output, err := c.PutSecretValue(ctx, &secretsmanager.PutSecretValueInput{
+		SecretId:     &t.ID,
+		SecretString: &value,
+	})
+	if err != nil {
+		return "", apperrors.Wrapf(err, "put value of secret %s", t.Name)
+	}
+	return Version{ID: appaws.Str(output.VersionId)}.Label(KindSecret), nil
+}
+
+func putParameter(ctx context.Context, c Client, t Target, s Settings, value string) (string, error) {
+	input := &ssm.PutParameterInput{
+		Name:      &t.ID,
+		Value:     &value,
+		Overwrite: appaws.BoolPtr(true),
+		Type:      types.ParameterType(s.Type),
+		Tier:      types.ParameterTier(s.Tier),
+	}
+	if s.Type == string(types.ParameterTypeSecureString) && s.KeyID != "" {
+		input.KeyId = &s.KeyID
+	}
+	if s.DataType != "" {
+		input.DataType = &s.DataType
+	}
+	if s.AllowedPattern != "" {
+		input.AllowedPattern = &s.AllowedPattern
+	}
+	if s.Description != "" {
+		input.Description = &s.Description
+	}
+	output, err := c.PutParameter(ctx, input)
+	if err != nil {
+		return "", apperrors.Wrapf(err, "put parameter %s", t.Name)
+	}
+	return "v" + strconv.FormatInt(output.Version, 10), nil
+}
@@ -0,0 +1,51 @@
+package secretvalue
+
+import (
+	"context"
+	"testing"
+
+	ssmtypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+)
+
+func TestPut_ParameterKeepsSettings(t *testing.T) {
+	c := &fakeClient{}
+	target := Target{Kind: KindParameter, ID: "/app/db", Name: "/app/db"}
+	current, err := LoadCurrent(context.Background(), c, target)
+	if err != nil {
+		t.Fatalf("LoadCurrent() error = %v", err)
+	}
+
+	label, err := Put(context.Background(), c, target, current, "c")
+	if err != nil {
+		t.Fatalf("Put() error = %v", err)
+	}
+	if label != "v3" {
+		t.Errorf("Put() = %q, want v3", label)
+	}
+	in := c.putParam
+	if in == nil || *in.Value != "c" || !*in.Overwrite {
+		t.Fatalf("PutParameter input = %+v", in)
+	}
+	if in.Type != ssmtypes.ParameterTypeSecureString || in.Tier != ssmtypes.ParameterTierAdvanced {
+		t.Errorf("type = %q, tier = %q", in.Type, in.Tier)
+	}
+	if in.KeyId == nil || *in.KeyId != "alias/app" || in.Description == nil || *in.Description != "db password" {
+		t.Errorf("KeyId = %v, Description = %v", in.KeyId, in.Description)
+	}
+}
+
+func TestPut_Secret(t *testing.T) {
+	c := &fakeClient{}
+	target := Target{Kind: KindSecret, ID: "db", Name: "db"}
+	label, err := Put(context.Background(), c, target, Version{ID: "v-new"}, `{"password":"new"}`)
+	if err != nil {
+		t.Fatalf("Put() error = %v", err)
+	}
+	if label != "01234567" || *c.putSecret.SecretString != `{"password":"new"}` {
+		t.Errorf("Put() = %q, input = %+v", label, c.putSecret)
+	}
+
+	if _, err := Put(context.Background(), c, target, Version{Binary: true}, "x"); err == nil {
+		t.Error("expected error for a binary secret")
+	}
+}
@@ -0,0 +1,354 @@
+// Package secretvalue reads Secrets Manager secret and SSM parameter values
+// for the masked value view: each version with its stages or labels, the
+// value split into key/value rows when it is a JSON object, and the rows
+// that changed between two versions.
+package secretvalue
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	ssmtypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	apperrors "github.com/clawscli/claws/internal/errors"
+)
+
+// maxSecretVersions bounds the secret versions listed; Secrets Manager
+// keeps about 100, and each needs its own GetSecretValue call once shown.
+const maxSecretVersions = 10
+
+// Mask replaces a value that has not been revealed. Its length is fixed so
+// the value's length is not shown either.
+const Mask = "••••••••"
+
+// Kind is the service a value is stored in.
+type Kind int
+
+// Value kinds
+const (
+	KindSecret Kind = iota
+	KindParameter
+)
+
+// String returns a label for the kind.
+func (k Kind) String() string {
+	if k == KindParameter {
+		return "parameter"
+	}
+	return "secret"
+}
+
+// Target identifies the secret or parameter whose value is shown.
+type Target struct {
+	Kind Kind
+	ID   string // secret ARN or name, or parameter name
+	Name string
+}
+
+// Provider is implemented by resources with a secret value.
+type Provider interface {
+	SecretValueTarget() Target
+}
+
+// Client is the subset of the Secrets Manager and SSM APIs used by this
+// package.
+type Client interface {
+	ListSecretVersionIds(ctx context.Context, params *secretsmanager.ListSecretVersionIdsInput, optFns ...func(*secretsmanager.Options)) (*secretsmanager.ListSecretVersionIdsOutput, error)
+	GetSecretValue(ctx context.Context, params *secretsmanager.GetSecretValueInput, optFns ...func(*secretsmanager.Options)) (*secretsmanager.GetSecretValueOutput, error)
+	GetParameterHistory(ctx context.Context, params *ssm.GetParameterHistoryInput, optFns ...func(*ssm.Options)) (*ssm.GetParameterHistoryOutput, error)
+	GetParameter(ctx context.Context, params *ssm.GetParameterInput, optFns ...func(*ssm.Options)) (*ssm.GetParameterOutput, error)
+	PutSecretValue(ctx context.Context, params *secretsmanager.PutSecretValueInput, optFns ...func(*secretsmanager.Options)) (*secretsmanager.PutSecretValueOutput, error)
+	PutParameter(ctx context.Context, params *ssm.PutParameterInput, optFns ...func(*ssm.Options)) (*ssm.PutParameterOutput, error)
+}
+
+type client struct {
+	sm  *secretsmanager.Client
+	ssm *ssm.Client
+}
+
+func (c client) ListSecretVersionIds(ctx context.Context, params *secretsmanager.ListSecretVersionIdsInput, optFns ...func(*secretsmanager.Options)) (*secretsmanager.ListSecretVersionIdsOutput, error) {
+	return c.sm.ListSecretVersionIds(ctx, params, optFns...)
+}
+
+func (c client) GetSecretValue(ctx context.Context, params *secretsmanager.GetSecretValueInput, optFns ...func(*secretsmanager.Options)) (*secretsmanager.GetSecretValueOutput, error) {
+	return c.sm.GetSecretValue(ctx, params, optFns...)
+}
+
+func (c client) GetParameterHistory(ctx context.Context, params *ssm.GetParameterHistoryInput, optFns ...func(*ssm.Options)) (*ssm.GetParameterHistoryOutput, error) {
+	return c.ssm.GetParameterHistory(ctx, params, optFns...)
+}
+
+func (c client) GetParameter(ctx context.Context, params *ssm.GetParameterInput, optFns ...func(*ssm.Options)) (*ssm.GetParameterOutput, error) {
+	return c.ssm.GetParameter(ctx, params, optFns...)
+}
+
+func (c client) PutSecretValue(ctx context.Context, params *secretsmanager.PutSecretValueInput, optFns ...func(*secretsmanager.Options)) (*secretsmanager.PutSecretValueOutput, error) {
+	return c.sm.PutSecretValue(ctx, params, optFns...)
+}
+
+func (c client) PutParameter(ctx context.Context, params *ssm.PutParameterInput, optFns ...func(*ssm.Options)) (*ssm.PutParameterOutput, error) {
+	return c.ssm.PutParameter(ctx, params, optFns...)
+}
+
+// NewClient creates Secrets Manager and SSM clients for the current profile
+// and region.
+func NewClient(ctx context.Context) (Client, error) {
+	cfg, err := appaws.NewConfig(ctx)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new secret value client")
+	}
+	return client{sm: secretsmanager.NewFromConfig(cfg), ssm: ssm.NewFromConfig(cfg)}, nil
+}
+
+// Version is one version of a value.
+type Version struct {
+	ID      string   // secret version ID, or parameter version number
+	Stages  []string // secret staging labels, or parameter labels
+	Created time.Time
+	Value   string
+	Binary  bool // Value is base64 of a binary secret
+	Loaded  bool // Value has been read; see LoadValue
+
+	Settings Settings // parameter attributes; empty for secrets
+}
+
+// Settings are the attributes of a parameter version that a new version
+// keeps.
+type Settings struct {
+	Type           string // String, StringList or SecureString
+	Tier           string
+	KeyID          string // KMS key of a SecureString
+	DataType       string
+	AllowedPattern string
+	Description    string
+}
+
+// Label returns a short name for the version: "v3" for a parameter, the
+// first characters of a secret version ID.
+func (v Version) Label(kind Kind) string {
+	if kind == KindParameter {
+		return "v" + v.ID
+	}
+	if len(v.ID) > 8 {
+		return v.ID[:8]
+	}
+	return v.ID
+}
+
+// Load returns the versions of t, newest first. Secret values and
+// SecureString parameter values are not read; LoadValue reads one version's
+// value when it is shown.
+func Load(ctx context.Context, c Client, t Target) ([]Version, error) {
+	if t.Kind == KindParameter {
+		return loadParameter(ctx, c, t, false)
+	}
+	return loadSecret(ctx, c, t)
+}
+
+// LoadValue returns v with its value read, decrypted if needed.
+func LoadValue(ctx context.Context, c Client, t Target, v Version) (Version, error) {
+	if v.Loaded {
+		return v, nil
+	}
+	if t.Kind == KindParameter {
+		output, err := c.GetParameter(ctx, &ssm.GetParameterInput{
+			Name:           appaws.StringPtr(t.ID + ":" + v.ID),
+			WithDecryption: appaws.BoolPtr(true),
+		})
+		if err != nil {
+			return v, apperrors.Wrapf(err, "get value of parameter %s", t.Name)
+		}
+		if output.Parameter != nil {
+			v.Value = appaws.Str(output.Parameter.Value)
+		}
+		v.Loaded = true
+		return v, nil
+	}
+
+	output, err := c.GetSecretValue(ctx, &secretsmanager.GetSecretValueInput{
+		SecretId:  &t.ID,
+		VersionId: &v.ID,
+	})
+	if err != nil {
+		return v, apperrors.Wrapf(err, "get value of secret %s", t.Name)
+	}
+	if output.SecretString != nil {
+		v.Value = *output.SecretString
+	} else {
+		v.Value = base64.StdEncoding.EncodeToString(output.SecretBinary)
+		v.Binary = true
+	}
+	v.Loaded = true
+	return v, nil
+}
+
+func loadSecret(ctx context.Context, c Client, t Target) ([]Version, error) {
+	var versions []Version
+	var token *string
+	for {
+		output, err := c.ListSecretVersionIds(ctx, &secretsmanager.ListSecretVersionIdsInput{
+			SecretId:  &t.ID,
+			NextToken: token,
+		})
+		if err != nil {
+			return nil, apperrors.Wrapf(err, "list versions of secret %s", t.Name)
+		}
+		for _, v := range output.Versions {
+			version := Version{ID: appaws.Str(v.VersionId), Stages: v.VersionStages}
+			if v.CreatedDate != nil {
+				version.Created = *v.CreatedDate
+			}
+			versions = append(versions, version)
+		}
+		if output.NextToken == nil {
+			break
+		}
+		token = output.NextToken
+	}
+
+	sort.SliceStable(versions, func(i, j int) bool { return versions[i].Created.After(versions[j].Created) })
+	if len(versions) > maxSecretVersions {
+		versions = versions[:maxSecretVersions]
+	}
+	return versions, nil
+}
+
+// loadParameter reads the parameter history. Without decrypt, SecureString
+// values are left unread.
+func loadParameter(ctx context.Context, c Client, t Target, decrypt bool) ([]Version, error) {
+	var versions []Version
+	var token *string
+	for {
+		output, err := c.GetParameterHistory(ctx, &ssm.GetParameterHistoryInput{
+			Name:           &t.ID,
+			WithDecryption: appaws.BoolPtr(decrypt),
+			NextToken:      token,
+		})
+		if err != nil {
+			return nil, apperrors.Wrapf(err, "get history of parameter %s", t.Name)
+		}
+		for _, p := range output.Parameters {
+			version := Version{
+				ID:     strconv.FormatInt(p.Version, 10),
+				Stages: p.Labels,
+				Settings: Settings{
+					Type:           string(p.Type),
+					Tier:           string(p.Tier),
+					KeyID:          appaws.Str(p.KeyId),
+					DataType:       appaws.Str(p.DataType),
+					AllowedPattern: appaws.Str(p.AllowedPattern),
+					Description:    appaws.Str(p.Description),
+				},
+			}
+			if p.LastModifiedDate != nil {
+				version.Created = *p.LastModifiedDate
+			}
+			if decrypt || p.Type != ssmtypes.ParameterTypeSecureString {
+				version.Value = appaws.Str(p.Value)
+				version.Loaded = true
+			}
+			versions = append(versions, version)
+		}
+		if output.NextToken == nil {
+			break
+		}
+		token = output.NextToken
+	}
+
+	// History is returned oldest first
+	for i, j := 0, len(versions)-1; i < j; i, j = i+1, j-1 {
+		versions[i], versions[j] = versions[j], versions[i]
+	}
+	return versions, nil
+}
+
+// Row is one key/value pair of a value. A value that is not a JSON object
+// is a single row with an empty Key.
+type Row struct {
+	Key   string
+	Value string
+}
+
+// Rows splits a JSON object value into rows sorted by key. String members
+// are shown unquoted; other members as compact JSON.
+func Rows(value string) []Row {
+	var obj map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(value), &obj); err != nil || obj == nil {
+		return []Row{{Value: value}}
+	}
+	rows := make([]Row, 0, len(obj))
+	for k, raw := range obj {
+		var s string
+		if err := json.Unmarshal(raw, &s); err != nil {
+			s = string(raw)
+		}
+		rows = append(rows, Row{Key: k, Value: s})
+	}
+	sort.Slice(rows, func(i, j int) bool { return rows[i].Key < rows[j].Key })
+	return rows
+}
+
+// ChangeKind is how a row differs between two versions.
+type ChangeKind int
+
+// Change kinds
+const (
+	Changed ChangeKind = iota
+	Added
+	Removed
+)
+
+// Change is a row that differs between two versions.
+type Change struct {
+	Key  string
+	Kind ChangeKind
+	Old  string
+	New  string
+}
+
+// Diff returns the rows that differ from old to new, sorted by key.
+func Diff(old, new string) []Change {
+	oldRows := make(map[string]string)
+	for _, r := range Rows(old) {
+		oldRows[r.Key] = r.Value
+	}
+	var changes []Change
+	seen := make(map[string]bool)
+	for _, r := range Rows(new) {
+		seen[r.Key] = true
+		prev, ok := oldRows[r.Key]
+		switch {
+		case !ok:
+			changes = append(changes, Change{Key: r.Key, Kind: Added, New: r.Value})
+		case prev != r.Value:
+			changes = append(changes, Change{Key: r.Key, Kind: Changed, Old: prev, New: r.Value})
+		}
+	}
+	for key, value := range oldRows {
+		if !seen[key] {
+			changes = append(changes, Change{Key: key, Kind: Removed, Old: value})
+		}
+	}
+	sort.SliceStable(changes, func(i, j int) bool { return changes[i].Key < changes[j].Key })
+	return changes
+}
+
+// Describe returns a one-line description of a version for the status line.
+func Describe(kind Kind, v Version) string {
+	s := v.Label(kind)
+	if len(v.Stages) > 0 {
+		s += fmt.Sprintf(" %v", v.Stages)
+	}
+	if !v.Created.IsZero() {
+		s += " • " + v.Created.Local().Format("2006-01-02 15:04")
+	}
+	return s
+}
@@ -0,0 +1,164 @@
+package secretvalue
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
+	smtypes "github.com/aws/aws-sdk-go-v2/service/secretsmanager/types"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	ssmtypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+)
+
+type fakeClient struct {
+	putSecret *secretsmanager.PutSecretValueInput
+	putParam  *ssm.PutParameterInput
+	gets      []string // versions whose value was read
+}
+
+var (
+	day1 = time.Date(2026, 9, 1, 0, 0, 0, 0, time.UTC)
+	day2 = time.Date(2026, 9, 2, 0, 0, 0, 0, time.UTC)
+)
+
+func (fakeClient) ListSecretVersionIds(_ context.Context, _ *secretsmanager.ListSecretVersionIdsInput, _ ...func(*secretsmanager.Options)) (*secretsmanager.ListSecretVersionIdsOutput, error) {
+	return &secretsmanager.ListSecretVersionIdsOutput{Versions: []smtypes.SecretVersionsListEntry{
+		{VersionId: aws.String("old-version-id"), VersionStages: []string{"AWSPREVIOUS"}, CreatedDate: &day1},
+		{VersionId: aws.String("new-version-id"), VersionStages: []string{"AWSCURRENT"}, CreatedDate: &day2},
+	}}, nil
+}
+
+func (f *fakeClient) GetSecretValue(_ context.Context, in *secretsmanager.GetSecretValueInput, _ ...func(*secretsmanager.Options)) (*secretsmanager.GetSecretValueOutput, error) {
+	f.gets = append(f.gets, aws.ToString(in.VersionId))
+	if in.VersionId != nil && *in.VersionId == "old-version-id" {
+		return &secretsmanager.GetSecretValueOutput{SecretString: aws.String(`{"user":"app","password":"one"}`)}, nil
+	}
+	return &secretsmanager.GetSecretValueOutput{SecretString: aws.String(`{"user":"app","password":"two","port":5432}`)}, nil
+}
+
+func (fakeClient) GetParameterHistory(_ context.Context, in *ssm.GetParameterHistoryInput, _ ...func(*ssm.Options)) (*ssm.GetParameterHistoryOutput, error) {
+	secure := "ciphertext"
+	if aws.ToBool(in.WithDecryption) {
+		secure = "b"
+	}
+	return &ssm.GetParameterHistoryOutput{Parameters: []ssmtypes.ParameterHistory{
+		{Version: 1, Value: aws.String("a"), LastModifiedDate: &day1},
+		{Version: 2, Value: aws.String(secure), Labels: []string{"prod"}, LastModifiedDate: &day2,
+			Type: ssmtypes.ParameterTypeSecureString, Tier: ssmtypes.ParameterTierAdvanced, KeyId: aws.String("alias/app"), Description: aws.String("db password")},
+	}}, nil
+}
+
+func (f *fakeClient) GetParameter(_ context.Context, in *ssm.GetParameterInput, _ ...func(*ssm.Options)) (*ssm.GetParameterOutput, error) {
+	f.gets = append(f.gets, aws.ToString(in.Name))
+	return &ssm.GetParameterOutput{Parameter: &ssmtypes.Parameter{Value: aws.String("b")}}, nil
+}
+
+func (f *fakeClient) PutSecretValue(_ context.Context, in *secretsmanager.PutSecretValueInput, _ ...func(*secretsmanager.Options)) (*secretsmanager.PutSecretValueOutput, error) {
+	f.putSecret = in
+	return &secretsmanager.PutSecretValueOutput{VersionId: aws.String("0123456789abcdef")}, nil
+}
+
+func (f *fakeClient) PutParameter(_ context.Context, in *ssm.PutParameterInput, _ ...func(*ssm.Options)) (*ssm.PutParameterOutput, error) {
+	f.putParam = in
+	return &ssm.PutParameterOutput{Version: 3}, nil
+}
+
+func TestLoad_Secret(t *testing.T) {
+	c := &fakeClient{}
+	target := Target{Kind: KindSecret, ID: "db", Name: "db"}
+	versions, err := Load(context.Background(), c, target)
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if len(versions) != 2 || versions[0].ID != "new-version-id" || versions[0].Stages[0] != "AWSCURRENT" {
+		t.Fatalf("Load() = %+v, want newest first", versions)
+	}
+	if versions[0].Label(KindSecret) != "new-vers" {
+		t.Errorf("Label() = %q", versions[0].Label(KindSecret))
+	}
+	if len(c.gets) != 0 || versions[0].Loaded {
+		t.Fatalf("Load() read values %v, want none", c.gets)
+	}
+
+	v, err := LoadValue(context.Background(), c, target, versions[1])
+	if err != nil {
+		t.Fatalf("LoadValue() error = %v", err)
+	}
+	if !v.Loaded || v.Value != `{"user":"app","password":"one"}` {
+		t.Errorf("LoadValue() = %+v", v)
+	}
+	if len(c.gets) != 1 || c.gets[0] != "old-version-id" {
+		t.Errorf("values read = %v, want only old-version-id", c.gets)
+	}
+}
+
+func TestLoad_Parameter(t *testing.T) {
+	c := &fakeClient{}
+	target := Target{Kind: KindParameter, ID: "/app/key", Name: "/app/key"}
+	versions, err := Load(context.Background(), c, target)
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if len(versions) != 2 || versions[0].Label(KindParameter) != "v2" {
+		t.Fatalf("Load() = %+v, want newest first", versions)
+	}
+	if versions[0].Loaded || versions[0].Value != "" {
+		t.Errorf("SecureString version = %+v, want its value unread", versions[0])
+	}
+	if !versions[1].Loaded || versions[1].Value != "a" {
+		t.Errorf("String version = %+v, want its value read", versions[1])
+	}
+
+	v, err := LoadValue(context.Background(), c, target, versions[0])
+	if err != nil {
+		t.Fatalf("LoadValue() error = %v", err)
+	}
+	if v.Value != "b" || len(c.gets) != 1 || c.gets[0] != "/app/key:2" {
+		t.Errorf("LoadValue() = %+v, reads = %v", v, c.gets)
+	}
+}
+
+func TestRows(t *testing.T) {
+	rows := Rows(`{"user":"app","port":5432,"opts":{"ssl":true}}`)
+	want := []Row{{"opts", `{"ssl":true}`}, {"port", "5432"}, {"user", "app"}}
+	if len(rows) != len(want) {
+		t.Fatalf("Rows() = %+v", rows)
+	}
+	for i := range want {
+		if rows[i] != want[i] {
+			t.Errorf("Rows()[%d] = %+v, want %+v", i, rows[i], want[i])
+		}
+	}
+
+	for _, plain := range []string{"hunter2", `["a"]`, "null"} {
+		if rows := Rows(plain); len(rows) != 1 || rows[0].Key != "" || rows[0].Value != plain {
+			t.Errorf("Rows(%q) = %+v, want a single row", plain, rows)
+		}
+	}
+}
+
+func TestDiff(t *testing.T) {
+	changes := Diff(`{"user":"app","password":"one","old":"x"}`, `{"user":"app","password":"two","port":5432}`)
+	want := []Change{
+		{Key: "old", Kind: Removed, Old: "x"},
+		{Key: "password", Kind: Changed, Old: "one", New: "two"},
+		{Key: "port", Kind: Added, New: "5432"},
+	}
+	if len(changes) != len(want) {
+		t.Fatalf("Diff() = %+v", changes)
+	}
+	for i := range want {
+		if changes[i] != want[i] {
+			t.Errorf("Diff()[%d] = %+v, want %+v", i, changes[i], want[i])
+		}
+	}
+
+	if changes := Diff("a", "b"); len(changes) != 1 || changes[0].Kind != Changed {
+		t.Errorf("Diff(plain) = %+v", changes)
+	}
+	if changes := Diff("a", "a"); len(changes) != 0 {
+		t.Errorf("Diff(same) = %+v", changes)
+	}
+}
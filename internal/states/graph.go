@@ -0,0 +1,156 @@
+package states
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+)
+
+// Definition is the part of an Amazon States Language definition needed to
+// draw the graph.
+type Definition struct {
+	StartAt string           `json:"StartAt"`
+	States  map[string]State `json:"States"`
+}
+
+// State is a single state of a definition.
+type State struct {
+	Type    string `json:"Type"`
+	Next    string `json:"Next"`
+	End     bool   `json:"End"`
+	Default string `json:"Default"`
+	Choices []struct {
+		Next string `json:"Next"`
+	} `json:"Choices"`
+	Catch []struct {
+		ErrorEquals []string `json:"ErrorEquals"`
+		Next        string   `json:"Next"`
+	} `json:"Catch"`
+	Branches      []Definition `json:"Branches"`
+	Iterator      *Definition  `json:"Iterator"`      // Map, legacy field
+	ItemProcessor *Definition  `json:"ItemProcessor"` // Map
+}
+
+// ParseDefinition parses an ASL JSON definition.
+func ParseDefinition(asl string) (Definition, error) {
+	var def Definition
+	if err := json.Unmarshal([]byte(asl), &def); err != nil {
+		return def, fmt.Errorf("parse state machine definition: %w", err)
+	}
+	if def.StartAt == "" || len(def.States) == 0 {
+		return def, fmt.Errorf("state machine definition has no states")
+	}
+	return def, nil
+}
+
+// Transition kinds
+const (
+	TransitionNext    = "next"
+	TransitionChoice  = "choice"
+	TransitionDefault = "default"
+	TransitionCatch   = "catch"
+)
+
+// Transition is an edge from a state.
+type Transition struct {
+	Kind   string
+	Target string
+	Taken  bool // the execution followed this edge
+}
+
+// GraphLine is a state, or the start of a Parallel branch or Map iteration
+// (with an empty Type), at an indentation depth.
+type GraphLine struct {
+	Depth       int
+	Name        string
+	Type        string
+	Status      string // status of the state's last step, "" when not visited
+	Visits      int
+	End         bool
+	Transitions []Transition
+}
+
+// Branch reports whether the line starts a Parallel branch or Map iteration.
+func (l GraphLine) Branch() bool {
+	return l.Type == ""
+}
+
+// Graph lays out the definition from StartAt, following Next, then Choice,
+// Default and Catch edges depth first, so the usual path reads top to
+// bottom. Branches of Parallel and Map states are indented below them, and
+// states that cannot be reached come last. The path the steps took is
+// marked on states and transitions.
+func Graph(def Definition, steps []Step) []GraphLine {
+	visits := map[string]int{}
+	status := map[string]string{}
+	for _, s := range steps {
+		visits[s.Name]++
+		status[s.Name] = s.Status
+	}
+	taken := transitions(steps)
+
+	var lines []GraphLine
+	var layout func(def Definition, depth int)
+	layout = func(def Definition, depth int) {
+		seen := map[string]bool{}
+		var visit func(name string)
+		visit = func(name string) {
+			st, ok := def.States[name]
+			if !ok || seen[name] {
+				return
+			}
+			seen[name] = true
+
+			line := GraphLine{
+				Depth:  depth,
+				Name:   name,
+				Type:   st.Type,
+				Status: status[name],
+				Visits: visits[name],
+				End:    st.End || st.Type == "Succeed" || st.Type == "Fail",
+			}
+			edge := func(kind, target string) {
+				if target != "" {
+					line.Transitions = append(line.Transitions, Transition{Kind: kind, Target: target, Taken: taken[[2]string{name, target}]})
+				}
+			}
+			edge(TransitionNext, st.Next)
+			for _, c := range st.Choices {
+				edge(TransitionChoice, c.Next)
+			}
+			edge(TransitionDefault, st.Default)
+			for _, c := range st.Catch {
+				edge(TransitionCatch, c.Next)
+			}
+			lines = append(lines, line)
+
+			for i, b := range st.Branches {
+				lines = append(lines, GraphLine{Depth: depth + 1, Name: fmt.Sprintf("branch %d", i+1)})
+				layout(b, depth+2)
+			}
+			if proc := st.ItemProcessor; proc != nil || st.Iterator != nil {
+				if proc == nil {
+					proc = st.Iterator
+				}
+				lines = append(lines, GraphLine{Depth: depth + 1, Name: "each item"})
+				layout(*proc, depth+2)
+			}
+
+			for _, t := range line.Transitions {
+				visit(t.Target)
+			}
+		}
+
+		visit(def.StartAt)
+		names := make([]string, 0, len(def.States))
+		for name := range def.States {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			visit(name)
+		}
+	}
+	layout(def, 0)
+	return lines
+}
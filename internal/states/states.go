@@ -0,0 +1,368 @@
+// Package states reads Step Functions execution history for the execution
+// view: the step timeline built from GetExecutionHistory events, with each
+// state's input, output and error, and the state machine definition the
+// execution ran with, for drawing the path taken.
+package states
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/sfn"
+	"github.com/aws/aws-sdk-go-v2/service/sfn/types"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	apperrors "github.com/clawscli/claws/internal/errors"
+)
+
+// maxEvents bounds the history read for very long executions.
+const maxEvents = 10000
+
+// Step statuses
+const (
+	StatusSucceeded = "SUCCEEDED"
+	StatusFailed    = "FAILED"
+	StatusRunning   = "RUNNING"
+	StatusAborted   = "ABORTED"
+)
+
+// Target identifies the execution to show.
+type Target struct {
+	ExecutionARN string
+	Name         string
+}
+
+// Provider is implemented by resources with an execution history.
+type Provider interface {
+	ExecutionTarget() Target
+}
+
+// Machine identifies a state machine to start executions of.
+type Machine struct {
+	ARN  string
+	Name string
+}
+
+// MachineProvider is implemented by resources that executions can be
+// started for.
+type MachineProvider interface {
+	MachineTarget() Machine
+}
+
+// Client is the subset of the Step Functions API used by this package.
+type Client interface {
+	DescribeExecution(ctx context.Context, params *sfn.DescribeExecutionInput, optFns ...func(*sfn.Options)) (*sfn.DescribeExecutionOutput, error)
+	GetExecutionHistory(ctx context.Context, params *sfn.GetExecutionHistoryInput, optFns ...func(*sfn.Options)) (*sfn.GetExecutionHistoryOutput, error)
+	DescribeStateMachineForExecution(ctx context.Context, params *sfn.DescribeStateMachineForExecutionInput, optFns ...func(*sfn.Options)) (*sfn.DescribeStateMachineForExecutionOutput, error)
+	StartExecution(ctx context.Context, params *sfn.StartExecutionInput, optFns ...func(*sfn.Options)) (*sfn.StartExecutionOutput, error)
+}
+
+// NewClient creates a Step Functions client for the current profile and
+// region.
+func NewClient(ctx context.Context) (Client, error) {
+	cfg, err := appaws.NewConfig(ctx)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new sfn client")
+	}
+	return sfn.NewFromConfig(cfg), nil
+}
+
+// Execution is the summary of an execution.
+type Execution struct {
+	ARN          string
+	Name         string
+	StateMachine string
+	Status       string
+	Start        time.Time
+	Stop         time.Time // zero while running
+	Input        string
+	Output       string
+	Error        string
+	Cause        string
+	RedriveCount int32
+}
+
+// Running reports whether the execution has not finished.
+func (e Execution) Running() bool {
+	return e.Status == string(types.ExecutionStatusRunning)
+}
+
+// Duration returns how long the execution ran, or has been running.
+func (e Execution) Duration() time.Duration {
+	if e.Start.IsZero() {
+		return 0
+	}
+	if e.Stop.IsZero() {
+		return time.Since(e.Start)
+	}
+	return e.Stop.Sub(e.Start)
+}
+
+// Step is a single state entered during the execution.
+type Step struct {
+	Name    string
+	Type    string // Task, Choice, Pass, Parallel, Map, Wait, Succeed or Fail
+	Entered time.Time
+	Exited  time.Time // zero while the state runs
+	Input   string
+	Output  string
+	Error   string
+	Cause   string
+	Status  string
+
+	enteredID int64
+	exitedID  int64
+}
+
+// Failed reports whether the state reported an error, whether or not a
+// Catch handled it.
+func (s Step) Failed() bool {
+	return s.Error != ""
+}
+
+// Duration returns how long the state ran, measured to now while running.
+func (s Step) Duration() time.Duration {
+	if s.Exited.IsZero() {
+		if s.Status == StatusRunning {
+			return time.Since(s.Entered)
+		}
+		return 0
+	}
+	return s.Exited.Sub(s.Entered)
+}
+
+// History is an execution with its steps and definition.
+type History struct {
+	Execution  Execution
+	Steps      []Step
+	Definition string // ASL JSON the execution ran with
+	Truncated  bool   // the history had more than maxEvents events
+}
+
+// FailedStep returns the index of the state the execution failed in, or -1.
+func (h History) FailedStep() int {
+	if h.Execution.Running() || h.Execution.Status == string(types.ExecutionStatusSucceeded) {
+		return -1
+	}
+	for i := len(h.Steps) - 1; i >= 0; i-- {
+		if h.Steps[i].Status == StatusFailed {
+			return i
+		}
+	}
+	return -1
+}
+
+// Load reads the execution, its full event history and its definition.
+func Load(ctx context.Context, client Client, t Target) (History, error) {
+	var h History
+
+	desc, err := client.DescribeExecution(ctx, &sfn.DescribeExecutionInput{ExecutionArn: &t.ExecutionARN})
+	if err != nil {
+		return h, apperrors.Wrapf(err, "describe execution %s", t.Name)
+	}
+	h.Execution = Execution{
+		ARN:          appaws.Str(desc.ExecutionArn),
+		Name:         appaws.Str(desc.Name),
+		StateMachine: appaws.Str(desc.StateMachineArn),
+		Status:       string(desc.Status),
+		Input:        appaws.Str(desc.Input),
+		Output:       appaws.Str(desc.Output),
+		Error:        appaws.Str(desc.Error),
+		Cause:        appaws.Str(desc.Cause),
+		RedriveCount: appaws.Int32(desc.RedriveCount),
+	}
+	if desc.StartDate != nil {
+		h.Execution.Start = *desc.StartDate
+	}
+	if desc.StopDate != nil {
+		h.Execution.Stop = *desc.StopDate
+	}
+
+	var events []types.HistoryEvent
+	paginator := sfn.NewGetExecutionHistoryPaginator(client, &sfn.GetExecutionHistoryInput{
+		ExecutionArn:         &t.ExecutionARN,
+		MaxResults:           1000,
+		IncludeExecutionData: appaws.BoolPtr(true),
+	})
+	for paginator.HasMorePages() {
+		if len(events) >= maxEvents {
+			h.Truncated = true
+			break
+		}
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return h, apperrors.Wrapf(err, "get execution history %s", t.Name)
+		}
+		events = append(events, output.Events...)
+	}
+	h.Steps = BuildSteps(events)
+
+	sm, err := client.DescribeStateMachineForExecution(ctx, &sfn.DescribeStateMachineForExecutionInput{ExecutionArn: &t.ExecutionARN})
+	if err != nil {
+		return h, apperrors.Wrapf(err, "describe state machine for %s", t.Name)
+	}
+	h.Definition = appaws.Str(sm.Definition)
+	return h, nil
+}
+
+// BuildSteps turns history events, oldest first, into steps ordered by
+// when they were entered. Errors from task, Lambda, activity and Map run
+// failures are attached to the state that was running; states still open
+// when the execution ends are marked failed or aborted.
+func BuildSteps(events []types.HistoryEvent) []Step {
+	var steps []Step
+	open := map[string]int{} // state name → index of its open step
+
+	lastOpen := func() int {
+		latest := -1
+		for _, i := range open {
+			if latest < 0 || steps[i].enteredID > steps[latest].enteredID {
+				latest = i
+			}
+		}
+		return latest
+	}
+
+	for _, e := range events {
+		ts := time.Time{}
+		if e.Timestamp != nil {
+			ts = *e.Timestamp
+		}
+		typ := string(e.Type)
+
+		switch {
+		case e.StateEnteredEventDetails != nil:
+			d := e.StateEnteredEventDetails
+			steps = append(steps, Step{
+				Name:      appaws.Str(d.Name),
+				Type:      strings.TrimSuffix(typ, "StateEntered"),
+				Entered:   ts,
+				Input:     appaws.Str(d.Input),
+				Status:    StatusRunning,
+				enteredID: e.Id,
+			})
+			open[appaws.Str(d.Name)] = len(steps) - 1
+			continue
+
+		case e.StateExitedEventDetails != nil:
+			d := e.StateExitedEventDetails
+			if i, ok := open[appaws.Str(d.Name)]; ok {
+				steps[i].Exited = ts
+				steps[i].Output = appaws.Str(d.Output)
+				steps[i].exitedID = e.Id
+				if steps[i].Status == StatusRunning {
+					steps[i].Status = StatusSucceeded
+				}
+				delete(open, appaws.Str(d.Name))
+			}
+			continue
+		}
+
+		if errName, cause, ok := failure(e); ok {
+			i := lastOpen()
+			if i >= 0 && steps[i].Error == "" {
+				steps[i].Error, steps[i].Cause = errName, cause
+				steps[i].Status = StatusFailed
+			}
+		}
+
+		switch e.Type {
+		case types.HistoryEventTypeExecutionFailed, types.HistoryEventTypeExecutionTimedOut, types.HistoryEventTypeExecutionAborted:
+			status := StatusFailed
+			if e.Type == types.HistoryEventTypeExecutionAborted {
+				status = StatusAborted
+			}
+			for name, i := range open {
+				steps[i].Exited = ts
+				steps[i].exitedID = e.Id
+				if steps[i].Status == StatusRunning {
+					steps[i].Status = status
+				}
+				delete(open, name)
+			}
+		}
+	}
+	return steps
+}
+
+// failure returns the error and cause of a failure event.
+func failure(e types.HistoryEvent) (string, string, bool) {
+	switch {
+	case e.TaskFailedEventDetails != nil:
+		return appaws.Str(e.TaskFailedEventDetails.Error), appaws.Str(e.TaskFailedEventDetails.Cause), true
+	case e.TaskTimedOutEventDetails != nil:
+		return appaws.Str(e.TaskTimedOutEventDetails.Error), appaws.Str(e.TaskTimedOutEventDetails.Cause), true
+	case e.TaskStartFailedEventDetails != nil:
+		return appaws.Str(e.TaskStartFailedEventDetails.Error), appaws.Str(e.TaskStartFailedEventDetails.Cause), true
+	case e.TaskSubmitFailedEventDetails != nil:
+		return appaws.Str(e.TaskSubmitFailedEventDetails.Error), appaws.Str(e.TaskSubmitFailedEventDetails.Cause), true
+	case e.LambdaFunctionFailedEventDetails != nil:
+		return appaws.Str(e.LambdaFunctionFailedEventDetails.Error), appaws.Str(e.LambdaFunctionFailedEventDetails.Cause), true
+	case e.LambdaFunctionTimedOutEventDetails != nil:
+		return appaws.Str(e.LambdaFunctionTimedOutEventDetails.Error), appaws.Str(e.LambdaFunctionTimedOutEventDetails.Cause), true
+	case e.LambdaFunctionStartFailedEventDetails != nil:
+		return appaws.Str(e.LambdaFunctionStartFailedEventDetails.Error), appaws.Str(e.LambdaFunctionStartFailedEventDetails.Cause), true
+	case e.LambdaFunctionScheduleFailedEventDetails != nil:
+		return appaws.Str(e.LambdaFunctionScheduleFailedEventDetails.Error), appaws.Str(e.LambdaFunctionScheduleFailedEventDetails.Cause), true
+	case e.ActivityFailedEventDetails != nil:
+		return appaws.Str(e.ActivityFailedEventDetails.Error), appaws.Str(e.ActivityFailedEventDetails.Cause), true
+	case e.ActivityTimedOutEventDetails != nil:
+		return appaws.Str(e.ActivityTimedOutEventDetails.Error), appaws.Str(e.ActivityTimedOutEventDetails.Cause), true
+	case e.ActivityScheduleFailedEventDetails != nil:
+		return appaws.Str(e.ActivityScheduleFailedEventDetails.Error), appaws.Str(e.ActivityScheduleFailedEventDetails.Cause), true
+	case e.MapRunFailedEventDetails != nil:
+		return appaws.Str(e.MapRunFailedEventDetails.Error), appaws.Str(e.MapRunFailedEventDetails.Cause), true
+	case e.EvaluationFailedEventDetails != nil:
+		return appaws.Str(e.EvaluationFailedEventDetails.Error), appaws.Str(e.EvaluationFailedEventDetails.Cause), true
+	case e.ExecutionFailedEventDetails != nil:
+		return appaws.Str(e.ExecutionFailedEventDetails.Error), appaws.Str(e.ExecutionFailedEventDetails.Cause), true
+	case e.ExecutionTimedOutEventDetails != nil:
+		return appaws.Str(e.ExecutionTimedOutEventDetails.Error), appaws.Str(e.ExecutionTimedOutEventDetails.Cause), true
+	}
+	return "", "", false
+}
+
+// transitions returns the transitions taken: each step to the first step
+// entered after it exited.
+func transitions(steps []Step) map[[2]string]bool {
+	taken := map[[2]string]bool{}
+	for _, s := range steps {
+		if s.exitedID == 0 {
+			continue
+		}
+		j := sort.Search(len(steps), func(j int) bool { return steps[j].enteredID > s.exitedID })
+		if j < len(steps) {
+			taken[[2]string{s.Name, steps[j].Name}] = true
+		}
+	}
+	return taken
+}
+
+// Start starts an execution of m with the JSON input, "{}" when empty. An
+// empty name lets Step Functions generate one.
+func Start(ctx context.Context, client Client, m Machine, name, input string) (Target, error) {
+	input = strings.TrimSpace(input)
+	if input == "" {
+		input = "{}"
+	}
+	if !json.Valid([]byte(input)) {
+		return Target{}, fmt.Errorf("input is not valid JSON")
+	}
+	params := &sfn.StartExecutionInput{
+		StateMachineArn: &m.ARN,
+		Input:           &input,
+	}
+	if name = strings.TrimSpace(name); name != "" {
+		params.Name = &name
+	}
+	output, err := client.StartExecution(ctx, params)
+	if err != nil {
+		return Target{}, apperrors.Wrapf(err, "start execution of %s", m.Name)
+	}
+	arn := appaws.Str(output.ExecutionArn)
+	return Target{ExecutionARN: arn, Name: arn[strings.LastIndex(arn, ":")+1:]}, nil
+}
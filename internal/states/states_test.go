@@ -0,0 +1,173 @@
+package states
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sfn"
+	"github.com/aws/aws-sdk-go-v2/service/sfn/types"
+)
+
+var t0 = time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+
+func at(sec int) *time.Time {
+	t := t0.Add(time.Duration(sec) * time.Second)
+	return &t
+}
+
+func entered(id int64, sec int, typ types.HistoryEventType, name, input string) types.HistoryEvent {
+	return types.HistoryEvent{Id: id, Timestamp: at(sec), Type: typ,
+		StateEnteredEventDetails: &types.StateEnteredEventDetails{Name: aws.String(name), Input: aws.String(input)}}
+}
+
+func exited(id int64, sec int, typ types.HistoryEventType, name, output string) types.HistoryEvent {
+	return types.HistoryEvent{Id: id, Timestamp: at(sec), Type: typ,
+		StateExitedEventDetails: &types.StateExitedEventDetails{Name: aws.String(name), Output: aws.String(output)}}
+}
+
+// testEvents is a run of Validate → Route (choice) → Charge, where Charge
+// fails and the execution fails with it.
+var testEvents = []types.HistoryEvent{
+	{Id: 1, Timestamp: at(0), Type: types.HistoryEventTypeExecutionStarted},
+	entered(2, 0, types.HistoryEventTypeTaskStateEntered, "Validate", `{"id":1}`),
+	exited(3, 2, types.HistoryEventTypeTaskStateExited, "Validate", `{"id":1,"ok":true}`),
+	entered(4, 2, types.HistoryEventTypeChoiceStateEntered, "Route", `{"id":1,"ok":true}`),
+	exited(5, 2, types.HistoryEventTypeChoiceStateExited, "Route", `{"id":1,"ok":true}`),
+	entered(6, 2, types.HistoryEventTypeTaskStateEntered, "Charge", `{"id":1,"ok":true}`),
+	{Id: 7, Timestamp: at(5), Type: types.HistoryEventTypeTaskFailed,
+		TaskFailedEventDetails: &types.TaskFailedEventDetails{Error: aws.String("PaymentDeclined"), Cause: aws.String("card expired")}},
+	{Id: 8, Timestamp: at(5), Type: types.HistoryEventTypeExecutionFailed,
+		ExecutionFailedEventDetails: &types.ExecutionFailedEventDetails{Error: aws.String("PaymentDeclined"), Cause: aws.String("card expired")}},
+}
+
+const testDefinition = `{
+  "StartAt": "Validate",
+  "States": {
+    "Validate": {"Type": "Task", "Resource": "arn:aws:lambda:x", "Next": "Route"},
+    "Route": {"Type": "Choice", "Choices": [{"Variable": "$.ok", "BooleanEquals": true, "Next": "Charge"}], "Default": "Reject"},
+    "Charge": {"Type": "Task", "Resource": "arn:aws:lambda:y", "Catch": [{"ErrorEquals": ["Retryable"], "Next": "Reject"}], "End": true},
+    "Reject": {"Type": "Fail", "Error": "Rejected"},
+    "Fanout": {"Type": "Parallel", "Branches": [{"StartAt": "A", "States": {"A": {"Type": "Pass", "End": true}}}], "End": true}
+  }
+}`
+
+func TestBuildSteps(t *testing.T) {
+	steps := BuildSteps(testEvents)
+	if len(steps) != 3 {
+		t.Fatalf("steps = %+v", steps)
+	}
+	validate, route, charge := steps[0], steps[1], steps[2]
+	if validate.Type != "Task" || validate.Status != StatusSucceeded || validate.Duration() != 2*time.Second {
+		t.Errorf("Validate = %+v", validate)
+	}
+	if validate.Output != `{"id":1,"ok":true}` {
+		t.Errorf("Validate output = %q", validate.Output)
+	}
+	if route.Type != "Choice" || route.Status != StatusSucceeded {
+		t.Errorf("Route = %+v", route)
+	}
+	if charge.Status != StatusFailed || charge.Error != "PaymentDeclined" || charge.Cause != "card expired" {
+		t.Errorf("Charge = %+v", charge)
+	}
+	if charge.Duration() != 3*time.Second {
+		t.Errorf("Charge duration = %v, want 3s (closed by the execution failure)", charge.Duration())
+	}
+
+	h := History{Execution: Execution{Status: "FAILED"}, Steps: steps}
+	if got := h.FailedStep(); got != 2 {
+		t.Errorf("FailedStep() = %d, want 2", got)
+	}
+}
+
+func TestGraph(t *testing.T) {
+	def, err := ParseDefinition(testDefinition)
+	if err != nil {
+		t.Fatalf("ParseDefinition() error = %v", err)
+	}
+	lines := Graph(def, BuildSteps(testEvents))
+
+	var names []string
+	for _, l := range lines {
+		names = append(names, l.Name)
+	}
+	want := []string{"Validate", "Route", "Charge", "Reject", "Fanout", "branch 1", "A"}
+	if len(names) != len(want) {
+		t.Fatalf("Graph() names = %v, want %v", names, want)
+	}
+	for i := range want {
+		if names[i] != want[i] {
+			t.Fatalf("Graph() names = %v, want %v", names, want)
+		}
+	}
+
+	route := lines[1]
+	if route.Status != StatusSucceeded || len(route.Transitions) != 2 {
+		t.Fatalf("Route line = %+v", route)
+	}
+	if !route.Transitions[0].Taken || route.Transitions[0].Target != "Charge" || route.Transitions[1].Taken {
+		t.Errorf("Route transitions = %+v, want only Charge taken", route.Transitions)
+	}
+	if lines[2].Status != StatusFailed || lines[3].Visits != 0 || !lines[3].End {
+		t.Errorf("Charge = %+v, Reject = %+v", lines[2], lines[3])
+	}
+	if !lines[5].Branch() || lines[6].Depth != 2 {
+		t.Errorf("branch lines = %+v, %+v", lines[5], lines[6])
+	}
+}
+
+type fakeClient struct{}
+
+func (fakeClient) DescribeExecution(_ context.Context, in *sfn.DescribeExecutionInput, _ ...func(*sfn.Options)) (*sfn.DescribeExecutionOutput, error) {
+	return &sfn.DescribeExecutionOutput{
+		ExecutionArn: in.ExecutionArn,
+		Name:         aws.String("run-1"),
+		Status:       types.ExecutionStatusFailed,
+		StartDate:    at(0),
+		StopDate:     at(5),
+		Error:        aws.String("PaymentDeclined"),
+	}, nil
+}
+
+func (fakeClient) GetExecutionHistory(_ context.Context, in *sfn.GetExecutionHistoryInput, _ ...func(*sfn.Options)) (*sfn.GetExecutionHistoryOutput, error) {
+	if in.NextToken == nil {
+		return &sfn.GetExecutionHistoryOutput{Events: testEvents[:4], NextToken: aws.String("p2")}, nil
+	}
+	return &sfn.GetExecutionHistoryOutput{Events: testEvents[4:]}, nil
+}
+
+func (fakeClient) DescribeStateMachineForExecution(_ context.Context, _ *sfn.DescribeStateMachineForExecutionInput, _ ...func(*sfn.Options)) (*sfn.DescribeStateMachineForExecutionOutput, error) {
+	return &sfn.DescribeStateMachineForExecutionOutput{Definition: aws.String(testDefinition)}, nil
+}
+
+func (fakeClient) StartExecution(_ context.Context, in *sfn.StartExecutionInput, _ ...func(*sfn.Options)) (*sfn.StartExecutionOutput, error) {
+	return &sfn.StartExecutionOutput{ExecutionArn: aws.String("arn:aws:states:us-east-1:1:execution:sm:" + aws.ToString(in.Name))}, nil
+}
+
+func TestStart(t *testing.T) {
+	m := Machine{ARN: "arn:aws:states:us-east-1:1:stateMachine:sm", Name: "sm"}
+	target, err := Start(context.Background(), fakeClient{}, m, " run-2 ", "")
+	if err != nil {
+		t.Fatalf("Start() error = %v", err)
+	}
+	if target.Name != "run-2" || target.ExecutionARN != "arn:aws:states:us-east-1:1:execution:sm:run-2" {
+		t.Errorf("Start() = %+v", target)
+	}
+	if _, err := Start(context.Background(), fakeClient{}, m, "", "{"); err == nil {
+		t.Error("Start() with invalid JSON should fail")
+	}
+}
+
+func TestLoad(t *testing.T) {
+	h, err := Load(context.Background(), fakeClient{}, Target{ExecutionARN: "arn:aws:states:us-east-1:1:execution:sm:run-1", Name: "run-1"})
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if h.Execution.Name != "run-1" || h.Execution.Duration() != 5*time.Second || h.Execution.Running() {
+		t.Errorf("Execution = %+v", h.Execution)
+	}
+	if len(h.Steps) != 3 || h.Definition != testDefinition {
+		t.Errorf("Load() read %d steps across pages, definition %d bytes", len(h.Steps), len(h.Definition))
+	}
+}
@@ -0,0 +1,270 @@
+// Package taskdef compares ECS task definition revisions for the revision
+// diff view: each revision is flattened into field paths such as
+// containerDefinitions[web].image, and the paths that were added, removed
+// or changed between two revisions are reported, with sensitive
+// environment values masked.
+package taskdef
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/aws/aws-sdk-go-v2/service/ecs"
+	"github.com/aws/aws-sdk-go-v2/service/ecs/types"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	apperrors "github.com/clawscli/claws/internal/errors"
+)
+
+// Target identifies a task definition revision.
+type Target struct {
+	Family   string
+	Revision int32
+}
+
+// String returns the "family:revision" form accepted by the ECS API.
+func (t Target) String() string {
+	return fmt.Sprintf("%s:%d", t.Family, t.Revision)
+}
+
+// ParseTarget parses a task definition ARN or "family:revision".
+func ParseTarget(s string) (Target, bool) {
+	if i := strings.LastIndex(s, "task-definition/"); i >= 0 {
+		s = s[i+len("task-definition/"):]
+	}
+	family, rev, ok := strings.Cut(s, ":")
+	if !ok || family == "" {
+		return Target{}, false
+	}
+	n, err := strconv.ParseInt(rev, 10, 32)
+	if err != nil || n < 1 {
+		return Target{}, false
+	}
+	return Target{Family: family, Revision: int32(n)}, true
+}
+
+// Provider is implemented by resources that are a task definition revision.
+type Provider interface {
+	TaskDefTarget() Target
+}
+
+// Client is the subset of the ECS API used by this package.
+type Client interface {
+	DescribeTaskDefinition(ctx context.Context, params *ecs.DescribeTaskDefinitionInput, optFns ...func(*ecs.Options)) (*ecs.DescribeTaskDefinitionOutput, error)
+}
+
+// NewClient creates an ECS client for the current profile and region.
+func NewClient(ctx context.Context) (Client, error) {
+	cfg, err := appaws.NewConfig(ctx)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new ecs client")
+	}
+	return ecs.NewFromConfig(cfg), nil
+}
+
+// Load describes the revision t.
+func Load(ctx context.Context, client Client, t Target) (*types.TaskDefinition, error) {
+	out, err := client.DescribeTaskDefinition(ctx, &ecs.DescribeTaskDefinitionInput{
+		TaskDefinition: appaws.StringPtr(t.String()),
+	})
+	if err != nil {
+		return nil, apperrors.Wrapf(err, "describe task definition %s", t)
+	}
+	return out.TaskDefinition, nil
+}
+
+// maxPreviousLookback bounds how many deleted revisions LoadPrevious skips.
+const maxPreviousLookback = 10
+
+// LoadPrevious describes the newest revision of t's family before t.
+// Revisions that were deleted are skipped.
+func LoadPrevious(ctx context.Context, client Client, t Target) (*types.TaskDefinition, error) {
+	if t.Revision <= 1 {
+		return nil, fmt.Errorf("%s is the first revision", t)
+	}
+	var lastErr error
+	for rev := t.Revision - 1; rev >= 1 && rev >= t.Revision-maxPreviousLookback; rev-- {
+		td, err := Load(ctx, client, Target{Family: t.Family, Revision: rev})
+		if err == nil {
+			return td, nil
+		}
+		lastErr = err
+	}
+	return nil, lastErr
+}
+
+// Mask replaces the characters of a sensitive value.
+const Mask = "••••••••"
+
+// sensitiveWords mark an environment variable name as holding a secret.
+var sensitiveWords = []string{"PASSWORD", "PASSWD", "SECRET", "TOKEN", "API_KEY", "APIKEY", "PRIVATE_KEY", "ACCESS_KEY", "CREDENTIAL", "AUTH"}
+
+// IsSensitive returns whether an environment variable name suggests a secret.
+func IsSensitive(name string) bool {
+	upper := strings.ToUpper(name)
+	for _, word := range sensitiveWords {
+		if strings.Contains(upper, word) {
+			return true
+		}
+	}
+	return false
+}
+
+// MaskEnv returns value, masked when name is sensitive.
+func MaskEnv(name, value string) string {
+	if value != "" && IsSensitive(name) {
+		return Mask
+	}
+	return value
+}
+
+// ChangeKind is how a field differs between two revisions.
+type ChangeKind string
+
+// Change kinds
+const (
+	Added   ChangeKind = "added"
+	Removed ChangeKind = "removed"
+	Changed ChangeKind = "changed"
+)
+
+// Change is a field that differs between two revisions.
+type Change struct {
+	Path string
+	Kind ChangeKind
+	Old  string
+	New  string
+}
+
+// ignoredFields are set by ECS on every revision and always differ.
+var ignoredFields = map[string]bool{
+	"taskDefinitionArn":  true,
+	"revision":           true,
+	"status":             true,
+	"registeredAt":       true,
+	"registeredBy":       true,
+	"deregisteredAt":     true,
+	"deleteRequestedAt":  true,
+	"requiresAttributes": true,
+	"compatibilities":    true,
+}
+
+// Diff returns the fields that differ from old to new, ordered by path.
+// Values of sensitive environment variables are masked.
+func Diff(old, new *types.TaskDefinition) ([]Change, error) {
+	before, err := Flatten(old)
+	if err != nil {
+		return nil, err
+	}
+	after, err := Flatten(new)
+	if err != nil {
+		return nil, err
+	}
+
+	var changes []Change
+	for path, o := range before {
+		n, ok := after[path]
+		switch {
+		case !ok:
+			changes = append(changes, Change{Path: path, Kind: Removed, Old: maskPath(path, o)})
+		case n != o:
+			changes = append(changes, Change{Path: path, Kind: Changed, Old: maskPath(path, o), New: maskPath(path, n)})
+		}
+	}
+	for path, n := range after {
+		if _, ok := before[path]; !ok {
+			changes = append(changes, Change{Path: path, Kind: Added, New: maskPath(path, n)})
+		}
+	}
+	sort.Slice(changes, func(i, j int) bool { return changes[i].Path < changes[j].Path })
+	return changes, nil
+}
+
+// maskPath masks value when path is a sensitive environment variable,
+// e.g. containerDefinitions[web].environment[DB_PASSWORD].value.
+func maskPath(path, value string) string {
+	const env = ".environment["
+	i := strings.LastIndex(path, env)
+	if i < 0 {
+		return value
+	}
+	name, _, _ := strings.Cut(path[i+len(env):], "]")
+	return MaskEnv(name, value)
+}
+
+// Flatten returns the fields of td keyed by path. Lists of objects with a
+// name, such as containers and environment variables, are keyed by that
+// name so reordering them is not a change; lists of scalars are joined.
+func Flatten(td *types.TaskDefinition) (map[string]string, error) {
+	fields := make(map[string]string)
+	if td == nil {
+		return fields, nil
+	}
+	data, err := json.Marshal(td)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "encode task definition")
+	}
+	var doc map[string]any
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return nil, apperrors.Wrap(err, "decode task definition")
+	}
+	for key, value := range doc {
+		key = lowerFirst(key)
+		if ignoredFields[key] {
+			continue
+		}
+		flatten(fields, key, value)
+	}
+	return fields, nil
+}
+
+func flatten(fields map[string]string, path string, value any) {
+	switch v := value.(type) {
+	case nil:
+	case map[string]any:
+		for key, child := range v {
+			flatten(fields, path+"."+lowerFirst(key), child)
+		}
+	case []any:
+		if len(v) == 0 {
+			return
+		}
+		if _, ok := v[0].(map[string]any); !ok {
+			parts := make([]string, len(v))
+			for i, item := range v {
+				parts[i] = fmt.Sprint(item)
+			}
+			fields[path] = strings.Join(parts, " ")
+			return
+		}
+		for i, item := range v {
+			key := strconv.Itoa(i)
+			if obj, ok := item.(map[string]any); ok {
+				if name, ok := obj["Name"].(string); ok && name != "" {
+					// the name is in the path already
+					key = name
+					delete(obj, "Name")
+				}
+			}
+			flatten(fields, path+"["+key+"]", item)
+		}
+	case float64:
+		fields[path] = strconv.FormatFloat(v, 'f', -1, 64)
+	default:
+		fields[path] = fmt.Sprint(v)
+	}
+}
+
+// lowerFirst turns the SDK's Go field names into the API's JSON names,
+// e.g. ContainerDefinitions into containerDefinitions.
+func lowerFirst(s string) string {
+	for i, r := range s {
+		return string(unicode.ToLower(r)) + s[i+len(string(r)):]
+	}
+	return s
+}
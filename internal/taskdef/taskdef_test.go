@@ -0,0 +1,117 @@
+package taskdef
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ecs"
+	"github.com/aws/aws-sdk-go-v2/service/ecs/types"
+)
+
+func TestParseTarget(t *testing.T) {
+	tests := []struct {
+		in   string
+		want Target
+		ok   bool
+	}{
+		{"arn:aws:ecs:us-east-1:123456789012:task-definition/web:12", Target{"web", 12}, true},
+		{"web:3", Target{"web", 3}, true},
+		{"web", Target{}, false},
+		{"web:latest", Target{}, false},
+	}
+	for _, tt := range tests {
+		got, ok := ParseTarget(tt.in)
+		if got != tt.want || ok != tt.ok {
+			t.Errorf("ParseTarget(%q) = %v, %v, want %v, %v", tt.in, got, ok, tt.want, tt.ok)
+		}
+	}
+}
+
+func webRevision(rev int32, image string, env ...types.KeyValuePair) *types.TaskDefinition {
+	return &types.TaskDefinition{
+		Family:            aws.String("web"),
+		Revision:          rev,
+		TaskDefinitionArn: aws.String("arn:aws:ecs:us-east-1:123456789012:task-definition/web"),
+		Cpu:               aws.String("256"),
+		Memory:            aws.String("512"),
+		ContainerDefinitions: []types.ContainerDefinition{{
+			Name:        aws.String("app"),
+			Image:       aws.String(image),
+			Environment: env,
+		}},
+	}
+}
+
+func TestDiff(t *testing.T) {
+	old := webRevision(1, "web:1.0",
+		types.KeyValuePair{Name: aws.String("LOG_LEVEL"), Value: aws.String("info")},
+		types.KeyValuePair{Name: aws.String("DB_PASSWORD"), Value: aws.String("hunter2")},
+		types.KeyValuePair{Name: aws.String("REGION"), Value: aws.String("us-east-1")},
+	)
+	new := webRevision(2, "web:1.1",
+		types.KeyValuePair{Name: aws.String("DB_PASSWORD"), Value: aws.String("correct-horse")},
+		types.KeyValuePair{Name: aws.String("LOG_LEVEL"), Value: aws.String("debug")},
+		types.KeyValuePair{Name: aws.String("FEATURE_X"), Value: aws.String("on")},
+	)
+	new.Memory = aws.String("1024")
+
+	changes, err := Diff(old, new)
+	if err != nil {
+		t.Fatalf("Diff() error = %v", err)
+	}
+	want := []Change{
+		{Path: "containerDefinitions[app].environment[DB_PASSWORD].value", Kind: Changed, Old: Mask, New: Mask},
+		{Path: "containerDefinitions[app].environment[FEATURE_X].value", Kind: Added, New: "on"},
+		{Path: "containerDefinitions[app].environment[LOG_LEVEL].value", Kind: Changed, Old: "info", New: "debug"},
+		{Path: "containerDefinitions[app].environment[REGION].value", Kind: Removed, Old: "us-east-1"},
+		{Path: "containerDefinitions[app].image", Kind: Changed, Old: "web:1.0", New: "web:1.1"},
+		{Path: "memory", Kind: Changed, Old: "512", New: "1024"},
+	}
+	if len(changes) != len(want) {
+		t.Fatalf("Diff() = %+v, want %+v", changes, want)
+	}
+	for i := range want {
+		if changes[i] != want[i] {
+			t.Errorf("change %d = %+v, want %+v", i, changes[i], want[i])
+		}
+	}
+
+	if same, _ := Diff(old, old); len(same) != 0 {
+		t.Errorf("Diff() of a revision with itself = %+v", same)
+	}
+}
+
+func TestMaskEnv(t *testing.T) {
+	if MaskEnv("api_token", "abc") != Mask || MaskEnv("LOG_LEVEL", "info") != "info" || MaskEnv("SECRET", "") != "" {
+		t.Error("MaskEnv() should mask only non-empty values of sensitive names")
+	}
+}
+
+type fakeClient struct {
+	revisions map[string]*types.TaskDefinition
+}
+
+func (f *fakeClient) DescribeTaskDefinition(_ context.Context, in *ecs.DescribeTaskDefinitionInput, _ ...func(*ecs.Options)) (*ecs.DescribeTaskDefinitionOutput, error) {
+	td, ok := f.revisions[*in.TaskDefinition]
+	if !ok {
+		return nil, errors.New("ClientException: unable to describe task definition")
+	}
+	return &ecs.DescribeTaskDefinitionOutput{TaskDefinition: td}, nil
+}
+
+func TestLoadPrevious(t *testing.T) {
+	client := &fakeClient{revisions: map[string]*types.TaskDefinition{
+		"web:2": webRevision(2, "web:1.0"),
+		"web:5": webRevision(5, "web:1.1"),
+	}}
+
+	td, err := LoadPrevious(context.Background(), client, Target{"web", 5})
+	if err != nil || td.Revision != 2 {
+		t.Fatalf("LoadPrevious() = %v, %v; want revision 2, skipping deleted 3 and 4", td, err)
+	}
+	if _, err := LoadPrevious(context.Background(), client, Target{"web", 1}); err == nil {
+		t.Error("LoadPrevious() of the first revision should fail")
+	}
+}
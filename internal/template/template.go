@@ -0,0 +1,183 @@
+// Package template reads a CloudFormation stack's template for the
+// template view: the body as deployed, or after transforms such as SAM are
+// processed, and the stack's parameters with their current and declared
+// values.
+package template
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	apperrors "github.com/clawscli/claws/internal/errors"
+)
+
+// Template stages
+const (
+	StageOriginal  = string(types.TemplateStageOriginal)
+	StageProcessed = string(types.TemplateStageProcessed)
+)
+
+// Target identifies the stack whose template is shown.
+type Target struct {
+	StackName string
+	StackID   string
+}
+
+// stack returns the identifier passed to the API. The ID also resolves
+// deleted stacks.
+func (t Target) stack() *string {
+	if t.StackID != "" {
+		return &t.StackID
+	}
+	return &t.StackName
+}
+
+// Provider is implemented by resources with a template.
+type Provider interface {
+	TemplateTarget() Target
+}
+
+// Client is the subset of the CloudFormation API used by this package.
+type Client interface {
+	GetTemplate(ctx context.Context, params *cloudformation.GetTemplateInput, optFns ...func(*cloudformation.Options)) (*cloudformation.GetTemplateOutput, error)
+	GetTemplateSummary(ctx context.Context, params *cloudformation.GetTemplateSummaryInput, optFns ...func(*cloudformation.Options)) (*cloudformation.GetTemplateSummaryOutput, error)
+	DescribeStacks(ctx context.Context, params *cloudformation.DescribeStacksInput, optFns ...func(*cloudformation.Options)) (*cloudformation.DescribeStacksOutput, error)
+}
+
+// NewClient creates a CloudFormation client for the current profile and
+// region.
+func NewClient(ctx context.Context) (Client, error) {
+	cfg, err := appaws.NewConfig(ctx)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new cloudformation client")
+	}
+	return cloudformation.NewFromConfig(cfg), nil
+}
+
+// Parameter is a stack parameter with its declaration in the template.
+type Parameter struct {
+	Key           string
+	Value         string
+	ResolvedValue string // value of an SSM parameter type
+	Default       string
+	Type          string
+	Description   string
+	NoEcho        bool
+}
+
+// Template is a stack template and its parameters.
+type Template struct {
+	Body       string
+	Stage      string
+	Stages     []string // stages available; Processed only with transforms
+	Parameters []Parameter
+}
+
+// IsJSON returns whether the body is JSON rather than YAML.
+func (t *Template) IsJSON() bool {
+	return strings.HasPrefix(strings.TrimSpace(t.Body), "{")
+}
+
+// Pretty returns the body with JSON indented; YAML is returned as is.
+func (t *Template) Pretty() string {
+	if !t.IsJSON() {
+		return t.Body
+	}
+	var buf bytes.Buffer
+	if err := json.Indent(&buf, []byte(t.Body), "", "  "); err != nil {
+		return t.Body
+	}
+	return buf.String()
+}
+
+// HasStage returns whether stage is available for the stack.
+func (t *Template) HasStage(stage string) bool {
+	for _, s := range t.Stages {
+		if s == stage {
+			return true
+		}
+	}
+	return false
+}
+
+// Load reads the template of t at stage, which defaults to Original, and
+// the stack's parameters.
+func Load(ctx context.Context, client Client, t Target, stage string) (*Template, error) {
+	if stage == "" {
+		stage = StageOriginal
+	}
+
+	out, err := client.GetTemplate(ctx, &cloudformation.GetTemplateInput{
+		StackName:     t.stack(),
+		TemplateStage: types.TemplateStage(stage),
+	})
+	if err != nil {
+		return nil, apperrors.Wrapf(err, "get template %s", t.StackName)
+	}
+	tmpl := &Template{
+		Body:  appaws.Str(out.TemplateBody),
+		Stage: stage,
+	}
+	for _, s := range out.StagesAvailable {
+		tmpl.Stages = append(tmpl.Stages, string(s))
+	}
+
+	stacks, err := client.DescribeStacks(ctx, &cloudformation.DescribeStacksInput{StackName: t.stack()})
+	if err != nil {
+		return nil, apperrors.Wrapf(err, "describe stack %s", t.StackName)
+	}
+	summary, err := client.GetTemplateSummary(ctx, &cloudformation.GetTemplateSummaryInput{StackName: t.stack()})
+	if err != nil {
+		return nil, apperrors.Wrapf(err, "get template summary %s", t.StackName)
+	}
+
+	var values []types.Parameter
+	if len(stacks.Stacks) > 0 {
+		values = stacks.Stacks[0].Parameters
+	}
+	tmpl.Parameters = parameters(values, summary.Parameters)
+	return tmpl, nil
+}
+
+// parameters joins the stack's parameter values with their declarations,
+// sorted by key.
+func parameters(values []types.Parameter, decls []types.ParameterDeclaration) []Parameter {
+	byKey := make(map[string]*Parameter)
+	var params []*Parameter
+	get := func(key string) *Parameter {
+		if p, ok := byKey[key]; ok {
+			return p
+		}
+		p := &Parameter{Key: key}
+		byKey[key] = p
+		params = append(params, p)
+		return p
+	}
+
+	for _, d := range decls {
+		p := get(appaws.Str(d.ParameterKey))
+		p.Default = appaws.Str(d.DefaultValue)
+		p.Type = appaws.Str(d.ParameterType)
+		p.Description = appaws.Str(d.Description)
+		p.NoEcho = appaws.Bool(d.NoEcho)
+	}
+	for _, v := range values {
+		p := get(appaws.Str(v.ParameterKey))
+		p.Value = appaws.Str(v.ParameterValue)
+		p.ResolvedValue = appaws.Str(v.ResolvedValue)
+	}
+
+	sort.Slice(params, func(i, j int) bool { return params[i].Key < params[j].Key })
+	result := make([]Parameter, len(params))
+	for i, p := range params {
+		result[i] = *p
+	}
+	return result
+}
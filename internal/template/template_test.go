@@ -0,0 +1,84 @@
+package template
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+)
+
+type fakeClient struct {
+	stages []types.TemplateStage
+}
+
+func (f *fakeClient) GetTemplate(_ context.Context, in *cloudformation.GetTemplateInput, _ ...func(*cloudformation.Options)) (*cloudformation.GetTemplateOutput, error) {
+	f.stages = append(f.stages, in.TemplateStage)
+	body := `{"Resources":{"Bucket":{"Type":"AWS::S3::Bucket"}}}`
+	if in.TemplateStage == types.TemplateStageOriginal {
+		body = "Transform: AWS::Serverless-2016-10-31\nResources: {}\n"
+	}
+	return &cloudformation.GetTemplateOutput{
+		TemplateBody:    aws.String(body),
+		StagesAvailable: []types.TemplateStage{types.TemplateStageOriginal, types.TemplateStageProcessed},
+	}, nil
+}
+
+func (f *fakeClient) GetTemplateSummary(_ context.Context, _ *cloudformation.GetTemplateSummaryInput, _ ...func(*cloudformation.Options)) (*cloudformation.GetTemplateSummaryOutput, error) {
+	return &cloudformation.GetTemplateSummaryOutput{Parameters: []types.ParameterDeclaration{
+		{ParameterKey: aws.String("Stage"), DefaultValue: aws.String("dev"), ParameterType: aws.String("String")},
+		{ParameterKey: aws.String("DbPassword"), NoEcho: aws.Bool(true), ParameterType: aws.String("String")},
+		{ParameterKey: aws.String("Ami"), ParameterType: aws.String("AWS::SSM::Parameter::Value<AWS::EC2::Image::Id>")},
+	}}, nil
+}
+
+func (f *fakeClient) DescribeStacks(_ context.Context, _ *cloudformation.DescribeStacksInput, _ ...func(*cloudformation.Options)) (*cloudformation.DescribeStacksOutput, error) {
+	return &cloudformation.DescribeStacksOutput{Stacks: []types.Stack{{Parameters: []types.Parameter{
+		{ParameterKey: aws.String("Stage"), ParameterValue: aws.String("prod")},
+		{ParameterKey: aws.String("DbPassword"), ParameterValue: aws.String("****")},
+		{ParameterKey: aws.String("Ami"), ParameterValue: aws.String("/aws/ami/latest"), ResolvedValue: aws.String("ami-123")},
+	}}}}, nil
+}
+
+func TestLoad(t *testing.T) {
+	client := &fakeClient{}
+	target := Target{StackName: "app", StackID: "arn:aws:cloudformation:us-east-1:1:stack/app/x"}
+
+	tmpl, err := Load(context.Background(), client, target, "")
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	if tmpl.Stage != StageOriginal || tmpl.IsJSON() || !tmpl.HasStage(StageProcessed) {
+		t.Errorf("template = %+v", tmpl)
+	}
+	if tmpl.Pretty() != tmpl.Body {
+		t.Error("YAML should be shown as is")
+	}
+
+	want := []Parameter{
+		{Key: "Ami", Value: "/aws/ami/latest", ResolvedValue: "ami-123", Type: "AWS::SSM::Parameter::Value<AWS::EC2::Image::Id>"},
+		{Key: "DbPassword", Value: "****", Type: "String", NoEcho: true},
+		{Key: "Stage", Value: "prod", Default: "dev", Type: "String"},
+	}
+	if len(tmpl.Parameters) != len(want) {
+		t.Fatalf("Parameters = %+v", tmpl.Parameters)
+	}
+	for i := range want {
+		if tmpl.Parameters[i] != want[i] {
+			t.Errorf("Parameters[%d] = %+v, want %+v", i, tmpl.Parameters[i], want[i])
+		}
+	}
+
+	tmpl, err = Load(context.Background(), client, target, StageProcessed)
+	if err != nil {
+		t.Fatalf("Load(Processed) error = %v", err)
+	}
+	if !tmpl.IsJSON() || !strings.Contains(tmpl.Pretty(), "\n    \"Bucket\"") {
+		t.Errorf("processed template should be indented JSON:\n%s", tmpl.Pretty())
+	}
+	if len(client.stages) != 2 || client.stages[1] != types.TemplateStageProcessed {
+		t.Errorf("stages requested = %v", client.stages)
+	}
+}
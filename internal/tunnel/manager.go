@@ -0,0 +1,175 @@
+package tunnel
+
+import (
+	"bytes"
+	"fmt"
+	"net"
+	"os/exec"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/clawscli/claws/internal/action"
+	"github.com/clawscli/claws/internal/log"
+)
+
+// outputLimit bounds the session output kept per tunnel.
+const outputLimit = 8 << 10
+
+// Tunnel is a port forwarding session started from claws.
+type Tunnel struct {
+	ID        int
+	Endpoint  Endpoint
+	Name      string // resource name shown in the tunnels view
+	Bastion   Bastion
+	LocalPort int
+	Started   time.Time
+
+	cmd     *exec.Cmd
+	mu      sync.Mutex
+	output  bytes.Buffer
+	done    bool
+	stopped bool // ended by Stop, so its exit status is not an error
+	err     error
+}
+
+// LocalAddress returns the forwarded address on this machine.
+func (t *Tunnel) LocalAddress() string {
+	return net.JoinHostPort("localhost", strconv.Itoa(t.LocalPort))
+}
+
+// Running reports whether the session is still running.
+func (t *Tunnel) Running() bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return !t.done
+}
+
+// Err returns why the session ended, or nil while running or after a
+// clean exit.
+func (t *Tunnel) Err() error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.err
+}
+
+// Output returns the last lines the session printed.
+func (t *Tunnel) Output() string {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return strings.TrimSpace(t.output.String())
+}
+
+// Write keeps the session's output, dropping the oldest half when it grows
+// past outputLimit.
+func (t *Tunnel) Write(p []byte) (int, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.output.Write(p)
+	if t.output.Len() > outputLimit {
+		kept := t.output.Bytes()[t.output.Len()-outputLimit/2:]
+		t.output = *bytes.NewBuffer(bytes.Clone(kept))
+	}
+	return len(p), nil
+}
+
+// Manager tracks the tunnels started in this process.
+type Manager struct {
+	mu      sync.Mutex
+	nextID  int
+	tunnels []*Tunnel
+}
+
+// Global is the manager used by the views.
+var Global = &Manager{}
+
+// Start runs ex in the background as t's session. The local port must be
+// free.
+func (m *Manager) Start(t *Tunnel, ex *action.ExecWithHeader) error {
+	if err := m.checkPort(t.LocalPort); err != nil {
+		return err
+	}
+	ex.SetStdout(t)
+	ex.SetStderr(t)
+	cmd, err := ex.Start()
+	if err != nil {
+		return err
+	}
+
+	m.mu.Lock()
+	m.nextID++
+	t.ID = m.nextID
+	t.cmd = cmd
+	t.Started = time.Now()
+	m.tunnels = append(m.tunnels, t)
+	m.mu.Unlock()
+
+	log.Info("tunnel started", "id", t.ID, "endpoint", t.Endpoint.Address(), "bastion", t.Bastion.InstanceID, "localPort", t.LocalPort)
+	go func() {
+		err := cmd.Wait()
+		t.mu.Lock()
+		t.done = true
+		if err != nil && !t.stopped {
+			t.err = err
+		}
+		t.mu.Unlock()
+		log.Info("tunnel ended", "id", t.ID, "error", err)
+	}()
+	return nil
+}
+
+// checkPort fails when a running tunnel or another program uses port.
+func (m *Manager) checkPort(port int) error {
+	for _, t := range m.List() {
+		if t.LocalPort == port && t.Running() {
+			return fmt.Errorf("local port %d is used by tunnel %d", port, t.ID)
+		}
+	}
+	l, err := net.Listen("tcp", net.JoinHostPort("localhost", strconv.Itoa(port)))
+	if err != nil {
+		return fmt.Errorf("local port %d is in use", port)
+	}
+	return l.Close()
+}
+
+// List returns the tunnels, oldest first.
+func (m *Manager) List() []*Tunnel {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]*Tunnel(nil), m.tunnels...)
+}
+
+// Stop ends the tunnel's session.
+func (m *Manager) Stop(t *Tunnel) error {
+	if !t.Running() {
+		return nil
+	}
+	log.Info("stopping tunnel", "id", t.ID)
+	t.mu.Lock()
+	t.stopped = true
+	t.mu.Unlock()
+	return action.StopExec(t.cmd)
+}
+
+// Remove forgets an ended tunnel.
+func (m *Manager) Remove(t *Tunnel) {
+	if t.Running() {
+		return
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for i, other := range m.tunnels {
+		if other == t {
+			m.tunnels = append(m.tunnels[:i], m.tunnels[i+1:]...)
+			return
+		}
+	}
+}
+
+// StopAll ends every running tunnel, when claws exits.
+func (m *Manager) StopAll() {
+	for _, t := range m.List() {
+		_ = m.Stop(t)
+	}
+}
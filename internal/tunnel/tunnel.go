@@ -0,0 +1,199 @@
+// Package tunnel forwards local ports to private endpoints such as RDS
+// instances through an SSM-managed bastion instance in the same VPC, and
+// tracks the running port forwarding sessions for the tunnels view.
+package tunnel
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	ssmtypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+
+	appaws "github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/dao"
+	apperrors "github.com/clawscli/claws/internal/errors"
+	"github.com/clawscli/claws/internal/registry"
+)
+
+// Document is the SSM document for forwarding to a remote host.
+const Document = "AWS-StartPortForwardingSessionToRemoteHost"
+
+// Endpoint is a private endpoint a port can be forwarded to.
+type Endpoint struct {
+	Service        string
+	ResourceType   string
+	ID             string
+	Host           string
+	Port           int32
+	VpcID          string   // empty when only the security groups are known
+	SecurityGroups []string // used to find the VPC when VpcID is empty
+}
+
+// Address returns host:port.
+func (e Endpoint) Address() string {
+	return net.JoinHostPort(e.Host, strconv.Itoa(int(e.Port)))
+}
+
+// Provider is implemented by resources a port can be forwarded to.
+type Provider interface {
+	TunnelEndpoint() Endpoint
+}
+
+// Bastion is a running instance with an online SSM agent that sessions go
+// through.
+type Bastion struct {
+	InstanceID string
+	Name       string
+	VpcID      string
+}
+
+// Label returns the bastion's name and ID.
+func (b Bastion) Label() string {
+	if b.Name == "" {
+		return b.InstanceID
+	}
+	return b.Name + " (" + b.InstanceID + ")"
+}
+
+// Client is the subset of the SSM API used by this package.
+type Client interface {
+	DescribeInstanceInformation(ctx context.Context, params *ssm.DescribeInstanceInformationInput, optFns ...func(*ssm.Options)) (*ssm.DescribeInstanceInformationOutput, error)
+}
+
+// NewClient creates an SSM client for the current profile and region.
+func NewClient(ctx context.Context) (Client, error) {
+	cfg, err := appaws.NewConfig(ctx)
+	if err != nil {
+		return nil, apperrors.Wrap(err, "new ssm client")
+	}
+	return ssm.NewFromConfig(cfg), nil
+}
+
+// ResolveVPC returns the endpoint's VPC, looking it up from its first
+// security group when the endpoint does not report one. Empty when
+// neither is known.
+func ResolveVPC(ctx context.Context, reg *registry.Registry, e Endpoint) (string, error) {
+	if e.VpcID != "" || len(e.SecurityGroups) == 0 {
+		return e.VpcID, nil
+	}
+	d, err := reg.GetDAO(ctx, "ec2", "security-groups")
+	if err != nil {
+		return "", err
+	}
+	r, err := d.Get(ctx, e.SecurityGroups[0])
+	if err != nil {
+		return "", err
+	}
+	if sg, ok := dao.UnwrapResource(r).Raw().(types.SecurityGroup); ok {
+		return appaws.Str(sg.VpcId), nil
+	}
+	return "", nil
+}
+
+// FindBastions returns the running instances in vpcID (any VPC when empty)
+// whose SSM agent is online, sorted by name.
+func FindBastions(ctx context.Context, reg *registry.Registry, c Client, vpcID string) ([]Bastion, error) {
+	d, err := reg.GetDAO(ctx, "ec2", "instances")
+	if err != nil {
+		return nil, err
+	}
+	resources, err := d.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var candidates []Bastion
+	for _, r := range resources {
+		inst, ok := dao.UnwrapResource(r).Raw().(types.Instance)
+		if !ok || inst.State == nil || inst.State.Name != types.InstanceStateNameRunning {
+			continue
+		}
+		if vpcID != "" && appaws.Str(inst.VpcId) != vpcID {
+			continue
+		}
+		candidates = append(candidates, Bastion{InstanceID: r.GetID(), Name: r.GetName(), VpcID: appaws.Str(inst.VpcId)})
+	}
+
+	online, err := onlineInstances(ctx, c, candidates)
+	if err != nil {
+		return nil, err
+	}
+	bastions := slices.DeleteFunc(candidates, func(b Bastion) bool { return !online[b.InstanceID] })
+	slices.SortFunc(bastions, func(a, b Bastion) int {
+		if c := strings.Compare(a.Name, b.Name); c != 0 {
+			return c
+		}
+		return strings.Compare(a.InstanceID, b.InstanceID)
+	})
+	return bastions, nil
+}
+
+// instanceInformationBatch is the most instance IDs one
+// DescribeInstanceInformation filter accepts.
+const instanceInformationBatch = 50
+
+// onlineInstances returns which of the bastions' SSM agents are online.
+func onlineInstances(ctx context.Context, c Client, bastions []Bastion) (map[string]bool, error) {
+	online := make(map[string]bool)
+	for batch := range slices.Chunk(bastions, instanceInformationBatch) {
+		ids := make([]string, len(batch))
+		for i, b := range batch {
+			ids[i] = b.InstanceID
+		}
+		var token *string
+		for {
+			output, err := c.DescribeInstanceInformation(ctx, &ssm.DescribeInstanceInformationInput{
+				Filters:   []ssmtypes.InstanceInformationStringFilter{{Key: appaws.StringPtr("InstanceIds"), Values: ids}},
+				NextToken: token,
+			})
+			if err != nil {
+				return nil, apperrors.Wrap(err, "describe instance information")
+			}
+			for _, info := range output.InstanceInformationList {
+				if info.PingStatus == ssmtypes.PingStatusOnline {
+					online[appaws.Str(info.InstanceId)] = true
+				}
+			}
+			if output.NextToken == nil {
+				break
+			}
+			token = output.NextToken
+		}
+	}
+	return online, nil
+}
+
+// DefaultLocalPort returns the local port suggested for e: its own port,
+// moved above 1024 when it is privileged.
+func DefaultLocalPort(e Endpoint) int {
+	if e.Port < 1024 {
+		return int(e.Port) + 10000
+	}
+	return int(e.Port)
+}
+
+// ParsePort parses a local port typed by the user.
+func ParsePort(s string) (int, error) {
+	port, err := strconv.Atoi(strings.TrimSpace(s))
+	if err != nil || port < 1 || port > 65535 {
+		return 0, fmt.Errorf("local port must be a number between 1 and 65535")
+	}
+	return port, nil
+}
+
+// Command returns the AWS CLI command that forwards localPort through b to
+// e.
+func Command(b Bastion, e Endpoint, localPort int) string {
+	params, _ := json.Marshal(map[string][]string{
+		"host":            {e.Host},
+		"portNumber":      {strconv.Itoa(int(e.Port))},
+		"localPortNumber": {strconv.Itoa(localPort)},
+	})
+	return fmt.Sprintf("aws ssm start-session --target %s --document-name %s --parameters '%s'", b.InstanceID, Document, params)
+}
@@ -0,0 +1,228 @@
+package tunnel
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	ssmtypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+
+	"github.com/clawscli/claws/internal/action"
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/registry"
+)
+
+type fakeClient struct {
+	online []string
+}
+
+func (f *fakeClient) DescribeInstanceInformation(_ context.Context, in *ssm.DescribeInstanceInformationInput, _ ...func(*ssm.Options)) (*ssm.DescribeInstanceInformationOutput, error) {
+	out := &ssm.DescribeInstanceInformationOutput{}
+	for _, id := range in.Filters[0].Values {
+		status := ssmtypes.PingStatusConnectionLost
+		for _, online := range f.online {
+			if id == online {
+				status = ssmtypes.PingStatusOnline
+			}
+		}
+		out.InstanceInformationList = append(out.InstanceInformationList, ssmtypes.InstanceInformation{InstanceId: aws.String(id), PingStatus: status})
+	}
+	return out, nil
+}
+
+// fakeDAO serves fixed resources for the ec2 DAOs the package looks up.
+type fakeDAO struct {
+	dao.BaseDAO
+	resources []dao.Resource
+}
+
+func (d *fakeDAO) List(context.Context) ([]dao.Resource, error) { return d.resources, nil }
+
+func (d *fakeDAO) Get(_ context.Context, id string) (dao.Resource, error) {
+	for _, r := range d.resources {
+		if r.GetID() == id {
+			return r, nil
+		}
+	}
+	return nil, nil
+}
+
+func (d *fakeDAO) Delete(context.Context, string) error { return nil }
+
+func instance(id, name, vpc string, state types.InstanceStateName) dao.Resource {
+	return &dao.BaseResource{ID: id, Name: name, Data: types.Instance{
+		InstanceId: aws.String(id),
+		VpcId:      aws.String(vpc),
+		State:      &types.InstanceState{Name: state},
+	}}
+}
+
+func testRegistry() *registry.Registry {
+	reg := registry.New()
+	instances := &fakeDAO{BaseDAO: dao.NewBaseDAO("ec2", "instances"), resources: []dao.Resource{
+		instance("i-3", "web", "vpc-1", types.InstanceStateNameRunning),
+		instance("i-1", "bastion", "vpc-1", types.InstanceStateNameRunning),
+		instance("i-2", "stopped", "vpc-1", types.InstanceStateNameStopped),
+		instance("i-4", "other", "vpc-2", types.InstanceStateNameRunning),
+		instance("i-5", "no-agent", "vpc-1", types.InstanceStateNameRunning),
+	}}
+	groups := &fakeDAO{BaseDAO: dao.NewBaseDAO("ec2", "security-groups"), resources: []dao.Resource{
+		&dao.BaseResource{ID: "sg-1", Data: types.SecurityGroup{GroupId: aws.String("sg-1"), VpcId: aws.String("vpc-1")}},
+	}}
+	reg.RegisterCustom("ec2", "instances", registry.Entry{DAOFactory: func(context.Context) (dao.DAO, error) { return instances, nil }})
+	reg.RegisterCustom("ec2", "security-groups", registry.Entry{DAOFactory: func(context.Context) (dao.DAO, error) { return groups, nil }})
+	return reg
+}
+
+func TestFindBastions(t *testing.T) {
+	client := &fakeClient{online: []string{"i-1", "i-2", "i-3", "i-4"}}
+	bastions, err := FindBastions(context.Background(), testRegistry(), client, "vpc-1")
+	if err != nil {
+		t.Fatalf("FindBastions() error = %v", err)
+	}
+	if len(bastions) != 2 || bastions[0].InstanceID != "i-1" || bastions[1].InstanceID != "i-3" {
+		t.Errorf("FindBastions() = %+v, want running online instances in vpc-1 sorted by name", bastions)
+	}
+
+	all, err := FindBastions(context.Background(), testRegistry(), client, "")
+	if err != nil {
+		t.Fatalf("FindBastions() error = %v", err)
+	}
+	if len(all) != 3 {
+		t.Errorf("FindBastions() without a VPC = %+v, want instances in any VPC", all)
+	}
+}
+
+func TestResolveVPC(t *testing.T) {
+	ctx := context.Background()
+	reg := testRegistry()
+	if vpc, _ := ResolveVPC(ctx, reg, Endpoint{VpcID: "vpc-9", SecurityGroups: []string{"sg-1"}}); vpc != "vpc-9" {
+		t.Errorf("ResolveVPC() = %q, want the endpoint's own VPC", vpc)
+	}
+	if vpc, _ := ResolveVPC(ctx, reg, Endpoint{SecurityGroups: []string{"sg-1"}}); vpc != "vpc-1" {
+		t.Errorf("ResolveVPC() = %q, want the security group's VPC", vpc)
+	}
+	if vpc, _ := ResolveVPC(ctx, reg, Endpoint{}); vpc != "" {
+		t.Errorf("ResolveVPC() = %q, want empty", vpc)
+	}
+}
+
+func TestCommand(t *testing.T) {
+	e := Endpoint{Host: "db.example.rds.amazonaws.com", Port: 5432}
+	cmd := Command(Bastion{InstanceID: "i-1"}, e, 15432)
+	if !strings.HasPrefix(cmd, "aws ssm start-session --target i-1 --document-name "+Document+" --parameters '") {
+		t.Fatalf("Command() = %q", cmd)
+	}
+	var params map[string][]string
+	raw := cmd[strings.Index(cmd, "'")+1 : len(cmd)-1]
+	if err := json.Unmarshal([]byte(raw), &params); err != nil {
+		t.Fatalf("parameters %q: %v", raw, err)
+	}
+	if params["host"][0] != e.Host || params["portNumber"][0] != "5432" || params["localPortNumber"][0] != "15432" {
+		t.Errorf("parameters = %v", params)
+	}
+}
+
+func TestDefaultLocalPort(t *testing.T) {
+	if got := DefaultLocalPort(Endpoint{Port: 3306}); got != 3306 {
+		t.Errorf("DefaultLocalPort(3306) = %d", got)
+	}
+	if got := DefaultLocalPort(Endpoint{Port: 443}); got != 10443 {
+		t.Errorf("DefaultLocalPort(443) = %d, want 10443", got)
+	}
+}
+
+func TestParsePort(t *testing.T) {
+	if port, err := ParsePort(" 8080 "); err != nil || port != 8080 {
+		t.Errorf("ParsePort() = %d, %v", port, err)
+	}
+	for _, bad := range []string{"", "0", "65536", "http"} {
+		if _, err := ParsePort(bad); err == nil {
+			t.Errorf("ParsePort(%q) should fail", bad)
+		}
+	}
+}
+
+func freePort(t *testing.T) int {
+	t.Helper()
+	l, err := net.Listen("tcp", "localhost:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+	return l.Addr().(*net.TCPAddr).Port
+}
+
+func waitFor(t *testing.T, cond func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatal("timed out")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestManager(t *testing.T) {
+	m := &Manager{}
+	port := freePort(t)
+	tun := &Tunnel{LocalPort: port}
+	ex := &action.ExecWithHeader{Command: "echo ready; sleep 30", ActionName: "Port Forward", SkipAWSEnv: true}
+	if err := m.Start(tun, ex); err != nil {
+		t.Fatalf("Start() error = %v", err)
+	}
+	if tun.ID != 1 || !tun.Running() || len(m.List()) != 1 {
+		t.Fatalf("tunnel = %+v, want tunnel 1 running", tun)
+	}
+
+	again := &Tunnel{LocalPort: port}
+	if err := m.Start(again, &action.ExecWithHeader{Command: "true", SkipAWSEnv: true}); err == nil {
+		t.Error("Start() should refuse a local port used by a running tunnel")
+	}
+
+	m.Remove(tun)
+	if len(m.List()) != 1 {
+		t.Error("Remove() should keep a running tunnel")
+	}
+	waitFor(t, func() bool { return strings.Contains(tun.Output(), "ready") })
+	if err := m.Stop(tun); err != nil {
+		t.Fatalf("Stop() error = %v", err)
+	}
+	waitFor(t, func() bool { return !tun.Running() })
+	if tun.Err() != nil {
+		t.Errorf("Err() = %v, want nil for a stopped tunnel", tun.Err())
+	}
+	m.Remove(tun)
+	if len(m.List()) != 0 {
+		t.Error("Remove() should forget an ended tunnel")
+	}
+}
+
+func TestManager_PortInUse(t *testing.T) {
+	l, err := net.Listen("tcp", "localhost:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+	tun := &Tunnel{LocalPort: l.Addr().(*net.TCPAddr).Port}
+	if err := (&Manager{}).Start(tun, &action.ExecWithHeader{Command: "true", SkipAWSEnv: true}); err == nil {
+		t.Error("Start() should refuse a local port another program listens on")
+	}
+}
+
+func TestTunnel_OutputLimit(t *testing.T) {
+	tun := &Tunnel{}
+	_, _ = tun.Write([]byte(strings.Repeat("x", outputLimit)))
+	_, _ = tun.Write([]byte("\nlast line"))
+	out := tun.Output()
+	if len(out) > outputLimit || !strings.HasSuffix(out, "last line") {
+		t.Errorf("Output() kept %d bytes, want the latest at most %d", len(out), outputLimit)
+	}
+}
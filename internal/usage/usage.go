@@ -0,0 +1,250 @@
+// Package usage provides reverse "used by" lookups for shared resources.
+//
+// A Finder describes one kind of referencing resource (e.g. Lambda functions
+// referencing a security group). Referencing packages register finders for
+// the target types they point at, and Find assembles the results by listing
+// the referencing resources through their existing DAOs.
+package usage
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/log"
+	"github.com/clawscli/claws/internal/registry"
+)
+
+const (
+	// maxConcurrentFinders bounds how many referencing resource types are listed at once.
+	maxConcurrentFinders = 8
+	// maxConcurrentDetails bounds concurrent Get calls for Detail finders.
+	maxConcurrentDetails = 10
+	// maxDetailCandidates caps the number of Get calls a single Detail finder makes.
+	maxDetailCandidates = 200
+	// listPageSize and maxListPages bound listing of paginated DAOs.
+	listPageSize = 100
+	maxListPages = 50
+)
+
+// Finder locates resources of one type that reference a target resource.
+type Finder struct {
+	Service  string // Service of the referencing resource
+	Resource string // Resource type of the referencing resource
+	Relation string // Human-readable relation, e.g. "security group", "execution role"
+
+	// Detail requests that each listed candidate is fetched via DAO.Get before
+	// Refs is called, for resources whose List output lacks the reference.
+	Detail bool
+
+	// Refs returns the identifiers (IDs, names or ARNs) the candidate references.
+	Refs func(candidate dao.Resource) []string
+}
+
+// Reference is a resource found to reference the target.
+type Reference struct {
+	Service      string
+	ResourceType string
+	Relation     string
+	Resource     dao.Resource
+}
+
+// Result holds the references found and any per-finder errors.
+type Result struct {
+	References []Reference
+	Errors     []string
+}
+
+// Registry maps target resource types to the finders that reference them.
+type Registry struct {
+	mu      sync.RWMutex
+	finders map[string][]Finder
+}
+
+// Global is the default usage registry populated by custom packages.
+var Global = NewRegistry()
+
+// NewRegistry creates an empty usage registry.
+func NewRegistry() *Registry {
+	return &Registry{finders: make(map[string][]Finder)}
+}
+
+func key(service, resource string) string {
+	return service + "/" + resource
+}
+
+// Register adds finders for the given target service/resource.
+func (r *Registry) Register(service, resource string, finders ...Finder) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	k := key(service, resource)
+	r.finders[k] = append(r.finders[k], finders...)
+}
+
+// Get returns the finders registered for the target service/resource,
+// sorted by referencing service and resource type.
+func (r *Registry) Get(service, resource string) []Finder {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	finders := append([]Finder(nil), r.finders[key(service, resource)]...)
+	sort.SliceStable(finders, func(i, j int) bool {
+		if finders[i].Service != finders[j].Service {
+			return finders[i].Service < finders[j].Service
+		}
+		return finders[i].Resource < finders[j].Resource
+	})
+	return finders
+}
+
+// Supports reports whether any finders are registered for the target.
+func (r *Registry) Supports(service, resource string) bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return len(r.finders[key(service, resource)]) > 0
+}
+
+// Matches reports whether ref identifies target. A reference matches the
+// target's ID, name or ARN, or an ARN whose trailing path segment is the
+// target's ID or name (e.g. "arn:aws:kms:...:key/<id>", "arn:aws:iam::...:role/path/<name>").
+func Matches(target dao.Resource, ref string) bool {
+	if ref == "" || target == nil {
+		return false
+	}
+	id, name, arn := target.GetID(), target.GetName(), target.GetARN()
+	if ref == id || ref == name || (arn != "" && ref == arn) {
+		return true
+	}
+	if !strings.HasPrefix(ref, "arn:") {
+		return false
+	}
+	if id != "" && strings.HasSuffix(ref, "/"+id) {
+		return true
+	}
+	return name != "" && strings.HasSuffix(ref, "/"+name)
+}
+
+// Find runs all finders against target with bounded concurrency. The target
+// itself is never reported as a reference to itself.
+func Find(ctx context.Context, reg *registry.Registry, target dao.Resource, finders []Finder) Result {
+	var (
+		mu     sync.Mutex
+		result Result
+		wg     sync.WaitGroup
+		sem    = make(chan struct{}, maxConcurrentFinders)
+	)
+
+	for _, f := range finders {
+		wg.Add(1)
+		go func(f Finder) {
+			defer wg.Done()
+			select {
+			case sem <- struct{}{}:
+				defer func() { <-sem }()
+			case <-ctx.Done():
+				return
+			}
+
+			refs, err := run(ctx, reg, f, target)
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				log.Warn("usage finder failed", "service", f.Service, "resource", f.Resource, "error", err)
+				result.Errors = append(result.Errors, fmt.Sprintf("%s/%s: %v", f.Service, f.Resource, err))
+			}
+			result.References = append(result.References, refs...)
+		}(f)
+	}
+	wg.Wait()
+
+	sort.SliceStable(result.References, func(i, j int) bool {
+		a, b := result.References[i], result.References[j]
+		if a.Service != b.Service {
+			return a.Service < b.Service
+		}
+		if a.ResourceType != b.ResourceType {
+			return a.ResourceType < b.ResourceType
+		}
+		return a.Resource.GetName() < b.Resource.GetName()
+	})
+	sort.Strings(result.Errors)
+	return result
+}
+
+func run(ctx context.Context, reg *registry.Registry, f Finder, target dao.Resource) ([]Reference, error) {
+	if f.Refs == nil {
+		return nil, nil
+	}
+	d, err := reg.GetDAO(ctx, f.Service, f.Resource)
+	if err != nil {
+		return nil, err
+	}
+	candidates, err := dao.ListAll(ctx, d, listPageSize, maxListPages)
+	if err != nil {
+		return nil, err
+	}
+	if f.Detail {
+		candidates = fetchDetails(ctx, d, candidates)
+	}
+
+	var refs []Reference
+	for _, c := range candidates {
+		if isSame(c, target) {
+			continue
+		}
+		for _, ref := range f.Refs(dao.UnwrapResource(c)) {
+			if Matches(target, ref) {
+				refs = append(refs, Reference{
+					Service:      f.Service,
+					ResourceType: f.Resource,
+					Relation:     f.Relation,
+					Resource:     c,
+				})
+				break
+			}
+		}
+	}
+	return refs, nil
+}
+
+// fetchDetails replaces each candidate with its DAO.Get result, keeping the
+// listed resource when Get fails.
+func fetchDetails(ctx context.Context, d dao.DAO, candidates []dao.Resource) []dao.Resource {
+	if len(candidates) > maxDetailCandidates {
+		log.Warn("usage detail lookup truncated", "service", d.ServiceName(), "resource", d.ResourceType(), "count", len(candidates))
+		candidates = candidates[:maxDetailCandidates]
+	}
+
+	out := make([]dao.Resource, len(candidates))
+	sem := make(chan struct{}, maxConcurrentDetails)
+	var wg sync.WaitGroup
+	for i, c := range candidates {
+		out[i] = c
+		wg.Add(1)
+		go func(i int, c dao.Resource) {
+			defer wg.Done()
+			select {
+			case sem <- struct{}{}:
+				defer func() { <-sem }()
+			case <-ctx.Done():
+				return
+			}
+			detail, err := d.Get(ctx, c.GetID())
+			if err != nil {
+				log.Debug("usage detail fetch failed", "id", c.GetID(), "error", err)
+				return
+			}
+			out[i] = detail
+		}(i, c)
+	}
+	wg.Wait()
+	return out
+}
+
+func isSame(candidate, target dao.Resource) bool {
+	c := dao.UnwrapResource(candidate)
+	t := dao.UnwrapResource(target)
+	return c.GetID() == t.GetID() && c.GetARN() == t.GetARN() && c.GetName() == t.GetName()
+}
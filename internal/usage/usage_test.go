@@ -0,0 +1,195 @@
+package usage
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/registry"
+)
+
+type fakeDAO struct {
+	dao.BaseDAO
+	resources []dao.Resource
+	details   map[string]dao.Resource
+	err       error
+}
+
+func (d *fakeDAO) List(_ context.Context) ([]dao.Resource, error) {
+	return d.resources, d.err
+}
+
+func (d *fakeDAO) Get(_ context.Context, id string) (dao.Resource, error) {
+	if r, ok := d.details[id]; ok {
+		return r, nil
+	}
+	return nil, errors.New("not found")
+}
+
+func (d *fakeDAO) Delete(_ context.Context, _ string) error {
+	return nil
+}
+
+func newRegistry(service, resource string, d *fakeDAO) *registry.Registry {
+	reg := registry.New()
+	d.BaseDAO = dao.NewBaseDAO(service, resource)
+	reg.RegisterCustom(service, resource, registry.Entry{
+		DAOFactory: func(_ context.Context) (dao.DAO, error) { return d, nil },
+	})
+	return reg
+}
+
+func TestMatches(t *testing.T) {
+	key := &dao.BaseResource{
+		ID:  "1234abcd-12ab-34cd-56ef-1234567890ab",
+		ARN: "arn:aws:kms:us-east-1:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab",
+	}
+	role := &dao.BaseResource{
+		ID:   "MyRole",
+		Name: "MyRole",
+		ARN:  "arn:aws:iam::123456789012:role/service/MyRole",
+	}
+
+	tests := []struct {
+		name   string
+		target dao.Resource
+		ref    string
+		want   bool
+	}{
+		{"key id", key, "1234abcd-12ab-34cd-56ef-1234567890ab", true},
+		{"key arn", key, key.ARN, true},
+		{"key arn other region", key, "arn:aws:kms:eu-west-1:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab", true},
+		{"other key", key, "arn:aws:kms:us-east-1:123456789012:key/other", false},
+		{"role arn", role, role.ARN, true},
+		{"role arn without path", role, "arn:aws:iam::123456789012:role/MyRole", true},
+		{"role name suffix only", role, "arn:aws:iam::123456789012:role/NotMyRole", false},
+		{"non-arn suffix", role, "path/MyRole", false},
+		{"empty ref", role, "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Matches(tt.target, tt.ref); got != tt.want {
+				t.Errorf("Matches(%q) = %v, want %v", tt.ref, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRegistry(t *testing.T) {
+	r := NewRegistry()
+	if r.Supports("ec2", "security-groups") {
+		t.Error("empty registry should not support anything")
+	}
+
+	r.Register("ec2", "security-groups",
+		Finder{Service: "lambda", Resource: "functions"},
+		Finder{Service: "ec2", Resource: "instances"},
+	)
+
+	if !r.Supports("ec2", "security-groups") {
+		t.Error("Supports() = false, want true")
+	}
+	got := r.Get("ec2", "security-groups")
+	if len(got) != 2 {
+		t.Fatalf("Get() returned %d finders, want 2", len(got))
+	}
+	if got[0].Service != "ec2" || got[1].Service != "lambda" {
+		t.Errorf("Get() not sorted by service: %s, %s", got[0].Service, got[1].Service)
+	}
+}
+
+func TestFind(t *testing.T) {
+	target := &dao.BaseResource{ID: "sg-target", Name: "web"}
+	d := &fakeDAO{resources: []dao.Resource{
+		&dao.BaseResource{ID: "fn-a", Name: "a", Data: []string{"sg-target", "sg-other"}},
+		&dao.BaseResource{ID: "fn-b", Name: "b", Data: []string{"sg-other"}},
+		&dao.BaseResource{ID: "fn-c", Name: "c", Data: []string{"sg-target"}},
+	}}
+	reg := newRegistry("lambda", "functions", d)
+
+	finders := []Finder{{
+		Service:  "lambda",
+		Resource: "functions",
+		Relation: "security group",
+		Refs: func(r dao.Resource) []string {
+			refs, _ := r.Raw().([]string)
+			return refs
+		},
+	}}
+
+	result := Find(context.Background(), reg, target, finders)
+	if len(result.Errors) != 0 {
+		t.Fatalf("unexpected errors: %v", result.Errors)
+	}
+	if len(result.References) != 2 {
+		t.Fatalf("got %d references, want 2", len(result.References))
+	}
+	if result.References[0].Resource.GetName() != "a" || result.References[1].Resource.GetName() != "c" {
+		t.Errorf("unexpected references: %s, %s", result.References[0].Resource.GetName(), result.References[1].Resource.GetName())
+	}
+	if result.References[0].Relation != "security group" {
+		t.Errorf("Relation = %q, want %q", result.References[0].Relation, "security group")
+	}
+}
+
+func TestFind_SkipsTarget(t *testing.T) {
+	target := &dao.BaseResource{ID: "sg-1", Data: []string{"sg-1"}}
+	d := &fakeDAO{resources: []dao.Resource{target}}
+	reg := newRegistry("ec2", "security-groups", d)
+
+	result := Find(context.Background(), reg, target, []Finder{{
+		Service:  "ec2",
+		Resource: "security-groups",
+		Refs: func(r dao.Resource) []string {
+			refs, _ := r.Raw().([]string)
+			return refs
+		},
+	}})
+	if len(result.References) != 0 {
+		t.Errorf("target should not reference itself, got %d references", len(result.References))
+	}
+}
+
+func TestFind_Detail(t *testing.T) {
+	target := &dao.BaseResource{ID: "MyRole", ARN: "arn:aws:iam::123456789012:role/MyRole"}
+	d := &fakeDAO{
+		resources: []dao.Resource{
+			&dao.BaseResource{ID: "sm-1", Name: "one"},
+			&dao.BaseResource{ID: "sm-2", Name: "two"},
+		},
+		details: map[string]dao.Resource{
+			"sm-1": &dao.BaseResource{ID: "sm-1", Name: "one", Data: "arn:aws:iam::123456789012:role/MyRole"},
+			"sm-2": &dao.BaseResource{ID: "sm-2", Name: "two", Data: "arn:aws:iam::123456789012:role/Other"},
+		},
+	}
+	reg := newRegistry("sfn", "state-machines", d)
+
+	result := Find(context.Background(), reg, target, []Finder{{
+		Service:  "sfn",
+		Resource: "state-machines",
+		Detail:   true,
+		Refs: func(r dao.Resource) []string {
+			s, _ := r.Raw().(string)
+			return []string{s}
+		},
+	}})
+	if len(result.References) != 1 || result.References[0].Resource.GetID() != "sm-1" {
+		t.Errorf("expected only sm-1, got %+v", result.References)
+	}
+}
+
+func TestFind_Errors(t *testing.T) {
+	target := &dao.BaseResource{ID: "sg-1"}
+	d := &fakeDAO{err: errors.New("access denied")}
+	reg := newRegistry("lambda", "functions", d)
+
+	result := Find(context.Background(), reg, target, []Finder{
+		{Service: "lambda", Resource: "functions", Refs: func(dao.Resource) []string { return nil }},
+		{Service: "missing", Resource: "things", Refs: func(dao.Resource) []string { return nil }},
+	})
+	if len(result.Errors) != 2 {
+		t.Errorf("got %d errors, want 2: %v", len(result.Errors), result.Errors)
+	}
+}
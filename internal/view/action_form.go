@@ -0,0 +1,153 @@
+package view
+
+import (
+	"fmt"
+	"slices"
+
+	"charm.land/bubbles/v2/textinput"
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+
+	"github.com/clawscli/claws/internal/action"
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/ui"
+)
+
+// actionFormWidth is the width of the form's text inputs.
+const actionFormWidth = 40
+
+// actionForm asks for the input of an action in the action menu, one row
+// per field. Enum fields cycle through their options; the other types are
+// typed and validated on submit.
+type actionForm struct {
+	name     string
+	resource dao.Resource
+	fields   []action.Field
+	inputs   []textinput.Model
+	focus    int
+	err      error
+}
+
+// newActionForm creates a form for the action's fields that apply to the
+// resource, prefilled with their defaults.
+func newActionForm(act action.Action, resource dao.Resource) *actionForm {
+	f := &actionForm{name: act.Name, resource: resource, fields: action.InputFields(act, resource)}
+	for _, field := range f.fields {
+		in := textinput.New()
+		in.Prompt = ""
+		in.SetWidth(actionFormWidth)
+		in.SetValue(field.DefaultValue(resource))
+		if field.Type == action.FieldDuration {
+			in.Placeholder = "e.g. 30s, 5m"
+		}
+		f.inputs = append(f.inputs, in)
+	}
+	return f
+}
+
+// Init focuses the first field.
+func (f *actionForm) Init() tea.Cmd {
+	return f.setFocus(0)
+}
+
+func (f *actionForm) setFocus(i int) tea.Cmd {
+	f.inputs[f.focus].Blur()
+	f.focus = i
+	if f.fields[i].Type == action.FieldEnum {
+		return nil
+	}
+	return f.inputs[i].Focus()
+}
+
+// Update handles a key press. It returns true when the form was submitted
+// with valid values.
+func (f *actionForm) Update(msg tea.Msg) (bool, tea.Cmd) {
+	key, ok := msg.(tea.KeyPressMsg)
+	if !ok {
+		var cmd tea.Cmd
+		f.inputs[f.focus], cmd = f.inputs[f.focus].Update(msg)
+		return false, cmd
+	}
+
+	switch key.String() {
+	case "tab", "down":
+		return false, f.setFocus((f.focus + 1) % len(f.fields))
+	case "shift+tab", "up":
+		return false, f.setFocus((f.focus + len(f.fields) - 1) % len(f.fields))
+	case "enter":
+		for i, field := range f.fields {
+			if err := field.ValidateFor(f.resource, f.inputs[i].Value()); err != nil {
+				f.err = err
+				return false, f.setFocus(i)
+			}
+		}
+		f.err = nil
+		return true, nil
+	}
+
+	if field := f.fields[f.focus]; field.Type == action.FieldEnum {
+		switch key.String() {
+		case "left", "h":
+			f.cycle(field, -1)
+		case "right", "l", "space":
+			f.cycle(field, 1)
+		}
+		return false, nil
+	}
+	var cmd tea.Cmd
+	f.inputs[f.focus], cmd = f.inputs[f.focus].Update(msg)
+	return false, cmd
+}
+
+// cycle selects the next or previous option of an enum field.
+func (f *actionForm) cycle(field action.Field, step int) {
+	if len(field.Options) == 0 {
+		return
+	}
+	i := slices.Index(field.Options, f.inputs[f.focus].Value())
+	i = (i + step + len(field.Options)) % len(field.Options)
+	f.inputs[f.focus].SetValue(field.Options[i])
+}
+
+// values returns the entered values keyed by field name.
+func (f *actionForm) values() action.Values {
+	values := make(action.Values, len(f.fields))
+	for i, field := range f.fields {
+		values[field.Name] = f.inputs[i].Value()
+	}
+	return values
+}
+
+// View renders the form in the action menu's box style.
+func (f *actionForm) View(s actionMenuStyles) string {
+	t := ui.Current()
+	labelWidth := 0
+	for _, field := range f.fields {
+		labelWidth = max(labelWidth, lipgloss.Width(field.DisplayLabel()))
+	}
+	label := lipgloss.NewStyle().Width(labelWidth + 2).Foreground(t.TextDim)
+
+	content := s.bold.Render(f.name) + "\n\n"
+	for i, field := range f.fields {
+		l := label
+		if i == f.focus {
+			l = l.Foreground(t.Accent).Bold(true)
+		}
+		value := f.inputs[i].View()
+		if field.Type == action.FieldEnum {
+			value = "◂ " + f.inputs[i].Value() + " ▸"
+			if i == f.focus {
+				value = s.shortcut.Render(value)
+			}
+		}
+		content += l.Render(field.DisplayLabel()) + value + "\n"
+		if i == f.focus && field.Help != "" {
+			content += label.Render("") + ui.DimStyle().Render(field.Help) + "\n"
+		}
+	}
+	if f.err != nil {
+		content += "\n" + ui.DangerStyle().Render(fmt.Sprintf("Error: %v", f.err)) + "\n"
+	}
+	content += "\n" + ui.DimStyle().Render("Tab to move, Enter to continue, Esc to cancel")
+	return s.box.Render(content)
+}
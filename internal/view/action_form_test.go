@@ -0,0 +1,113 @@
+package view
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	tea "charm.land/bubbletea/v2"
+
+	"github.com/clawscli/claws/internal/action"
+	"github.com/clawscli/claws/internal/dao"
+)
+
+// registerFormAction registers an API action with input for the form tests
+// and returns the values its executor receives.
+func registerFormAction(t *testing.T, confirm action.ConfirmLevel) *action.Values {
+	t.Helper()
+	var got action.Values
+	action.Global.Register("formtest", "items", []action.Action{{
+		Name:      "Scale",
+		Shortcut:  "s",
+		Type:      action.ActionTypeAPI,
+		Operation: "Scale",
+		Confirm:   confirm,
+		Input: []action.Field{
+			{Name: "count", Label: "Count", Type: action.FieldInt, Min: action.Bound(1),
+				Default: func(dao.Resource) string { return "2" }},
+			{Name: "mode", Label: "Mode", Type: action.FieldEnum, Options: []string{"fast", "slow"}},
+		},
+	}})
+	action.RegisterExecutor("formtest", "items", func(_ context.Context, act action.Action, _ dao.Resource) action.ActionResult {
+		got = act.Values
+		return action.SuccessResult("scaled")
+	})
+	return &got
+}
+
+func typeText(m *ActionMenu, s string) {
+	for _, r := range s {
+		m.Update(tea.KeyPressMsg{Text: string(r), Code: r})
+	}
+}
+
+func TestActionMenuForm(t *testing.T) {
+	got := registerFormAction(t, action.ConfirmNone)
+	menu := NewActionMenu(context.Background(), &mockResource{id: "t-1", name: "table"}, "formtest", "items")
+
+	menu.Update(tea.KeyPressMsg{Text: "s", Code: 's'})
+	if menu.form == nil || !menu.HasActiveInput() {
+		t.Fatal("Expected the input form to open for an action with input")
+	}
+	if view := menu.ViewString(); !strings.Contains(view, "Count") || !strings.Contains(view, "fast") {
+		t.Errorf("form view missing fields or defaults:\n%s", view)
+	}
+
+	// Replace the default count, then pick the second mode
+	menu.Update(tea.KeyPressMsg{Code: tea.KeyBackspace})
+	typeText(menu, "7")
+	menu.Update(tea.KeyPressMsg{Code: tea.KeyTab})
+	menu.Update(tea.KeyPressMsg{Code: tea.KeyRight})
+	menu.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+
+	if menu.form != nil {
+		t.Fatalf("Expected the form to close after a valid submit, error: %v", menu.form.err)
+	}
+	if got.String("count") != "7" || got.String("mode") != "slow" {
+		t.Errorf("executor values = %v, want count 7 and mode slow", *got)
+	}
+	if menu.result == nil || !menu.result.Success {
+		t.Errorf("result = %+v, want success", menu.result)
+	}
+}
+
+func TestActionMenuFormInvalid(t *testing.T) {
+	got := registerFormAction(t, action.ConfirmNone)
+	menu := NewActionMenu(context.Background(), &mockResource{id: "t-1", name: "table"}, "formtest", "items")
+
+	menu.Update(tea.KeyPressMsg{Text: "s", Code: 's'})
+	menu.Update(tea.KeyPressMsg{Code: tea.KeyBackspace})
+	typeText(menu, "0")
+	menu.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+
+	if menu.form == nil || menu.form.err == nil {
+		t.Fatal("Expected the form to stay open with an error for a value below the minimum")
+	}
+	if *got != nil {
+		t.Error("Executor should not run with invalid input")
+	}
+
+	menu.Update(tea.KeyPressMsg{Code: tea.KeyEscape})
+	if menu.form != nil || menu.HasActiveInput() {
+		t.Error("Expected esc to cancel the form")
+	}
+}
+
+func TestActionMenuFormThenConfirm(t *testing.T) {
+	got := registerFormAction(t, action.ConfirmSimple)
+	menu := NewActionMenu(context.Background(), &mockResource{id: "t-1", name: "table"}, "formtest", "items")
+
+	menu.Update(tea.KeyPressMsg{Text: "s", Code: 's'})
+	menu.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+	if !menu.confirming {
+		t.Fatal("Expected confirmation after the form")
+	}
+	if view := menu.ViewString(); !strings.Contains(view, "Count: 2") {
+		t.Errorf("confirmation should list the entered values:\n%s", view)
+	}
+
+	menu.Update(tea.KeyPressMsg{Text: "y", Code: 'y'})
+	if got.String("count") != "2" || got.String("mode") != "fast" {
+		t.Errorf("executor values = %v, want the defaults", *got)
+	}
+}
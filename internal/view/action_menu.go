@@ -58,6 +58,7 @@ type dangerousState struct {
 type ActionMenu struct {
 	ctx            context.Context
 	resource       dao.Resource
+	marked         dao.Resource // passed to actions with UsesMarked
 	service        string
 	resType        string
 	actions        []action.Action
@@ -68,6 +69,8 @@ type ActionMenu struct {
 	lastExecAction *action.Action
 	styles         actionMenuStyles
 	dangerous      dangerousState
+	form           *actionForm
+	values         action.Values // input entered in the form for the pending action
 }
 
 // NewActionMenu creates a new ActionMenu
@@ -80,7 +83,7 @@ func NewActionMenu(ctx context.Context, resource dao.Resource, service, resType
 		if act.Filter != nil && !act.Filter(resource) {
 			continue
 		}
-		if readOnly && !action.IsAllowedInReadOnly(act) {
+		if readOnly && !action.Global.IsAllowedInReadOnly(act, service, resType) {
 			continue
 		}
 		filtered = append(filtered, act)
@@ -127,7 +130,7 @@ func (m *ActionMenu) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		return m, nil
 
 	case tea.MouseMotionMsg:
-		if !m.confirming && !m.dangerous.active {
+		if !m.confirming && !m.dangerous.active && m.form == nil {
 			if idx := m.getActionAtPosition(msg.Y); idx >= 0 && idx != m.cursor {
 				m.cursor = idx
 			}
@@ -135,7 +138,7 @@ func (m *ActionMenu) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		return m, nil
 
 	case tea.MouseClickMsg:
-		if msg.Button == tea.MouseLeft && !m.confirming && !m.dangerous.active {
+		if msg.Button == tea.MouseLeft && !m.confirming && !m.dangerous.active && m.form == nil {
 			if idx := m.getActionAtPosition(msg.Y); idx >= 0 {
 				m.cursor = idx
 				return m.handleActionConfirm(m.actions[idx], idx)
@@ -144,6 +147,23 @@ func (m *ActionMenu) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		return m, nil
 
 	case tea.KeyPressMsg:
+		if m.form != nil {
+			if IsEscKey(msg) {
+				m.form = nil
+				return m, nil
+			}
+			submitted, cmd := m.form.Update(msg)
+			if !submitted {
+				return m, cmd
+			}
+			m.values = m.form.values()
+			m.form = nil
+			if m.confirmIdx < len(m.actions) {
+				return m.confirmAction(m.actions[m.confirmIdx], m.confirmIdx)
+			}
+			return m, nil
+		}
+
 		if m.dangerous.active {
 			switch msg.String() {
 			case "enter":
@@ -216,11 +236,30 @@ func (m *ActionMenu) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				}
 			}
 		}
+
+	default:
+		// Cursor blinks of the form's inputs
+		if m.form != nil {
+			_, cmd := m.form.Update(msg)
+			return m, cmd
+		}
 	}
 	return m, nil
 }
 
+// handleActionConfirm asks for the action's input, if it takes any, and
+// then for confirmation.
 func (m *ActionMenu) handleActionConfirm(act action.Action, idx int) (tea.Model, tea.Cmd) {
+	m.values = nil
+	if act.Type == action.ActionTypeAPI && len(action.InputFields(act, m.resource)) > 0 {
+		m.form = newActionForm(act, m.resource)
+		m.confirmIdx = idx
+		return m, m.form.Init()
+	}
+	return m.confirmAction(act, idx)
+}
+
+func (m *ActionMenu) confirmAction(act action.Action, idx int) (tea.Model, tea.Cmd) {
 	switch act.Confirm {
 	case action.ConfirmDangerous:
 		m.dangerous.active = true
@@ -237,6 +276,20 @@ func (m *ActionMenu) handleActionConfirm(act action.Action, idx int) (tea.Model,
 	}
 }
 
+// SetMarked sets the resource marked in the browser, for actions that use it.
+func (m *ActionMenu) SetMarked(res dao.Resource) {
+	m.marked = res
+}
+
+// actionContext returns the context for act, with the marked resource for
+// actions that use it.
+func (m *ActionMenu) actionContext(act action.Action) context.Context {
+	if act.UsesMarked && m.marked != nil {
+		return dao.WithMarked(m.ctx, m.marked)
+	}
+	return m.ctx
+}
+
 func (m *ActionMenu) getConfirmToken(act action.Action) string {
 	if act.ConfirmToken != nil {
 		return act.ConfirmToken(m.resource)
@@ -273,8 +326,20 @@ func (m *ActionMenu) executeAction(act action.Action) (tea.Model, tea.Cmd) {
 		})
 	}
 
+	if act.Type == action.ActionTypeView {
+		v, err := openViewTarget(m.actionContext(act), act.Target, m.resource)
+		if err != nil {
+			m.result = &action.ActionResult{Success: false, Error: err}
+			return m, nil
+		}
+		return m, func() tea.Msg { return NavigateMsg{View: v} }
+	}
+
 	// For other actions, execute directly
-	result := action.ExecuteWithDAO(m.ctx, act, m.resource, m.service, m.resType)
+	if m.values != nil {
+		act.Values = m.values
+	}
+	result := action.ExecuteWithDAO(m.actionContext(act), act, m.resource, m.service, m.resType)
 	m.result = &result
 
 	// If action has a follow-up message, send it
@@ -314,7 +379,10 @@ func (m *ActionMenu) ViewString() string {
 		out += style.Render(fmt.Sprintf("%s %s", shortcut, act.Name)) + "\n"
 	}
 
-	if m.dangerous.active && m.confirmIdx < len(m.actions) {
+	if m.form != nil {
+		out += "\n"
+		out += m.form.View(s)
+	} else if m.dangerous.active && m.confirmIdx < len(m.actions) {
 		act := m.actions[m.confirmIdx]
 		out += "\n"
 		out += m.renderDangerousConfirm(act)
@@ -324,6 +392,9 @@ func (m *ActionMenu) ViewString() string {
 
 		confirmContent := s.bold.Render("Confirm Action") + "\n"
 		confirmContent += fmt.Sprintf("Execute '%s' on %s?\n\n", act.Name, m.resource.GetID())
+		if summary := m.values.Summary(action.InputFields(act, m.resource)); summary != "" {
+			confirmContent += summary + "\n\n"
+		}
 		confirmContent += "Press " + s.yes.Render("[Y]") + " to confirm or " + s.no.Render("[N]") + " to cancel"
 
 		out += s.box.Render(confirmContent)
@@ -338,7 +409,7 @@ func (m *ActionMenu) ViewString() string {
 		}
 	}
 
-	if !m.confirming && !m.dangerous.active {
+	if !m.confirming && !m.dangerous.active && m.form == nil {
 		out += "\n\n" + ui.DimStyle().Render("Press shortcut key or Enter to execute, Esc to cancel")
 	}
 
@@ -353,6 +424,9 @@ func (m *ActionMenu) renderDangerousConfirm(act action.Action) string {
 	content := dangerTitle + "\n\n"
 	content += fmt.Sprintf("You are about to %s:\n", s.no.Render(act.Name))
 	content += s.bold.Render(m.dangerous.token) + "\n\n"
+	if summary := m.values.Summary(action.InputFields(act, m.resource)); summary != "" {
+		content += summary + "\n\n"
+	}
 
 	suffix := action.ConfirmSuffix(m.dangerous.token)
 	if len(suffix) < len(m.dangerous.token) {
@@ -393,6 +467,9 @@ func (m *ActionMenu) SetSize(_, _ int) tea.Cmd {
 }
 
 func (m *ActionMenu) StatusLine() string {
+	if m.form != nil {
+		return "Tab:next field • Enter:continue • Esc:cancel"
+	}
 	if m.dangerous.active {
 		suffix := action.ConfirmSuffix(m.dangerous.token)
 		if m.dangerous.input != "" && !strings.HasPrefix(suffix, m.dangerous.input) {
@@ -410,5 +487,5 @@ func (m *ActionMenu) StatusLine() string {
 }
 
 func (m *ActionMenu) HasActiveInput() bool {
-	return m.dangerous.active
+	return m.dangerous.active || m.form != nil
 }
@@ -7,6 +7,7 @@ import (
 	tea "charm.land/bubbletea/v2"
 
 	"github.com/clawscli/claws/internal/action"
+	"github.com/clawscli/claws/internal/dao"
 )
 
 func TestActionMenuMouseHover(t *testing.T) {
@@ -218,3 +219,28 @@ func TestActionMenuConfirmDangerousHasActiveInput(t *testing.T) {
 		t.Error("Expected HasActiveInput() to be true when dangerousConfirm is active")
 	}
 }
+
+func TestActionMenuMarkedOnlyForUsesMarked(t *testing.T) {
+	var got []dao.Resource
+	action.Global.RegisterExecutor("test", "marked", func(ctx context.Context, act action.Action, resource dao.Resource) action.ActionResult {
+		got = append(got, dao.MarkedFromContext(ctx))
+		return action.SuccessResult("ok")
+	})
+	defer action.Global.RegisterExecutor("test", "marked", nil)
+
+	menu := NewActionMenu(context.Background(), &mockResource{id: "i-1"}, "test", "marked")
+	menu.SetMarked(&mockResource{id: "i-2"})
+
+	menu.executeAction(action.Action{Name: "Plain", Type: action.ActionTypeAPI, Operation: "Plain"})
+	menu.executeAction(action.Action{Name: "Both", Type: action.ActionTypeAPI, Operation: "Both", UsesMarked: true})
+
+	if len(got) != 2 {
+		t.Fatalf("executor called %d times, want 2", len(got))
+	}
+	if got[0] != nil {
+		t.Errorf("action without UsesMarked got marked resource %s", got[0].GetID())
+	}
+	if got[1] == nil || got[1].GetID() != "i-2" {
+		t.Errorf("action with UsesMarked got marked resource %v, want i-2", got[1])
+	}
+}
@@ -0,0 +1,171 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	tea "charm.land/bubbletea/v2"
+
+	"github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/config"
+	"github.com/clawscli/claws/internal/dao"
+	navmsg "github.com/clawscli/claws/internal/msg"
+	"github.com/clawscli/claws/internal/registry"
+)
+
+// ParseResourceRef parses an ARN or AWS console URL into an ARN.
+// Returns nil if s is neither.
+func ParseResourceRef(s string) *aws.ARN {
+	s = strings.TrimSpace(s)
+	if a := aws.ParseARN(s); a != nil {
+		return a
+	}
+	return aws.ParseConsoleURL(s)
+}
+
+// IsResourceRef reports whether s looks like a pasted ARN or console URL.
+func IsResourceRef(s string) bool {
+	s = strings.TrimSpace(s)
+	return strings.HasPrefix(s, "arn:") || aws.IsConsoleURL(s)
+}
+
+// NewARNDetailView creates a DetailView for the resource identified by arn.
+// The resource is loaded through its DAO's Get in the ARN's region, using the
+// profile whose account matches the ARN's account ID when one is known.
+func NewARNDetailView(ctx context.Context, reg *registry.Registry, arn *aws.ARN, tags map[string]string) (*DetailView, error) {
+	if arn == nil {
+		return nil, fmt.Errorf("invalid ARN")
+	}
+
+	service, resourceType := arn.ServiceResourceType()
+	if service == "" || resourceType == "" || !arn.CanNavigate() {
+		return nil, fmt.Errorf("unsupported ARN: %s", arn.Raw)
+	}
+	if _, ok := reg.Get(service, resourceType); !ok {
+		return nil, fmt.Errorf("unsupported resource type: %s/%s", service, resourceType)
+	}
+
+	if profileID := profileForAccount(arn.AccountID); profileID != "" {
+		ctx = aws.WithSelectionOverride(ctx, config.ProfileSelectionFromID(profileID))
+	}
+	if arn.Region != "" {
+		ctx = aws.WithRegionOverride(ctx, arn.Region)
+	}
+	if filterKey, filterValue := arn.ExtractParentFilter(); filterKey != "" {
+		ctx = dao.WithFilter(ctx, filterKey, filterValue)
+	}
+
+	renderer, err := reg.GetRenderer(service, resourceType)
+	if err != nil {
+		return nil, err
+	}
+	daoInst, err := reg.GetDAO(ctx, service, resourceType)
+	if err != nil {
+		daoInst = nil
+	}
+
+	minimalResource := &dao.BaseResource{
+		ID:   resourceIDForGet(arn),
+		Name: arn.ShortID(),
+		ARN:  arn.Raw,
+		Tags: tags,
+	}
+
+	return NewDetailView(ctx, minimalResource, renderer, service, resourceType, reg, daoInst), nil
+}
+
+// switchForARN selects the profile and region of arn when they are not
+// selected yet, so that views opened from its detail view use them too. It
+// returns the message that makes the app reload for the new selection, or
+// nil when nothing changed.
+func switchForARN(arn *aws.ARN) tea.Msg {
+	cfg := config.Global()
+	profileID := profileForAccount(arn.AccountID)
+	switchProfile := profileID != "" && !slices.ContainsFunc(cfg.Selections(), func(sel config.ProfileSelection) bool {
+		return sel.ID() == profileID
+	})
+	switchRegion := arn.Region != "" && !slices.Contains(cfg.Regions(), arn.Region)
+
+	if switchRegion {
+		cfg.SetRegions([]string{arn.Region})
+	}
+	// One message only: each pops the view stack back to a refreshable view
+	if switchProfile {
+		selections := []config.ProfileSelection{config.ProfileSelectionFromID(profileID)}
+		cfg.SetSelections(selections)
+		return navmsg.ProfilesChangedMsg{Selections: selections}
+	}
+	if switchRegion {
+		return navmsg.RegionChangedMsg{Regions: cfg.Regions()}
+	}
+	return nil
+}
+
+// profileForAccount returns the profile to use for resources in accountID.
+// Selected profiles are preferred over other known profiles. Returns "" when
+// the current profile already matches or no profile is known for the account.
+func profileForAccount(accountID string) string {
+	if accountID == "" {
+		return ""
+	}
+
+	cfg := config.Global()
+	selections := cfg.Selections()
+	for _, sel := range selections {
+		if cfg.GetAccountIDForProfile(sel.ID()) == accountID {
+			if len(selections) == 1 {
+				return ""
+			}
+			return sel.ID()
+		}
+	}
+
+	var candidates []string
+	for profileID, id := range cfg.AccountIDs() {
+		if id == accountID {
+			candidates = append(candidates, profileID)
+		}
+	}
+	if len(candidates) == 0 {
+		return ""
+	}
+	sort.Strings(candidates)
+	return candidates[0]
+}
+
+// resourceIDForGet returns the identifier the resource's DAO.Get expects,
+// derived from its ARN.
+func resourceIDForGet(arn *aws.ARN) string {
+	switch arn.Service {
+	case "states", "sns", "acm", "elasticloadbalancing", "cloudformation", "secretsmanager":
+		// Get takes the full ARN
+		return arn.Raw
+	case "iam":
+		if arn.ResourceType == "policy" {
+			return arn.Raw
+		}
+		// Roles, users and groups are fetched by name, without the path
+		return arn.ShortID()
+	case "ecs":
+		// service/cluster/name or task/cluster/id
+		return arn.ShortID()
+	case "logs":
+		return strings.TrimSuffix(arn.ResourceID, ":*")
+	case "bedrock-agentcore":
+		// ARN: arn:aws:bedrock-agentcore:region:account:runtime/RUNTIME_ID/runtime-endpoint/DEFAULT
+		// Extract just the runtime ID (first segment) for GetAgentRuntime API
+		// idx > 0 (not >= 0): if "/" is at position 0, the prefix would be empty string which is invalid
+		if idx := strings.Index(arn.ResourceID, "/"); idx > 0 {
+			return arn.ResourceID[:idx]
+		}
+		return arn.ResourceID
+	default:
+		if arn.ResourceID != "" {
+			return arn.ResourceID
+		}
+		return arn.Raw
+	}
+}
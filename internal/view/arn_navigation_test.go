@@ -0,0 +1,163 @@
+package view
+
+import (
+	"context"
+	"testing"
+
+	tea "charm.land/bubbletea/v2"
+
+	"github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/config"
+	navmsg "github.com/clawscli/claws/internal/msg"
+	"github.com/clawscli/claws/internal/registry"
+	"github.com/clawscli/claws/internal/render"
+)
+
+func TestResourceIDForGet(t *testing.T) {
+	tests := []struct {
+		arn  string
+		want string
+	}{
+		{"arn:aws:ec2:us-east-1:123456789012:instance/i-abc", "i-abc"},
+		{"arn:aws:iam::123456789012:role/service-role/my-role", "my-role"},
+		{"arn:aws:iam::aws:policy/AdministratorAccess", "arn:aws:iam::aws:policy/AdministratorAccess"},
+		{"arn:aws:ecs:us-east-1:123456789012:service/prod/api", "api"},
+		{"arn:aws:logs:us-east-1:123456789012:log-group:/aws/lambda/fn:*", "/aws/lambda/fn"},
+		{"arn:aws:sns:us-east-1:123456789012:topic", "arn:aws:sns:us-east-1:123456789012:topic"},
+		{"arn:aws:lambda:us-east-1:123456789012:function:fn", "fn"},
+		{"arn:aws:bedrock-agentcore:us-east-1:123456789012:runtime/rt-1/runtime-endpoint/DEFAULT", "rt-1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.arn, func(t *testing.T) {
+			if got := resourceIDForGet(aws.ParseARN(tt.arn)); got != tt.want {
+				t.Errorf("resourceIDForGet() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsResourceRef(t *testing.T) {
+	tests := []struct {
+		input string
+		want  bool
+	}{
+		{"arn:aws:s3:::bucket", true},
+		{"https://us-east-1.console.aws.amazon.com/ec2/home", true},
+		{"ec2/instances", false},
+		{"search arn", false},
+	}
+	for _, tt := range tests {
+		if got := IsResourceRef(tt.input); got != tt.want {
+			t.Errorf("IsResourceRef(%q) = %v, want %v", tt.input, got, tt.want)
+		}
+	}
+}
+
+func TestNewARNDetailView(t *testing.T) {
+	reg := registry.New()
+	reg.RegisterCustom("lambda", "functions", registry.Entry{
+		RendererFactory: func() render.Renderer { return &mockRenderer{} },
+	})
+
+	dv, err := NewARNDetailView(context.Background(), reg, aws.ParseARN("arn:aws:lambda:eu-west-1:123456789012:function:fn"), nil)
+	if err != nil {
+		t.Fatalf("NewARNDetailView() error = %v", err)
+	}
+	if dv.service != "lambda" || dv.resType != "functions" {
+		t.Errorf("detail view type = %s/%s, want lambda/functions", dv.service, dv.resType)
+	}
+	if dv.resource.GetID() != "fn" {
+		t.Errorf("resource ID = %q, want fn", dv.resource.GetID())
+	}
+	if region := aws.GetRegionFromContext(dv.ctx); region != "eu-west-1" {
+		t.Errorf("region override = %q, want eu-west-1", region)
+	}
+
+	if _, err := NewARNDetailView(context.Background(), reg, aws.ParseARN("arn:aws:sqs:us-east-1:123456789012:queue"), nil); err == nil {
+		t.Error("expected error for unregistered resource type")
+	}
+}
+
+func TestCommandInput_ARN(t *testing.T) {
+	regions := config.Global().Regions()
+	config.Global().SetRegion("us-east-1")
+	defer config.Global().SetRegions(regions)
+
+	reg := registry.New()
+	reg.RegisterCustom("lambda", "functions", registry.Entry{
+		RendererFactory: func() render.Renderer { return &mockRenderer{} },
+	})
+
+	tests := []struct {
+		input   string
+		wantNav bool
+	}{
+		{"arn arn:aws:lambda:us-east-1:123456789012:function:fn", true},
+		{"arn:aws:lambda:us-east-1:123456789012:function:fn", true},
+		{"https://us-east-1.console.aws.amazon.com/lambda/home?region=us-east-1#/functions/fn", true},
+		{"arn", false},
+		{"arn not-an-arn", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			ci := NewCommandInput(context.Background(), reg)
+			ci.Activate()
+			ci.textInput.SetValue(tt.input)
+
+			cmd, nav := ci.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+			if tt.wantNav {
+				if nav == nil {
+					t.Fatal("expected NavigateMsg")
+				}
+				if _, ok := nav.View.(*DetailView); !ok {
+					t.Errorf("expected DetailView, got %T", nav.View)
+				}
+				return
+			}
+			if nav != nil {
+				t.Error("expected no navigation")
+			}
+			if cmd == nil {
+				t.Fatal("expected error command")
+			}
+			if _, ok := cmd().(ErrorMsg); !ok {
+				t.Error("expected ErrorMsg")
+			}
+		})
+	}
+
+	// A resource in another region switches the region before opening
+	ci := NewCommandInput(context.Background(), reg)
+	ci.Activate()
+	ci.textInput.SetValue("arn:aws:lambda:eu-west-1:123456789012:function:fn")
+	cmd, nav := ci.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+	if nav != nil || cmd == nil {
+		t.Fatalf("expected a command switching the region, got nav = %v", nav)
+	}
+	if got := config.Global().Regions(); len(got) != 1 || got[0] != "eu-west-1" {
+		t.Errorf("regions = %v, want [eu-west-1]", got)
+	}
+}
+
+func TestSwitchForARN(t *testing.T) {
+	regions := config.Global().Regions()
+	config.Global().SetRegions([]string{"us-east-1", "eu-west-1"})
+	defer config.Global().SetRegions(regions)
+
+	if msg := switchForARN(aws.ParseARN("arn:aws:lambda:eu-west-1:123456789012:function:fn")); msg != nil {
+		t.Errorf("switchForARN() = %v for a selected region, want nil", msg)
+	}
+	if msg := switchForARN(aws.ParseARN("arn:aws:iam::123456789012:role/r")); msg != nil {
+		t.Errorf("switchForARN() = %v for a global resource, want nil", msg)
+	}
+
+	msg, ok := switchForARN(aws.ParseARN("arn:aws:lambda:ap-south-1:123456789012:function:fn")).(navmsg.RegionChangedMsg)
+	if !ok || len(msg.Regions) != 1 || msg.Regions[0] != "ap-south-1" {
+		t.Errorf("switchForARN() = %v, want RegionChangedMsg for ap-south-1", msg)
+	}
+	if got := config.Global().Regions(); len(got) != 1 || got[0] != "ap-south-1" {
+		t.Errorf("regions = %v, want [ap-south-1]", got)
+	}
+}
@@ -49,6 +49,12 @@ type DiffCompletionProvider interface {
 	GetMarkedResourceName() string
 }
 
+// LogGroupProvider provides the log groups of the current selection, which
+// :insights queries when no groups are given
+type LogGroupProvider interface {
+	SelectedLogGroups() []string
+}
+
 type CommandInput struct {
 	ctx         context.Context
 	registry    *registry.Registry
@@ -63,6 +69,8 @@ type CommandInput struct {
 	tagProvider TagCompletionProvider
 	// Diff completion
 	diffProvider DiffCompletionProvider
+	// Log groups for :insights
+	logGroupProvider LogGroupProvider
 }
 
 // NewCommandInput creates a new CommandInput
@@ -70,7 +78,7 @@ func NewCommandInput(ctx context.Context, reg *registry.Registry) *CommandInput
 	ti := textinput.New()
 	ti.Placeholder = "service/resource"
 	ti.Prompt = ":"
-	ti.CharLimit = 50
+	ti.CharLimit = 2048
 	ti.SetWidth(30)
 
 	return &CommandInput{
@@ -211,6 +219,11 @@ func (c *CommandInput) SetDiffProvider(provider DiffCompletionProvider) {
 	c.diffProvider = provider
 }
 
+// SetLogGroupProvider sets the provider of log groups for :insights
+func (c *CommandInput) SetLogGroupProvider(provider LogGroupProvider) {
+	c.logGroupProvider = provider
+}
+
 func (c *CommandInput) executeCommand() (tea.Cmd, *NavigateMsg) {
 	input := strings.TrimSpace(c.textInput.Value())
 
@@ -289,6 +302,36 @@ func (c *CommandInput) executeCommand() (tea.Cmd, *NavigateMsg) {
 		return nil, &NavigateMsg{View: browser}
 	}
 
+	// Handle search command: :search <query> [in:svc,...] - omnisearch across resource types
+	if input == "search" || strings.HasPrefix(input, "search ") {
+		query, scope := ParseSearchArgs(strings.TrimPrefix(input, "search"))
+		searchView := NewSearchView(c.ctx, c.registry, query, scope)
+		return nil, &NavigateMsg{View: searchView}
+	}
+
+	// Handle insights command: :insights [group...] - Logs Insights over the given or selected log groups
+	if input == "insights" || strings.HasPrefix(input, "insights ") {
+		groups := splitGroups(strings.TrimPrefix(input, "insights"))
+		if len(groups) == 0 && c.logGroupProvider != nil {
+			groups = c.logGroupProvider.SelectedLogGroups()
+		}
+		return nil, &NavigateMsg{View: NewInsightsView(c.ctx, groups)}
+	}
+
+	// Handle tunnels command: :tunnels - port forwards started in this session
+	if input == "tunnels" {
+		return nil, &NavigateMsg{View: NewTunnelsView(c.ctx)}
+	}
+
+	// Handle arn command: :arn <arn|console-url>, or a pasted ARN/console URL
+	if input == "arn" || strings.HasPrefix(input, "arn ") || IsResourceRef(input) {
+		ref := input
+		if input == "arn" || strings.HasPrefix(input, "arn ") {
+			ref = strings.TrimSpace(strings.TrimPrefix(input, "arn"))
+		}
+		return c.openResourceRef(ref)
+	}
+
 	// Handle diff command: :diff <name> or :diff <name1> <name2>
 	if strings.HasPrefix(input, "diff ") {
 		args := strings.TrimSpace(strings.TrimPrefix(input, "diff "))
@@ -364,6 +407,38 @@ func (c *CommandInput) executeCommand() (tea.Cmd, *NavigateMsg) {
 	return nil, nil
 }
 
+// openResourceRef opens the detail view for an ARN or console URL. When the
+// resource is in a region or account that is not selected, the region and
+// profile are switched first.
+func (c *CommandInput) openResourceRef(ref string) (tea.Cmd, *NavigateMsg) {
+	if ref == "" {
+		return func() tea.Msg {
+			return ErrorMsg{Err: fmt.Errorf("usage: :arn <arn|console-url>")}
+		}, nil
+	}
+
+	arn := ParseResourceRef(ref)
+	if arn == nil {
+		return func() tea.Msg {
+			return ErrorMsg{Err: fmt.Errorf("not an ARN or recognized console URL: %s", ref)}
+		}, nil
+	}
+
+	detailView, err := NewARNDetailView(c.ctx, c.registry, arn, nil)
+	if err != nil {
+		return func() tea.Msg {
+			return ErrorMsg{Err: err}
+		}, nil
+	}
+	if changed := switchForARN(arn); changed != nil {
+		return tea.Sequence(
+			func() tea.Msg { return changed },
+			func() tea.Msg { return NavigateMsg{View: detailView} },
+		), nil
+	}
+	return nil, &NavigateMsg{View: detailView}
+}
+
 // parseSortCommand parses the sort command and returns a SortMsg command
 // Syntax: :sort, :sort <column>, :sort desc <column>
 func (c *CommandInput) parseSortCommand(input string) tea.Cmd {
@@ -455,6 +530,26 @@ func (c *CommandInput) GetSuggestions() []string {
 			suggestions = append(suggestions, "tags")
 		}
 
+		// Add "search" command (omnisearch)
+		if strings.HasPrefix("search", input) {
+			suggestions = append(suggestions, "search")
+		}
+
+		// Add "insights" command (Logs Insights queries)
+		if strings.HasPrefix("insights", input) {
+			suggestions = append(suggestions, "insights")
+		}
+
+		// Add "tunnels" command (running port forwards)
+		if strings.HasPrefix("tunnels", input) {
+			suggestions = append(suggestions, "tunnels")
+		}
+
+		// Add "arn" command (jump to ARN or console URL)
+		if strings.HasPrefix("arn", input) {
+			suggestions = append(suggestions, "arn")
+		}
+
 		// Add "sort" command
 		if strings.HasPrefix("sort", input) {
 			suggestions = append(suggestions, "sort")
@@ -190,6 +190,46 @@ func TestCommandInput_QuitCommand(t *testing.T) {
 	}
 }
 
+type mockLogGroupProvider []string
+
+func (m mockLogGroupProvider) SelectedLogGroups() []string { return m }
+
+func TestCommandInput_InsightsCommand(t *testing.T) {
+	ctx := context.Background()
+	reg := registry.New()
+
+	tests := []struct {
+		input    string
+		provider LogGroupProvider
+		want     string
+	}{
+		{"insights", nil, ""},
+		{"insights", mockLogGroupProvider{"/selected"}, "/selected"},
+		{"insights /a,/b", mockLogGroupProvider{"/selected"}, "/a, /b"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			ci := NewCommandInput(ctx, reg)
+			ci.SetLogGroupProvider(tt.provider)
+			ci.Activate()
+			ci.textInput.SetValue(tt.input)
+
+			_, nav := ci.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+			if nav == nil {
+				t.Fatal("Expected NavigateMsg for :insights")
+			}
+			iv, ok := nav.View.(*InsightsView)
+			if !ok {
+				t.Fatalf("View = %T, want *InsightsView", nav.View)
+			}
+			if got := iv.groupsInput.Value(); got != tt.want {
+				t.Errorf("groups = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
 // mockDiffProvider for testing getDiffSuggestions
 type mockDiffProvider struct {
 	names      []string
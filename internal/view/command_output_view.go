@@ -0,0 +1,299 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"charm.land/bubbles/v2/spinner"
+	"charm.land/bubbles/v2/viewport"
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+
+	"github.com/clawscli/claws/internal/runcmd"
+	"github.com/clawscli/claws/internal/ui"
+)
+
+// commandRefreshInterval is how often a running command is reloaded.
+const commandRefreshInterval = 3 * time.Second
+
+// commandListMax bounds the instance list height; longer lists scroll.
+const commandListMax = 8
+
+// CommandOutputView shows an SSM Run Command's result on each instance:
+// status and exit code in a list, and the selected instance's stdout and
+// stderr below it. Reloads until every invocation has finished.
+type CommandOutputView struct {
+	ctx    context.Context
+	client runcmd.Client
+	target runcmd.Target
+
+	invocations []runcmd.Invocation
+	cursor      int
+	loading     bool
+	err         error
+	message     string
+
+	viewport viewport.Model
+	width    int
+	height   int
+	spinner  spinner.Model
+}
+
+// NewCommandOutputView creates a CommandOutputView for t.
+func NewCommandOutputView(ctx context.Context, t runcmd.Target) *CommandOutputView {
+	return &CommandOutputView{
+		ctx:      ctx,
+		target:   t,
+		loading:  true,
+		viewport: viewport.New(),
+		spinner:  ui.NewSpinner(),
+	}
+}
+
+type commandOutputMsg struct {
+	invocations []runcmd.Invocation
+	err         error
+}
+
+type commandRefreshMsg struct{}
+
+// Init implements tea.Model
+func (v *CommandOutputView) Init() tea.Cmd {
+	return tea.Batch(v.spinner.Tick, v.load())
+}
+
+func (v *CommandOutputView) load() tea.Cmd {
+	if v.client == nil {
+		c, err := runcmd.NewClient(v.ctx)
+		if err != nil {
+			return func() tea.Msg { return commandOutputMsg{err: err} }
+		}
+		v.client = c
+	}
+	ctx, client, target := v.ctx, v.client, v.target
+	return func() tea.Msg {
+		invocations, err := runcmd.Load(ctx, client, target)
+		return commandOutputMsg{invocations: invocations, err: err}
+	}
+}
+
+// Update implements tea.Model
+func (v *CommandOutputView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case commandOutputMsg:
+		v.loading = false
+		v.err = msg.err
+		if msg.err != nil {
+			return v, nil
+		}
+		v.invocations = msg.invocations
+		v.cursor = min(v.cursor, max(len(v.invocations)-1, 0))
+		v.setOutput(false)
+		if !runcmd.AllDone(v.invocations) {
+			return v, tea.Tick(commandRefreshInterval, func(time.Time) tea.Msg { return commandRefreshMsg{} })
+		}
+		return v, nil
+
+	case commandRefreshMsg:
+		return v.reload()
+
+	case spinner.TickMsg:
+		if v.loading {
+			var cmd tea.Cmd
+			v.spinner, cmd = v.spinner.Update(msg)
+			return v, cmd
+		}
+		return v, nil
+
+	case tea.KeyPressMsg:
+		return v.handleKey(msg)
+	}
+	return v, nil
+}
+
+func (v *CommandOutputView) handleKey(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "j", "down":
+		if v.cursor < len(v.invocations)-1 {
+			v.cursor++
+			v.setOutput(true)
+		}
+		return v, nil
+	case "k", "up":
+		if v.cursor > 0 {
+			v.cursor--
+			v.setOutput(true)
+		}
+		return v, nil
+	case "y":
+		if v.cursor < len(v.invocations) {
+			inv := v.invocations[v.cursor]
+			v.message = "Copied output of " + inv.InstanceID
+			return v, tea.SetClipboard(inv.Stdout)
+		}
+		return v, nil
+	case "ctrl+r":
+		return v.reload()
+	case "g":
+		v.viewport.GotoTop()
+		return v, nil
+	case "G":
+		v.viewport.GotoBottom()
+		return v, nil
+	}
+	var cmd tea.Cmd
+	v.viewport, cmd = v.viewport.Update(msg)
+	return v, cmd
+}
+
+func (v *CommandOutputView) reload() (tea.Model, tea.Cmd) {
+	if v.loading {
+		return v, nil
+	}
+	v.loading = true
+	return v, tea.Batch(v.spinner.Tick, v.load())
+}
+
+// setOutput shows the selected instance's output, from the top when the
+// selection changed.
+func (v *CommandOutputView) setOutput(selected bool) {
+	if v.cursor >= len(v.invocations) {
+		v.viewport.SetContent("")
+		return
+	}
+	inv := v.invocations[v.cursor]
+	var parts []string
+	if inv.Stdout != "" {
+		parts = append(parts, strings.TrimRight(inv.Stdout, "\n"))
+	}
+	if inv.Stderr != "" {
+		parts = append(parts, ui.DangerStyle().Render("stderr")+"\n"+strings.TrimRight(inv.Stderr, "\n"))
+	}
+	if len(parts) == 0 {
+		if inv.Done() {
+			parts = append(parts, ui.DimStyle().Render("No output"))
+		} else {
+			parts = append(parts, ui.DimStyle().Render("Waiting for output..."))
+		}
+	}
+	v.viewport.SetContent(strings.Join(parts, "\n\n"))
+	if selected {
+		v.viewport.GotoTop()
+	}
+	v.resize()
+}
+
+// invocationStyle colours an invocation status
+func invocationStyle(inv runcmd.Invocation) lipgloss.Style {
+	switch inv.Status {
+	case "Success":
+		return ui.SuccessStyle()
+	case "Failed", "Cancelled", "TimedOut":
+		return ui.DangerStyle()
+	default:
+		return ui.WarningStyle()
+	}
+}
+
+func (v *CommandOutputView) listHeight() int {
+	return min(len(v.invocations), commandListMax)
+}
+
+func (v *CommandOutputView) list() string {
+	n := v.listHeight()
+	start := max(0, min(v.cursor-n+1, len(v.invocations)-n))
+	if v.cursor < start {
+		start = v.cursor
+	}
+	theme := ui.Current()
+	selected := lipgloss.NewStyle().Foreground(theme.SelectionText).Background(theme.Selection)
+	var lines []string
+	for i := start; i < start+n; i++ {
+		inv := v.invocations[i]
+		code := ""
+		if inv.Done() {
+			code = fmt.Sprintf("exit %d", inv.ResponseCode)
+		}
+		cols := fmt.Sprintf("%-20s %-24s ", inv.InstanceID, truncateValue(inv.InstanceName, 24))
+		if i == v.cursor {
+			lines = append(lines, selected.Render(fmt.Sprintf("%s%-12s %s", cols, inv.Status, code)))
+			continue
+		}
+		lines = append(lines, cols+invocationStyle(inv).Render(fmt.Sprintf("%-12s", inv.Status))+" "+ui.DimStyle().Render(code))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// ViewString returns the view content as a string
+func (v *CommandOutputView) ViewString() string {
+	theme := ui.Current()
+	header := lipgloss.NewStyle().
+		Foreground(theme.TableHeaderText).
+		Background(theme.TableHeader).
+		Padding(0, 1).
+		Width(v.width).
+		Render(fmt.Sprintf("Command Output: %s  %s", v.target.CommandID, v.target.Document))
+
+	status := lipgloss.NewStyle().Foreground(theme.TextDim).Render(v.statusText())
+	out := header + "\n" + lipgloss.NewStyle().Padding(0, 1).Render(status) + "\n"
+
+	if v.err != nil {
+		out += ui.DangerStyle().Render(fmt.Sprintf("Error: %v", v.err)) + "\n"
+	}
+	if len(v.invocations) == 0 {
+		return out
+	}
+	rule := lipgloss.NewStyle().Foreground(theme.TextMuted).Render(strings.Repeat("─", max(v.width, 1)))
+	return out + v.list() + "\n" + rule + "\n" + v.viewport.View()
+}
+
+func (v *CommandOutputView) statusText() string {
+	var parts []string
+	if v.loading {
+		parts = append(parts, v.spinner.View()+" Loading...")
+	}
+	if len(v.invocations) > 0 {
+		done, failed := 0, 0
+		for _, inv := range v.invocations {
+			if inv.Done() {
+				done++
+				if inv.Status != "Success" {
+					failed++
+				}
+			}
+		}
+		parts = append(parts, fmt.Sprintf("%d of %d done", done, len(v.invocations)))
+		if failed > 0 {
+			parts = append(parts, fmt.Sprintf("%d failed", failed))
+		}
+	}
+	if v.message != "" {
+		parts = append(parts, v.message)
+	}
+	return strings.Join(parts, " • ")
+}
+
+// View implements tea.Model
+func (v *CommandOutputView) View() tea.View {
+	return tea.NewView(v.ViewString())
+}
+
+// SetSize implements View
+func (v *CommandOutputView) SetSize(width, height int) tea.Cmd {
+	v.width = width
+	v.height = height
+	v.resize()
+	return nil
+}
+
+func (v *CommandOutputView) resize() {
+	v.viewport.SetWidth(v.width)
+	v.viewport.SetHeight(max(v.height-4-v.listHeight(), 3))
+}
+
+// StatusLine implements View
+func (v *CommandOutputView) StatusLine() string {
+	return "j/k:instance • y:copy stdout • ctrl+r:reload • esc:back"
+}
@@ -0,0 +1,86 @@
+package view
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/aws/aws-sdk-go-v2/service/ssm/types"
+
+	"github.com/clawscli/claws/internal/action"
+	"github.com/clawscli/claws/internal/runcmd"
+)
+
+type mockCommandResource struct {
+	mockResource
+}
+
+func (m *mockCommandResource) CommandTarget() runcmd.Target {
+	return runcmd.Target{CommandID: m.id, Document: runcmd.DocumentShell}
+}
+
+func newTestCommandOutputView(client *fakeRunCmdClient) *CommandOutputView {
+	v := NewCommandOutputView(context.Background(), runcmd.Target{CommandID: "cmd-1", Document: runcmd.DocumentShell, InstanceIDs: []string{"i-1"}})
+	v.client = client
+	v.SetSize(100, 30)
+	return v
+}
+
+func TestCommandOutputView_Running(t *testing.T) {
+	client := &fakeRunCmdClient{status: types.CommandInvocationStatusInProgress}
+	v := newTestCommandOutputView(client)
+
+	_, cmd := v.Update(v.load()())
+	if cmd == nil {
+		t.Error("a running command should schedule a refresh")
+	}
+	out := v.ViewString()
+	for _, want := range []string{"0 of 2 done", "web-1", "InProgress", "hello from i-1"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output missing %q:\n%s", want, out)
+		}
+	}
+
+	client.status = types.CommandInvocationStatusSuccess
+	_, cmd = v.Update(commandRefreshMsg{})
+	if !v.loading || cmd == nil {
+		t.Fatal("refresh should reload")
+	}
+	if _, cmd = v.Update(v.load()()); cmd != nil {
+		t.Error("a finished command should not refresh again")
+	}
+	if !strings.Contains(v.ViewString(), "2 of 2 done • 1 failed") {
+		t.Errorf("status not updated:\n%s", v.ViewString())
+	}
+}
+
+func TestCommandOutputView_SelectInstance(t *testing.T) {
+	v := newTestCommandOutputView(&fakeRunCmdClient{status: types.CommandInvocationStatusSuccess})
+	v.Update(v.load()())
+
+	v.Update(tea.KeyPressMsg{Code: 'j', Text: "j"})
+	out := v.ViewString()
+	for _, want := range []string{"hello from i-2", "stderr", "permission denied", "exit 1"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output missing %q:\n%s", want, out)
+		}
+	}
+
+	if _, cmd := v.Update(tea.KeyPressMsg{Code: 'y', Text: "y"}); cmd == nil {
+		t.Error("y should copy the selected instance's output")
+	}
+}
+
+func TestOpenViewTarget_CommandOutput(t *testing.T) {
+	v, err := openViewTarget(context.Background(), action.TargetCommandOutput, &mockCommandResource{mockResource{id: "cmd-1"}})
+	if err != nil {
+		t.Fatalf("openViewTarget() error = %v", err)
+	}
+	if _, ok := v.(*CommandOutputView); !ok {
+		t.Fatalf("openViewTarget() = %T, want *CommandOutputView", v)
+	}
+	if _, err := openViewTarget(context.Background(), action.TargetCommandOutput, &mockResource{id: "x"}); err == nil {
+		t.Error("expected error for a resource without command output")
+	}
+}
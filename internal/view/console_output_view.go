@@ -0,0 +1,343 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"charm.land/bubbles/v2/spinner"
+	"charm.land/bubbles/v2/textinput"
+	"charm.land/bubbles/v2/viewport"
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+
+	"github.com/clawscli/claws/internal/consoleoutput"
+	"github.com/clawscli/claws/internal/logs"
+	"github.com/clawscli/claws/internal/render"
+	"github.com/clawscli/claws/internal/ui"
+)
+
+// consoleMatchContext is the number of lines kept above a search match
+// when jumping to it.
+const consoleMatchContext = 3
+
+// ConsoleOutputView shows an EC2 instance's serial console output, scrolled
+// to the end where the latest boot messages are. "/" searches it, and n/N
+// step through the matching lines.
+type ConsoleOutputView struct {
+	ctx    context.Context
+	client consoleoutput.Client
+	target consoleoutput.Target
+
+	output  consoleoutput.Output
+	lines   []string
+	loaded  bool
+	loading bool
+	err     error
+
+	searching bool
+	input     textinput.Model
+	term      string
+	matches   []int // indexes of lines containing term
+	match     int
+
+	viewport  viewport.Model
+	width     int
+	height    int
+	spinner   spinner.Model
+	highlight lipgloss.Style
+}
+
+// NewConsoleOutputView creates a ConsoleOutputView for t.
+func NewConsoleOutputView(ctx context.Context, t consoleoutput.Target) *ConsoleOutputView {
+	input := textinput.New()
+	input.Prompt = "search: "
+	input.CharLimit = 256
+
+	theme := ui.Current()
+	return &ConsoleOutputView{
+		ctx:       ctx,
+		target:    t,
+		loading:   true,
+		input:     input,
+		viewport:  viewport.New(),
+		spinner:   ui.NewSpinner(),
+		highlight: lipgloss.NewStyle().Foreground(theme.SelectionText).Background(theme.Selection).Bold(true),
+	}
+}
+
+type consoleOutputMsg struct {
+	output consoleoutput.Output
+	err    error
+}
+
+// Init implements tea.Model
+func (v *ConsoleOutputView) Init() tea.Cmd {
+	return tea.Batch(v.spinner.Tick, v.load())
+}
+
+func (v *ConsoleOutputView) load() tea.Cmd {
+	if v.client == nil {
+		c, err := consoleoutput.NewClient(v.ctx)
+		if err != nil {
+			return func() tea.Msg { return consoleOutputMsg{err: err} }
+		}
+		v.client = c
+	}
+	ctx, client, target := v.ctx, v.client, v.target
+	return func() tea.Msg {
+		output, err := consoleoutput.Load(ctx, client, target)
+		return consoleOutputMsg{output: output, err: err}
+	}
+}
+
+// Update implements tea.Model
+func (v *ConsoleOutputView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case consoleOutputMsg:
+		v.loading = false
+		v.err = msg.err
+		if msg.err != nil {
+			return v, nil
+		}
+		v.output = msg.output
+		v.lines = msg.output.Lines()
+		v.findMatches()
+		v.refresh()
+		v.viewport.GotoBottom()
+		v.loaded = true
+		return v, nil
+
+	case spinner.TickMsg:
+		if v.loading {
+			var cmd tea.Cmd
+			v.spinner, cmd = v.spinner.Update(msg)
+			return v, cmd
+		}
+		return v, nil
+
+	case tea.KeyPressMsg:
+		if v.searching {
+			return v.handleSearchKey(msg)
+		}
+		return v.handleKey(msg)
+	}
+	return v, nil
+}
+
+func (v *ConsoleOutputView) handleKey(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "/":
+		v.searching = true
+		v.input.SetValue(v.term)
+		v.input.CursorEnd()
+		v.resize()
+		return v, v.input.Focus()
+	case "n":
+		v.stepMatch(1)
+		return v, nil
+	case "N":
+		v.stepMatch(-1)
+		return v, nil
+	case "c":
+		v.term = ""
+		v.findMatches()
+		v.refresh()
+		return v, nil
+	case "ctrl+r":
+		if v.loading {
+			return v, nil
+		}
+		v.loading = true
+		return v, tea.Batch(v.spinner.Tick, v.load())
+	case "g", "home":
+		v.viewport.GotoTop()
+		return v, nil
+	case "G", "end":
+		v.viewport.GotoBottom()
+		return v, nil
+	case "j":
+		v.viewport.ScrollDown(1)
+		return v, nil
+	case "k":
+		v.viewport.ScrollUp(1)
+		return v, nil
+	}
+	var cmd tea.Cmd
+	v.viewport, cmd = v.viewport.Update(msg)
+	return v, cmd
+}
+
+func (v *ConsoleOutputView) handleSearchKey(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "esc":
+		v.endSearch()
+		return v, nil
+	case "enter":
+		v.term = strings.TrimSpace(v.input.Value())
+		v.endSearch()
+		v.findMatches()
+		v.refresh()
+		v.jumpFrom(v.viewport.YOffset())
+		return v, nil
+	}
+	var cmd tea.Cmd
+	v.input, cmd = v.input.Update(msg)
+	return v, cmd
+}
+
+func (v *ConsoleOutputView) endSearch() {
+	v.searching = false
+	v.input.Blur()
+	v.resize()
+}
+
+func (v *ConsoleOutputView) findMatches() {
+	v.matches = nil
+	v.match = 0
+	if v.term == "" {
+		return
+	}
+	for i, line := range v.lines {
+		if len(logs.MatchIndexes(line, v.term)) > 0 {
+			v.matches = append(v.matches, i)
+		}
+	}
+}
+
+// jumpFrom shows the first match at or below line, wrapping to the first
+// match.
+func (v *ConsoleOutputView) jumpFrom(line int) {
+	if len(v.matches) == 0 {
+		return
+	}
+	v.match = 0
+	for i, m := range v.matches {
+		if m >= line {
+			v.match = i
+			break
+		}
+	}
+	v.showMatch()
+}
+
+// stepMatch moves to the next (delta 1) or previous (delta -1) match,
+// wrapping around.
+func (v *ConsoleOutputView) stepMatch(delta int) {
+	if len(v.matches) == 0 {
+		return
+	}
+	v.match = (v.match + delta + len(v.matches)) % len(v.matches)
+	v.showMatch()
+}
+
+func (v *ConsoleOutputView) showMatch() {
+	v.viewport.SetYOffset(max(v.matches[v.match]-consoleMatchContext, 0))
+}
+
+func (v *ConsoleOutputView) refresh() {
+	if len(v.lines) == 0 {
+		v.viewport.SetContent(ui.DimStyle().Render("No console output yet"))
+		return
+	}
+	if v.term == "" {
+		v.viewport.SetContent(strings.Join(v.lines, "\n"))
+		return
+	}
+	lines := make([]string, len(v.lines))
+	for i, line := range v.lines {
+		lines[i] = highlightMatches(line, v.term, v.highlight)
+	}
+	v.viewport.SetContent(strings.Join(lines, "\n"))
+}
+
+// ViewString returns the view content as a string
+func (v *ConsoleOutputView) ViewString() string {
+	theme := ui.Current()
+	title := "Console Output: " + v.target.InstanceID
+	if v.target.Name != "" {
+		title = fmt.Sprintf("Console Output: %s (%s)", v.target.Name, v.target.InstanceID)
+	}
+	header := lipgloss.NewStyle().
+		Foreground(theme.TableHeaderText).
+		Background(theme.TableHeader).
+		Padding(0, 1).
+		Width(v.width).
+		Render(title)
+
+	status := lipgloss.NewStyle().Foreground(theme.TextDim).Render(v.statusText())
+	out := header + "\n" + lipgloss.NewStyle().Padding(0, 1).Render(status) + "\n"
+	if v.searching {
+		out += v.input.View() + "\n"
+	}
+
+	if v.err != nil {
+		return out + ui.DangerStyle().Render(fmt.Sprintf("Error: %v", v.err)) + "\n"
+	}
+	if !v.loaded {
+		return out
+	}
+	return out + v.viewport.View()
+}
+
+func (v *ConsoleOutputView) statusText() string {
+	var parts []string
+	if v.loading {
+		parts = append(parts, v.spinner.View()+" Loading console output...")
+	}
+	if v.loaded {
+		if v.output.Latest {
+			parts = append(parts, "latest output")
+		} else {
+			parts = append(parts, "output from the last boot or stop")
+		}
+		if !v.output.Timestamp.IsZero() {
+			parts = append(parts, "captured "+render.FormatAge(v.output.Timestamp)+" ago")
+		}
+		parts = append(parts, fmt.Sprintf("%d lines", len(v.lines)))
+	}
+	if v.term != "" {
+		if len(v.matches) == 0 {
+			parts = append(parts, fmt.Sprintf("no matches for %q", v.term))
+		} else {
+			parts = append(parts, fmt.Sprintf("match %d of %d for %q", v.match+1, len(v.matches), v.term))
+		}
+	}
+	return strings.Join(parts, " • ")
+}
+
+// View implements tea.Model
+func (v *ConsoleOutputView) View() tea.View {
+	return tea.NewView(v.ViewString())
+}
+
+// SetSize implements View
+func (v *ConsoleOutputView) SetSize(width, height int) tea.Cmd {
+	v.width = width
+	v.height = height
+	v.input.SetWidth(max(width-10, 20))
+	v.resize()
+	return nil
+}
+
+func (v *ConsoleOutputView) resize() {
+	reserved := 3
+	if v.searching {
+		reserved++
+	}
+	v.viewport.SetWidth(v.width)
+	v.viewport.SetHeight(max(v.height-reserved, 3))
+}
+
+// StatusLine implements View
+func (v *ConsoleOutputView) StatusLine() string {
+	if v.searching {
+		return "enter:search • esc:cancel"
+	}
+	return "/:search • n/N:next/prev match • c:clear • g/G:top/bottom • ctrl+r:reload • esc:back"
+}
+
+// HasActiveInput implements InputCapture
+func (v *ConsoleOutputView) HasActiveInput() bool {
+	return v.searching
+}
@@ -0,0 +1,117 @@
+package view
+
+import (
+	"context"
+	"encoding/base64"
+	"strings"
+	"testing"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+
+	"github.com/clawscli/claws/internal/action"
+	"github.com/clawscli/claws/internal/consoleoutput"
+)
+
+type fakeConsoleClient struct {
+	text string
+}
+
+func (f *fakeConsoleClient) GetConsoleOutput(_ context.Context, _ *ec2.GetConsoleOutputInput, _ ...func(*ec2.Options)) (*ec2.GetConsoleOutputOutput, error) {
+	return &ec2.GetConsoleOutputOutput{Output: aws.String(base64.StdEncoding.EncodeToString([]byte(f.text)))}, nil
+}
+
+type mockConsoleResource struct {
+	mockResource
+}
+
+func (m *mockConsoleResource) ConsoleTarget() consoleoutput.Target {
+	return consoleoutput.Target{InstanceID: m.id}
+}
+
+func newTestConsoleOutputView(text string) *ConsoleOutputView {
+	v := NewConsoleOutputView(context.Background(), consoleoutput.Target{InstanceID: "i-1", Name: "web"})
+	v.client = &fakeConsoleClient{text: text}
+	v.SetSize(80, 8)
+	v.Update(v.load()())
+	return v
+}
+
+func TestConsoleOutputView_Load(t *testing.T) {
+	var lines []string
+	for i := range 30 {
+		lines = append(lines, "boot line "+string(rune('a'+i%26)))
+	}
+	lines = append(lines, "Kernel panic - not syncing")
+	v := newTestConsoleOutputView(strings.Join(lines, "\r\n"))
+
+	out := v.ViewString()
+	for _, want := range []string{"web (i-1)", "latest output", "31 lines", "Kernel panic"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output missing %q:\n%s", want, out)
+		}
+	}
+	if !v.viewport.AtBottom() {
+		t.Error("the view should start at the end of the output")
+	}
+}
+
+func TestConsoleOutputView_Search(t *testing.T) {
+	var lines []string
+	for i := range 40 {
+		line := "line"
+		if i == 5 || i == 25 {
+			line = "cloud-init ERROR"
+		}
+		lines = append(lines, line)
+	}
+	v := newTestConsoleOutputView(strings.Join(lines, "\n"))
+	v.viewport.GotoTop()
+
+	v.Update(tea.KeyPressMsg{Code: '/', Text: "/"})
+	if !v.HasActiveInput() {
+		t.Fatal("/ should open the search input")
+	}
+	for _, r := range "error" {
+		v.Update(tea.KeyPressMsg{Code: r, Text: string(r)})
+	}
+	v.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+
+	if len(v.matches) != 2 || v.matches[0] != 5 {
+		t.Fatalf("matches = %v", v.matches)
+	}
+	if v.viewport.YOffset() != 5-consoleMatchContext {
+		t.Errorf("YOffset = %d, want the first match in view", v.viewport.YOffset())
+	}
+	if !strings.Contains(v.ViewString(), `match 1 of 2 for "error"`) {
+		t.Errorf("status missing match count:\n%s", v.ViewString())
+	}
+
+	v.Update(tea.KeyPressMsg{Code: 'n', Text: "n"})
+	if v.match != 1 || v.viewport.YOffset() != 25-consoleMatchContext {
+		t.Errorf("n: match = %d, YOffset = %d", v.match, v.viewport.YOffset())
+	}
+	v.Update(tea.KeyPressMsg{Code: 'n', Text: "n"})
+	if v.match != 0 {
+		t.Errorf("n should wrap to the first match, match = %d", v.match)
+	}
+
+	v.Update(tea.KeyPressMsg{Code: 'c', Text: "c"})
+	if v.term != "" || len(v.matches) != 0 {
+		t.Errorf("c should clear the search: term = %q, matches = %v", v.term, v.matches)
+	}
+}
+
+func TestOpenViewTarget_ConsoleOutput(t *testing.T) {
+	v, err := openViewTarget(context.Background(), action.TargetConsoleOutput, &mockConsoleResource{mockResource{id: "i-1"}})
+	if err != nil {
+		t.Fatalf("openViewTarget() error = %v", err)
+	}
+	if _, ok := v.(*ConsoleOutputView); !ok {
+		t.Fatalf("openViewTarget() = %T, want *ConsoleOutputView", v)
+	}
+	if _, err := openViewTarget(context.Background(), action.TargetConsoleOutput, &mockResource{id: "x"}); err == nil {
+		t.Error("expected error for a resource without console output")
+	}
+}
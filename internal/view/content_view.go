@@ -0,0 +1,516 @@
+package view
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"unicode/utf8"
+
+	"charm.land/bubbles/v2/spinner"
+	"charm.land/bubbles/v2/textinput"
+	"charm.land/bubbles/v2/viewport"
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+
+	"github.com/clawscli/claws/internal/render"
+	"github.com/clawscli/claws/internal/ui"
+)
+
+// contentPreviewLimit is the number of bytes read for a preview.
+const contentPreviewLimit = 1 << 20
+
+// maxCSVColumnWidth caps the width of a column in the CSV table.
+const maxCSVColumnWidth = 40
+
+// ContentSource is implemented by resources whose content can be previewed
+// and downloaded, such as S3 objects.
+type ContentSource interface {
+	// ContentName identifies the content, e.g. an s3:// URI. It may be
+	// followed by a space and a qualifier such as a version.
+	ContentName() string
+	// ReadContent reads up to limit bytes and returns the full content size.
+	ReadContent(ctx context.Context, limit int64) (data []byte, size int64, err error)
+	// DownloadContent writes the full content to path, or into path when it
+	// is a directory, and returns the file written and its size.
+	DownloadContent(ctx context.Context, path string) (string, int64, error)
+}
+
+// TailContentSource is implemented by sources whose ReadContent returns the
+// end of the content rather than the start, such as database log files. The
+// preview then opens at the bottom.
+type TailContentSource interface {
+	ContentSource
+	TailContent() bool
+}
+
+type contentFormat int
+
+const (
+	contentText contentFormat = iota
+	contentJSON
+	contentCSV
+	contentBinary
+)
+
+func (f contentFormat) String() string {
+	switch f {
+	case contentJSON:
+		return "JSON"
+	case contentCSV:
+		return "CSV"
+	case contentBinary:
+		return "binary"
+	default:
+		return "text"
+	}
+}
+
+// contentViewStyles holds cached lipgloss styles for performance
+type contentViewStyles struct {
+	header    lipgloss.Style
+	info      lipgloss.Style
+	csvHeader lipgloss.Style
+}
+
+func newContentViewStyles() contentViewStyles {
+	t := ui.Current()
+	return contentViewStyles{
+		header:    lipgloss.NewStyle().Foreground(t.TableHeaderText).Background(t.TableHeader).Padding(0, 1),
+		info:      lipgloss.NewStyle().Foreground(t.TextDim).Padding(0, 1),
+		csvHeader: lipgloss.NewStyle().Foreground(t.Accent).Bold(true),
+	}
+}
+
+// ContentView previews the start of a ContentSource in a viewport.
+// JSON is pretty-printed and CSV is aligned into columns; "r" shows the raw
+// content instead. The full content can be saved to a local path.
+type ContentView struct {
+	ctx    context.Context
+	source ContentSource
+
+	data      []byte
+	size      int64
+	format    contentFormat
+	raw       bool
+	loading   bool
+	err       error
+	message   string
+	saving    bool
+	saveInput textinput.Model
+
+	viewport viewport.Model
+	ready    bool
+	width    int
+	height   int
+	spinner  spinner.Model
+	styles   contentViewStyles
+}
+
+// NewContentView creates a ContentView for source.
+func NewContentView(ctx context.Context, source ContentSource) *ContentView {
+	ti := textinput.New()
+	ti.Prompt = "save to: "
+	ti.CharLimit = 1024
+
+	return &ContentView{
+		ctx:       ctx,
+		source:    source,
+		loading:   true,
+		saveInput: ti,
+		spinner:   ui.NewSpinner(),
+		styles:    newContentViewStyles(),
+	}
+}
+
+type contentLoadedMsg struct {
+	data []byte
+	size int64
+	err  error
+}
+
+type contentSavedMsg struct {
+	path string
+	size int64
+	err  error
+}
+
+// Init implements tea.Model
+func (v *ContentView) Init() tea.Cmd {
+	return tea.Batch(v.spinner.Tick, v.load())
+}
+
+func (v *ContentView) load() tea.Cmd {
+	ctx, source := v.ctx, v.source
+	return func() tea.Msg {
+		data, size, err := source.ReadContent(ctx, contentPreviewLimit)
+		return contentLoadedMsg{data: data, size: size, err: err}
+	}
+}
+
+func (v *ContentView) save(path string) tea.Cmd {
+	ctx, source := v.ctx, v.source
+	return func() tea.Msg {
+		written, size, err := source.DownloadContent(ctx, expandHome(path))
+		return contentSavedMsg{path: written, size: size, err: err}
+	}
+}
+
+// Update implements tea.Model
+func (v *ContentView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case contentLoadedMsg:
+		v.loading = false
+		if msg.err != nil {
+			v.err = msg.err
+			return v, nil
+		}
+		v.data = msg.data
+		v.size = msg.size
+		v.format = detectContentFormat(v.source.ContentName(), v.data)
+		v.setContent()
+		return v, nil
+
+	case contentSavedMsg:
+		if msg.err != nil {
+			v.message = ui.DangerStyle().Render(fmt.Sprintf("Save failed: %v", msg.err))
+		} else {
+			v.message = ui.SuccessStyle().Render(fmt.Sprintf("Saved %s to %s", render.FormatSize(msg.size), msg.path))
+		}
+		return v, nil
+
+	case spinner.TickMsg:
+		if v.loading {
+			var cmd tea.Cmd
+			v.spinner, cmd = v.spinner.Update(msg)
+			return v, cmd
+		}
+		return v, nil
+
+	case tea.KeyPressMsg:
+		if v.saving {
+			return v.handleSaveInput(msg)
+		}
+		if model, cmd, ok := v.handleKey(msg); ok {
+			return model, cmd
+		}
+	}
+
+	var cmd tea.Cmd
+	v.viewport, cmd = v.viewport.Update(msg)
+	return v, cmd
+}
+
+func (v *ContentView) handleKey(msg tea.KeyPressMsg) (tea.Model, tea.Cmd, bool) {
+	switch msg.String() {
+	case "r":
+		v.raw = !v.raw
+		v.setContent()
+		return v, nil, true
+
+	case "w":
+		v.viewport.SoftWrap = !v.viewport.SoftWrap
+		v.viewport.SetXOffset(0)
+		return v, nil, true
+
+	case "s":
+		v.saving = true
+		v.message = ""
+		v.saveInput.SetValue("." + string(filepath.Separator))
+		v.saveInput.CursorEnd()
+		v.saveInput.Focus()
+		v.resizeViewport()
+		return v, textinput.Blink, true
+
+	case "ctrl+r":
+		v.loading = true
+		v.err = nil
+		return v, tea.Batch(v.spinner.Tick, v.load()), true
+
+	case "g", "home":
+		v.viewport.GotoTop()
+		return v, nil, true
+
+	case "G", "end":
+		v.viewport.GotoBottom()
+		return v, nil, true
+
+	case "j":
+		v.viewport.ScrollDown(1)
+		return v, nil, true
+
+	case "k":
+		v.viewport.ScrollUp(1)
+		return v, nil, true
+	}
+	return v, nil, false
+}
+
+func (v *ContentView) handleSaveInput(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "esc":
+		v.endSave()
+		return v, nil
+
+	case "enter":
+		path := strings.TrimSpace(v.saveInput.Value())
+		v.endSave()
+		if path == "" {
+			return v, nil
+		}
+		v.message = "saving..."
+		return v, v.save(path)
+	}
+
+	var cmd tea.Cmd
+	v.saveInput, cmd = v.saveInput.Update(msg)
+	return v, cmd
+}
+
+func (v *ContentView) endSave() {
+	v.saving = false
+	v.saveInput.Blur()
+	v.resizeViewport()
+}
+
+func (v *ContentView) truncated() bool {
+	return int64(len(v.data)) < v.size
+}
+
+func (v *ContentView) tail() bool {
+	t, ok := v.source.(TailContentSource)
+	return ok && t.TailContent()
+}
+
+func (v *ContentView) setContent() {
+	if !v.ready {
+		return
+	}
+	v.viewport.SetContent(v.renderContent())
+	if v.tail() {
+		v.viewport.GotoBottom()
+	} else {
+		v.viewport.GotoTop()
+	}
+}
+
+func (v *ContentView) renderContent() string {
+	if v.format == contentBinary {
+		return ui.DimStyle().Render("Binary content cannot be previewed; press s to save it.")
+	}
+	text := strings.ToValidUTF8(string(v.data), "�")
+	if v.raw {
+		return text
+	}
+	switch v.format {
+	case contentJSON:
+		var buf bytes.Buffer
+		if err := json.Indent(&buf, v.data, "", "  "); err == nil {
+			return buf.String()
+		}
+	case contentCSV:
+		if table, ok := renderCSV(v.data, v.source.ContentName(), v.styles.csvHeader); ok {
+			return table
+		}
+	}
+	return text
+}
+
+// renderCSV aligns CSV records into columns, styling the first row as a
+// header. A truncated last record is dropped.
+func renderCSV(data []byte, name string, headerStyle lipgloss.Style) (string, bool) {
+	r := csv.NewReader(bytes.NewReader(data))
+	r.FieldsPerRecord = -1
+	r.LazyQuotes = true
+	if contentExt(name) == ".tsv" {
+		r.Comma = '\t'
+	}
+
+	var records [][]string
+	for {
+		rec, err := r.Read()
+		if err != nil { // io.EOF, or a record cut off by the preview limit
+			break
+		}
+		records = append(records, rec)
+	}
+	if len(records) == 0 {
+		return "", false
+	}
+
+	var widths []int
+	for _, rec := range records {
+		for i, field := range rec {
+			if i >= len(widths) {
+				widths = append(widths, 0)
+			}
+			widths[i] = max(widths[i], min(lipgloss.Width(field), maxCSVColumnWidth))
+		}
+	}
+
+	var sb strings.Builder
+	for n, rec := range records {
+		cells := make([]string, len(rec))
+		for i, field := range rec {
+			field = strings.ReplaceAll(field, "\n", " ")
+			if lipgloss.Width(field) > maxCSVColumnWidth {
+				field = string([]rune(field)[:maxCSVColumnWidth-1]) + "…"
+			}
+			cells[i] = field + strings.Repeat(" ", max(widths[i]-lipgloss.Width(field), 0))
+		}
+		line := strings.TrimRight(strings.Join(cells, "  "), " ")
+		if n == 0 {
+			line = headerStyle.Render(line)
+		}
+		sb.WriteString(line + "\n")
+	}
+	return sb.String(), true
+}
+
+// detectContentFormat picks a preview format from the name's extension,
+// falling back to sniffing the content.
+func detectContentFormat(name string, data []byte) contentFormat {
+	if isBinaryContent(data) {
+		return contentBinary
+	}
+	switch contentExt(name) {
+	case ".json":
+		return contentJSON
+	case ".csv", ".tsv":
+		return contentCSV
+	}
+	trimmed := bytes.TrimSpace(data)
+	if len(trimmed) > 0 && (trimmed[0] == '{' || trimmed[0] == '[') && json.Valid(trimmed) {
+		return contentJSON
+	}
+	return contentText
+}
+
+// contentExt returns the lowercased extension of a content name.
+func contentExt(name string) string {
+	name, _, _ = strings.Cut(name, " ")
+	return strings.ToLower(filepath.Ext(name))
+}
+
+// isBinaryContent reports whether data looks like binary content.
+// Up to 3 trailing bytes are ignored, since a preview may cut a multi-byte
+// character in half.
+func isBinaryContent(data []byte) bool {
+	if bytes.IndexByte(data, 0) >= 0 {
+		return true
+	}
+	for cut := 0; cut <= 3 && cut <= len(data); cut++ {
+		if utf8.Valid(data[:len(data)-cut]) {
+			return false
+		}
+	}
+	return true
+}
+
+// expandHome expands a leading "~/" to the user's home directory.
+func expandHome(path string) string {
+	if rest, ok := strings.CutPrefix(path, "~/"); ok {
+		if home, err := os.UserHomeDir(); err == nil {
+			return filepath.Join(home, rest)
+		}
+	}
+	return path
+}
+
+// ViewString returns the view content as a string
+func (v *ContentView) ViewString() string {
+	header := v.styles.header.Width(v.width).Render("Preview: " + v.source.ContentName())
+	info := v.styles.info.Render(v.infoLine())
+
+	out := header + "\n" + info + "\n"
+	if v.saving {
+		out += lipgloss.NewStyle().Padding(0, 1).Render(v.saveInput.View()) + "\n"
+	}
+
+	switch {
+	case v.err != nil:
+		return out + ui.DangerStyle().Render(fmt.Sprintf("Error: %v", v.err))
+	case v.loading:
+		return out
+	case !v.ready:
+		return out
+	case len(v.data) == 0:
+		return out + ui.DimStyle().Render("Empty content")
+	}
+	return out + v.viewport.View()
+}
+
+func (v *ContentView) infoLine() string {
+	if v.loading {
+		return v.spinner.View() + " loading"
+	}
+	if v.err != nil {
+		return ""
+	}
+
+	format := v.format.String()
+	if v.raw && v.format != contentBinary {
+		format += " (raw)"
+	}
+	parts := []string{format, render.FormatSize(v.size)}
+	if v.truncated() {
+		shown := "first"
+		if v.tail() {
+			shown = "last"
+		}
+		parts = append(parts, ui.WarningStyle().Render(fmt.Sprintf("showing %s %s", shown, render.FormatSize(int64(len(v.data))))))
+	}
+	if v.message != "" {
+		parts = append(parts, v.message)
+	}
+	return strings.Join(parts, " • ")
+}
+
+// View implements tea.Model
+func (v *ContentView) View() tea.View {
+	return tea.NewView(v.ViewString())
+}
+
+// SetSize implements View
+func (v *ContentView) SetSize(width, height int) tea.Cmd {
+	v.width = width
+	v.height = height
+	if !v.ready {
+		v.viewport = viewport.New(viewport.WithWidth(width), viewport.WithHeight(height))
+		v.ready = true
+		if !v.loading {
+			v.setContent()
+		}
+	}
+	v.resizeViewport()
+	return nil
+}
+
+func (v *ContentView) resizeViewport() {
+	if !v.ready {
+		return
+	}
+	chrome := 2
+	if v.saving {
+		chrome++
+	}
+	v.viewport.SetWidth(v.width)
+	v.viewport.SetHeight(max(v.height-chrome, 3))
+}
+
+// StatusLine implements View
+func (v *ContentView) StatusLine() string {
+	if v.saving {
+		return "enter:save (file or directory) • esc:cancel"
+	}
+	return "r:raw w:wrap s:save ctrl+r:reload q/esc:back"
+}
+
+// HasActiveInput implements InputCapture
+func (v *ContentView) HasActiveInput() bool {
+	return v.saving
+}
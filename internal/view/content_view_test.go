@@ -0,0 +1,167 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	tea "charm.land/bubbletea/v2"
+
+	"github.com/clawscli/claws/internal/action"
+)
+
+type mockContentResource struct {
+	mockResource
+	name       string
+	data       string
+	size       int64
+	downloaded string
+}
+
+func (m *mockContentResource) ContentName() string { return m.name }
+
+func (m *mockContentResource) ReadContent(_ context.Context, limit int64) ([]byte, int64, error) {
+	data := m.data
+	if int64(len(data)) > limit {
+		data = data[:limit]
+	}
+	size := m.size
+	if size == 0 {
+		size = int64(len(m.data))
+	}
+	return []byte(data), size, nil
+}
+
+func (m *mockContentResource) DownloadContent(_ context.Context, path string) (string, int64, error) {
+	m.downloaded = path
+	return path, int64(len(m.data)), nil
+}
+
+func loadContentView(t *testing.T, res *mockContentResource) *ContentView {
+	t.Helper()
+	v := NewContentView(context.Background(), res)
+	v.SetSize(120, 30)
+	v.Update(v.load()())
+	return v
+}
+
+func TestContentView_JSON(t *testing.T) {
+	v := loadContentView(t, &mockContentResource{name: "s3://b/data.json", data: `{"a":1,"b":[2,3]}`})
+	if v.format != contentJSON {
+		t.Fatalf("format = %v, want JSON", v.format)
+	}
+	if got := v.renderContent(); !strings.Contains(got, "\n  \"a\": 1,") {
+		t.Errorf("JSON should be pretty-printed:\n%s", got)
+	}
+
+	v.Update(tea.KeyPressMsg{Code: 'r', Text: "r"})
+	if got := v.renderContent(); got != `{"a":1,"b":[2,3]}` {
+		t.Errorf("raw content = %q", got)
+	}
+}
+
+func TestContentView_CSV(t *testing.T) {
+	v := loadContentView(t, &mockContentResource{name: "s3://b/report.csv", data: "name,count\nalpha,1\nbe,22\n"})
+	if v.format != contentCSV {
+		t.Fatalf("format = %v, want CSV", v.format)
+	}
+	lines := strings.Split(strings.TrimSpace(v.renderContent()), "\n")
+	if len(lines) != 3 || !strings.HasPrefix(lines[1], "alpha  1") || !strings.HasPrefix(lines[2], "be     22") {
+		t.Errorf("CSV should be aligned:\n%s", strings.Join(lines, "\n"))
+	}
+}
+
+func TestContentView_Truncated(t *testing.T) {
+	v := loadContentView(t, &mockContentResource{name: "s3://b/big.log", data: "hello", size: 5 << 20})
+	if !v.truncated() {
+		t.Fatal("truncated() = false for a partial read")
+	}
+	if !strings.Contains(v.infoLine(), "showing first") {
+		t.Errorf("info line should mention the preview limit: %q", v.infoLine())
+	}
+}
+
+type mockTailContentResource struct {
+	mockContentResource
+}
+
+func (m *mockTailContentResource) TailContent() bool { return true }
+
+func TestContentView_Tail(t *testing.T) {
+	var lines []string
+	for i := range 100 {
+		lines = append(lines, fmt.Sprintf("log line %d", i))
+	}
+	res := &mockTailContentResource{mockContentResource{name: "db-1/error/postgresql.log", data: strings.Join(lines, "\n"), size: 5 << 20}}
+	v := NewContentView(context.Background(), res)
+	v.SetSize(120, 30)
+	v.Update(v.load()())
+	if !v.viewport.AtBottom() {
+		t.Error("a tail preview should open at the end")
+	}
+	if !strings.Contains(v.infoLine(), "showing last") {
+		t.Errorf("info line should say the end is shown: %q", v.infoLine())
+	}
+}
+
+func TestContentView_Save(t *testing.T) {
+	res := &mockContentResource{name: "s3://b/a.txt", data: "hello"}
+	v := loadContentView(t, res)
+
+	v.Update(tea.KeyPressMsg{Code: 's', Text: "s"})
+	if !v.HasActiveInput() {
+		t.Fatal("s should open the save prompt")
+	}
+	v.saveInput.SetValue("/tmp/out.txt")
+	_, cmd := v.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+	if v.HasActiveInput() || cmd == nil {
+		t.Fatal("enter should close the prompt and start the download")
+	}
+	v.Update(cmd())
+	if res.downloaded != "/tmp/out.txt" {
+		t.Errorf("downloaded to %q, want /tmp/out.txt", res.downloaded)
+	}
+	if !strings.Contains(v.message, "Saved") {
+		t.Errorf("message = %q", v.message)
+	}
+}
+
+func TestDetectContentFormat(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+		want contentFormat
+	}{
+		{"s3://b/a.json", `{"a":1}`, contentJSON},
+		{"s3://b/a.JSON (v1)", `{"a":`, contentJSON},
+		{"s3://b/a.csv", "a,b\n", contentCSV},
+		{"s3://b/a.tsv", "a\tb\n", contentCSV},
+		{"s3://b/a.txt", "plain", contentText},
+		{"s3://b/noext", ` [1, 2] `, contentJSON},
+		{"s3://b/noext", "[not json", contentText},
+		{"s3://b/a.bin", "ab\x00cd", contentBinary},
+		{"s3://b/a.txt", "caf\xc3", contentText}, // cut multi-byte character
+		{"s3://b/a.bin", "\xff\xfe\xfd\xfc\xfb", contentBinary},
+	}
+	for _, tt := range tests {
+		if got := detectContentFormat(tt.name, []byte(tt.data)); got != tt.want {
+			t.Errorf("detectContentFormat(%q, %q) = %v, want %v", tt.name, tt.data, got, tt.want)
+		}
+	}
+}
+
+func TestOpenViewTarget_Preview(t *testing.T) {
+	res := &mockContentResource{mockResource: mockResource{id: "b/a.txt"}, name: "s3://b/a.txt"}
+	v, err := openViewTarget(context.Background(), action.TargetPreview, res)
+	if err != nil {
+		t.Fatalf("openViewTarget() error = %v", err)
+	}
+	if _, ok := v.(*ContentView); !ok {
+		t.Fatalf("openViewTarget() = %T, want *ContentView", v)
+	}
+
+	if _, err := openViewTarget(context.Background(), action.TargetPreview, &mockResource{id: "x"}); err == nil {
+		t.Error("expected error for a resource without content")
+	}
+}
@@ -0,0 +1,215 @@
+package view
+
+import (
+	"context"
+	"fmt"
+
+	"charm.land/bubbles/v2/textinput"
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+
+	"github.com/clawscli/claws/internal/action"
+	"github.com/clawscli/claws/internal/config"
+	"github.com/clawscli/claws/internal/scale"
+	"github.com/clawscli/claws/internal/ui"
+)
+
+// DesiredCountView sets the desired task count of an ECS service from a
+// numeric input, after confirmation. Blocked in read-only mode.
+type DesiredCountView struct {
+	ctx    context.Context
+	client scale.Client
+	target scale.Target
+
+	editing  bool
+	confirm  bool
+	updating bool
+	input    textinput.Model
+	desired  int32
+	result   string
+	err      error
+
+	width  int
+	height int
+}
+
+// NewDesiredCountView creates a DesiredCountView for t, with the input
+// focused and prefilled with the current desired count.
+func NewDesiredCountView(ctx context.Context, t scale.Target) *DesiredCountView {
+	input := textinput.New()
+	input.Prompt = ""
+	input.CharLimit = 5
+	input.SetValue(fmt.Sprintf("%d", t.Desired))
+
+	v := &DesiredCountView{
+		ctx:     ctx,
+		target:  t,
+		editing: true,
+		input:   input,
+	}
+	v.input.Focus()
+	return v
+}
+
+type desiredCountSetMsg struct {
+	desired int32
+	err     error
+}
+
+// Init implements tea.Model
+func (v *DesiredCountView) Init() tea.Cmd {
+	return textinput.Blink
+}
+
+// Update implements tea.Model
+func (v *DesiredCountView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case desiredCountSetMsg:
+		v.updating = false
+		if msg.err != nil {
+			v.err = msg.err
+			return v, nil
+		}
+		v.result = fmt.Sprintf("Desired count of %s: %d → %d", v.target.Service, v.target.Desired, msg.desired)
+		v.target.Desired = msg.desired
+		return v, nil
+
+	case tea.KeyPressMsg:
+		if v.confirm {
+			switch msg.String() {
+			case "y", "Y":
+				v.confirm = false
+				return v, v.set()
+			case "n", "N", "esc", "q":
+				v.confirm = false
+			}
+			return v, nil
+		}
+		if !v.editing {
+			switch msg.String() {
+			case "e", "enter":
+				v.editing = true
+				v.result = ""
+				return v, v.input.Focus()
+			}
+			return v, nil
+		}
+		switch msg.String() {
+		case "esc":
+			v.editing = false
+			v.input.Blur()
+			return v, nil
+		case "enter":
+			v.askSet()
+			return v, nil
+		}
+		var cmd tea.Cmd
+		v.input, cmd = v.input.Update(msg)
+		return v, cmd
+	}
+	return v, nil
+}
+
+// askSet checks the input and asks for confirmation.
+func (v *DesiredCountView) askSet() {
+	if config.Global().ReadOnly() {
+		v.err = action.ErrReadOnlyDenied
+		return
+	}
+	desired, err := scale.ParseCount(v.input.Value())
+	if err != nil {
+		v.err = err
+		return
+	}
+	if desired == v.target.Desired {
+		v.err = fmt.Errorf("desired count is already %d", desired)
+		return
+	}
+	v.err = nil
+	v.desired = desired
+	v.confirm = true
+}
+
+func (v *DesiredCountView) set() tea.Cmd {
+	if config.Global().ReadOnly() {
+		v.err = action.ErrReadOnlyDenied
+		return nil
+	}
+	if v.client == nil {
+		c, err := scale.NewClient(v.ctx)
+		if err != nil {
+			v.err = err
+			return nil
+		}
+		v.client = c
+	}
+	v.updating = true
+	v.editing = false
+	v.input.Blur()
+	ctx, client, target, desired := v.ctx, v.client, v.target, v.desired
+	return func() tea.Msg {
+		actual, err := scale.SetDesired(ctx, client, target, desired)
+		return desiredCountSetMsg{desired: actual, err: err}
+	}
+}
+
+// ViewString returns the view content as a string
+func (v *DesiredCountView) ViewString() string {
+	theme := ui.Current()
+	header := lipgloss.NewStyle().
+		Foreground(theme.TableHeaderText).
+		Background(theme.TableHeader).
+		Padding(0, 1).
+		Width(v.width).
+		Render("Set Desired Count: " + v.target.Service)
+
+	label := lipgloss.NewStyle().Foreground(theme.TextDim).Width(16)
+	body := label.Render("Cluster") + v.target.Cluster + "\n" +
+		label.Render("Current") + fmt.Sprintf("%d", v.target.Desired) + "\n"
+	if v.editing {
+		label = label.Foreground(theme.Accent).Bold(true)
+	}
+	body += label.Render("Desired count") + v.input.View() + "\n\n"
+
+	switch {
+	case v.confirm:
+		body += ui.WarningStyle().Render(fmt.Sprintf("Set the desired count of %s from %d to %d?", v.target.Service, v.target.Desired, v.desired)) +
+			" " + ui.DimStyle().Render("[y/n]")
+	case v.updating:
+		body += ui.DimStyle().Render("Updating service...")
+	case v.err != nil:
+		body += ui.DangerStyle().Render(fmt.Sprintf("Error: %v", v.err))
+	case v.result != "":
+		body += ui.SuccessStyle().Render(v.result)
+	}
+	return header + "\n" + lipgloss.NewStyle().Padding(0, 1).Render(body)
+}
+
+// View implements tea.Model
+func (v *DesiredCountView) View() tea.View {
+	return tea.NewView(v.ViewString())
+}
+
+// SetSize implements View
+func (v *DesiredCountView) SetSize(width, height int) tea.Cmd {
+	v.width = width
+	v.height = height
+	v.input.SetWidth(10)
+	return nil
+}
+
+// StatusLine implements View
+func (v *DesiredCountView) StatusLine() string {
+	switch {
+	case v.confirm:
+		return "y:confirm • n:cancel"
+	case v.editing:
+		return "enter:set • esc:done editing"
+	}
+	return "e:edit • esc:back"
+}
+
+// HasActiveInput implements InputCapture
+func (v *DesiredCountView) HasActiveInput() bool {
+	return v.editing || v.confirm
+}
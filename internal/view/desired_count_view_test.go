@@ -0,0 +1,112 @@
+package view
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/aws/aws-sdk-go-v2/service/ecs"
+	"github.com/aws/aws-sdk-go-v2/service/ecs/types"
+
+	"github.com/clawscli/claws/internal/action"
+	"github.com/clawscli/claws/internal/config"
+	"github.com/clawscli/claws/internal/scale"
+)
+
+type fakeScaleClient struct {
+	updates []*ecs.UpdateServiceInput
+}
+
+func (f *fakeScaleClient) UpdateService(_ context.Context, in *ecs.UpdateServiceInput, _ ...func(*ecs.Options)) (*ecs.UpdateServiceOutput, error) {
+	f.updates = append(f.updates, in)
+	return &ecs.UpdateServiceOutput{Service: &types.Service{DesiredCount: *in.DesiredCount}}, nil
+}
+
+type mockScaleResource struct {
+	mockResource
+}
+
+func (m *mockScaleResource) ScaleTarget() scale.Target {
+	return scale.Target{Cluster: "prod", Service: m.id, Desired: 2}
+}
+
+// typeCount replaces the input with s.
+func typeCount(v *DesiredCountView, s string) {
+	v.input.SetValue("")
+	for _, r := range s {
+		v.Update(tea.KeyPressMsg{Code: r, Text: string(r)})
+	}
+}
+
+func TestDesiredCountView_Set(t *testing.T) {
+	client := &fakeScaleClient{}
+	v := NewDesiredCountView(context.Background(), scale.Target{Cluster: "prod", Service: "web", Desired: 2})
+	v.client = client
+	v.SetSize(100, 20)
+
+	if v.input.Value() != "2" {
+		t.Errorf("input = %q, want the current count", v.input.Value())
+	}
+
+	typeCount(v, "5")
+	v.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+	if !v.confirm {
+		t.Fatalf("enter should ask for confirmation, err = %v", v.err)
+	}
+	if !strings.Contains(v.ViewString(), "from 2 to 5") {
+		t.Errorf("confirmation should show the change:\n%s", v.ViewString())
+	}
+
+	_, cmd := v.Update(tea.KeyPressMsg{Code: 'y', Text: "y"})
+	if cmd == nil {
+		t.Fatal("confirming should update the service")
+	}
+	v.Update(cmd())
+	if len(client.updates) != 1 || *client.updates[0].DesiredCount != 5 {
+		t.Fatalf("updates = %+v", client.updates)
+	}
+	if v.target.Desired != 5 || !strings.Contains(v.ViewString(), "2 → 5") {
+		t.Errorf("result not shown:\n%s", v.ViewString())
+	}
+	if v.HasActiveInput() {
+		t.Error("input should be released after the update so esc goes back")
+	}
+}
+
+func TestDesiredCountView_Invalid(t *testing.T) {
+	v := NewDesiredCountView(context.Background(), scale.Target{Cluster: "prod", Service: "web", Desired: 2})
+	for _, in := range []string{"x", "2"} {
+		typeCount(v, in)
+		v.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+		if v.confirm || v.err == nil {
+			t.Errorf("input %q should be rejected", in)
+		}
+	}
+}
+
+func TestDesiredCountView_ReadOnly(t *testing.T) {
+	config.Global().SetReadOnly(true)
+	defer config.Global().SetReadOnly(false)
+
+	v := NewDesiredCountView(context.Background(), scale.Target{Cluster: "prod", Service: "web", Desired: 2})
+	typeCount(v, "3")
+	v.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+	if v.confirm || !errors.Is(v.err, action.ErrReadOnlyDenied) {
+		t.Errorf("set should be blocked in read-only mode: confirm = %v, err = %v", v.confirm, v.err)
+	}
+}
+
+func TestOpenViewTarget_DesiredCount(t *testing.T) {
+	v, err := openViewTarget(context.Background(), action.TargetDesiredCount, &mockScaleResource{mockResource{id: "web"}})
+	if err != nil {
+		t.Fatalf("openViewTarget() error = %v", err)
+	}
+	if _, ok := v.(*DesiredCountView); !ok {
+		t.Fatalf("openViewTarget() = %T, want *DesiredCountView", v)
+	}
+	if _, err := openViewTarget(context.Background(), action.TargetDesiredCount, &mockResource{id: "x"}); err == nil {
+		t.Error("expected error for a resource that cannot be scaled")
+	}
+}
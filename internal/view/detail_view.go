@@ -15,6 +15,7 @@ import (
 	"github.com/clawscli/claws/internal/registry"
 	"github.com/clawscli/claws/internal/render"
 	"github.com/clawscli/claws/internal/ui"
+	"github.com/clawscli/claws/internal/usage"
 )
 
 // DetailView displays detailed information about a single resource
@@ -147,6 +148,13 @@ func (d *DetailView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				}
 			}
 		}
+
+		if msg.String() == "U" && d.registry != nil && usage.Global.Supports(d.service, d.resType) {
+			usageView := NewUsageView(d.ctx, d.registry, d.service, d.resType, d.resource)
+			return d, func() tea.Msg {
+				return NavigateMsg{View: usageView}
+			}
+		}
 	}
 
 	// Pass other messages to viewport for scrolling
@@ -250,6 +258,10 @@ func (d *DetailView) StatusLine() string {
 		parts = append(parts, "a:actions")
 	}
 
+	if usage.Global.Supports(d.service, d.resType) {
+		parts = append(parts, "U:used-by")
+	}
+
 	// Add navigation shortcuts
 	if navInfo := d.getNavigationShortcuts(); navInfo != "" {
 		parts = append(parts, navInfo)
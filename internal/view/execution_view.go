@@ -0,0 +1,594 @@
+package view
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"charm.land/bubbles/v2/spinner"
+	"charm.land/bubbles/v2/viewport"
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+
+	"github.com/clawscli/claws/internal/render"
+	"github.com/clawscli/claws/internal/states"
+	"github.com/clawscli/claws/internal/ui"
+)
+
+// executionRefreshInterval is how often a running execution is reloaded.
+const executionRefreshInterval = 5 * time.Second
+
+type executionTab int
+
+const (
+	executionTimeline executionTab = iota
+	executionGraph
+	executionIO
+	executionTabCount
+)
+
+var executionTabNames = []string{"Timeline", "Graph", "Input/Output"}
+
+// ExecutionView shows a Step Functions execution: a timeline of the states
+// it entered with durations and each state's input, output and error, a
+// text graph of the state machine with the path taken, and the execution's
+// own input and output. Running executions reload periodically.
+type ExecutionView struct {
+	ctx    context.Context
+	client states.Client
+	target states.Target
+
+	history  *states.History
+	graph    []states.GraphLine
+	graphErr error
+	loading  bool
+	err      error
+
+	tab    executionTab
+	cursor int
+	offset int
+	detail bool
+
+	pane    viewport.Model // graph and input/output tabs
+	stepBox viewport.Model // step detail
+
+	width   int
+	height  int
+	spinner spinner.Model
+}
+
+// NewExecutionView creates an ExecutionView for t.
+func NewExecutionView(ctx context.Context, t states.Target) *ExecutionView {
+	return &ExecutionView{
+		ctx:     ctx,
+		target:  t,
+		loading: true,
+		pane:    viewport.New(),
+		stepBox: viewport.New(),
+		spinner: ui.NewSpinner(),
+	}
+}
+
+type executionLoadedMsg struct {
+	history states.History
+	err     error
+}
+
+type executionRefreshMsg struct{}
+
+// Init implements tea.Model
+func (v *ExecutionView) Init() tea.Cmd {
+	return tea.Batch(v.spinner.Tick, v.load())
+}
+
+func (v *ExecutionView) load() tea.Cmd {
+	if v.client == nil {
+		c, err := states.NewClient(v.ctx)
+		if err != nil {
+			return func() tea.Msg { return executionLoadedMsg{err: err} }
+		}
+		v.client = c
+	}
+	ctx, client, target := v.ctx, v.client, v.target
+	return func() tea.Msg {
+		h, err := states.Load(ctx, client, target)
+		return executionLoadedMsg{history: h, err: err}
+	}
+}
+
+// Update implements tea.Model
+func (v *ExecutionView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case executionLoadedMsg:
+		v.loading = false
+		v.err = msg.err
+		if msg.err != nil && msg.history.Execution.ARN == "" {
+			return v, nil
+		}
+		first := v.history == nil
+		atEnd := !first && v.cursor >= len(v.history.Steps)-1
+		v.history = &msg.history
+		v.graph, v.graphErr = nil, nil
+		if msg.history.Definition != "" {
+			def, err := states.ParseDefinition(msg.history.Definition)
+			if err != nil {
+				v.graphErr = err
+			} else {
+				v.graph = states.Graph(def, msg.history.Steps)
+			}
+		}
+		if first {
+			// Open on the failing state, or follow the latest one
+			if i := msg.history.FailedStep(); i >= 0 {
+				v.cursor = i
+			} else {
+				v.cursor = max(len(msg.history.Steps)-1, 0)
+			}
+		} else if atEnd {
+			v.cursor = len(msg.history.Steps) - 1
+		}
+		v.cursor = min(v.cursor, max(len(msg.history.Steps)-1, 0))
+		if v.detail {
+			v.setStepDetail()
+		}
+		if msg.history.Execution.Running() {
+			return v, tea.Tick(executionRefreshInterval, func(time.Time) tea.Msg { return executionRefreshMsg{} })
+		}
+		return v, nil
+
+	case executionRefreshMsg:
+		if v.loading {
+			return v, nil
+		}
+		v.loading = true
+		return v, tea.Batch(v.spinner.Tick, v.load())
+
+	case spinner.TickMsg:
+		if v.loading {
+			var cmd tea.Cmd
+			v.spinner, cmd = v.spinner.Update(msg)
+			return v, cmd
+		}
+		return v, nil
+
+	case tea.MouseWheelMsg:
+		switch {
+		case v.detail:
+			var cmd tea.Cmd
+			v.stepBox, cmd = v.stepBox.Update(msg)
+			return v, cmd
+		case v.tab == executionTimeline:
+			if msg.Button == tea.MouseWheelUp {
+				v.moveCursor(-3)
+			} else if msg.Button == tea.MouseWheelDown {
+				v.moveCursor(3)
+			}
+			return v, nil
+		}
+		var cmd tea.Cmd
+		v.pane, cmd = v.pane.Update(msg)
+		return v, cmd
+
+	case tea.KeyPressMsg:
+		if v.detail {
+			return v.handleDetailKey(msg)
+		}
+		return v.handleKey(msg)
+	}
+	return v, nil
+}
+
+func (v *ExecutionView) handleKey(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "tab":
+		v.tab = (v.tab + 1) % executionTabCount
+		return v, nil
+	case "shift+tab":
+		v.tab = (v.tab + executionTabCount - 1) % executionTabCount
+		return v, nil
+	case "1", "2", "3":
+		v.tab = executionTab(msg.String()[0] - '1')
+		return v, nil
+	case "ctrl+r":
+		if !v.loading {
+			v.loading = true
+			return v, tea.Batch(v.spinner.Tick, v.load())
+		}
+		return v, nil
+	}
+
+	if v.tab != executionTimeline {
+		switch msg.String() {
+		case "g":
+			v.pane.GotoTop()
+			return v, nil
+		case "G":
+			v.pane.GotoBottom()
+			return v, nil
+		case "j":
+			v.pane.ScrollDown(1)
+			return v, nil
+		case "k":
+			v.pane.ScrollUp(1)
+			return v, nil
+		}
+		var cmd tea.Cmd
+		v.pane, cmd = v.pane.Update(msg)
+		return v, cmd
+	}
+
+	switch msg.String() {
+	case "j", "down":
+		v.moveCursor(1)
+	case "k", "up":
+		v.moveCursor(-1)
+	case "pgdown":
+		v.moveCursor(v.listHeight())
+	case "pgup":
+		v.moveCursor(-v.listHeight())
+	case "g", "home":
+		v.moveCursor(-v.cursor)
+	case "G", "end":
+		v.moveCursor(len(v.steps()))
+	case "f":
+		if v.history != nil {
+			if i := v.history.FailedStep(); i >= 0 {
+				v.moveCursor(i - v.cursor)
+			}
+		}
+	case "enter", "d":
+		if v.cursor < len(v.steps()) {
+			v.detail = true
+			v.setStepDetail()
+		}
+	}
+	return v, nil
+}
+
+func (v *ExecutionView) handleDetailKey(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "esc", "q", "enter":
+		v.detail = false
+		return v, nil
+	case "]":
+		v.moveCursor(1)
+		v.setStepDetail()
+		return v, nil
+	case "[":
+		v.moveCursor(-1)
+		v.setStepDetail()
+		return v, nil
+	case "g":
+		v.stepBox.GotoTop()
+		return v, nil
+	case "G":
+		v.stepBox.GotoBottom()
+		return v, nil
+	case "j":
+		v.stepBox.ScrollDown(1)
+		return v, nil
+	case "k":
+		v.stepBox.ScrollUp(1)
+		return v, nil
+	}
+	var cmd tea.Cmd
+	v.stepBox, cmd = v.stepBox.Update(msg)
+	return v, cmd
+}
+
+func (v *ExecutionView) steps() []states.Step {
+	if v.history == nil {
+		return nil
+	}
+	return v.history.Steps
+}
+
+func (v *ExecutionView) moveCursor(delta int) {
+	n := len(v.steps())
+	if n == 0 {
+		return
+	}
+	v.cursor = min(max(v.cursor+delta, 0), n-1)
+}
+
+func (v *ExecutionView) listHeight() int {
+	return max(v.height-5, 3)
+}
+
+// setStepDetail shows the selected step's error, input and output.
+func (v *ExecutionView) setStepDetail() {
+	steps := v.steps()
+	if v.cursor >= len(steps) {
+		return
+	}
+	s := steps[v.cursor]
+	d := render.NewDetailBuilder()
+	d.Title(s.Type+" State", s.Name)
+	d.Section("Basic Information")
+	d.Field("Status", s.Status)
+	d.Field("Entered", s.Entered.Format("2006-01-02 15:04:05.000"))
+	if !s.Exited.IsZero() {
+		d.Field("Exited", s.Exited.Format("2006-01-02 15:04:05.000"))
+	}
+	d.Field("Duration", formatStepDuration(s.Duration()))
+	if s.Failed() {
+		d.Section("Error")
+		d.Field("Error", s.Error)
+		if s.Cause != "" {
+			d.Line(prettyJSON(s.Cause))
+		}
+	}
+	d.Section("Input")
+	d.Line(prettyJSON(s.Input))
+	if !s.Exited.IsZero() {
+		d.Section("Output")
+		d.Line(prettyJSON(s.Output))
+	}
+	v.stepBox.SetContent(d.String())
+	v.stepBox.GotoTop()
+}
+
+// paneContent renders the graph or input/output tab.
+func (v *ExecutionView) paneContent() string {
+	if v.history == nil {
+		return ""
+	}
+	if v.tab == executionIO {
+		return v.ioContent()
+	}
+	return v.graphContent()
+}
+
+func (v *ExecutionView) ioContent() string {
+	e := v.history.Execution
+	d := render.NewDetailBuilder()
+	d.Section("Execution")
+	d.Field("Status", e.Status)
+	d.Field("State Machine", e.StateMachine[strings.LastIndex(e.StateMachine, ":")+1:])
+	if !e.Start.IsZero() {
+		d.Field("Started", e.Start.Format("2006-01-02 15:04:05"))
+	}
+	if !e.Stop.IsZero() {
+		d.Field("Stopped", e.Stop.Format("2006-01-02 15:04:05"))
+	}
+	d.Field("Duration", render.FormatDuration(e.Duration()))
+	if e.RedriveCount > 0 {
+		d.Field("Redrives", fmt.Sprintf("%d", e.RedriveCount))
+	}
+	if e.Error != "" {
+		d.Section("Error")
+		d.Field("Error", e.Error)
+		if e.Cause != "" {
+			d.Line(prettyJSON(e.Cause))
+		}
+	}
+	d.Section("Input")
+	d.Line(prettyJSON(e.Input))
+	if e.Output != "" {
+		d.Section("Output")
+		d.Line(prettyJSON(e.Output))
+	}
+	return d.String()
+}
+
+func (v *ExecutionView) graphContent() string {
+	if v.graphErr != nil {
+		return ui.DangerStyle().Render(v.graphErr.Error())
+	}
+	theme := ui.Current()
+	accent := lipgloss.NewStyle().Foreground(theme.Accent)
+	dim := ui.DimStyle()
+
+	var out strings.Builder
+	for _, l := range v.graph {
+		out.WriteString(strings.Repeat("  ", l.Depth))
+		if l.Branch() {
+			out.WriteString(dim.Render("┊ " + l.Name))
+			out.WriteString("\n")
+			continue
+		}
+		name := l.Name
+		if l.Visits > 0 {
+			name = lipgloss.NewStyle().Bold(true).Render(name)
+		}
+		out.WriteString(stepMarker(l.Status) + " " + name + dim.Render(" ("+l.Type+")"))
+		if l.Visits > 1 {
+			out.WriteString(dim.Render(fmt.Sprintf(" ×%d", l.Visits)))
+		}
+		for _, t := range l.Transitions {
+			label := "→ " + t.Target
+			if t.Kind != states.TransitionNext {
+				label = "→ " + t.Kind + ": " + t.Target
+			}
+			if t.Taken {
+				out.WriteString("  " + accent.Render(strings.Replace(label, "→", "⇒", 1)))
+			} else {
+				out.WriteString("  " + dim.Render(label))
+			}
+		}
+		if l.End {
+			out.WriteString(dim.Render("  ■ end"))
+		}
+		out.WriteString("\n")
+	}
+	return out.String()
+}
+
+// stepMarker returns the symbol for a step status; "" marks a state that
+// was not entered.
+func stepMarker(status string) string {
+	switch status {
+	case states.StatusSucceeded:
+		return ui.SuccessStyle().Render("✓")
+	case states.StatusFailed:
+		return ui.DangerStyle().Render("✗")
+	case states.StatusRunning:
+		return ui.WarningStyle().Render("▶")
+	case states.StatusAborted:
+		return ui.WarningStyle().Render("⊘")
+	}
+	return ui.DimStyle().Render("·")
+}
+
+func formatStepDuration(d time.Duration) string {
+	if d < time.Minute {
+		return d.Round(time.Millisecond).String()
+	}
+	return render.FormatDuration(d)
+}
+
+// prettyJSON formats JSON string with indentation
+func prettyJSON(s string) string {
+	var buf bytes.Buffer
+	if err := json.Indent(&buf, []byte(s), "", "  "); err != nil {
+		return s
+	}
+	return buf.String()
+}
+
+func (v *ExecutionView) timelineView() string {
+	steps := v.steps()
+	if len(steps) == 0 {
+		return ui.DimStyle().Render("No states entered yet")
+	}
+
+	height := v.listHeight()
+	if v.cursor < v.offset {
+		v.offset = v.cursor
+	}
+	if v.cursor >= v.offset+height {
+		v.offset = v.cursor - height + 1
+	}
+	v.offset = min(v.offset, max(len(steps)-height, 0))
+
+	theme := ui.Current()
+	selected := lipgloss.NewStyle().Foreground(theme.SelectionText).Background(theme.Selection)
+	nameWidth := 12
+	for _, s := range steps {
+		nameWidth = max(nameWidth, min(lipgloss.Width(s.Name), 40))
+	}
+	start := v.history.Execution.Start
+	failed := v.history.FailedStep()
+
+	header := fmt.Sprintf("  %-10s  %-*s  %-9s  %-9s  %s", "AT", nameWidth, "STATE", "TYPE", "STATUS", "DURATION")
+	lines := []string{ui.DimStyle().Render(header)}
+	for i := v.offset; i < min(v.offset+height, len(steps)); i++ {
+		s := steps[i]
+		at := ""
+		if !start.IsZero() {
+			at = "+" + formatStepDuration(s.Entered.Sub(start))
+		}
+		text := fmt.Sprintf("%-10s  %-*s  %-9s  %-9s  %s", at, nameWidth, truncateValue(s.Name, nameWidth), s.Type, s.Status, formatStepDuration(s.Duration()))
+		if s.Failed() {
+			text += "  " + s.Error
+		}
+		switch {
+		case i == v.cursor:
+			lines = append(lines, stepMarker(s.Status)+" "+selected.Render(text))
+		case i == failed:
+			lines = append(lines, stepMarker(s.Status)+" "+ui.DangerStyle().Bold(true).Render(text))
+		case s.Failed():
+			lines = append(lines, stepMarker(s.Status)+" "+ui.DangerStyle().Render(text))
+		default:
+			lines = append(lines, stepMarker(s.Status)+" "+text)
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// ViewString returns the view content as a string
+func (v *ExecutionView) ViewString() string {
+	theme := ui.Current()
+	header := lipgloss.NewStyle().
+		Foreground(theme.TableHeaderText).
+		Background(theme.TableHeader).
+		Padding(0, 1).
+		Width(v.width).
+		Render("Step Functions Execution: " + v.target.Name)
+
+	var tabs []string
+	for i, name := range executionTabNames {
+		if executionTab(i) == v.tab {
+			tabs = append(tabs, lipgloss.NewStyle().Foreground(theme.Accent).Bold(true).Render("["+name+"]"))
+		} else {
+			tabs = append(tabs, ui.DimStyle().Render(" "+name+" "))
+		}
+	}
+	status := lipgloss.NewStyle().Foreground(theme.TextDim).Render(v.statusText())
+	out := header + "\n" + lipgloss.NewStyle().Padding(0, 1).Render(strings.Join(tabs, " ")+"  "+status) + "\n"
+
+	if v.err != nil {
+		out += ui.DangerStyle().Render(fmt.Sprintf("Error: %v", v.err)) + "\n"
+	}
+	if v.history == nil {
+		return out
+	}
+
+	switch {
+	case v.detail:
+		return out + v.stepBox.View()
+	case v.tab == executionTimeline:
+		return out + v.timelineView()
+	}
+	v.pane.SetContent(v.paneContent())
+	return out + v.pane.View()
+}
+
+func (v *ExecutionView) statusText() string {
+	if v.history == nil {
+		if v.loading {
+			return v.spinner.View() + " Loading history..."
+		}
+		return ""
+	}
+	e := v.history.Execution
+	text := fmt.Sprintf("%s • %d states • %s", e.Status, len(v.history.Steps), render.FormatDuration(e.Duration()))
+	if v.history.Truncated {
+		text += " • history truncated"
+	}
+	if v.loading {
+		text = v.spinner.View() + " " + text
+	}
+	return text
+}
+
+// View implements tea.Model
+func (v *ExecutionView) View() tea.View {
+	return tea.NewView(v.ViewString())
+}
+
+// SetSize implements View
+func (v *ExecutionView) SetSize(width, height int) tea.Cmd {
+	v.width = width
+	v.height = height
+	v.pane.SetWidth(width)
+	v.pane.SetHeight(max(height-3, 3))
+	v.stepBox.SetWidth(width)
+	v.stepBox.SetHeight(max(height-3, 3))
+	return nil
+}
+
+// StatusLine implements View
+func (v *ExecutionView) StatusLine() string {
+	if v.detail {
+		return "[/]:prev/next state • j/k:scroll • esc:back"
+	}
+	if v.tab == executionTimeline {
+		parts := []string{"enter:state details"}
+		if v.history != nil && v.history.FailedStep() >= 0 {
+			parts = append(parts, "f:failed state")
+		}
+		parts = append(parts, "tab:graph", "ctrl+r:reload")
+		return strings.Join(parts, " ") + " • esc:back"
+	}
+	return "tab:next view • j/k:scroll • ctrl+r:reload • esc:back"
+}
+
+// HasActiveInput implements InputCapture. The state detail handles esc
+// itself to return to the timeline.
+func (v *ExecutionView) HasActiveInput() bool {
+	return v.detail
+}
@@ -0,0 +1,193 @@
+package view
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sfn"
+	"github.com/aws/aws-sdk-go-v2/service/sfn/types"
+
+	"github.com/clawscli/claws/internal/action"
+	"github.com/clawscli/claws/internal/config"
+	"github.com/clawscli/claws/internal/states"
+)
+
+type fakeStatesClient struct {
+	started []*sfn.StartExecutionInput
+}
+
+func (f *fakeStatesClient) DescribeExecution(_ context.Context, in *sfn.DescribeExecutionInput, _ ...func(*sfn.Options)) (*sfn.DescribeExecutionOutput, error) {
+	start := time.Now().Add(-time.Minute)
+	stop := start.Add(3 * time.Second)
+	return &sfn.DescribeExecutionOutput{
+		ExecutionArn:    in.ExecutionArn,
+		StateMachineArn: aws.String("arn:aws:states:us-east-1:1:stateMachine:orders"),
+		Name:            aws.String("run-1"),
+		Status:          types.ExecutionStatusFailed,
+		StartDate:       &start,
+		StopDate:        &stop,
+		Input:           aws.String(`{"id":1}`),
+		Error:           aws.String("PaymentDeclined"),
+	}, nil
+}
+
+func (f *fakeStatesClient) GetExecutionHistory(_ context.Context, _ *sfn.GetExecutionHistoryInput, _ ...func(*sfn.Options)) (*sfn.GetExecutionHistoryOutput, error) {
+	t := time.Now().Add(-time.Minute)
+	at := func(sec int) *time.Time {
+		ts := t.Add(time.Duration(sec) * time.Second)
+		return &ts
+	}
+	return &sfn.GetExecutionHistoryOutput{Events: []types.HistoryEvent{
+		{Id: 1, Timestamp: at(0), Type: types.HistoryEventTypeExecutionStarted},
+		{Id: 2, Timestamp: at(0), Type: types.HistoryEventTypeTaskStateEntered,
+			StateEnteredEventDetails: &types.StateEnteredEventDetails{Name: aws.String("Validate"), Input: aws.String(`{"id":1}`)}},
+		{Id: 3, Timestamp: at(1), Type: types.HistoryEventTypeTaskStateExited,
+			StateExitedEventDetails: &types.StateExitedEventDetails{Name: aws.String("Validate"), Output: aws.String(`{"id":1}`)}},
+		{Id: 4, Timestamp: at(1), Type: types.HistoryEventTypeTaskStateEntered,
+			StateEnteredEventDetails: &types.StateEnteredEventDetails{Name: aws.String("Charge"), Input: aws.String(`{"id":1}`)}},
+		{Id: 5, Timestamp: at(3), Type: types.HistoryEventTypeTaskFailed,
+			TaskFailedEventDetails: &types.TaskFailedEventDetails{Error: aws.String("PaymentDeclined"), Cause: aws.String("card expired")}},
+		{Id: 6, Timestamp: at(3), Type: types.HistoryEventTypeExecutionFailed,
+			ExecutionFailedEventDetails: &types.ExecutionFailedEventDetails{Error: aws.String("PaymentDeclined")}},
+	}}, nil
+}
+
+func (f *fakeStatesClient) DescribeStateMachineForExecution(_ context.Context, _ *sfn.DescribeStateMachineForExecutionInput, _ ...func(*sfn.Options)) (*sfn.DescribeStateMachineForExecutionOutput, error) {
+	return &sfn.DescribeStateMachineForExecutionOutput{Definition: aws.String(`{
+		"StartAt": "Validate",
+		"States": {
+			"Validate": {"Type": "Task", "Next": "Charge"},
+			"Charge": {"Type": "Task", "Catch": [{"ErrorEquals": ["Retry"], "Next": "Refund"}], "End": true},
+			"Refund": {"Type": "Pass", "End": true}
+		}
+	}`)}, nil
+}
+
+func (f *fakeStatesClient) StartExecution(_ context.Context, in *sfn.StartExecutionInput, _ ...func(*sfn.Options)) (*sfn.StartExecutionOutput, error) {
+	f.started = append(f.started, in)
+	return &sfn.StartExecutionOutput{ExecutionArn: aws.String("arn:aws:states:us-east-1:1:execution:orders:run-2")}, nil
+}
+
+type mockExecutionResource struct {
+	mockResource
+}
+
+func (m *mockExecutionResource) ExecutionTarget() states.Target {
+	return states.Target{ExecutionARN: "arn:aws:states:us-east-1:1:execution:orders:" + m.id, Name: m.id}
+}
+
+func newTestExecutionView(client *fakeStatesClient) *ExecutionView {
+	v := NewExecutionView(context.Background(), states.Target{ExecutionARN: "arn:aws:states:us-east-1:1:execution:orders:run-1", Name: "run-1"})
+	v.client = client
+	v.SetSize(120, 30)
+	v.Update(v.load()())
+	return v
+}
+
+func TestExecutionView_Timeline(t *testing.T) {
+	v := newTestExecutionView(&fakeStatesClient{})
+
+	if len(v.history.Steps) != 2 || v.cursor != 1 {
+		t.Fatalf("steps = %d, cursor = %d: should open on the failed state", len(v.history.Steps), v.cursor)
+	}
+	out := v.ViewString()
+	for _, want := range []string{"Validate", "Charge", "FAILED", "PaymentDeclined"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("timeline missing %q:\n%s", want, out)
+		}
+	}
+
+	v.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+	if !v.detail || !v.HasActiveInput() {
+		t.Fatal("enter should open the state detail")
+	}
+	out = v.ViewString()
+	if !strings.Contains(out, "card expired") || !strings.Contains(out, `"id": 1`) {
+		t.Errorf("state detail should show the cause and input:\n%s", out)
+	}
+	v.Update(tea.KeyPressMsg{Code: tea.KeyEscape})
+	if v.detail {
+		t.Error("esc should close the state detail")
+	}
+}
+
+func TestExecutionView_Graph(t *testing.T) {
+	v := newTestExecutionView(&fakeStatesClient{})
+
+	v.Update(tea.KeyPressMsg{Code: tea.KeyTab})
+	if v.tab != executionGraph {
+		t.Fatalf("tab = %v, want graph", v.tab)
+	}
+	out := v.ViewString()
+	if !strings.Contains(out, "⇒ Charge") {
+		t.Errorf("graph should mark Validate → Charge as taken:\n%s", out)
+	}
+	if !strings.Contains(out, "→ catch: Refund") || strings.Contains(out, "⇒ catch: Refund") {
+		t.Errorf("graph should show the untaken catch edge:\n%s", out)
+	}
+
+	v.Update(tea.KeyPressMsg{Code: tea.KeyTab})
+	if out := v.ViewString(); !strings.Contains(out, "PaymentDeclined") || !strings.Contains(out, "orders") {
+		t.Errorf("input/output tab missing execution error:\n%s", out)
+	}
+}
+
+func TestStartExecutionView(t *testing.T) {
+	client := &fakeStatesClient{}
+	v := NewStartExecutionView(context.Background(), states.Machine{ARN: "arn:aws:states:us-east-1:1:stateMachine:orders", Name: "orders"})
+	v.client = client
+	v.SetSize(120, 30)
+
+	v.editor.SetValue(`{"id": `)
+	v.Update(tea.KeyPressMsg{Code: 's', Mod: tea.ModCtrl})
+	if v.confirm || v.err == nil {
+		t.Fatal("invalid JSON input should not be started")
+	}
+
+	v.editor.SetValue(`{"id": 2}`)
+	v.Update(tea.KeyPressMsg{Code: 's', Mod: tea.ModCtrl})
+	if !v.confirm {
+		t.Fatalf("ctrl+s should ask for confirmation (err = %v)", v.err)
+	}
+	_, cmd := v.Update(tea.KeyPressMsg{Code: 'y', Text: "y"})
+	_, cmd = v.Update(cmd())
+	if len(client.started) != 1 || aws.ToString(client.started[0].Input) != `{"id": 2}` {
+		t.Fatalf("started = %+v", client.started)
+	}
+	nav, ok := cmd().(NavigateMsg)
+	if !ok {
+		t.Fatal("starting should navigate to the execution")
+	}
+	if ev, ok := nav.View.(*ExecutionView); !ok || ev.target.Name != "run-2" {
+		t.Errorf("navigated to %T %+v", nav.View, nav.View)
+	}
+}
+
+func TestStartExecutionView_ReadOnly(t *testing.T) {
+	config.Global().SetReadOnly(true)
+	defer config.Global().SetReadOnly(false)
+
+	v := NewStartExecutionView(context.Background(), states.Machine{Name: "orders"})
+	v.Update(tea.KeyPressMsg{Code: 's', Mod: tea.ModCtrl})
+	if v.confirm || !errors.Is(v.err, action.ErrReadOnlyDenied) {
+		t.Errorf("start should be blocked in read-only mode: confirm = %v, err = %v", v.confirm, v.err)
+	}
+}
+
+func TestOpenViewTarget_Execution(t *testing.T) {
+	v, err := openViewTarget(context.Background(), action.TargetExecution, &mockExecutionResource{mockResource{id: "run-1"}})
+	if err != nil {
+		t.Fatalf("openViewTarget() error = %v", err)
+	}
+	if _, ok := v.(*ExecutionView); !ok {
+		t.Fatalf("openViewTarget() = %T, want *ExecutionView", v)
+	}
+	if _, err := openViewTarget(context.Background(), action.TargetStartExecution, &mockResource{id: "x"}); err == nil {
+		t.Error("expected error for a resource that is not a state machine")
+	}
+}
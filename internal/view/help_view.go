@@ -84,6 +84,7 @@ func (h *HelpView) renderContent() string {
 	out += s.key.Render("c") + s.desc.Render("Clear filter") + "\n"
 	out += s.key.Render("Ctrl+r") + s.desc.Render("Refresh resources") + "\n"
 	out += s.key.Render("a") + s.desc.Render("Show actions menu") + "\n"
+	out += s.key.Render("U") + s.desc.Render("Show resources using this one") + "\n"
 
 	// Filter Syntax
 	out += "\n" + s.section.Render("Filter Syntax") + "\n"
@@ -108,6 +109,12 @@ func (h *HelpView) renderContent() string {
 	out += s.key.Render(":tags") + s.desc.Render("Browse all tagged resources") + "\n"
 	out += s.key.Render(":tags Env=prod") + s.desc.Render("Browse with tag filter") + "\n"
 
+	// Search Commands
+	out += "\n" + s.section.Render("Search Commands") + "\n"
+	out += s.key.Render(":search text") + s.desc.Render("Search all resources by ID/name/ARN/IP") + "\n"
+	out += s.key.Render(":search x in:ec2") + s.desc.Render("Limit search to services/types") + "\n"
+	out += s.key.Render(":arn <arn|url>") + s.desc.Render("Open resource by ARN or console URL") + "\n"
+
 	// Diff Commands
 	out += "\n" + s.section.Render("Compare Resources") + "\n"
 	out += s.key.Render("m") + s.desc.Render("Mark resource for comparison") + "\n"
@@ -115,9 +122,112 @@ func (h *HelpView) renderContent() string {
 	out += s.key.Render(":diff name") + s.desc.Render("Compare current row with named resource") + "\n"
 	out += s.key.Render(":diff a b") + s.desc.Render("Compare two named resources") + "\n"
 
+	// Logs Insights
+	out += "\n" + s.section.Render("Logs Insights") + "\n"
+	out += s.key.Render(":insights") + s.desc.Render("Query the selected log groups") + "\n"
+	out += s.key.Render(":insights /a,/b") + s.desc.Render("Query the given log groups") + "\n"
+	out += s.key.Render("ctrl+r") + s.desc.Render("Run query") + "\n"
+	out += s.key.Render("ctrl+o / S") + s.desc.Render("Browse saved queries") + "\n"
+	out += s.key.Render("y / Y / E") + s.desc.Render("Copy CSV / copy JSON / export CSV file") + "\n"
+
+	// Log viewer
+	out += "\n" + s.section.Render("Log Viewer") + "\n"
+	out += s.key.Render("f") + s.desc.Render("Follow new events (live tail)") + "\n"
+	out += s.key.Render("space") + s.desc.Render("Pause/resume following") + "\n"
+	out += s.key.Render("1-8") + s.desc.Render("Time range: 5m 15m 1h 3h 12h 24h 3d 7d") + "\n"
+	out += s.key.Render("/") + s.desc.Render("Filter pattern (server-side)") + "\n"
+	out += s.key.Render("H") + s.desc.Render("Highlight text") + "\n"
+	out += s.key.Render("J / s / w") + s.desc.Render("Toggle JSON formatting / streams / wrap") + "\n"
+	out += s.key.Render("N") + s.desc.Render("Load more events") + "\n"
+
+	// Content preview
+	out += "\n" + s.section.Render("Content Preview") + "\n"
+	out += s.key.Render("r") + s.desc.Render("Toggle raw / formatted JSON and CSV") + "\n"
+	out += s.key.Render("s") + s.desc.Render("Save to a local file or directory") + "\n"
+	out += s.key.Render("w") + s.desc.Render("Toggle wrap") + "\n"
+
+	// DynamoDB item explorer
+	out += "\n" + s.section.Render("DynamoDB Items") + "\n"
+	out += s.key.Render("e") + s.desc.Render("Edit query: index, key condition, filter") + "\n"
+	out += s.key.Render("N") + s.desc.Render("Load more items") + "\n"
+	out += s.key.Render("J") + s.desc.Render("Toggle plain / DynamoDB JSON") + "\n"
+	out += s.key.Render("n/E") + s.desc.Render("New / edit item (not in read-only mode)") + "\n"
+	out += s.key.Render("D") + s.desc.Render("Delete item (not in read-only mode)") + "\n"
+
+	out += "\n" + s.section.Render("Lambda Invoke") + "\n"
+	out += s.key.Render("ctrl+r") + s.desc.Render("Invoke with the payload (not in read-only mode)") + "\n"
+	out += s.key.Render("ctrl+x") + s.desc.Render("Dry run: validate parameters and permissions") + "\n"
+	out += s.key.Render("tab") + s.desc.Render("Next qualifier: $LATEST, versions, aliases") + "\n"
+	out += s.key.Render("ctrl+s/o") + s.desc.Render("Save payload as event / load saved event") + "\n"
+	out += s.key.Render("ctrl+l") + s.desc.Render("Format payload JSON") + "\n"
+
+	out += "\n" + s.section.Render("Step Functions Execution") + "\n"
+	out += s.key.Render("tab/1-3") + s.desc.Render("Timeline / graph / input and output") + "\n"
+	out += s.key.Render("enter") + s.desc.Render("State input, output and error") + "\n"
+	out += s.key.Render("f") + s.desc.Render("Jump to the failed state") + "\n"
+
+	out += "\n" + s.section.Render("Stack Template") + "\n"
+	out += s.key.Render("tab/1-2") + s.desc.Render("Template / parameters") + "\n"
+	out += s.key.Render("p") + s.desc.Render("Original / processed template (transforms)") + "\n"
+	out += s.key.Render("y") + s.desc.Render("Copy template") + "\n"
+
+	out += "\n" + s.section.Render("IAM Policies") + "\n"
+	out += s.key.Render("tab/1-4") + s.desc.Render("Documents / effective / trust / simulate") + "\n"
+	out += s.key.Render("e") + s.desc.Render("Edit simulated actions and resource") + "\n"
+	out += s.key.Render("enter") + s.desc.Render("Run the simulation") + "\n"
+
+	out += "\n" + s.section.Render("Reachability") + "\n"
+	out += s.key.Render("tab") + s.desc.Render("Destination / port / protocol") + "\n"
+	out += s.key.Render("enter") + s.desc.Render("Evaluate SGs, NACLs and routes") + "\n"
+	out += s.key.Render("A") + s.desc.Render("Run a Reachability Analyzer path") + "\n"
+
+	out += "\n" + s.section.Render("Task Definition Diff") + "\n"
+	out += s.key.Render("[ / ]") + s.desc.Render("Compare with an older / newer revision") + "\n"
+
+	out += "\n" + s.section.Render("Set Desired Count") + "\n"
+	out += s.key.Render("enter") + s.desc.Render("Set the typed count (asks to confirm)") + "\n"
+	out += s.key.Render("e") + s.desc.Render("Edit the count again") + "\n"
+
+	out += "\n" + s.section.Render("Secret Value") + "\n"
+	out += s.key.Render("v") + s.desc.Render("Reveal / hide values") + "\n"
+	out += s.key.Render("y / Y") + s.desc.Render("Copy selected row / whole value") + "\n"
+	out += s.key.Render("[ / ]") + s.desc.Render("Older / newer version") + "\n"
+	out += s.key.Render("d") + s.desc.Render("Diff with the previous version") + "\n"
+
+	out += "\n" + s.section.Render("Put New Version") + "\n"
+	out += s.key.Render("ctrl+s") + s.desc.Render("Review changes and save") + "\n"
+	out += s.key.Render("ctrl+e") + s.desc.Render("Edit in $EDITOR") + "\n"
+	out += s.key.Render("v") + s.desc.Render("Reveal values while reviewing") + "\n"
+
+	out += "\n" + s.section.Render("Run Command") + "\n"
+	out += s.key.Render("tab") + s.desc.Render("Next field: command, instances, document") + "\n"
+	out += s.key.Render("ctrl+s") + s.desc.Render("Run on the instances (asks to confirm)") + "\n"
+	out += s.key.Render("j / k") + s.desc.Render("Select instance in the output") + "\n"
+	out += s.key.Render("y") + s.desc.Render("Copy the instance's stdout") + "\n"
+
+	out += "\n" + s.section.Render("Console Output") + "\n"
+	out += s.key.Render("/") + s.desc.Render("Search the output") + "\n"
+	out += s.key.Render("n / N") + s.desc.Render("Next / previous match") + "\n"
+	out += s.key.Render("c") + s.desc.Render("Clear the search") + "\n"
+
+	out += "\n" + s.section.Render("Port Forward") + "\n"
+	out += s.key.Render("j / k") + s.desc.Render("Select the bastion instance") + "\n"
+	out += s.key.Render("p") + s.desc.Render("Edit the local port") + "\n"
+	out += s.key.Render("enter") + s.desc.Render("Start the tunnel") + "\n"
+	out += s.key.Render(":tunnels") + s.desc.Render("List running tunnels") + "\n"
+	out += s.key.Render("x / d") + s.desc.Render("Stop / remove a tunnel") + "\n"
+
+	// Action input forms
+	out += "\n" + s.section.Render("Action Input") + "\n"
+	out += s.key.Render("tab / ↑ ↓") + s.desc.Render("Move between fields") + "\n"
+	out += s.key.Render("← / →") + s.desc.Render("Cycle the options of a choice") + "\n"
+	out += s.key.Render("enter") + s.desc.Render("Validate and continue to confirmation") + "\n"
+
 	// Actions
 	out += "\n" + s.section.Render("Actions (EC2 Instances)") + "\n"
 	out += s.key.Render("x") + s.desc.Render("SSM Session") + "\n"
+	out += s.key.Render("c") + s.desc.Render("Run Command") + "\n"
+	out += s.key.Render("o") + s.desc.Render("Console Output") + "\n"
 	out += s.key.Render("s") + s.desc.Render("SSH") + "\n"
 	out += s.key.Render("S") + s.desc.Render("Stop instance") + "\n"
 	out += s.key.Render("R") + s.desc.Render("Start instance") + "\n"
@@ -131,6 +241,8 @@ func (h *HelpView) renderContent() string {
 	out += s.key.Render("Subnet") + s.desc.Render("v:VPC e:Instances") + "\n"
 	out += s.key.Render("Instance") + s.desc.Render("v:VPC u:Subnet g:SecurityGroups") + "\n"
 	out += s.key.Render("SecurityGroup") + s.desc.Render("v:VPC e:Instances") + "\n"
+	out += s.key.Render("Stack") + s.desc.Render("e:Events r:Resources o:Outputs s:ChangeSets f:Drifts n:Nested p:Parent") + "\n"
+	out += s.key.Render("RDS Instance") + s.desc.Render("s:Snapshots l:LogFiles e:Events p:Parameters w:Maintenance") + "\n"
 
 	// Global
 	out += "\n" + s.section.Render("Global") + "\n"
@@ -0,0 +1,893 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"charm.land/bubbles/v2/spinner"
+	"charm.land/bubbles/v2/table"
+	"charm.land/bubbles/v2/textarea"
+	"charm.land/bubbles/v2/textinput"
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+
+	"github.com/clawscli/claws/internal/logs"
+	"github.com/clawscli/claws/internal/render"
+	"github.com/clawscli/claws/internal/ui"
+)
+
+const defaultInsightsQuery = `fields @timestamp, @message
+| sort @timestamp desc
+| limit 100`
+
+const (
+	insightsPollInterval = time.Second
+	insightsMaxColWidth  = 60
+)
+
+type insightsMode int
+
+const (
+	insightsEditing insightsMode = iota
+	insightsResults
+	insightsRow
+	insightsSaved
+)
+
+// Editor fields, in tab order
+const (
+	insightsFieldGroups = iota
+	insightsFieldSince
+	insightsFieldQuery
+	insightsFieldCount
+)
+
+// InsightsView runs CloudWatch Logs Insights queries and shows the results
+// in a table. Saved query definitions can be browsed and run.
+type InsightsView struct {
+	ctx    context.Context
+	client logs.InsightsClient
+
+	mode     insightsMode
+	prevMode insightsMode
+	focus    int
+
+	groupsInput textinput.Model
+	sinceInput  textinput.Model
+	queryInput  textarea.Model
+
+	runID    int
+	queryID  string
+	running  bool
+	started  time.Time
+	elapsed  time.Duration
+	result   logs.QueryResult
+	rows     [][]string
+	hasRun   bool
+	err      error
+	message  string
+	rowIndex int
+
+	sortColumn    int
+	sortAscending bool
+
+	defs        []logs.QueryDefinition
+	defsLoading bool
+	defsErr     error
+	defsCursor  int
+
+	table   table.Model
+	width   int
+	height  int
+	spinner spinner.Model
+}
+
+// NewInsightsView creates an InsightsView querying groups. The query editor
+// is shown first.
+func NewInsightsView(ctx context.Context, groups []string) *InsightsView {
+	groupsInput := textinput.New()
+	groupsInput.Prompt = ""
+	groupsInput.Placeholder = "/aws/lambda/my-function, /ecs/my-service"
+	groupsInput.CharLimit = 4096
+	groupsInput.SetValue(strings.Join(groups, ", "))
+
+	sinceInput := textinput.New()
+	sinceInput.Prompt = ""
+	sinceInput.Placeholder = "1h"
+	sinceInput.CharLimit = 16
+	sinceInput.SetValue("1h")
+
+	queryInput := textarea.New()
+	queryInput.ShowLineNumbers = false
+	queryInput.SetValue(defaultInsightsQuery)
+
+	v := &InsightsView{
+		ctx:         ctx,
+		groupsInput: groupsInput,
+		sinceInput:  sinceInput,
+		queryInput:  queryInput,
+		sortColumn:  -1,
+		spinner:     ui.NewSpinner(),
+	}
+	if len(groups) > 0 {
+		v.focus = insightsFieldQuery
+	}
+	v.focusField()
+	return v
+}
+
+type insightsStartedMsg struct {
+	runID   int
+	queryID string
+	err     error
+}
+
+type insightsPollMsg struct {
+	runID int
+}
+
+type insightsResultMsg struct {
+	runID  int
+	result logs.QueryResult
+	err    error
+}
+
+type insightsDefsMsg struct {
+	defs []logs.QueryDefinition
+	err  error
+}
+
+// Init implements tea.Model
+func (v *InsightsView) Init() tea.Cmd {
+	return textinput.Blink
+}
+
+// insightsClient returns the client, creating it on first use.
+func (v *InsightsView) insightsClient() (logs.InsightsClient, error) {
+	if v.client == nil {
+		c, err := logs.NewClient(v.ctx)
+		if err != nil {
+			return nil, err
+		}
+		v.client = c
+	}
+	return v.client, nil
+}
+
+// run starts the query from the editor, stopping any query still running.
+func (v *InsightsView) run() tea.Cmd {
+	since, err := parseSince(v.sinceInput.Value())
+	if err != nil {
+		v.err = err
+		return nil
+	}
+	client, err := v.insightsClient()
+	if err != nil {
+		v.err = err
+		return nil
+	}
+
+	v.stopQuery()
+	v.runID++
+	v.running = true
+	v.hasRun = true
+	v.started = time.Now()
+	v.elapsed = 0
+	v.result = logs.QueryResult{}
+	v.rows = nil
+	v.err = nil
+	v.message = ""
+	v.mode = insightsResults
+	v.blurAll()
+	v.buildTable()
+
+	q := logs.InsightsQuery{
+		Groups: splitGroups(v.groupsInput.Value()),
+		Query:  strings.TrimSpace(v.queryInput.Value()),
+		Start:  time.Now().Add(-since),
+	}
+	ctx, runID := v.ctx, v.runID
+	return tea.Batch(v.spinner.Tick, func() tea.Msg {
+		id, err := logs.StartQuery(ctx, client, q)
+		return insightsStartedMsg{runID: runID, queryID: id, err: err}
+	})
+}
+
+func (v *InsightsView) poll() tea.Cmd {
+	ctx, client, queryID, runID := v.ctx, v.client, v.queryID, v.runID
+	return func() tea.Msg {
+		result, err := logs.GetQueryResults(ctx, client, queryID)
+		return insightsResultMsg{runID: runID, result: result, err: err}
+	}
+}
+
+// stopQuery stops the running query in the background, so that abandoned
+// queries do not keep scanning.
+func (v *InsightsView) stopQuery() {
+	if !v.running || v.queryID == "" || v.client == nil {
+		return
+	}
+	client, queryID := v.client, v.queryID
+	ctx := context.WithoutCancel(v.ctx)
+	go func() {
+		ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+		defer cancel()
+		_ = logs.StopQuery(ctx, client, queryID)
+	}()
+	v.running = false
+	v.queryID = ""
+}
+
+// Close stops a running query.
+func (v *InsightsView) Close() {
+	v.stopQuery()
+}
+
+func (v *InsightsView) loadDefinitions() tea.Cmd {
+	client, err := v.insightsClient()
+	if err != nil {
+		v.defsErr = err
+		return nil
+	}
+	v.defsLoading = true
+	v.defsErr = nil
+	ctx := v.ctx
+	return tea.Batch(v.spinner.Tick, func() tea.Msg {
+		defs, err := logs.QueryDefinitions(ctx, client)
+		return insightsDefsMsg{defs: defs, err: err}
+	})
+}
+
+// Update implements tea.Model
+func (v *InsightsView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case insightsStartedMsg:
+		if msg.runID != v.runID {
+			return v, nil
+		}
+		if msg.err != nil {
+			v.running = false
+			v.err = msg.err
+			return v, nil
+		}
+		v.queryID = msg.queryID
+		return v, v.poll()
+
+	case insightsPollMsg:
+		if msg.runID != v.runID || !v.running {
+			return v, nil
+		}
+		return v, v.poll()
+
+	case insightsResultMsg:
+		if msg.runID != v.runID || !v.running {
+			return v, nil
+		}
+		v.elapsed = time.Since(v.started)
+		if msg.err != nil {
+			v.running = false
+			v.err = msg.err
+			return v, nil
+		}
+		v.setResult(msg.result)
+		if msg.result.Done() {
+			v.running = false
+			v.queryID = ""
+			if msg.result.Status != "Complete" {
+				v.err = fmt.Errorf("query %s", strings.ToLower(string(msg.result.Status)))
+			}
+			return v, nil
+		}
+		runID := v.runID
+		return v, tea.Tick(insightsPollInterval, func(time.Time) tea.Msg {
+			return insightsPollMsg{runID: runID}
+		})
+
+	case insightsDefsMsg:
+		v.defsLoading = false
+		v.defs = msg.defs
+		v.defsErr = msg.err
+		v.defsCursor = 0
+		return v, nil
+
+	case SortMsg:
+		v.handleSort(msg)
+		return v, nil
+
+	case spinner.TickMsg:
+		if v.running || v.defsLoading {
+			var cmd tea.Cmd
+			v.spinner, cmd = v.spinner.Update(msg)
+			return v, cmd
+		}
+		return v, nil
+
+	case tea.MouseWheelMsg:
+		if v.mode == insightsResults {
+			var cmd tea.Cmd
+			v.table, cmd = v.table.Update(msg)
+			return v, cmd
+		}
+		return v, nil
+
+	case tea.KeyPressMsg:
+		switch v.mode {
+		case insightsEditing:
+			return v.handleEditorKey(msg)
+		case insightsRow:
+			return v.handleRowKey(msg)
+		case insightsSaved:
+			return v.handleSavedKey(msg)
+		}
+		if model, cmd, ok := v.handleResultsKey(msg); ok {
+			return model, cmd
+		}
+	}
+
+	if v.mode == insightsResults {
+		var cmd tea.Cmd
+		v.table, cmd = v.table.Update(msg)
+		return v, cmd
+	}
+	return v, nil
+}
+
+func (v *InsightsView) handleEditorKey(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "ctrl+r":
+		return v, v.run()
+
+	case "ctrl+o":
+		return v, v.openSaved()
+
+	case "tab":
+		v.focus = (v.focus + 1) % insightsFieldCount
+		return v, v.focusField()
+
+	case "shift+tab":
+		v.focus = (v.focus + insightsFieldCount - 1) % insightsFieldCount
+		return v, v.focusField()
+
+	case "esc":
+		v.blurAll()
+		v.mode = insightsResults
+		return v, nil
+	}
+
+	var cmd tea.Cmd
+	switch v.focus {
+	case insightsFieldGroups:
+		v.groupsInput, cmd = v.groupsInput.Update(msg)
+	case insightsFieldSince:
+		v.sinceInput, cmd = v.sinceInput.Update(msg)
+	case insightsFieldQuery:
+		v.queryInput, cmd = v.queryInput.Update(msg)
+	}
+	return v, cmd
+}
+
+func (v *InsightsView) handleResultsKey(msg tea.KeyPressMsg) (tea.Model, tea.Cmd, bool) {
+	switch msg.String() {
+	case "e", "i":
+		v.mode = insightsEditing
+		return v, v.focusField(), true
+
+	case "ctrl+r":
+		return v, v.run(), true
+
+	case "S":
+		return v, v.openSaved(), true
+
+	case "x":
+		if v.running {
+			v.stopQuery()
+			v.message = "Query stopped"
+		}
+		return v, nil, true
+
+	case "enter", "d":
+		if cursor := v.table.Cursor(); cursor < len(v.rows) {
+			v.rowIndex = cursor
+			v.mode = insightsRow
+		}
+		return v, nil, true
+
+	case "y":
+		if len(v.rows) == 0 {
+			return v, nil, true
+		}
+		v.message = fmt.Sprintf("Copied %d rows as CSV", len(v.rows))
+		return v, tea.SetClipboard(v.sortedResult().CSV()), true
+
+	case "Y":
+		if len(v.rows) == 0 {
+			return v, nil, true
+		}
+		data, err := v.sortedResult().JSON()
+		if err != nil {
+			v.err = err
+			return v, nil, true
+		}
+		v.message = fmt.Sprintf("Copied %d rows as JSON", len(v.rows))
+		return v, tea.SetClipboard(data), true
+
+	case "E":
+		if len(v.rows) == 0 {
+			return v, nil, true
+		}
+		path, err := v.export()
+		if err != nil {
+			v.err = err
+			return v, nil, true
+		}
+		v.message = fmt.Sprintf("Exported %d rows to %s", len(v.rows), path)
+		return v, nil, true
+
+	case "j", "down":
+		v.table.MoveDown(1)
+		return v, nil, true
+
+	case "k", "up":
+		v.table.MoveUp(1)
+		return v, nil, true
+	}
+	return v, nil, false
+}
+
+func (v *InsightsView) handleRowKey(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "esc", "q", "enter":
+		v.mode = insightsResults
+	case "j", "down":
+		if v.rowIndex < len(v.rows)-1 {
+			v.rowIndex++
+			v.table.SetCursor(v.rowIndex)
+		}
+	case "k", "up":
+		if v.rowIndex > 0 {
+			v.rowIndex--
+			v.table.SetCursor(v.rowIndex)
+		}
+	}
+	return v, nil
+}
+
+func (v *InsightsView) openSaved() tea.Cmd {
+	v.prevMode = v.mode
+	v.mode = insightsSaved
+	v.blurAll()
+	if v.defs == nil && !v.defsLoading {
+		return v.loadDefinitions()
+	}
+	return nil
+}
+
+func (v *InsightsView) handleSavedKey(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "esc", "q":
+		v.mode = v.prevMode
+		if v.mode == insightsEditing {
+			return v, v.focusField()
+		}
+		return v, nil
+
+	case "j", "down":
+		if v.defsCursor < len(v.defs)-1 {
+			v.defsCursor++
+		}
+
+	case "k", "up":
+		if v.defsCursor > 0 {
+			v.defsCursor--
+		}
+
+	case "ctrl+r":
+		v.defs = nil
+		return v, v.loadDefinitions()
+
+	case "enter":
+		if !v.loadDefinition() {
+			return v, nil
+		}
+		if len(splitGroups(v.groupsInput.Value())) == 0 {
+			// Nothing to query yet; let the user pick groups first
+			v.mode = insightsEditing
+			v.focus = insightsFieldGroups
+			return v, v.focusField()
+		}
+		return v, v.run()
+
+	case "e":
+		if v.loadDefinition() {
+			v.mode = insightsEditing
+			v.focus = insightsFieldQuery
+			return v, v.focusField()
+		}
+	}
+	return v, nil
+}
+
+// loadDefinition copies the selected saved query into the editor. Its log
+// groups replace the current ones when it has any.
+func (v *InsightsView) loadDefinition() bool {
+	if v.defsCursor >= len(v.defs) {
+		return false
+	}
+	def := v.defs[v.defsCursor]
+	v.queryInput.SetValue(def.Query)
+	if len(def.Groups) > 0 {
+		v.groupsInput.SetValue(strings.Join(def.Groups, ", "))
+	}
+	return true
+}
+
+func (v *InsightsView) focusField() tea.Cmd {
+	v.blurAll()
+	switch v.focus {
+	case insightsFieldGroups:
+		return v.groupsInput.Focus()
+	case insightsFieldSince:
+		return v.sinceInput.Focus()
+	default:
+		return v.queryInput.Focus()
+	}
+}
+
+func (v *InsightsView) blurAll() {
+	v.groupsInput.Blur()
+	v.sinceInput.Blur()
+	v.queryInput.Blur()
+}
+
+func (v *InsightsView) setResult(result logs.QueryResult) {
+	cursor := v.table.Cursor()
+	v.result = result
+	v.applySort()
+	v.buildTable()
+	v.table.SetCursor(min(cursor, max(len(v.rows)-1, 0)))
+}
+
+// handleSort sorts by the named column; "@" may be omitted.
+func (v *InsightsView) handleSort(msg SortMsg) {
+	v.sortColumn = -1
+	if msg.Column != "" {
+		name := strings.TrimPrefix(strings.ToLower(msg.Column), "@")
+		for i, col := range v.result.Columns {
+			if strings.TrimPrefix(strings.ToLower(col), "@") == name {
+				v.sortColumn = i
+				break
+			}
+		}
+		if v.sortColumn < 0 {
+			v.message = fmt.Sprintf("No column %q", msg.Column)
+		}
+	}
+	v.sortAscending = msg.Ascending
+	v.applySort()
+	v.buildTable()
+}
+
+// applySort orders rows by the sort column, keeping the query's order
+// when unsorted.
+func (v *InsightsView) applySort() {
+	v.rows = slices.Clone(v.result.Rows)
+	if v.sortColumn < 0 || v.sortColumn >= len(v.result.Columns) {
+		return
+	}
+	col := v.sortColumn
+	slices.SortStableFunc(v.rows, func(a, b []string) int {
+		cmp := compareValues(a[col], b[col])
+		if !v.sortAscending {
+			cmp = -cmp
+		}
+		return cmp
+	})
+}
+
+// sortedResult returns the result with rows in display order.
+func (v *InsightsView) sortedResult() logs.QueryResult {
+	r := v.result
+	r.Rows = v.rows
+	return r
+}
+
+// export writes the results as CSV to the working directory.
+func (v *InsightsView) export() (string, error) {
+	name := fmt.Sprintf("insights-%s.csv", time.Now().Format("20060102-150405"))
+	if err := os.WriteFile(name, []byte(v.sortedResult().CSV()), 0o600); err != nil {
+		return "", fmt.Errorf("export results: %w", err)
+	}
+	if abs, err := filepath.Abs(name); err == nil {
+		return abs, nil
+	}
+	return name, nil
+}
+
+func (v *InsightsView) buildTable() {
+	tableWidth := v.width
+	if tableWidth < 80 {
+		tableWidth = 120
+	}
+	tableHeight := v.height - 4
+	if tableHeight < 10 {
+		tableHeight = 20
+	}
+
+	columns := make([]table.Column, len(v.result.Columns))
+	used := 0
+	for i, name := range v.result.Columns {
+		width := lipgloss.Width(name)
+		for _, row := range v.rows {
+			width = max(width, min(lipgloss.Width(row[i]), insightsMaxColWidth))
+		}
+		title := name
+		if i == v.sortColumn {
+			if v.sortAscending {
+				title += " ↑"
+			} else {
+				title += " ↓"
+			}
+			width = max(width, lipgloss.Width(title))
+		}
+		columns[i] = table.Column{Title: title, Width: width}
+		used += width + 2
+	}
+	// The last column (usually @message) takes the remaining width
+	if n := len(columns); n > 0 {
+		if rest := tableWidth - used; rest > 0 {
+			columns[n-1].Width += rest
+		}
+	}
+
+	rows := make([]table.Row, len(v.rows))
+	for i, row := range v.rows {
+		cells := make(table.Row, len(row))
+		for j, cell := range row {
+			cells[j] = strings.ReplaceAll(cell, "\n", " ")
+		}
+		rows[i] = cells
+	}
+
+	tbl := table.New(
+		table.WithColumns(columns),
+		table.WithRows(rows),
+		table.WithFocused(true),
+		table.WithHeight(tableHeight),
+		table.WithWidth(tableWidth),
+	)
+
+	s := table.DefaultStyles()
+	theme := ui.Current()
+	s.Header = s.Header.
+		BorderStyle(lipgloss.NormalBorder()).
+		BorderForeground(theme.TableBorder).
+		BorderBottom(true).
+		Bold(false)
+	s.Selected = s.Selected.
+		Foreground(theme.SelectionText).
+		Background(theme.Selection).
+		Bold(false)
+
+	tbl.SetStyles(s)
+	v.table = tbl
+}
+
+// ViewString returns the view content as a string
+func (v *InsightsView) ViewString() string {
+	theme := ui.Current()
+	title := "Logs Insights"
+	if groups := splitGroups(v.groupsInput.Value()); len(groups) == 1 {
+		title += ": " + groups[0]
+	} else if len(groups) > 1 {
+		title += fmt.Sprintf(": %d log groups", len(groups))
+	}
+	header := lipgloss.NewStyle().
+		Foreground(theme.TableHeaderText).
+		Background(theme.TableHeader).
+		Padding(0, 1).
+		Width(v.width).
+		Render(title)
+	status := lipgloss.NewStyle().Foreground(theme.TextDim).Padding(0, 1).Render(v.statusText())
+	out := header + "\n" + status + "\n"
+
+	switch v.mode {
+	case insightsEditing:
+		return out + v.editorView()
+	case insightsSaved:
+		return out + v.savedView()
+	case insightsRow:
+		return out + v.rowView()
+	}
+
+	if v.err != nil {
+		out += ui.DangerStyle().Render(fmt.Sprintf("Error: %v", v.err)) + "\n"
+	}
+	if !v.hasRun {
+		return out + ui.DimStyle().Render("No query run yet. Press e to edit the query, S for saved queries.")
+	}
+	if len(v.rows) == 0 && !v.running {
+		return out + ui.DimStyle().Render("No results")
+	}
+	return out + v.table.View()
+}
+
+func (v *InsightsView) statusText() string {
+	switch {
+	case v.mode == insightsSaved && v.defsLoading:
+		return v.spinner.View() + " Loading saved queries..."
+	case v.running:
+		return fmt.Sprintf("%s Running %s • %s", v.spinner.View(), time.Since(v.started).Round(time.Second), v.statsText())
+	case v.message != "":
+		return v.message
+	case v.hasRun && v.err == nil:
+		return fmt.Sprintf("%d rows in %s • %s", len(v.rows), v.elapsed.Round(100*time.Millisecond), v.statsText())
+	}
+	return ""
+}
+
+func (v *InsightsView) statsText() string {
+	r := v.result
+	return fmt.Sprintf("%s matched / %s scanned • %s",
+		formatCount(r.RecordsMatched), formatCount(r.RecordsScanned), render.FormatSize(int64(r.BytesScanned)))
+}
+
+func (v *InsightsView) editorView() string {
+	theme := ui.Current()
+	label := lipgloss.NewStyle().Foreground(theme.TextDim).Width(12)
+	active := lipgloss.NewStyle().Foreground(theme.Accent).Bold(true).Width(12)
+	labelFor := func(field int, text string) string {
+		if v.focus == field {
+			return active.Render(text)
+		}
+		return label.Render(text)
+	}
+
+	out := labelFor(insightsFieldGroups, "Log groups") + v.groupsInput.View() + "\n"
+	out += labelFor(insightsFieldSince, "Time range") + v.sinceInput.View() +
+		ui.DimStyle().Render("  e.g. 15m, 3h, 7d") + "\n\n"
+	out += labelFor(insightsFieldQuery, "Query") + "\n"
+	out += v.queryInput.View() + "\n"
+	if v.err != nil {
+		out += "\n" + ui.DangerStyle().Render(fmt.Sprintf("Error: %v", v.err))
+	}
+	return out
+}
+
+func (v *InsightsView) savedView() string {
+	if v.defsErr != nil {
+		return ui.DangerStyle().Render(fmt.Sprintf("Error: %v", v.defsErr))
+	}
+	if v.defsLoading {
+		return ""
+	}
+	if len(v.defs) == 0 {
+		return ui.DimStyle().Render("No saved queries")
+	}
+
+	theme := ui.Current()
+	selected := lipgloss.NewStyle().Foreground(theme.SelectionText).Background(theme.Selection)
+	var out strings.Builder
+	out.WriteString(ui.DimStyle().Render("Saved queries") + "\n")
+	for i, def := range v.defs {
+		line := def.Name
+		if len(def.Groups) > 0 {
+			line += ui.DimStyle().Render(fmt.Sprintf("  (%s)", strings.Join(def.Groups, ", ")))
+		}
+		if i == v.defsCursor {
+			line = selected.Render(def.Name)
+		}
+		out.WriteString("  " + line + "\n")
+	}
+	if v.defsCursor < len(v.defs) {
+		out.WriteString("\n" + ui.DimStyle().Render(v.defs[v.defsCursor].Query))
+	}
+	return out.String()
+}
+
+func (v *InsightsView) rowView() string {
+	if v.rowIndex >= len(v.rows) {
+		return ""
+	}
+	theme := ui.Current()
+	key := lipgloss.NewStyle().Foreground(theme.Secondary).Bold(true)
+	var out strings.Builder
+	out.WriteString(ui.DimStyle().Render(fmt.Sprintf("Row %d of %d", v.rowIndex+1, len(v.rows))) + "\n\n")
+	for i, col := range v.result.Columns {
+		value := v.rows[v.rowIndex][i]
+		if value == "" {
+			continue
+		}
+		value, _ = logs.PrettyJSON(value)
+		out.WriteString(key.Render(col) + "\n" + value + "\n\n")
+	}
+	return out.String()
+}
+
+// View implements tea.Model
+func (v *InsightsView) View() tea.View {
+	return tea.NewView(v.ViewString())
+}
+
+// SetSize implements View
+func (v *InsightsView) SetSize(width, height int) tea.Cmd {
+	v.width = width
+	v.height = height
+	v.groupsInput.SetWidth(max(width-16, 20))
+	v.sinceInput.SetWidth(10)
+	v.queryInput.SetWidth(max(width-2, 20))
+	v.queryInput.SetHeight(max(height-10, 3))
+	v.buildTable()
+	return nil
+}
+
+// StatusLine implements View
+func (v *InsightsView) StatusLine() string {
+	switch v.mode {
+	case insightsEditing:
+		return "ctrl+r:run • tab:next field • ctrl+o:saved queries • esc:results"
+	case insightsSaved:
+		return "enter:run • e:edit • ctrl+r:reload • esc:back"
+	case insightsRow:
+		return "j/k:prev/next row • esc:back"
+	}
+	parts := []string{"e:edit query", "ctrl+r:rerun", "S:saved", "enter:row", "y/Y:copy csv/json", "E:export csv", ":sort <col>"}
+	if v.running {
+		parts = append(parts, "x:stop")
+	}
+	return strings.Join(parts, " ") + " • esc:back"
+}
+
+// HasActiveInput implements InputCapture. Everything except the results
+// table handles esc itself.
+func (v *InsightsView) HasActiveInput() bool {
+	return v.mode != insightsResults
+}
+
+// splitGroups parses a comma- or space-separated list of log group names.
+func splitGroups(s string) []string {
+	var groups []string
+	for _, g := range strings.FieldsFunc(s, func(r rune) bool { return r == ',' || r == ' ' || r == '\n' }) {
+		if !slices.Contains(groups, g) {
+			groups = append(groups, g)
+		}
+	}
+	return groups
+}
+
+// parseSince parses a relative time range such as "15m", "3h" or "7d".
+func parseSince(s string) (time.Duration, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return time.Hour, nil
+	}
+	if days, ok := strings.CutSuffix(s, "d"); ok {
+		n, err := strconv.Atoi(days)
+		if err != nil || n <= 0 {
+			return 0, fmt.Errorf("invalid time range %q", s)
+		}
+		return time.Duration(n) * 24 * time.Hour, nil
+	}
+	d, err := time.ParseDuration(s)
+	if err != nil || d <= 0 {
+		return 0, fmt.Errorf("invalid time range %q", s)
+	}
+	return d, nil
+}
+
+func formatCount(n float64) string {
+	switch {
+	case n >= 1e9:
+		return fmt.Sprintf("%.1fB", n/1e9)
+	case n >= 1e6:
+		return fmt.Sprintf("%.1fM", n/1e6)
+	case n >= 1e3:
+		return fmt.Sprintf("%.1fK", n/1e3)
+	}
+	return strconv.FormatFloat(n, 'f', 0, 64)
+}
@@ -0,0 +1,252 @@
+package view
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs/types"
+
+	"github.com/clawscli/claws/internal/logs"
+)
+
+type mockInsightsClient struct {
+	startIn *cloudwatchlogs.StartQueryInput
+	results cloudwatchlogs.GetQueryResultsOutput
+	defs    []types.QueryDefinition
+}
+
+func (m *mockInsightsClient) StartQuery(_ context.Context, in *cloudwatchlogs.StartQueryInput, _ ...func(*cloudwatchlogs.Options)) (*cloudwatchlogs.StartQueryOutput, error) {
+	m.startIn = in
+	return &cloudwatchlogs.StartQueryOutput{QueryId: aws.String("q-1")}, nil
+}
+
+func (m *mockInsightsClient) GetQueryResults(_ context.Context, _ *cloudwatchlogs.GetQueryResultsInput, _ ...func(*cloudwatchlogs.Options)) (*cloudwatchlogs.GetQueryResultsOutput, error) {
+	return &m.results, nil
+}
+
+func (m *mockInsightsClient) StopQuery(_ context.Context, _ *cloudwatchlogs.StopQueryInput, _ ...func(*cloudwatchlogs.Options)) (*cloudwatchlogs.StopQueryOutput, error) {
+	return &cloudwatchlogs.StopQueryOutput{}, nil
+}
+
+func (m *mockInsightsClient) DescribeQueryDefinitions(_ context.Context, _ *cloudwatchlogs.DescribeQueryDefinitionsInput, _ ...func(*cloudwatchlogs.Options)) (*cloudwatchlogs.DescribeQueryDefinitionsOutput, error) {
+	return &cloudwatchlogs.DescribeQueryDefinitionsOutput{QueryDefinitions: m.defs}, nil
+}
+
+func newTestInsightsView(groups []string, client *mockInsightsClient) *InsightsView {
+	v := NewInsightsView(context.Background(), groups)
+	v.client = client
+	v.SetSize(120, 40)
+	return v
+}
+
+// runCmd executes cmd and feeds the resulting messages back into v,
+// skipping ticks.
+func runInsightsCmd(t *testing.T, v *InsightsView, cmd tea.Cmd) {
+	t.Helper()
+	for cmd != nil {
+		msg := cmd()
+		if batch, ok := msg.(tea.BatchMsg); ok {
+			for _, c := range batch {
+				runInsightsCmd(t, v, c)
+			}
+			return
+		}
+		switch msg.(type) {
+		case insightsStartedMsg, insightsResultMsg, insightsDefsMsg:
+			_, cmd = v.Update(msg)
+		default:
+			return
+		}
+	}
+}
+
+func TestInsightsView_New(t *testing.T) {
+	v := NewInsightsView(context.Background(), []string{"/a", "/b"})
+	if v.mode != insightsEditing || !v.HasActiveInput() {
+		t.Error("view should start in the editor")
+	}
+	if v.focus != insightsFieldQuery {
+		t.Errorf("focus = %d, want query field when groups are given", v.focus)
+	}
+	if got := v.groupsInput.Value(); got != "/a, /b" {
+		t.Errorf("groups = %q", got)
+	}
+
+	empty := NewInsightsView(context.Background(), nil)
+	if empty.focus != insightsFieldGroups {
+		t.Errorf("focus = %d, want groups field without groups", empty.focus)
+	}
+}
+
+func TestInsightsView_Run(t *testing.T) {
+	client := &mockInsightsClient{results: cloudwatchlogs.GetQueryResultsOutput{
+		Status: types.QueryStatusComplete,
+		Results: [][]types.ResultField{
+			{{Field: aws.String("@timestamp"), Value: aws.String("2024-01-15 10:00:00")}, {Field: aws.String("count"), Value: aws.String("9")}},
+			{{Field: aws.String("@timestamp"), Value: aws.String("2024-01-15 10:05:00")}, {Field: aws.String("count"), Value: aws.String("10")}},
+		},
+	}}
+	v := newTestInsightsView([]string{"/aws/lambda/fn"}, client)
+	v.sinceInput.SetValue("15m")
+
+	_, cmd := v.Update(tea.KeyPressMsg{Code: 'r', Mod: tea.ModCtrl})
+	if v.mode != insightsResults || !v.running {
+		t.Fatalf("ctrl+r should start the query: mode=%d running=%v", v.mode, v.running)
+	}
+	runInsightsCmd(t, v, cmd)
+
+	if client.startIn == nil {
+		t.Fatal("StartQuery not called")
+	}
+	if got := aws.ToInt64(client.startIn.EndTime) - aws.ToInt64(client.startIn.StartTime); got < 899 || got > 901 {
+		t.Errorf("time range = %ds, want 900s", got)
+	}
+	if v.running {
+		t.Error("running should be false after a complete result")
+	}
+	if len(v.rows) != 2 || v.err != nil {
+		t.Fatalf("rows=%d err=%v", len(v.rows), v.err)
+	}
+
+	// Numeric columns sort numerically
+	v.Update(SortMsg{Column: "count", Ascending: false})
+	if v.rows[0][1] != "10" {
+		t.Errorf("sorted rows[0] = %v, want count 10 first", v.rows[0])
+	}
+	if !strings.Contains(v.ViewString(), "count ↓") {
+		t.Error("sorted column should be marked in the header")
+	}
+
+	v.Update(SortMsg{Column: "timestamp", Ascending: true})
+	if v.sortColumn != 0 {
+		t.Errorf("sortColumn = %d, want 0 (\"@\" may be omitted)", v.sortColumn)
+	}
+	v.Update(SortMsg{Column: ""})
+	if v.sortColumn != -1 || v.rows[0][1] != "9" {
+		t.Error(":sort without a column should restore the query order")
+	}
+}
+
+func TestInsightsView_RunErrors(t *testing.T) {
+	v := newTestInsightsView(nil, &mockInsightsClient{})
+
+	v.sinceInput.SetValue("soon")
+	v.Update(tea.KeyPressMsg{Code: 'r', Mod: tea.ModCtrl})
+	if v.err == nil || v.running {
+		t.Error("invalid time range should fail before running")
+	}
+
+	v.sinceInput.SetValue("1h")
+	_, cmd := v.Update(tea.KeyPressMsg{Code: 'r', Mod: tea.ModCtrl})
+	runInsightsCmd(t, v, cmd)
+	if v.err == nil || !strings.Contains(v.err.Error(), "no log groups") {
+		t.Errorf("err = %v, want no log groups", v.err)
+	}
+}
+
+func TestInsightsView_FailedQuery(t *testing.T) {
+	client := &mockInsightsClient{results: cloudwatchlogs.GetQueryResultsOutput{Status: types.QueryStatusFailed}}
+	v := newTestInsightsView([]string{"/a"}, client)
+
+	_, cmd := v.Update(tea.KeyPressMsg{Code: 'r', Mod: tea.ModCtrl})
+	runInsightsCmd(t, v, cmd)
+	if v.err == nil || !strings.Contains(v.err.Error(), "failed") {
+		t.Errorf("err = %v, want query failed", v.err)
+	}
+}
+
+func TestInsightsView_StaleResults(t *testing.T) {
+	v := newTestInsightsView([]string{"/a"}, &mockInsightsClient{})
+	v.runID = 2
+	v.running = true
+
+	v.Update(insightsResultMsg{runID: 1, result: logs.QueryResult{Columns: []string{"a"}, Rows: [][]string{{"x"}}}})
+	if len(v.rows) != 0 {
+		t.Error("results from a previous run should be ignored")
+	}
+}
+
+func TestInsightsView_SavedQueries(t *testing.T) {
+	client := &mockInsightsClient{
+		defs: []types.QueryDefinition{
+			{Name: aws.String("errors"), QueryString: aws.String("filter @message like /ERROR/"), LogGroupNames: []string{"/saved"}},
+			{Name: aws.String("count"), QueryString: aws.String("stats count(*)")},
+		},
+		results: cloudwatchlogs.GetQueryResultsOutput{Status: types.QueryStatusComplete},
+	}
+	v := newTestInsightsView([]string{"/a"}, client)
+
+	_, cmd := v.Update(tea.KeyPressMsg{Code: 'o', Mod: tea.ModCtrl})
+	if v.mode != insightsSaved {
+		t.Fatalf("mode = %d, want saved", v.mode)
+	}
+	runInsightsCmd(t, v, cmd)
+	if len(v.defs) != 2 {
+		t.Fatalf("defs = %d, want 2", len(v.defs))
+	}
+
+	// "e" loads the second query into the editor, keeping the groups
+	v.Update(tea.KeyPressMsg{Code: 'j', Text: "j"})
+	v.Update(tea.KeyPressMsg{Code: 'e', Text: "e"})
+	if v.mode != insightsEditing || v.queryInput.Value() != "stats count(*)" || v.groupsInput.Value() != "/a" {
+		t.Errorf("mode=%d query=%q groups=%q", v.mode, v.queryInput.Value(), v.groupsInput.Value())
+	}
+
+	// enter runs the first one against its own groups
+	v.Update(tea.KeyPressMsg{Code: 'o', Mod: tea.ModCtrl})
+	v.Update(tea.KeyPressMsg{Code: 'k', Text: "k"})
+	_, cmd = v.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+	runInsightsCmd(t, v, cmd)
+	if client.startIn == nil || aws.ToString(client.startIn.QueryString) != "filter @message like /ERROR/" {
+		t.Fatal("enter should run the saved query")
+	}
+	if len(client.startIn.LogGroupNames) != 1 || client.startIn.LogGroupNames[0] != "/saved" {
+		t.Errorf("groups = %v, want the saved query's groups", client.startIn.LogGroupNames)
+	}
+}
+
+func TestInsightsView_EscLeavesEditor(t *testing.T) {
+	v := newTestInsightsView(nil, &mockInsightsClient{})
+	v.Update(tea.KeyPressMsg{Code: tea.KeyEscape})
+	if v.mode != insightsResults || v.HasActiveInput() {
+		t.Error("esc should leave the editor so the next esc goes back")
+	}
+	v.Update(tea.KeyPressMsg{Code: 'e', Text: "e"})
+	if v.mode != insightsEditing {
+		t.Error("e should reopen the editor")
+	}
+}
+
+func TestParseSince(t *testing.T) {
+	tests := []struct {
+		in   string
+		want time.Duration
+		err  bool
+	}{
+		{"", time.Hour, false},
+		{"15m", 15 * time.Minute, false},
+		{"3h", 3 * time.Hour, false},
+		{"7d", 7 * 24 * time.Hour, false},
+		{"0d", 0, true},
+		{"-1h", 0, true},
+		{"soon", 0, true},
+	}
+	for _, tt := range tests {
+		got, err := parseSince(tt.in)
+		if (err != nil) != tt.err || got != tt.want {
+			t.Errorf("parseSince(%q) = %v, %v", tt.in, got, err)
+		}
+	}
+}
+
+func TestSplitGroups(t *testing.T) {
+	got := splitGroups(" /a, /b /c,,/a ")
+	if strings.Join(got, "|") != "/a|/b|/c" {
+		t.Errorf("splitGroups() = %v", got)
+	}
+}
@@ -0,0 +1,632 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"charm.land/bubbles/v2/spinner"
+	"charm.land/bubbles/v2/textarea"
+	"charm.land/bubbles/v2/textinput"
+	"charm.land/bubbles/v2/viewport"
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+
+	"github.com/clawscli/claws/internal/action"
+	"github.com/clawscli/claws/internal/config"
+	"github.com/clawscli/claws/internal/invoke"
+	"github.com/clawscli/claws/internal/ui"
+)
+
+type invokeMode int
+
+const (
+	invokeEditor invokeMode = iota
+	invokeResult
+	invokeEvents
+	invokeSaveName
+)
+
+type invokeConfirm int
+
+const (
+	invokeConfirmNone invokeConfirm = iota
+	invokeConfirmRun
+	invokeConfirmDelete
+)
+
+// InvokeView invokes a Lambda function with a JSON payload edited in place
+// or loaded from the function's saved test events, and shows the response
+// payload, function error and log tail. Real invocations are blocked in
+// read-only mode; dry runs are always allowed.
+type InvokeView struct {
+	ctx    context.Context
+	client invoke.Client
+	store  *invoke.EventStore
+	target invoke.Target
+
+	mode    invokeMode
+	confirm invokeConfirm
+
+	qualifiers   []invoke.Qualifier
+	qualifierIdx int
+
+	editor    textarea.Model
+	nameInput textinput.Model
+	eventName string // the saved event the payload was loaded from
+
+	events      []invoke.Event
+	eventCursor int
+
+	runID   int
+	loading bool
+	dryRun  bool
+	result  *invoke.Result
+	output  viewport.Model
+	err     error
+	message string
+
+	width   int
+	height  int
+	spinner spinner.Model
+}
+
+// NewInvokeView creates an InvokeView for t, starting in the payload editor.
+func NewInvokeView(ctx context.Context, t invoke.Target) *InvokeView {
+	editor := textarea.New()
+	editor.ShowLineNumbers = false
+	editor.CharLimit = 0
+	editor.SetValue("{}")
+
+	name := textinput.New()
+	name.Prompt = "Event name: "
+	name.CharLimit = 100
+
+	v := &InvokeView{
+		ctx:        ctx,
+		store:      invoke.NewEventStore(invoke.DefaultEventsPath()),
+		target:     t,
+		qualifiers: []invoke.Qualifier{{Name: invoke.Latest}},
+		editor:     editor,
+		nameInput:  name,
+		output:     viewport.New(),
+		spinner:    ui.NewSpinner(),
+	}
+	v.editor.Focus()
+	return v
+}
+
+type invokeQualifiersMsg struct {
+	qualifiers []invoke.Qualifier
+	err        error
+}
+
+type invokeResultMsg struct {
+	runID  int
+	result invoke.Result
+	err    error
+}
+
+// Init implements tea.Model
+func (v *InvokeView) Init() tea.Cmd {
+	client, err := v.invokeClient()
+	if err != nil {
+		v.err = err
+		return textarea.Blink
+	}
+	ctx, target := v.ctx, v.target
+	return tea.Batch(textarea.Blink, func() tea.Msg {
+		quals, err := invoke.Qualifiers(ctx, client, target)
+		return invokeQualifiersMsg{qualifiers: quals, err: err}
+	})
+}
+
+// invokeClient returns the client, creating it on first use.
+func (v *InvokeView) invokeClient() (invoke.Client, error) {
+	if v.client == nil {
+		c, err := invoke.NewClient(v.ctx)
+		if err != nil {
+			return nil, err
+		}
+		v.client = c
+	}
+	return v.client, nil
+}
+
+func (v *InvokeView) qualifier() invoke.Qualifier {
+	return v.qualifiers[v.qualifierIdx]
+}
+
+// run invokes the function with the editor payload.
+func (v *InvokeView) run(dryRun bool) tea.Cmd {
+	if !dryRun && config.Global().ReadOnly() {
+		v.err = action.ErrReadOnlyDenied
+		return nil
+	}
+	payload := strings.TrimSpace(v.editor.Value())
+	if payload == "" {
+		payload = "{}"
+	}
+	if err := invoke.ValidatePayload(payload); err != nil {
+		v.err = err
+		return nil
+	}
+	client, err := v.invokeClient()
+	if err != nil {
+		v.err = err
+		return nil
+	}
+
+	v.runID++
+	v.loading = true
+	v.dryRun = dryRun
+	v.err = nil
+	v.message = ""
+	v.editor.Blur()
+	v.mode = invokeResult
+
+	ctx, target, runID := v.ctx, v.target, v.runID
+	req := invoke.Request{Qualifier: v.qualifier().Name, Payload: payload, DryRun: dryRun}
+	return tea.Batch(v.spinner.Tick, func() tea.Msg {
+		result, err := invoke.Invoke(ctx, client, target, req)
+		return invokeResultMsg{runID: runID, result: result, err: err}
+	})
+}
+
+// Update implements tea.Model
+func (v *InvokeView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case invokeQualifiersMsg:
+		if len(msg.qualifiers) > 0 {
+			v.qualifiers = msg.qualifiers
+			v.qualifierIdx = min(v.qualifierIdx, len(v.qualifiers)-1)
+		}
+		if msg.err != nil {
+			v.err = msg.err
+		}
+		return v, nil
+
+	case invokeResultMsg:
+		if msg.runID != v.runID {
+			return v, nil
+		}
+		v.loading = false
+		if !v.dryRun {
+			v.record(msg.result, msg.err)
+		}
+		if msg.err != nil {
+			v.err = msg.err
+			v.result = nil
+			v.output.SetContent("")
+			return v, nil
+		}
+		v.result = &msg.result
+		v.setOutput()
+		return v, nil
+
+	case spinner.TickMsg:
+		if v.loading {
+			var cmd tea.Cmd
+			v.spinner, cmd = v.spinner.Update(msg)
+			return v, cmd
+		}
+		return v, nil
+
+	case tea.MouseWheelMsg:
+		if v.mode == invokeResult {
+			var cmd tea.Cmd
+			v.output, cmd = v.output.Update(msg)
+			return v, cmd
+		}
+		return v, nil
+
+	case tea.KeyPressMsg:
+		if v.confirm != invokeConfirmNone {
+			return v.handleConfirmKey(msg)
+		}
+		switch v.mode {
+		case invokeEditor:
+			return v.handleEditorKey(msg)
+		case invokeEvents:
+			return v.handleEventsKey(msg)
+		case invokeSaveName:
+			return v.handleNameKey(msg)
+		}
+		return v.handleResultKey(msg)
+	}
+	return v, nil
+}
+
+func (v *InvokeView) handleEditorKey(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "esc":
+		v.editor.Blur()
+		v.mode = invokeResult
+		return v, nil
+	case "ctrl+r":
+		v.askRun()
+		return v, nil
+	case "ctrl+x":
+		return v, v.run(true)
+	case "tab":
+		v.cycleQualifier(1)
+		return v, nil
+	case "shift+tab":
+		v.cycleQualifier(-1)
+		return v, nil
+	case "ctrl+s":
+		v.nameInput.SetValue(v.eventName)
+		v.nameInput.CursorEnd()
+		v.editor.Blur()
+		v.mode = invokeSaveName
+		return v, v.nameInput.Focus()
+	case "ctrl+o":
+		v.openEvents()
+		return v, nil
+	case "ctrl+l":
+		if err := invoke.ValidatePayload(v.editor.Value()); err != nil {
+			v.err = err
+			return v, nil
+		}
+		v.err = nil
+		v.editor.SetValue(invoke.PrettyJSON(v.editor.Value()))
+		return v, nil
+	}
+	var cmd tea.Cmd
+	v.editor, cmd = v.editor.Update(msg)
+	return v, cmd
+}
+
+func (v *InvokeView) handleResultKey(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "e", "enter":
+		return v, v.openEditor()
+	case "i":
+		v.askRun()
+		return v, nil
+	case "I":
+		return v, v.run(true)
+	case "o":
+		v.openEvents()
+		return v, nil
+	case "tab":
+		v.cycleQualifier(1)
+		return v, nil
+	case "shift+tab":
+		v.cycleQualifier(-1)
+		return v, nil
+	case "y":
+		if v.result != nil && len(v.result.Payload) > 0 {
+			v.message = "Copied response payload"
+			return v, tea.SetClipboard(v.result.PrettyPayload())
+		}
+		return v, nil
+	case "g":
+		v.output.GotoTop()
+		return v, nil
+	case "G":
+		v.output.GotoBottom()
+		return v, nil
+	case "j":
+		v.output.ScrollDown(1)
+		return v, nil
+	case "k":
+		v.output.ScrollUp(1)
+		return v, nil
+	}
+	var cmd tea.Cmd
+	v.output, cmd = v.output.Update(msg)
+	return v, cmd
+}
+
+func (v *InvokeView) handleEventsKey(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "esc", "q":
+		return v, v.openEditor()
+	case "j", "down":
+		if v.eventCursor < len(v.events)-1 {
+			v.eventCursor++
+		}
+	case "k", "up":
+		if v.eventCursor > 0 {
+			v.eventCursor--
+		}
+	case "enter":
+		if v.eventCursor < len(v.events) {
+			e := v.events[v.eventCursor]
+			v.editor.SetValue(e.Payload)
+			v.editor.MoveToBegin()
+			v.eventName = e.Name
+			v.message = "Loaded event " + e.Name
+			return v, v.openEditor()
+		}
+	case "D":
+		if v.eventCursor < len(v.events) {
+			v.confirm = invokeConfirmDelete
+		}
+	}
+	return v, nil
+}
+
+func (v *InvokeView) handleNameKey(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "esc":
+		v.nameInput.Blur()
+		return v, v.openEditor()
+	case "enter":
+		e := invoke.Event{Name: strings.TrimSpace(v.nameInput.Value()), Payload: strings.TrimSpace(v.editor.Value())}
+		if err := v.store.Save(v.target, e); err != nil {
+			v.err = err
+			return v, nil
+		}
+		v.nameInput.Blur()
+		v.eventName = e.Name
+		v.err = nil
+		v.message = "Saved event " + e.Name
+		return v, v.openEditor()
+	}
+	var cmd tea.Cmd
+	v.nameInput, cmd = v.nameInput.Update(msg)
+	return v, cmd
+}
+
+func (v *InvokeView) handleConfirmKey(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "y", "Y":
+		confirm := v.confirm
+		v.confirm = invokeConfirmNone
+		if confirm == invokeConfirmDelete {
+			v.deleteEvent()
+			return v, nil
+		}
+		return v, v.run(false)
+	case "n", "N", "esc", "q":
+		v.confirm = invokeConfirmNone
+	}
+	return v, nil
+}
+
+// record logs a real invocation like an API action run from the action
+// menu. A function error counts as a failure.
+func (v *InvokeView) record(result invoke.Result, err error) {
+	if err == nil && result.Failed() {
+		err = fmt.Errorf("function error: %s", result.FunctionError)
+	}
+	act := action.Action{Name: "Invoke", Type: action.ActionTypeAPI, Operation: "Invoke"}
+	action.Record(act, "lambda", "functions", v.target.Key(), action.ActionResult{Success: err == nil, Error: err})
+}
+
+// askRun asks to confirm a real invocation, which is denied up front in
+// read-only mode.
+func (v *InvokeView) askRun() {
+	if config.Global().ReadOnly() {
+		v.err = action.ErrReadOnlyDenied
+		return
+	}
+	v.confirm = invokeConfirmRun
+}
+
+func (v *InvokeView) openEditor() tea.Cmd {
+	v.mode = invokeEditor
+	return v.editor.Focus()
+}
+
+// openEvents shows the saved events of the function.
+func (v *InvokeView) openEvents() {
+	events, err := v.store.Events(v.target)
+	if err != nil {
+		v.err = err
+		return
+	}
+	v.events = events
+	v.eventCursor = 0
+	for i, e := range events {
+		if e.Name == v.eventName {
+			v.eventCursor = i
+		}
+	}
+	v.err = nil
+	v.editor.Blur()
+	v.mode = invokeEvents
+}
+
+func (v *InvokeView) deleteEvent() {
+	name := v.events[v.eventCursor].Name
+	if err := v.store.Delete(v.target, name); err != nil {
+		v.err = err
+		return
+	}
+	if v.eventName == name {
+		v.eventName = ""
+	}
+	v.message = "Deleted event " + name
+	v.openEvents()
+	v.eventCursor = min(v.eventCursor, max(len(v.events)-1, 0))
+}
+
+func (v *InvokeView) cycleQualifier(dir int) {
+	n := len(v.qualifiers)
+	v.qualifierIdx = (v.qualifierIdx + dir + n) % n
+}
+
+// setOutput renders the result into the output viewport.
+func (v *InvokeView) setOutput() {
+	r := v.result
+	theme := ui.Current()
+	section := lipgloss.NewStyle().Foreground(theme.Accent).Bold(true)
+	var out strings.Builder
+
+	if r.DryRun {
+		out.WriteString(ui.SuccessStyle().Render(fmt.Sprintf("Dry run succeeded (status %d): parameters and permissions are valid", r.StatusCode)))
+		out.WriteString("\n")
+		v.output.SetContent(out.String())
+		v.output.GotoTop()
+		return
+	}
+
+	if r.Failed() {
+		out.WriteString(ui.DangerStyle().Render("Function error: " + r.FunctionError))
+		out.WriteString("\n\n")
+	}
+	out.WriteString(section.Render("Response"))
+	out.WriteString("\n")
+	if len(r.Payload) == 0 {
+		out.WriteString(ui.DimStyle().Render("(empty)"))
+	} else {
+		out.WriteString(r.PrettyPayload())
+	}
+	out.WriteString("\n\n")
+	out.WriteString(section.Render("Log output (tail)"))
+	out.WriteString("\n")
+	if r.LogTail == "" {
+		out.WriteString(ui.DimStyle().Render("(none)"))
+	} else {
+		out.WriteString(strings.TrimRight(r.LogTail, "\n"))
+	}
+	out.WriteString("\n")
+	v.output.SetContent(out.String())
+	v.output.GotoTop()
+}
+
+// ViewString returns the view content as a string
+func (v *InvokeView) ViewString() string {
+	theme := ui.Current()
+	header := lipgloss.NewStyle().
+		Foreground(theme.TableHeaderText).
+		Background(theme.TableHeader).
+		Padding(0, 1).
+		Width(v.width).
+		Render("Lambda Invoke: " + v.target.Name)
+
+	qualifier := lipgloss.NewStyle().Foreground(theme.Accent).Render("‹ " + v.qualifier().Label() + " ›")
+	line := "Qualifier " + qualifier
+	if v.eventName != "" {
+		line += ui.DimStyle().Render("  event: " + v.eventName)
+	}
+	status := lipgloss.NewStyle().Foreground(theme.TextDim).Padding(0, 1).Render(v.statusText())
+	out := header + "\n" + lipgloss.NewStyle().Padding(0, 1).Render(line) + "\n" + status + "\n"
+
+	if prompt := v.confirmPrompt(); prompt != "" {
+		out += prompt + "\n"
+	}
+	if v.err != nil {
+		out += ui.DangerStyle().Render(fmt.Sprintf("Error: %v", v.err)) + "\n"
+	}
+
+	switch v.mode {
+	case invokeEditor:
+		return out + v.editor.View()
+	case invokeSaveName:
+		return out + v.nameInput.View() + "\n\n" + v.editor.View()
+	case invokeEvents:
+		return out + v.eventsView()
+	}
+
+	if v.result == nil && !v.loading {
+		return out + ui.DimStyle().Render("Not invoked yet. Press e to edit the payload, i to invoke, I for a dry run.")
+	}
+	return out + v.output.View()
+}
+
+func (v *InvokeView) eventsView() string {
+	if len(v.events) == 0 {
+		return ui.DimStyle().Render("No saved events. Press ctrl+s in the editor to save the payload as an event.")
+	}
+	selected := lipgloss.NewStyle().Foreground(ui.Current().SelectionText).Background(ui.Current().Selection)
+	var lines []string
+	for i, e := range v.events {
+		preview := truncateValue(strings.Join(strings.Fields(e.Payload), " "), max(v.width-len(e.Name)-4, 10))
+		if i == v.eventCursor {
+			lines = append(lines, selected.Render(e.Name+"  "+preview))
+		} else {
+			lines = append(lines, e.Name+"  "+ui.DimStyle().Render(preview))
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+func (v *InvokeView) confirmPrompt() string {
+	var text string
+	switch v.confirm {
+	case invokeConfirmRun:
+		text = fmt.Sprintf("Invoke %s:%s? This runs the function.", v.target.Name, v.qualifier().Name)
+	case invokeConfirmDelete:
+		text = fmt.Sprintf("Delete saved event %s?", v.events[v.eventCursor].Name)
+	default:
+		return ""
+	}
+	return ui.WarningStyle().Render(text) + " " + ui.DimStyle().Render("[y/n]")
+}
+
+func (v *InvokeView) statusText() string {
+	switch {
+	case v.loading && v.dryRun:
+		return v.spinner.View() + " Dry run..."
+	case v.loading:
+		return v.spinner.View() + " Invoking..."
+	case v.message != "":
+		return v.message
+	case v.mode == invokeEvents:
+		return fmt.Sprintf("%d saved events", len(v.events))
+	case v.result != nil && v.result.DryRun:
+		return fmt.Sprintf("Dry run • status %d • %s", v.result.StatusCode, v.result.Duration.Round(time.Millisecond))
+	case v.result != nil:
+		text := fmt.Sprintf("Status %d • version %s • %s", v.result.StatusCode, v.result.ExecutedVersion, v.result.Duration.Round(time.Millisecond))
+		if v.result.Failed() {
+			text += " • function error"
+		}
+		return text
+	}
+	return ""
+}
+
+// View implements tea.Model
+func (v *InvokeView) View() tea.View {
+	return tea.NewView(v.ViewString())
+}
+
+// SetSize implements View
+func (v *InvokeView) SetSize(width, height int) tea.Cmd {
+	v.width = width
+	v.height = height
+	v.editor.SetWidth(max(width-2, 20))
+	v.editor.SetHeight(max(height-6, 3))
+	v.nameInput.SetWidth(max(width-20, 20))
+	v.output.SetWidth(width)
+	v.output.SetHeight(max(height-4, 3))
+	return nil
+}
+
+// StatusLine implements View
+func (v *InvokeView) StatusLine() string {
+	if v.confirm != invokeConfirmNone {
+		return "y:confirm • n:cancel"
+	}
+	readOnly := config.Global().ReadOnly()
+	switch v.mode {
+	case invokeEditor:
+		parts := []string{}
+		if !readOnly {
+			parts = append(parts, "ctrl+r:invoke")
+		}
+		parts = append(parts, "ctrl+x:dry run", "tab:qualifier", "ctrl+s:save event", "ctrl+o:events", "ctrl+l:format")
+		return strings.Join(parts, " ") + " • esc:result"
+	case invokeSaveName:
+		return "enter:save • esc:cancel"
+	case invokeEvents:
+		return "enter:load • D:delete • esc:editor"
+	}
+	parts := []string{"e:edit"}
+	if !readOnly {
+		parts = append(parts, "i:invoke")
+	}
+	parts = append(parts, "I:dry run", "tab:qualifier", "o:events", "y:copy response")
+	return strings.Join(parts, " ") + " • esc:back"
+}
+
+// HasActiveInput implements InputCapture. Everything except the result pane
+// handles esc itself.
+func (v *InvokeView) HasActiveInput() bool {
+	return v.mode != invokeResult || v.confirm != invokeConfirmNone
+}
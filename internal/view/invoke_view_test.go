@@ -0,0 +1,211 @@
+package view
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/aws/aws-sdk-go-v2/service/lambda/types"
+
+	"github.com/clawscli/claws/internal/action"
+	"github.com/clawscli/claws/internal/config"
+	"github.com/clawscli/claws/internal/invoke"
+)
+
+type fakeInvokeClient struct {
+	invokes []*lambda.InvokeInput
+}
+
+func (f *fakeInvokeClient) Invoke(_ context.Context, in *lambda.InvokeInput, _ ...func(*lambda.Options)) (*lambda.InvokeOutput, error) {
+	f.invokes = append(f.invokes, in)
+	if in.InvocationType == types.InvocationTypeDryRun {
+		return &lambda.InvokeOutput{StatusCode: 204}, nil
+	}
+	return &lambda.InvokeOutput{
+		StatusCode:      200,
+		ExecutedVersion: aws.String("3"),
+		FunctionError:   aws.String("Unhandled"),
+		Payload:         []byte(`{"errorMessage":"boom"}`),
+		LogResult:       aws.String(base64.StdEncoding.EncodeToString([]byte("ERROR boom\nEND RequestId: 1\n"))),
+	}, nil
+}
+
+func (f *fakeInvokeClient) ListVersionsByFunction(_ context.Context, _ *lambda.ListVersionsByFunctionInput, _ ...func(*lambda.Options)) (*lambda.ListVersionsByFunctionOutput, error) {
+	return &lambda.ListVersionsByFunctionOutput{Versions: []types.FunctionConfiguration{
+		{Version: aws.String("$LATEST")}, {Version: aws.String("3")},
+	}}, nil
+}
+
+func (f *fakeInvokeClient) ListAliases(_ context.Context, _ *lambda.ListAliasesInput, _ ...func(*lambda.Options)) (*lambda.ListAliasesOutput, error) {
+	return &lambda.ListAliasesOutput{Aliases: []types.AliasConfiguration{
+		{Name: aws.String("live"), FunctionVersion: aws.String("3")},
+	}}, nil
+}
+
+type mockInvokeResource struct {
+	mockResource
+}
+
+func (m *mockInvokeResource) InvokeTarget() invoke.Target { return invoke.Target{Name: m.id} }
+
+func newTestInvokeView(t *testing.T, client *fakeInvokeClient) *InvokeView {
+	v := NewInvokeView(context.Background(), invoke.Target{Name: "orders"})
+	v.client = client
+	v.store = invoke.NewEventStore(filepath.Join(t.TempDir(), "events.json"))
+	v.SetSize(120, 30)
+	runInvokeCmd(v, v.Init())
+	return v
+}
+
+// runInvokeCmd runs cmd and feeds its messages, including batched ones, to v.
+func runInvokeCmd(v *InvokeView, cmd tea.Cmd) {
+	if cmd == nil {
+		return
+	}
+	switch msg := cmd().(type) {
+	case tea.BatchMsg:
+		for _, c := range msg {
+			runInvokeCmd(v, c)
+		}
+	case invokeQualifiersMsg, invokeResultMsg:
+		v.Update(msg)
+	}
+}
+
+func TestInvokeView_Invoke(t *testing.T) {
+	client := &fakeInvokeClient{}
+	v := newTestInvokeView(t, client)
+
+	if len(v.qualifiers) != 3 {
+		t.Fatalf("qualifiers = %+v", v.qualifiers)
+	}
+	v.Update(tea.KeyPressMsg{Code: tea.KeyTab})
+	v.Update(tea.KeyPressMsg{Code: tea.KeyTab})
+	if v.qualifier().Name != "live" {
+		t.Fatalf("tab should cycle to the alias, got %s", v.qualifier().Name)
+	}
+
+	v.editor.SetValue(`{"orderId": 7}`)
+	v.Update(tea.KeyPressMsg{Code: 'r', Mod: tea.ModCtrl})
+	if v.confirm != invokeConfirmRun {
+		t.Fatalf("ctrl+r should ask for confirmation (err = %v)", v.err)
+	}
+	_, cmd := v.Update(tea.KeyPressMsg{Code: 'y', Text: "y"})
+	runInvokeCmd(v, cmd)
+
+	if len(client.invokes) != 1 {
+		t.Fatalf("invokes = %d", len(client.invokes))
+	}
+	in := client.invokes[0]
+	if aws.ToString(in.Qualifier) != "live" || string(in.Payload) != `{"orderId": 7}` {
+		t.Errorf("InvokeInput qualifier = %s, payload = %s", aws.ToString(in.Qualifier), in.Payload)
+	}
+	if v.mode != invokeResult || v.HasActiveInput() {
+		t.Errorf("mode = %v after invoke, want result", v.mode)
+	}
+	out := v.ViewString()
+	for _, want := range []string{"Function error: Unhandled", `"errorMessage": "boom"`, "ERROR boom"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("result view missing %q:\n%s", want, out)
+		}
+	}
+}
+
+func TestInvokeView_DeclineInvoke(t *testing.T) {
+	client := &fakeInvokeClient{}
+	v := newTestInvokeView(t, client)
+
+	v.Update(tea.KeyPressMsg{Code: 'r', Mod: tea.ModCtrl})
+	_, cmd := v.Update(tea.KeyPressMsg{Code: 'n', Text: "n"})
+	runInvokeCmd(v, cmd)
+	if len(client.invokes) != 0 || v.confirm != invokeConfirmNone || v.mode != invokeEditor {
+		t.Errorf("n should cancel the invoke: invokes = %d, confirm = %v, mode = %v", len(client.invokes), v.confirm, v.mode)
+	}
+}
+
+func TestInvokeView_InvalidPayload(t *testing.T) {
+	client := &fakeInvokeClient{}
+	v := newTestInvokeView(t, client)
+
+	v.editor.SetValue(`{"orderId": `)
+	v.Update(tea.KeyPressMsg{Code: 'x', Mod: tea.ModCtrl})
+	if v.err == nil || len(client.invokes) != 0 || v.mode != invokeEditor {
+		t.Errorf("invalid JSON should stay in the editor: err = %v, invokes = %d", v.err, len(client.invokes))
+	}
+}
+
+func TestInvokeView_SavedEvents(t *testing.T) {
+	v := newTestInvokeView(t, &fakeInvokeClient{})
+
+	v.editor.SetValue(`{"type": "created"}`)
+	v.Update(tea.KeyPressMsg{Code: 's', Mod: tea.ModCtrl})
+	if v.mode != invokeSaveName {
+		t.Fatalf("ctrl+s should ask for an event name, mode = %v", v.mode)
+	}
+	v.nameInput.SetValue("created")
+	v.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+	if v.mode != invokeEditor || v.eventName != "created" {
+		t.Fatalf("enter should save the event: mode = %v, err = %v", v.mode, v.err)
+	}
+
+	v.editor.SetValue("{}")
+	v.Update(tea.KeyPressMsg{Code: 'o', Mod: tea.ModCtrl})
+	if v.mode != invokeEvents || len(v.events) != 1 {
+		t.Fatalf("ctrl+o should list saved events: mode = %v, events = %+v", v.mode, v.events)
+	}
+	v.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+	if v.mode != invokeEditor || !strings.Contains(v.editor.Value(), `"type": "created"`) {
+		t.Fatalf("enter should load the event, editor = %q", v.editor.Value())
+	}
+
+	v.Update(tea.KeyPressMsg{Code: 'o', Mod: tea.ModCtrl})
+	v.Update(tea.KeyPressMsg{Code: 'D', Text: "D"})
+	v.Update(tea.KeyPressMsg{Code: 'y', Text: "y"})
+	if len(v.events) != 0 || v.eventName != "" {
+		t.Errorf("D should delete the event, events = %+v", v.events)
+	}
+}
+
+func TestInvokeView_ReadOnly(t *testing.T) {
+	config.Global().SetReadOnly(true)
+	defer config.Global().SetReadOnly(false)
+
+	client := &fakeInvokeClient{}
+	v := newTestInvokeView(t, client)
+
+	v.Update(tea.KeyPressMsg{Code: 'r', Mod: tea.ModCtrl})
+	if v.confirm != invokeConfirmNone || !errors.Is(v.err, action.ErrReadOnlyDenied) {
+		t.Errorf("invoke should be blocked in read-only mode: confirm = %v, err = %v", v.confirm, v.err)
+	}
+
+	_, cmd := v.Update(tea.KeyPressMsg{Code: 'x', Mod: tea.ModCtrl})
+	runInvokeCmd(v, cmd)
+	if len(client.invokes) != 1 || client.invokes[0].InvocationType != types.InvocationTypeDryRun {
+		t.Fatalf("dry run should be allowed in read-only mode, invokes = %d", len(client.invokes))
+	}
+	if !strings.Contains(v.ViewString(), "Dry run succeeded") {
+		t.Errorf("dry run result missing:\n%s", v.ViewString())
+	}
+	if strings.Contains(v.StatusLine(), "i:invoke") {
+		t.Error("status line should not offer invoke in read-only mode")
+	}
+}
+
+func TestOpenViewTarget_Invoke(t *testing.T) {
+	v, err := openViewTarget(context.Background(), action.TargetInvoke, &mockInvokeResource{mockResource{id: "orders"}})
+	if err != nil {
+		t.Fatalf("openViewTarget() error = %v", err)
+	}
+	if _, ok := v.(*InvokeView); !ok {
+		t.Fatalf("openViewTarget() = %T, want *InvokeView", v)
+	}
+	if _, err := openViewTarget(context.Background(), action.TargetInvoke, &mockResource{id: "x"}); err == nil {
+		t.Error("expected error for a resource that cannot be invoked")
+	}
+}
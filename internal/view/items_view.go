@@ -0,0 +1,968 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"charm.land/bubbles/v2/spinner"
+	"charm.land/bubbles/v2/table"
+	"charm.land/bubbles/v2/textarea"
+	"charm.land/bubbles/v2/textinput"
+	"charm.land/bubbles/v2/viewport"
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+
+	"github.com/clawscli/claws/internal/action"
+	"github.com/clawscli/claws/internal/config"
+	"github.com/clawscli/claws/internal/dynamo"
+	"github.com/clawscli/claws/internal/ui"
+)
+
+const itemsMaxColWidth = 40
+
+type itemsMode int
+
+const (
+	itemsForm itemsMode = iota
+	itemsResults
+	itemsItem
+	itemsEditor
+)
+
+// Form fields, in tab order
+const (
+	itemsFieldIndex = iota
+	itemsFieldPartition
+	itemsFieldSortOp
+	itemsFieldSort
+	itemsFieldSort2
+	itemsFieldFilter
+	itemsFieldCount
+)
+
+type itemsConfirm int
+
+const (
+	itemsConfirmNone itemsConfirm = iota
+	itemsConfirmPut
+	itemsConfirmDelete
+)
+
+// ItemsView queries or scans the items of a DynamoDB table or index and
+// shows them in a table, with columns taken from the item attributes.
+// Single items can be put and deleted, except in read-only mode.
+type ItemsView struct {
+	ctx    context.Context
+	client dynamo.Client
+	tbl    dynamo.Table
+
+	mode  itemsMode
+	focus int
+
+	indexIdx       int
+	sortOpIdx      int
+	partitionInput textinput.Model
+	sortInput      textinput.Model
+	sort2Input     textinput.Model
+	filterInput    textinput.Model
+
+	runID   int
+	request dynamo.Request
+	items   []dynamo.Item
+	lastKey dynamo.Item
+	scanned int32
+	columns []string
+	loading bool
+	hasRun  bool
+	err     error
+	message string
+
+	itemIndex  int
+	dynamoJSON bool
+	itemView   viewport.Model
+
+	editor     textarea.Model
+	editorNew  bool
+	editorFrom itemsMode
+	confirm    itemsConfirm
+	pending    dynamo.Item
+	pendingKey string
+
+	table   table.Model
+	width   int
+	height  int
+	spinner spinner.Model
+}
+
+// NewItemsView creates an ItemsView for t. The query form is shown first.
+func NewItemsView(ctx context.Context, t dynamo.Table) *ItemsView {
+	newInput := func(placeholder string) textinput.Model {
+		in := textinput.New()
+		in.Prompt = ""
+		in.Placeholder = placeholder
+		in.CharLimit = 2048
+		return in
+	}
+
+	editor := textarea.New()
+	editor.ShowLineNumbers = false
+	editor.CharLimit = 0
+
+	v := &ItemsView{
+		ctx:            ctx,
+		tbl:            t,
+		partitionInput: newInput("empty to scan"),
+		sortInput:      newInput(""),
+		sort2Input:     newInput(""),
+		filterInput:    newInput(`status = "active" and age >= 21`),
+		itemView:       viewport.New(),
+		editor:         editor,
+		spinner:        ui.NewSpinner(),
+	}
+	v.focus = itemsFieldPartition
+	v.focusField()
+	return v
+}
+
+type itemsFetchedMsg struct {
+	runID int
+	more  bool
+	page  dynamo.Page
+	err   error
+}
+
+type itemsWrittenMsg struct {
+	deleted bool
+	key     string
+	item    dynamo.Item
+	err     error
+}
+
+// Init implements tea.Model
+func (v *ItemsView) Init() tea.Cmd {
+	return textinput.Blink
+}
+
+// itemsClient returns the client, creating it on first use.
+func (v *ItemsView) itemsClient() (dynamo.Client, error) {
+	if v.client == nil {
+		c, err := dynamo.NewClient(v.ctx)
+		if err != nil {
+			return nil, err
+		}
+		v.client = c
+	}
+	return v.client, nil
+}
+
+func (v *ItemsView) index() dynamo.Index {
+	return v.tbl.AllIndexes()[v.indexIdx]
+}
+
+// formRequest builds the request from the form.
+func (v *ItemsView) formRequest() dynamo.Request {
+	r := dynamo.Request{
+		Index:          v.index().Name,
+		PartitionValue: strings.TrimSpace(v.partitionInput.Value()),
+		Filter:         strings.TrimSpace(v.filterInput.Value()),
+	}
+	if r.IsQuery() && v.index().SortKey.Name != "" {
+		r.SortOp = dynamo.SortOps[v.sortOpIdx]
+		r.SortValue = strings.TrimSpace(v.sortInput.Value())
+		r.SortValue2 = strings.TrimSpace(v.sort2Input.Value())
+	}
+	return r
+}
+
+// run starts the request from the form, replacing the results.
+func (v *ItemsView) run() tea.Cmd {
+	client, err := v.itemsClient()
+	if err != nil {
+		v.err = err
+		return nil
+	}
+	v.runID++
+	v.request = v.formRequest()
+	v.items = nil
+	v.lastKey = nil
+	v.scanned = 0
+	v.columns = nil
+	v.loading = true
+	v.hasRun = true
+	v.err = nil
+	v.message = ""
+	v.mode = itemsResults
+	v.blurAll()
+	v.buildTable()
+	return tea.Batch(v.spinner.Tick, v.fetch(client, v.request, false))
+}
+
+// more fetches the page after the current results.
+func (v *ItemsView) more() tea.Cmd {
+	if v.loading || len(v.lastKey) == 0 || v.client == nil {
+		return nil
+	}
+	v.loading = true
+	v.message = ""
+	r := v.request
+	r.StartKey = v.lastKey
+	return tea.Batch(v.spinner.Tick, v.fetch(v.client, r, true))
+}
+
+func (v *ItemsView) fetch(client dynamo.Client, r dynamo.Request, more bool) tea.Cmd {
+	ctx, tbl, runID := v.ctx, v.tbl, v.runID
+	return func() tea.Msg {
+		page, err := dynamo.Fetch(ctx, client, tbl, r)
+		return itemsFetchedMsg{runID: runID, more: more, page: page, err: err}
+	}
+}
+
+// Update implements tea.Model
+func (v *ItemsView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case itemsFetchedMsg:
+		if msg.runID != v.runID {
+			return v, nil
+		}
+		v.loading = false
+		v.err = msg.err
+		if msg.err != nil && len(msg.page.Items) == 0 {
+			return v, nil
+		}
+		cursor := v.table.Cursor()
+		v.items = append(v.items, msg.page.Items...)
+		v.lastKey = msg.page.LastKey
+		v.scanned += msg.page.Scanned
+		v.columns = dynamo.Columns(v.tbl, v.items)
+		v.buildTable()
+		if msg.more {
+			v.table.SetCursor(cursor)
+		}
+		return v, nil
+
+	case itemsWrittenMsg:
+		v.record(msg.deleted, msg.key, msg.err)
+		if msg.err != nil {
+			v.err = msg.err
+			return v, nil
+		}
+		if msg.deleted {
+			v.removeItem(msg.key)
+			v.message = "Deleted " + msg.key
+			if v.mode == itemsItem {
+				v.mode = itemsResults
+			}
+			return v, nil
+		}
+		v.upsertItem(msg.item)
+		v.message = "Saved " + msg.key
+		return v, nil
+
+	case spinner.TickMsg:
+		if v.loading {
+			var cmd tea.Cmd
+			v.spinner, cmd = v.spinner.Update(msg)
+			return v, cmd
+		}
+		return v, nil
+
+	case tea.MouseWheelMsg:
+		switch v.mode {
+		case itemsResults:
+			var cmd tea.Cmd
+			v.table, cmd = v.table.Update(msg)
+			return v, cmd
+		case itemsItem:
+			var cmd tea.Cmd
+			v.itemView, cmd = v.itemView.Update(msg)
+			return v, cmd
+		}
+		return v, nil
+
+	case tea.KeyPressMsg:
+		if v.confirm != itemsConfirmNone {
+			return v.handleConfirmKey(msg)
+		}
+		switch v.mode {
+		case itemsForm:
+			return v.handleFormKey(msg)
+		case itemsItem:
+			return v.handleItemKey(msg)
+		case itemsEditor:
+			return v.handleEditorKey(msg)
+		}
+		if model, cmd, ok := v.handleResultsKey(msg); ok {
+			return model, cmd
+		}
+	}
+
+	if v.mode == itemsResults {
+		var cmd tea.Cmd
+		v.table, cmd = v.table.Update(msg)
+		return v, cmd
+	}
+	return v, nil
+}
+
+func (v *ItemsView) handleFormKey(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "ctrl+r", "enter":
+		return v, v.run()
+
+	case "tab", "down":
+		v.focus = v.nextField(1)
+		return v, v.focusField()
+
+	case "shift+tab", "up":
+		v.focus = v.nextField(-1)
+		return v, v.focusField()
+
+	case "esc":
+		v.blurAll()
+		v.mode = itemsResults
+		return v, nil
+	}
+
+	switch v.focus {
+	case itemsFieldIndex:
+		if n := len(v.tbl.AllIndexes()); cycleKey(msg, &v.indexIdx, n) {
+			if v.index().SortKey.Name == "" {
+				v.sortOpIdx = 0
+			}
+		}
+		return v, nil
+	case itemsFieldSortOp:
+		cycleKey(msg, &v.sortOpIdx, len(dynamo.SortOps))
+		return v, nil
+	}
+
+	var cmd tea.Cmd
+	switch v.focus {
+	case itemsFieldPartition:
+		v.partitionInput, cmd = v.partitionInput.Update(msg)
+	case itemsFieldSort:
+		v.sortInput, cmd = v.sortInput.Update(msg)
+	case itemsFieldSort2:
+		v.sort2Input, cmd = v.sort2Input.Update(msg)
+	case itemsFieldFilter:
+		v.filterInput, cmd = v.filterInput.Update(msg)
+	}
+	return v, cmd
+}
+
+// cycleKey moves *i through n choices on left/right or space, reporting
+// whether it changed.
+func cycleKey(msg tea.KeyPressMsg, i *int, n int) bool {
+	switch msg.String() {
+	case "right", "l", "space":
+		*i = (*i + 1) % n
+	case "left", "h":
+		*i = (*i + n - 1) % n
+	default:
+		return false
+	}
+	return true
+}
+
+// nextField returns the next form field in direction dir, skipping the
+// sort key fields when they do not apply.
+func (v *ItemsView) nextField(dir int) int {
+	f := v.focus
+	for range itemsFieldCount {
+		f = (f + dir + itemsFieldCount) % itemsFieldCount
+		if v.fieldEnabled(f) {
+			return f
+		}
+	}
+	return v.focus
+}
+
+func (v *ItemsView) fieldEnabled(f int) bool {
+	switch f {
+	case itemsFieldSortOp:
+		return v.index().SortKey.Name != ""
+	case itemsFieldSort:
+		return v.index().SortKey.Name != "" && dynamo.SortOps[v.sortOpIdx] != ""
+	case itemsFieldSort2:
+		return v.index().SortKey.Name != "" && dynamo.SortOps[v.sortOpIdx] == "between"
+	}
+	return true
+}
+
+func (v *ItemsView) handleResultsKey(msg tea.KeyPressMsg) (tea.Model, tea.Cmd, bool) {
+	switch msg.String() {
+	case "e", "i":
+		v.mode = itemsForm
+		return v, v.focusField(), true
+
+	case "ctrl+r":
+		return v, v.run(), true
+
+	case "N":
+		return v, v.more(), true
+
+	case "enter", "d":
+		if cursor := v.table.Cursor(); cursor < len(v.items) {
+			v.itemIndex = cursor
+			v.mode = itemsItem
+			v.setItemContent()
+		}
+		return v, nil, true
+
+	case "J":
+		v.dynamoJSON = !v.dynamoJSON
+		v.message = "Items copy and edit as " + v.formatName()
+		return v, nil, true
+
+	case "y":
+		return v, v.copyItem(v.table.Cursor()), true
+
+	case "n":
+		return v, v.openEditor(nil), true
+
+	case "E":
+		if cursor := v.table.Cursor(); cursor < len(v.items) {
+			return v, v.openEditor(v.items[cursor]), true
+		}
+		return v, nil, true
+
+	case "D":
+		if cursor := v.table.Cursor(); cursor < len(v.items) {
+			v.askDelete(v.items[cursor])
+		}
+		return v, nil, true
+
+	case "j", "down":
+		v.table.MoveDown(1)
+		return v, nil, true
+
+	case "k", "up":
+		v.table.MoveUp(1)
+		return v, nil, true
+	}
+	return v, nil, false
+}
+
+func (v *ItemsView) handleItemKey(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "esc", "q":
+		v.mode = itemsResults
+		return v, nil
+	case "J":
+		v.dynamoJSON = !v.dynamoJSON
+		v.setItemContent()
+		return v, nil
+	case "y":
+		return v, v.copyItem(v.itemIndex)
+	case "E":
+		return v, v.openEditor(v.items[v.itemIndex])
+	case "D":
+		v.askDelete(v.items[v.itemIndex])
+		return v, nil
+	case "]":
+		if v.itemIndex < len(v.items)-1 {
+			v.itemIndex++
+			v.table.SetCursor(v.itemIndex)
+			v.setItemContent()
+		}
+		return v, nil
+	case "[":
+		if v.itemIndex > 0 {
+			v.itemIndex--
+			v.table.SetCursor(v.itemIndex)
+			v.setItemContent()
+		}
+		return v, nil
+	case "g":
+		v.itemView.GotoTop()
+		return v, nil
+	case "G":
+		v.itemView.GotoBottom()
+		return v, nil
+	case "j":
+		v.itemView.ScrollDown(1)
+		return v, nil
+	case "k":
+		v.itemView.ScrollUp(1)
+		return v, nil
+	}
+	var cmd tea.Cmd
+	v.itemView, cmd = v.itemView.Update(msg)
+	return v, cmd
+}
+
+func (v *ItemsView) handleEditorKey(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "esc":
+		v.editor.Blur()
+		v.err = nil
+		v.mode = v.editorFrom
+		return v, nil
+
+	case "ctrl+s":
+		item, err := dynamo.ParseItem(v.editor.Value(), v.dynamoJSON)
+		if err != nil {
+			v.err = err
+			return v, nil
+		}
+		if _, err := v.tbl.ItemKey(item); err != nil {
+			v.err = err
+			return v, nil
+		}
+		v.err = nil
+		v.pending = item
+		v.pendingKey = dynamo.KeyString(v.tbl, item)
+		v.confirm = itemsConfirmPut
+		return v, nil
+
+	case "ctrl+t":
+		// Convert the editor contents to the other format
+		item, err := dynamo.ParseItem(v.editor.Value(), v.dynamoJSON)
+		if err != nil {
+			v.err = err
+			return v, nil
+		}
+		v.err = nil
+		v.dynamoJSON = !v.dynamoJSON
+		v.editor.SetValue(v.formatItem(item))
+		return v, nil
+	}
+
+	var cmd tea.Cmd
+	v.editor, cmd = v.editor.Update(msg)
+	return v, cmd
+}
+
+func (v *ItemsView) handleConfirmKey(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "y", "Y":
+		confirm := v.confirm
+		v.confirm = itemsConfirmNone
+		return v, v.write(confirm == itemsConfirmDelete)
+	case "n", "N", "esc", "q":
+		v.confirm = itemsConfirmNone
+		v.pending = nil
+	}
+	return v, nil
+}
+
+// openEditor opens the item editor, with item or, for a new item, a
+// template of the key attributes.
+func (v *ItemsView) openEditor(item dynamo.Item) tea.Cmd {
+	if config.Global().ReadOnly() {
+		v.err = action.ErrReadOnlyDenied
+		return nil
+	}
+	v.editorNew = item == nil
+	v.editorFrom = v.mode
+	if item == nil {
+		item = v.tbl.NewItem()
+	}
+	v.editor.SetValue(v.formatItem(item))
+	v.editor.MoveToBegin()
+	v.err = nil
+	v.message = ""
+	v.mode = itemsEditor
+	return v.editor.Focus()
+}
+
+func (v *ItemsView) askDelete(item dynamo.Item) {
+	if config.Global().ReadOnly() {
+		v.err = action.ErrReadOnlyDenied
+		return
+	}
+	v.pending = item
+	v.pendingKey = dynamo.KeyString(v.tbl, item)
+	v.confirm = itemsConfirmDelete
+}
+
+// write puts or deletes the pending item.
+func (v *ItemsView) write(deleted bool) tea.Cmd {
+	if config.Global().ReadOnly() {
+		v.err = action.ErrReadOnlyDenied
+		return nil
+	}
+	client, err := v.itemsClient()
+	if err != nil {
+		v.err = err
+		return nil
+	}
+	if !deleted {
+		v.editor.Blur()
+		v.mode = itemsResults
+	}
+	ctx, tbl, item, key := v.ctx, v.tbl, v.pending, v.pendingKey
+	v.pending = nil
+	return func() tea.Msg {
+		var err error
+		if deleted {
+			err = dynamo.DeleteItem(ctx, client, tbl, item)
+		} else {
+			err = dynamo.PutItem(ctx, client, tbl, item)
+		}
+		return itemsWrittenMsg{deleted: deleted, key: key, item: item, err: err}
+	}
+}
+
+// record logs a put or delete like an API action run from the action menu.
+func (v *ItemsView) record(deleted bool, key string, err error) {
+	act := action.Action{Name: "Put Item", Type: action.ActionTypeAPI, Operation: "PutItem"}
+	if deleted {
+		act = action.Action{Name: "Delete Item", Type: action.ActionTypeAPI, Operation: "DeleteItem"}
+	}
+	result := action.ActionResult{Success: err == nil, Error: err}
+	action.Record(act, "dynamodb", "tables", v.tbl.Name+" "+key, result)
+}
+
+// upsertItem replaces the result with item's key, or adds item.
+func (v *ItemsView) upsertItem(item dynamo.Item) {
+	key := dynamo.KeyString(v.tbl, item)
+	if i := v.findItem(key); i >= 0 {
+		v.items[i] = item
+	} else {
+		v.items = append(v.items, item)
+	}
+	v.hasRun = true
+	v.columns = dynamo.Columns(v.tbl, v.items)
+	cursor := v.table.Cursor()
+	v.buildTable()
+	v.table.SetCursor(min(cursor, max(len(v.items)-1, 0)))
+}
+
+func (v *ItemsView) removeItem(key string) {
+	i := v.findItem(key)
+	if i < 0 {
+		return
+	}
+	v.items = slices.Delete(v.items, i, i+1)
+	v.columns = dynamo.Columns(v.tbl, v.items)
+	cursor := v.table.Cursor()
+	v.buildTable()
+	v.table.SetCursor(min(cursor, max(len(v.items)-1, 0)))
+}
+
+func (v *ItemsView) findItem(key string) int {
+	return slices.IndexFunc(v.items, func(item dynamo.Item) bool {
+		return dynamo.KeyString(v.tbl, item) == key
+	})
+}
+
+func (v *ItemsView) formatItem(item dynamo.Item) string {
+	var (
+		s   string
+		err error
+	)
+	if v.dynamoJSON {
+		s, err = dynamo.DynamoJSON(item)
+	} else {
+		s, err = dynamo.PlainJSON(item)
+	}
+	if err != nil {
+		return err.Error()
+	}
+	return s
+}
+
+func (v *ItemsView) formatName() string {
+	if v.dynamoJSON {
+		return "DynamoDB JSON"
+	}
+	return "JSON"
+}
+
+func (v *ItemsView) copyItem(i int) tea.Cmd {
+	if i < 0 || i >= len(v.items) {
+		return nil
+	}
+	v.message = "Copied item as " + v.formatName()
+	return tea.SetClipboard(v.formatItem(v.items[i]))
+}
+
+func (v *ItemsView) setItemContent() {
+	if v.itemIndex < len(v.items) {
+		v.itemView.SetContent(v.formatItem(v.items[v.itemIndex]))
+		v.itemView.GotoTop()
+	}
+}
+
+func (v *ItemsView) focusField() tea.Cmd {
+	v.blurAll()
+	if !v.fieldEnabled(v.focus) {
+		v.focus = itemsFieldPartition
+	}
+	switch v.focus {
+	case itemsFieldPartition:
+		return v.partitionInput.Focus()
+	case itemsFieldSort:
+		return v.sortInput.Focus()
+	case itemsFieldSort2:
+		return v.sort2Input.Focus()
+	case itemsFieldFilter:
+		return v.filterInput.Focus()
+	}
+	return nil
+}
+
+func (v *ItemsView) blurAll() {
+	v.partitionInput.Blur()
+	v.sortInput.Blur()
+	v.sort2Input.Blur()
+	v.filterInput.Blur()
+}
+
+func (v *ItemsView) buildTable() {
+	tableWidth := v.width
+	if tableWidth < 80 {
+		tableWidth = 120
+	}
+	tableHeight := v.height - 4
+	if tableHeight < 10 {
+		tableHeight = 20
+	}
+
+	// Columns are added while they fit; the item view shows the rest
+	var columns []table.Column
+	used := 0
+	for _, name := range v.columns {
+		width := lipgloss.Width(name)
+		for _, item := range v.items {
+			width = max(width, min(lipgloss.Width(dynamo.CellValue(item[name])), itemsMaxColWidth))
+		}
+		if len(columns) > 0 && used+width+2 > tableWidth {
+			break
+		}
+		columns = append(columns, table.Column{Title: name, Width: width})
+		used += width + 2
+	}
+	if n := len(columns); n > 0 {
+		if rest := tableWidth - used; rest > 0 {
+			columns[n-1].Width += rest
+		}
+	}
+
+	rows := make([]table.Row, len(v.items))
+	for i, item := range v.items {
+		cells := make(table.Row, len(columns))
+		for j, col := range columns {
+			cells[j] = strings.ReplaceAll(dynamo.CellValue(item[col.Title]), "\n", " ")
+		}
+		rows[i] = cells
+	}
+
+	tbl := table.New(
+		table.WithColumns(columns),
+		table.WithRows(rows),
+		table.WithFocused(true),
+		table.WithHeight(tableHeight),
+		table.WithWidth(tableWidth),
+	)
+
+	s := table.DefaultStyles()
+	theme := ui.Current()
+	s.Header = s.Header.
+		BorderStyle(lipgloss.NormalBorder()).
+		BorderForeground(theme.TableBorder).
+		BorderBottom(true).
+		Bold(false)
+	s.Selected = s.Selected.
+		Foreground(theme.SelectionText).
+		Background(theme.Selection).
+		Bold(false)
+
+	tbl.SetStyles(s)
+	v.table = tbl
+}
+
+// ViewString returns the view content as a string
+func (v *ItemsView) ViewString() string {
+	theme := ui.Current()
+	title := "DynamoDB Items: " + v.tbl.Name
+	if idx := v.index(); idx.Name != "" {
+		title += " › " + idx.Name
+	}
+	header := lipgloss.NewStyle().
+		Foreground(theme.TableHeaderText).
+		Background(theme.TableHeader).
+		Padding(0, 1).
+		Width(v.width).
+		Render(title)
+	status := lipgloss.NewStyle().Foreground(theme.TextDim).Padding(0, 1).Render(v.statusText())
+	out := header + "\n" + status + "\n"
+
+	if prompt := v.confirmPrompt(); prompt != "" {
+		out += prompt + "\n"
+	}
+	if v.err != nil && v.mode != itemsForm {
+		out += ui.DangerStyle().Render(fmt.Sprintf("Error: %v", v.err)) + "\n"
+	}
+
+	switch v.mode {
+	case itemsForm:
+		return out + v.formView()
+	case itemsItem:
+		return out + v.itemView.View()
+	case itemsEditor:
+		return out + v.editor.View()
+	}
+
+	if !v.hasRun {
+		return out + ui.DimStyle().Render("No query run yet. Press e to query or scan, n to add an item.")
+	}
+	if len(v.items) == 0 && !v.loading {
+		return out + ui.DimStyle().Render("No items")
+	}
+	return out + v.table.View()
+}
+
+func (v *ItemsView) confirmPrompt() string {
+	var text string
+	switch v.confirm {
+	case itemsConfirmPut:
+		text = fmt.Sprintf("Put item %s? This replaces any item with the same key.", v.pendingKey)
+	case itemsConfirmDelete:
+		text = fmt.Sprintf("Delete item %s?", v.pendingKey)
+	default:
+		return ""
+	}
+	return ui.WarningStyle().Render(text) + " " + ui.DimStyle().Render("[y/n]")
+}
+
+func (v *ItemsView) statusText() string {
+	switch {
+	case v.loading:
+		return v.spinner.View() + " " + v.requestText() + "..."
+	case v.mode == itemsItem && v.itemIndex < len(v.items):
+		return fmt.Sprintf("Item %d of %d • %s • %s", v.itemIndex+1, len(v.items), dynamo.KeyString(v.tbl, v.items[v.itemIndex]), v.formatName())
+	case v.mode == itemsEditor:
+		if v.editorNew {
+			return "New item • " + v.formatName()
+		}
+		return "Edit item • " + v.formatName()
+	case v.message != "":
+		return v.message
+	case v.hasRun && v.err == nil:
+		text := fmt.Sprintf("%d items • %d scanned • %s", len(v.items), v.scanned, v.requestText())
+		if len(v.lastKey) > 0 {
+			text += " • more available"
+		}
+		if hidden := len(v.columns) - len(v.table.Columns()); hidden > 0 {
+			text += fmt.Sprintf(" • %d more attributes", hidden)
+		}
+		return text
+	}
+	return ""
+}
+
+// requestText describes the current request, such as "Query on gsi1".
+func (v *ItemsView) requestText() string {
+	op := "Scan"
+	if v.request.IsQuery() {
+		op = "Query"
+	}
+	idx, _ := v.tbl.Index(v.request.Index)
+	return op + " on " + idx.Label()
+}
+
+func (v *ItemsView) formView() string {
+	theme := ui.Current()
+	label := lipgloss.NewStyle().Foreground(theme.TextDim).Width(16)
+	active := lipgloss.NewStyle().Foreground(theme.Accent).Bold(true).Width(16)
+	labelFor := func(field int, text string) string {
+		if v.focus == field {
+			return active.Render(text)
+		}
+		return label.Render(text)
+	}
+	keyHint := func(k dynamo.Key) string {
+		return ui.DimStyle().Render(fmt.Sprintf("  %s (%s)", k.Name, k.Type))
+	}
+
+	idx := v.index()
+	var names []string
+	for i, ix := range v.tbl.AllIndexes() {
+		name := ix.Label()
+		if i == v.indexIdx {
+			name = lipgloss.NewStyle().Foreground(theme.Accent).Render("[" + name + "]")
+		}
+		names = append(names, name)
+	}
+	out := labelFor(itemsFieldIndex, "Index") + strings.Join(names, " ") + "\n"
+	out += labelFor(itemsFieldPartition, "Partition key") + v.partitionInput.View() + keyHint(idx.PartitionKey) + "\n"
+	if idx.SortKey.Name != "" {
+		op := dynamo.SortOps[v.sortOpIdx]
+		if op == "" {
+			op = "(any)"
+		}
+		out += labelFor(itemsFieldSortOp, "Sort condition") + "‹ " + op + " ›" + keyHint(idx.SortKey) + "\n"
+		if v.fieldEnabled(itemsFieldSort) {
+			out += labelFor(itemsFieldSort, "Sort key") + v.sortInput.View() + "\n"
+		}
+		if v.fieldEnabled(itemsFieldSort2) {
+			out += labelFor(itemsFieldSort2, "  and") + v.sort2Input.View() + "\n"
+		}
+	}
+	out += labelFor(itemsFieldFilter, "Filter") + v.filterInput.View() + "\n\n"
+	out += ui.DimStyle().Render("With a partition key value this runs a Query; without one, a Scan.") + "\n"
+	out += ui.DimStyle().Render(`Filter: attr = "text", attr >= 10, attr begins_with x, attr contains x, attr exists; join with "and".`)
+	if v.err != nil {
+		out += "\n\n" + ui.DangerStyle().Render(fmt.Sprintf("Error: %v", v.err))
+	}
+	return out
+}
+
+// View implements tea.Model
+func (v *ItemsView) View() tea.View {
+	return tea.NewView(v.ViewString())
+}
+
+// SetSize implements View
+func (v *ItemsView) SetSize(width, height int) tea.Cmd {
+	v.width = width
+	v.height = height
+	for _, in := range []*textinput.Model{&v.partitionInput, &v.sortInput, &v.sort2Input, &v.filterInput} {
+		in.SetWidth(max(width-40, 20))
+	}
+	v.itemView.SetWidth(width)
+	v.itemView.SetHeight(max(height-3, 3))
+	v.editor.SetWidth(max(width-2, 20))
+	v.editor.SetHeight(max(height-4, 3))
+	v.buildTable()
+	return nil
+}
+
+// StatusLine implements View
+func (v *ItemsView) StatusLine() string {
+	if v.confirm != itemsConfirmNone {
+		return "y:confirm • n:cancel"
+	}
+	readOnly := config.Global().ReadOnly()
+	switch v.mode {
+	case itemsForm:
+		return "enter:run • tab:next field • ←/→:change choice • esc:results"
+	case itemsEditor:
+		return "ctrl+s:save • ctrl+t:toggle DynamoDB JSON • esc:cancel"
+	case itemsItem:
+		parts := []string{"J:DynamoDB JSON", "y:copy", "[/]:prev/next"}
+		if !readOnly {
+			parts = append(parts, "E:edit", "D:delete")
+		}
+		return strings.Join(parts, " ") + " • esc:back"
+	}
+	parts := []string{"e:query", "ctrl+r:rerun", "enter:item"}
+	if len(v.lastKey) > 0 {
+		parts = append(parts, "N:more")
+	}
+	parts = append(parts, "y:copy")
+	if !readOnly {
+		parts = append(parts, "n:new", "E:edit", "D:delete")
+	}
+	return strings.Join(parts, " ") + " • esc:back"
+}
+
+// HasActiveInput implements InputCapture. Everything except the results
+// table handles esc itself.
+func (v *ItemsView) HasActiveInput() bool {
+	return v.mode != itemsResults || v.confirm != itemsConfirmNone
+}
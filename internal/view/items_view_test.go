@@ -0,0 +1,189 @@
+package view
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+
+	"github.com/clawscli/claws/internal/action"
+	"github.com/clawscli/claws/internal/config"
+	"github.com/clawscli/claws/internal/dynamo"
+)
+
+type fakeItemsClient struct {
+	items   []dynamo.Item
+	queries []*dynamodb.QueryInput
+	scans   []*dynamodb.ScanInput
+	puts    []dynamo.Item
+	deletes []dynamo.Item
+}
+
+func (f *fakeItemsClient) Query(_ context.Context, in *dynamodb.QueryInput, _ ...func(*dynamodb.Options)) (*dynamodb.QueryOutput, error) {
+	f.queries = append(f.queries, in)
+	return &dynamodb.QueryOutput{Items: f.items}, nil
+}
+
+func (f *fakeItemsClient) Scan(_ context.Context, in *dynamodb.ScanInput, _ ...func(*dynamodb.Options)) (*dynamodb.ScanOutput, error) {
+	f.scans = append(f.scans, in)
+	return &dynamodb.ScanOutput{Items: f.items}, nil
+}
+
+func (f *fakeItemsClient) PutItem(_ context.Context, in *dynamodb.PutItemInput, _ ...func(*dynamodb.Options)) (*dynamodb.PutItemOutput, error) {
+	f.puts = append(f.puts, in.Item)
+	return &dynamodb.PutItemOutput{}, nil
+}
+
+func (f *fakeItemsClient) DeleteItem(_ context.Context, in *dynamodb.DeleteItemInput, _ ...func(*dynamodb.Options)) (*dynamodb.DeleteItemOutput, error) {
+	f.deletes = append(f.deletes, in.Key)
+	return &dynamodb.DeleteItemOutput{}, nil
+}
+
+type mockItemsResource struct {
+	mockResource
+}
+
+func (m *mockItemsResource) ItemTable() dynamo.Table { return itemsTestTable }
+
+var itemsTestTable = dynamo.Table{
+	Name: "users",
+	Key:  dynamo.Index{PartitionKey: dynamo.Key{Name: "id", Type: "S"}},
+}
+
+func newTestItemsView(client *fakeItemsClient) *ItemsView {
+	v := NewItemsView(context.Background(), itemsTestTable)
+	v.client = client
+	v.SetSize(120, 30)
+	return v
+}
+
+// runItemsCmd runs cmd and feeds its messages, including batched ones, to v.
+func runItemsCmd(v *ItemsView, cmd tea.Cmd) {
+	if cmd == nil {
+		return
+	}
+	switch msg := cmd().(type) {
+	case tea.BatchMsg:
+		for _, c := range msg {
+			runItemsCmd(v, c)
+		}
+	case itemsFetchedMsg, itemsWrittenMsg:
+		v.Update(msg)
+	}
+}
+
+func TestItemsView_ScanAndQuery(t *testing.T) {
+	client := &fakeItemsClient{items: []dynamo.Item{
+		{"id": &types.AttributeValueMemberS{Value: "u1"}, "name": &types.AttributeValueMemberS{Value: "Ann"}},
+	}}
+	v := newTestItemsView(client)
+
+	_, cmd := v.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+	runItemsCmd(v, cmd)
+	if len(client.scans) != 1 || len(v.items) != 1 {
+		t.Fatalf("empty partition key should scan: scans = %d, items = %d", len(client.scans), len(v.items))
+	}
+	if cols := v.table.Columns(); len(cols) != 2 || cols[0].Title != "id" || cols[1].Title != "name" {
+		t.Errorf("columns = %v", cols)
+	}
+
+	v.Update(tea.KeyPressMsg{Code: 'e', Text: "e"})
+	v.partitionInput.SetValue("u1")
+	_, cmd = v.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+	runItemsCmd(v, cmd)
+	if len(client.queries) != 1 {
+		t.Fatalf("a partition key value should query, got %d queries", len(client.queries))
+	}
+
+	v.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+	if v.mode != itemsItem || !strings.Contains(v.ViewString(), `"name": "Ann"`) {
+		t.Fatalf("enter should show the item as JSON:\n%s", v.ViewString())
+	}
+	v.Update(tea.KeyPressMsg{Code: 'J', Text: "J"})
+	if !strings.Contains(v.ViewString(), `"S": "Ann"`) {
+		t.Errorf("J should switch to DynamoDB JSON:\n%s", v.ViewString())
+	}
+}
+
+func TestItemsView_DeleteConfirm(t *testing.T) {
+	client := &fakeItemsClient{items: []dynamo.Item{{"id": &types.AttributeValueMemberS{Value: "u1"}}}}
+	v := newTestItemsView(client)
+	_, cmd := v.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+	runItemsCmd(v, cmd)
+
+	v.Update(tea.KeyPressMsg{Code: 'D', Text: "D"})
+	if v.confirm != itemsConfirmDelete || !v.HasActiveInput() {
+		t.Fatal("D should ask for confirmation")
+	}
+	v.Update(tea.KeyPressMsg{Code: 'n', Text: "n"})
+	if v.confirm != itemsConfirmNone || len(client.deletes) != 0 {
+		t.Fatal("n should cancel the delete")
+	}
+
+	v.Update(tea.KeyPressMsg{Code: 'D', Text: "D"})
+	_, cmd = v.Update(tea.KeyPressMsg{Code: 'y', Text: "y"})
+	runItemsCmd(v, cmd)
+	if len(client.deletes) != 1 || len(v.items) != 0 {
+		t.Errorf("deletes = %d, items left = %d", len(client.deletes), len(v.items))
+	}
+}
+
+func TestItemsView_PutItem(t *testing.T) {
+	client := &fakeItemsClient{}
+	v := newTestItemsView(client)
+	v.Update(tea.KeyPressMsg{Code: tea.KeyEscape})
+
+	v.Update(tea.KeyPressMsg{Code: 'n', Text: "n"})
+	if v.mode != itemsEditor || !strings.Contains(v.editor.Value(), `"id": ""`) {
+		t.Fatalf("n should open the editor with a key template, got %q", v.editor.Value())
+	}
+	v.editor.SetValue(`{"id": "u2", "age": 30}`)
+	v.Update(tea.KeyPressMsg{Code: 's', Mod: tea.ModCtrl})
+	if v.confirm != itemsConfirmPut {
+		t.Fatalf("ctrl+s should ask for confirmation (err = %v)", v.err)
+	}
+	_, cmd := v.Update(tea.KeyPressMsg{Code: 'y', Text: "y"})
+	runItemsCmd(v, cmd)
+	if len(client.puts) != 1 || len(v.items) != 1 || v.mode != itemsResults {
+		t.Errorf("puts = %d, items = %d, mode = %v", len(client.puts), len(v.items), v.mode)
+	}
+}
+
+func TestItemsView_ReadOnly(t *testing.T) {
+	config.Global().SetReadOnly(true)
+	defer config.Global().SetReadOnly(false)
+
+	client := &fakeItemsClient{items: []dynamo.Item{{"id": &types.AttributeValueMemberS{Value: "u1"}}}}
+	v := newTestItemsView(client)
+	_, cmd := v.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+	runItemsCmd(v, cmd)
+
+	v.Update(tea.KeyPressMsg{Code: 'D', Text: "D"})
+	if v.confirm != itemsConfirmNone || !errors.Is(v.err, action.ErrReadOnlyDenied) {
+		t.Errorf("delete should be blocked in read-only mode: confirm = %v, err = %v", v.confirm, v.err)
+	}
+	v.Update(tea.KeyPressMsg{Code: 'n', Text: "n"})
+	if v.mode == itemsEditor {
+		t.Error("new item should be blocked in read-only mode")
+	}
+	if strings.Contains(v.StatusLine(), "D:delete") {
+		t.Error("status line should not offer delete in read-only mode")
+	}
+}
+
+func TestOpenViewTarget_Items(t *testing.T) {
+	v, err := openViewTarget(context.Background(), action.TargetItems, &mockItemsResource{mockResource{id: "users"}})
+	if err != nil {
+		t.Fatalf("openViewTarget() error = %v", err)
+	}
+	if _, ok := v.(*ItemsView); !ok {
+		t.Fatalf("openViewTarget() = %T, want *ItemsView", v)
+	}
+	if _, err := openViewTarget(context.Background(), action.TargetItems, &mockResource{id: "x"}); err == nil {
+		t.Error("expected error for a resource without items")
+	}
+}
@@ -0,0 +1,729 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"image/color"
+	"strings"
+	"time"
+
+	"charm.land/bubbles/v2/spinner"
+	"charm.land/bubbles/v2/textinput"
+	"charm.land/bubbles/v2/viewport"
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+
+	"github.com/clawscli/claws/internal/logs"
+	"github.com/clawscli/claws/internal/ui"
+)
+
+// maxLogEvents bounds the events kept in memory; the oldest are dropped.
+const maxLogEvents = 20000
+
+const logTimeFormat = "2006-01-02 15:04:05.000"
+
+// logRanges are the time-range jumps bound to the number keys.
+var logRanges = []struct {
+	key   string
+	since time.Duration
+	label string
+}{
+	{"1", 5 * time.Minute, "5m"},
+	{"2", 15 * time.Minute, "15m"},
+	{"3", time.Hour, "1h"},
+	{"4", 3 * time.Hour, "3h"},
+	{"5", 12 * time.Hour, "12h"},
+	{"6", 24 * time.Hour, "24h"},
+	{"7", 3 * 24 * time.Hour, "3d"},
+	{"8", 7 * 24 * time.Hour, "7d"},
+}
+
+type logInputMode int
+
+const (
+	logInputNone logInputMode = iota
+	logInputPattern
+	logInputHighlight
+)
+
+// logViewStyles holds cached lipgloss styles for performance
+type logViewStyles struct {
+	header    lipgloss.Style
+	info      lipgloss.Style
+	time      lipgloss.Style
+	highlight lipgloss.Style
+	live      lipgloss.Style
+	paused    lipgloss.Style
+	streams   []lipgloss.Style
+}
+
+func newLogViewStyles() logViewStyles {
+	t := ui.Current()
+	streamColors := []color.Color{t.Secondary, t.Accent, t.Success, t.Warning, t.Primary}
+	streams := make([]lipgloss.Style, len(streamColors))
+	for i, c := range streamColors {
+		streams[i] = lipgloss.NewStyle().Foreground(c)
+	}
+	return logViewStyles{
+		header:    lipgloss.NewStyle().Foreground(t.TableHeaderText).Background(t.TableHeader).Padding(0, 1),
+		info:      lipgloss.NewStyle().Foreground(t.TextDim).Padding(0, 1),
+		time:      lipgloss.NewStyle().Foreground(t.TextDim),
+		highlight: lipgloss.NewStyle().Foreground(t.SelectionText).Background(t.Selection).Bold(true),
+		live:      lipgloss.NewStyle().Foreground(t.Success).Bold(true),
+		paused:    lipgloss.NewStyle().Foreground(t.Warning).Bold(true),
+		streams:   streams,
+	}
+}
+
+// LogView shows CloudWatch Logs events for a log group or a set of streams.
+// It loads a time range with FilterLogEvents and follows new events with a
+// live tail session; filter patterns are applied server-side, highlights
+// client-side.
+type LogView struct {
+	ctx    context.Context
+	target logs.Target
+	client logs.Client
+
+	since     time.Duration
+	pattern   string
+	highlight string
+
+	events    []logs.Event
+	rendered  []string
+	query     logs.Query // first page of the current run
+	nextToken string
+	runID     int
+
+	loading     bool
+	loadingMore bool
+	err         error
+
+	follow     bool
+	paused     bool
+	pending    []logs.Event
+	tailCancel context.CancelFunc
+	tailID     int // bumped whenever a tail starts or stops
+	tailErr    error
+
+	prettyJSON  bool
+	showStreams bool
+
+	inputMode logInputMode
+	input     textinput.Model
+
+	viewport viewport.Model
+	ready    bool
+	width    int
+	height   int
+	spinner  spinner.Model
+	styles   logViewStyles
+}
+
+// NewLogView creates a LogView for target.
+func NewLogView(ctx context.Context, target logs.Target) *LogView {
+	since := target.Since
+	if since <= 0 {
+		since = logs.DefaultSince
+	}
+
+	ti := textinput.New()
+	ti.CharLimit = 512
+
+	return &LogView{
+		ctx:         ctx,
+		target:      target,
+		since:       since,
+		follow:      target.Follow,
+		prettyJSON:  true,
+		showStreams: len(target.Streams) != 1,
+		input:       ti,
+		loading:     true,
+		spinner:     ui.NewSpinner(),
+		styles:      newLogViewStyles(),
+	}
+}
+
+type logResolvedMsg struct {
+	target logs.Target
+	client logs.Client
+	err    error
+}
+
+type logPageMsg struct {
+	runID  int
+	page   logs.Page
+	append bool
+	err    error
+}
+
+type logTailStartedMsg struct {
+	tailID int
+	ch     <-chan logs.Batch
+	err    error
+}
+
+type logTailMsg struct {
+	tailID int
+	ch     <-chan logs.Batch
+	batch  logs.Batch
+	closed bool
+}
+
+// Init implements tea.Model
+func (v *LogView) Init() tea.Cmd {
+	return tea.Batch(v.spinner.Tick, v.resolve())
+}
+
+// resolve creates the client and resolves the target if needed.
+func (v *LogView) resolve() tea.Cmd {
+	ctx, target, client := v.ctx, v.target, v.client
+	return func() tea.Msg {
+		if client == nil {
+			c, err := logs.NewClient(ctx)
+			if err != nil {
+				return logResolvedMsg{err: err}
+			}
+			client = c
+		}
+		if target.Resolve != nil {
+			resolved, err := target.Resolve(ctx)
+			if err != nil {
+				return logResolvedMsg{err: err}
+			}
+			if resolved.Title == "" {
+				resolved.Title = target.Title
+			}
+			target = resolved
+		}
+		return logResolvedMsg{target: target, client: client}
+	}
+}
+
+// reload fetches the current time range from scratch, restarting the live
+// tail afterwards when following.
+func (v *LogView) reload() tea.Cmd {
+	v.stopTail()
+	v.runID++
+	v.events = nil
+	v.rendered = nil
+	v.pending = nil
+	v.nextToken = ""
+	v.err = nil
+	v.loading = true
+	v.refreshContent(true)
+
+	v.query = logs.Query{Start: time.Now().Add(-v.since), Pattern: v.pattern}
+	return tea.Batch(v.spinner.Tick, v.fetch(v.query, false))
+}
+
+func (v *LogView) fetch(query logs.Query, appendPage bool) tea.Cmd {
+	ctx, client, target, runID := v.ctx, v.client, v.target, v.runID
+	return func() tea.Msg {
+		page, err := logs.Fetch(ctx, client, target, query)
+		return logPageMsg{runID: runID, page: page, append: appendPage, err: err}
+	}
+}
+
+func (v *LogView) startTail() tea.Cmd {
+	v.stopTail()
+	v.tailErr = nil
+	ctx, cancel := context.WithCancel(v.ctx)
+	v.tailCancel = cancel
+	v.tailID++
+	client, target, pattern, tailID := v.client, v.target, v.pattern, v.tailID
+	return func() tea.Msg {
+		ch, err := logs.Tail(ctx, client, target, pattern)
+		return logTailStartedMsg{tailID: tailID, ch: ch, err: err}
+	}
+}
+
+// stopTail cancels the live tail. Messages still arriving from it carry an
+// old tailID and are dropped, so they cannot end a tail started after it.
+func (v *LogView) stopTail() {
+	if v.tailCancel != nil {
+		v.tailCancel()
+		v.tailCancel = nil
+	}
+	v.tailID++
+}
+
+func waitForTail(tailID int, ch <-chan logs.Batch) tea.Cmd {
+	return func() tea.Msg {
+		batch, ok := <-ch
+		return logTailMsg{tailID: tailID, ch: ch, batch: batch, closed: !ok}
+	}
+}
+
+// Close stops the live tail session.
+func (v *LogView) Close() {
+	v.stopTail()
+}
+
+// Update implements tea.Model
+func (v *LogView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case logResolvedMsg:
+		if msg.err != nil {
+			v.loading = false
+			v.err = msg.err
+			return v, nil
+		}
+		v.target = msg.target
+		v.client = msg.client
+		if len(v.target.Streams) > 1 {
+			v.showStreams = true
+		}
+		return v, v.reload()
+
+	case logPageMsg:
+		if msg.runID != v.runID {
+			return v, nil
+		}
+		v.loading = false
+		v.loadingMore = false
+		if msg.err != nil {
+			v.err = msg.err
+			return v, nil
+		}
+		v.nextToken = msg.page.NextToken
+		v.appendEvents(msg.page.Events)
+		if !msg.append && v.follow {
+			return v, v.startTail()
+		}
+		return v, nil
+
+	case logTailStartedMsg:
+		if msg.tailID != v.tailID || !v.follow {
+			return v, nil
+		}
+		if msg.err != nil {
+			v.tailErr = msg.err
+			v.follow = false
+			return v, nil
+		}
+		return v, waitForTail(msg.tailID, msg.ch)
+
+	case logTailMsg:
+		if msg.tailID != v.tailID || !v.follow {
+			return v, nil
+		}
+		if msg.closed {
+			v.follow = false
+			v.tailCancel = nil
+			return v, nil
+		}
+		if msg.batch.Err != nil {
+			v.tailErr = msg.batch.Err
+		}
+		if v.paused {
+			v.pending = append(v.pending, msg.batch.Events...)
+		} else {
+			v.appendEvents(msg.batch.Events)
+		}
+		return v, waitForTail(msg.tailID, msg.ch)
+
+	case spinner.TickMsg:
+		if v.loading || v.loadingMore {
+			var cmd tea.Cmd
+			v.spinner, cmd = v.spinner.Update(msg)
+			return v, cmd
+		}
+		return v, nil
+
+	case tea.KeyPressMsg:
+		if v.inputMode != logInputNone {
+			return v.handleInput(msg)
+		}
+		if model, cmd, ok := v.handleKey(msg); ok {
+			return model, cmd
+		}
+	}
+
+	var cmd tea.Cmd
+	v.viewport, cmd = v.viewport.Update(msg)
+	return v, cmd
+}
+
+func (v *LogView) handleKey(msg tea.KeyPressMsg) (tea.Model, tea.Cmd, bool) {
+	key := msg.String()
+	for _, r := range logRanges {
+		if key == r.key {
+			v.since = r.since
+			return v, v.reload(), true
+		}
+	}
+
+	switch key {
+	case "f":
+		if v.client == nil {
+			return v, nil, true
+		}
+		if v.follow {
+			v.follow = false
+			v.stopTail()
+			return v, nil, true
+		}
+		v.follow = true
+		v.paused = false
+		v.viewport.GotoBottom()
+		return v, v.startTail(), true
+
+	case "space", " ":
+		v.paused = !v.paused
+		if !v.paused {
+			v.appendEvents(v.pending)
+			v.pending = nil
+			v.viewport.GotoBottom()
+		}
+		return v, nil, true
+
+	case "/":
+		v.startInput(logInputPattern, "pattern: ", v.pattern)
+		return v, textinput.Blink, true
+
+	case "H":
+		v.startInput(logInputHighlight, "highlight: ", v.highlight)
+		return v, textinput.Blink, true
+
+	case "c":
+		reload := v.pattern != ""
+		v.pattern = ""
+		v.highlight = ""
+		if reload {
+			return v, v.reload(), true
+		}
+		v.refreshContent(false)
+		return v, nil, true
+
+	case "J":
+		v.prettyJSON = !v.prettyJSON
+		v.refreshContent(false)
+		return v, nil, true
+
+	case "s":
+		v.showStreams = !v.showStreams
+		v.refreshContent(false)
+		return v, nil, true
+
+	case "w":
+		v.viewport.SoftWrap = !v.viewport.SoftWrap
+		v.viewport.SetXOffset(0)
+		return v, nil, true
+
+	case "N":
+		if v.nextToken == "" || v.loadingMore || v.client == nil {
+			return v, nil, true
+		}
+		v.loadingMore = true
+		query := v.query
+		query.NextToken = v.nextToken
+		return v, tea.Batch(v.spinner.Tick, v.fetch(query, true)), true
+
+	case "ctrl+r":
+		if v.client == nil {
+			return v, nil, true
+		}
+		return v, v.reload(), true
+
+	case "g", "home":
+		v.viewport.GotoTop()
+		return v, nil, true
+
+	case "G", "end":
+		v.viewport.GotoBottom()
+		return v, nil, true
+
+	case "j":
+		v.viewport.ScrollDown(1)
+		return v, nil, true
+
+	case "k":
+		v.viewport.ScrollUp(1)
+		return v, nil, true
+	}
+	return v, nil, false
+}
+
+func (v *LogView) startInput(mode logInputMode, prompt, value string) {
+	v.inputMode = mode
+	v.input.Prompt = prompt
+	v.input.SetValue(value)
+	v.input.CursorEnd()
+	v.input.Focus()
+	v.resizeViewport()
+}
+
+func (v *LogView) handleInput(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "esc":
+		v.endInput()
+		return v, nil
+
+	case "enter":
+		mode, value := v.inputMode, strings.TrimSpace(v.input.Value())
+		v.endInput()
+		if mode == logInputPattern {
+			if value == v.pattern {
+				return v, nil
+			}
+			v.pattern = value
+			if v.client == nil {
+				return v, nil
+			}
+			return v, v.reload()
+		}
+		v.highlight = value
+		v.refreshContent(false)
+		return v, nil
+	}
+
+	var cmd tea.Cmd
+	v.input, cmd = v.input.Update(msg)
+	return v, cmd
+}
+
+func (v *LogView) endInput() {
+	v.inputMode = logInputNone
+	v.input.Blur()
+	v.resizeViewport()
+}
+
+// appendEvents adds events, rendering only the new ones. The view stays
+// pinned to the bottom if it was there.
+func (v *LogView) appendEvents(events []logs.Event) {
+	if len(events) == 0 {
+		return
+	}
+	atBottom := v.viewport.AtBottom()
+
+	v.events = append(v.events, events...)
+	for _, e := range events {
+		v.rendered = append(v.rendered, v.renderEvent(e))
+	}
+	if over := len(v.events) - maxLogEvents; over > 0 {
+		v.events = append([]logs.Event(nil), v.events[over:]...)
+		v.rendered = append([]string(nil), v.rendered[over:]...)
+	}
+
+	v.setContent()
+	if atBottom || v.follow && !v.paused {
+		v.viewport.GotoBottom()
+	}
+}
+
+// refreshContent re-renders all events after a display option changed.
+func (v *LogView) refreshContent(gotoBottom bool) {
+	v.rendered = make([]string, len(v.events))
+	for i, e := range v.events {
+		v.rendered[i] = v.renderEvent(e)
+	}
+	v.setContent()
+	if gotoBottom {
+		v.viewport.GotoBottom()
+	}
+}
+
+func (v *LogView) setContent() {
+	if !v.ready {
+		return
+	}
+	v.viewport.SetContentLines(v.rendered)
+}
+
+func (v *LogView) renderEvent(e logs.Event) string {
+	s := v.styles
+	prefix := s.time.Render(e.Time.Local().Format(logTimeFormat)) + " "
+	if v.showStreams && e.Stream != "" {
+		prefix += v.streamStyle(e.Stream).Render(shortStream(e.Stream)) + " "
+	}
+
+	message := e.Message
+	if v.prettyJSON {
+		message, _ = logs.PrettyJSON(message)
+	}
+
+	lines := strings.Split(message, "\n")
+	indent := strings.Repeat(" ", lipgloss.Width(prefix))
+	for i, line := range lines {
+		line = v.applyHighlight(line)
+		if i == 0 {
+			lines[i] = prefix + line
+		} else {
+			lines[i] = indent + line
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+func (v *LogView) applyHighlight(line string) string {
+	return highlightMatches(line, v.highlight, v.styles.highlight)
+}
+
+// highlightMatches renders each case-insensitive occurrence of term in line
+// with style.
+func highlightMatches(line, term string, style lipgloss.Style) string {
+	matches := logs.MatchIndexes(line, term)
+	if len(matches) == 0 {
+		return line
+	}
+	var sb strings.Builder
+	last := 0
+	for _, m := range matches {
+		sb.WriteString(line[last:m[0]])
+		sb.WriteString(style.Render(line[m[0]:m[1]]))
+		last = m[1]
+	}
+	sb.WriteString(line[last:])
+	return sb.String()
+}
+
+// streamStyle gives each stream a stable color.
+func (v *LogView) streamStyle(stream string) lipgloss.Style {
+	h := fnv.New32a()
+	h.Write([]byte(stream))
+	return v.styles.streams[int(h.Sum32()%uint32(len(v.styles.streams)))]
+}
+
+// shortStream shortens long stream names (e.g. ECS "prefix/container/task-id")
+// to their last 24 characters.
+func shortStream(stream string) string {
+	const width = 24
+	if len(stream) <= width {
+		return stream
+	}
+	return "…" + stream[len(stream)-width+1:]
+}
+
+// ViewString returns the view content as a string
+func (v *LogView) ViewString() string {
+	header := v.styles.header.Width(v.width).Render("Logs: " + v.target.Label())
+	info := v.styles.info.Render(v.infoLine())
+
+	out := header + "\n" + info + "\n"
+	if v.inputMode != logInputNone {
+		out += lipgloss.NewStyle().Padding(0, 1).Render(v.input.View()) + "\n"
+	}
+
+	switch {
+	case v.err != nil:
+		return out + ui.DangerStyle().Render(fmt.Sprintf("Error: %v", v.err))
+	case !v.ready:
+		return out
+	case len(v.events) == 0 && !v.loading:
+		msg := fmt.Sprintf("No log events in the last %s", formatSince(v.since))
+		if v.follow {
+			msg += "; waiting for new events..."
+		}
+		return out + ui.DimStyle().Render(msg)
+	}
+	return out + v.viewport.View()
+}
+
+func (v *LogView) infoLine() string {
+	var parts []string
+	switch {
+	case v.loading:
+		parts = append(parts, v.spinner.View()+" loading")
+	case v.follow && v.paused:
+		parts = append(parts, v.styles.paused.Render("❚❚ PAUSED")+fmt.Sprintf(" (%d new)", len(v.pending)))
+	case v.follow:
+		parts = append(parts, v.styles.live.Render("● LIVE"))
+	}
+
+	parts = append(parts, "last "+formatSince(v.since))
+	count := fmt.Sprintf("%d events", len(v.events))
+	if v.nextToken != "" {
+		count += " (more: N)"
+	}
+	if v.loadingMore {
+		count += " " + v.spinner.View()
+	}
+	parts = append(parts, count)
+
+	if v.pattern != "" {
+		parts = append(parts, "pattern: "+v.pattern)
+	}
+	if v.highlight != "" {
+		parts = append(parts, "highlight: "+v.highlight)
+	}
+	if v.tailErr != nil {
+		parts = append(parts, ui.WarningStyle().Render("⚠ "+v.tailErr.Error()))
+	}
+	return strings.Join(parts, " • ")
+}
+
+func formatSince(d time.Duration) string {
+	for _, r := range logRanges {
+		if r.since == d {
+			return r.label
+		}
+	}
+	return d.String()
+}
+
+// View implements tea.Model
+func (v *LogView) View() tea.View {
+	return tea.NewView(v.ViewString())
+}
+
+// SetSize implements View
+func (v *LogView) SetSize(width, height int) tea.Cmd {
+	v.width = width
+	v.height = height
+	if !v.ready {
+		v.viewport = viewport.New(viewport.WithWidth(width), viewport.WithHeight(height))
+		v.viewport.SoftWrap = true
+		v.ready = true
+		v.setContent()
+		v.viewport.GotoBottom()
+	}
+	v.resizeViewport()
+	return nil
+}
+
+func (v *LogView) resizeViewport() {
+	if !v.ready {
+		return
+	}
+	chrome := 2
+	if v.inputMode != logInputNone {
+		chrome++
+	}
+	v.viewport.SetWidth(v.width)
+	v.viewport.SetHeight(max(v.height-chrome, 3))
+}
+
+// StatusLine implements View
+func (v *LogView) StatusLine() string {
+	if v.inputMode == logInputPattern {
+		return "enter:apply filter pattern • esc:cancel"
+	}
+	if v.inputMode == logInputHighlight {
+		return "enter:highlight • esc:cancel"
+	}
+
+	follow := "f:follow"
+	if v.follow {
+		follow = "f:stop"
+	}
+	parts := []string{follow, "space:pause", "1-8:range", "/:pattern", "H:highlight", "J:json", "s:streams", "w:wrap"}
+	if v.nextToken != "" {
+		parts = append(parts, "N:more")
+	}
+	parts = append(parts, "q/esc:back")
+	return strings.Join(parts, " ")
+}
+
+// HasActiveInput implements InputCapture
+func (v *LogView) HasActiveInput() bool {
+	return v.inputMode != logInputNone
+}
+
+// SelectedLogGroups implements LogGroupProvider
+func (v *LogView) SelectedLogGroups() []string {
+	if v.target.Group == "" {
+		return nil
+	}
+	return []string{v.target.Group}
+}
@@ -0,0 +1,302 @@
+package view
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+
+	"github.com/clawscli/claws/internal/action"
+	"github.com/clawscli/claws/internal/logs"
+)
+
+type mockLogsResource struct {
+	mockResource
+	group string
+}
+
+func (m *mockLogsResource) LogTarget() logs.Target {
+	return logs.Target{Group: m.group}
+}
+
+func newTestLogView(target logs.Target) *LogView {
+	lv := NewLogView(context.Background(), target)
+	lv.SetSize(120, 30)
+	return lv
+}
+
+func testEvents(n int, stream string) []logs.Event {
+	base := time.Date(2024, 1, 15, 10, 0, 0, 0, time.UTC)
+	events := make([]logs.Event, n)
+	for i := range events {
+		events[i] = logs.Event{Time: base.Add(time.Duration(i) * time.Second), Stream: stream, Message: "event"}
+	}
+	return events
+}
+
+func TestLogView_New(t *testing.T) {
+	lv := NewLogView(context.Background(), logs.Target{Group: "/aws/lambda/fn"})
+	if lv.since != logs.DefaultSince {
+		t.Errorf("since = %v, want %v", lv.since, logs.DefaultSince)
+	}
+	if !lv.showStreams {
+		t.Error("showStreams should default to true for a whole group")
+	}
+	if !lv.prettyJSON {
+		t.Error("prettyJSON should default to true")
+	}
+
+	single := NewLogView(context.Background(), logs.Target{Group: "g", Streams: []string{"s"}, Since: 24 * time.Hour, Follow: true})
+	if single.showStreams {
+		t.Error("showStreams should default to false for a single stream")
+	}
+	if single.since != 24*time.Hour || !single.follow {
+		t.Errorf("since/follow = %v/%v, want 24h/true", single.since, single.follow)
+	}
+}
+
+func TestLogView_PageMsg(t *testing.T) {
+	lv := newTestLogView(logs.Target{Group: "g"})
+	lv.runID = 2
+
+	// Results from a previous run are dropped
+	lv.Update(logPageMsg{runID: 1, page: logs.Page{Events: testEvents(3, "a")}})
+	if len(lv.events) != 0 {
+		t.Fatalf("stale page appended %d events", len(lv.events))
+	}
+
+	lv.Update(logPageMsg{runID: 2, page: logs.Page{Events: testEvents(3, "a"), NextToken: "next"}})
+	if len(lv.events) != 3 {
+		t.Fatalf("events = %d, want 3", len(lv.events))
+	}
+	if lv.loading {
+		t.Error("loading should be false after a page")
+	}
+	if !strings.Contains(lv.StatusLine(), "N:more") {
+		t.Errorf("StatusLine() = %q, want N:more with a next token", lv.StatusLine())
+	}
+}
+
+func TestLogView_EventCap(t *testing.T) {
+	lv := newTestLogView(logs.Target{Group: "g"})
+	lv.appendEvents(testEvents(maxLogEvents, "a"))
+	lv.appendEvents(testEvents(10, "b"))
+
+	if len(lv.events) != maxLogEvents || len(lv.rendered) != maxLogEvents {
+		t.Fatalf("events/rendered = %d/%d, want %d", len(lv.events), len(lv.rendered), maxLogEvents)
+	}
+	if lv.events[len(lv.events)-1].Stream != "b" {
+		t.Error("newest events should be kept")
+	}
+}
+
+func TestLogView_PauseBuffersTail(t *testing.T) {
+	lv := newTestLogView(logs.Target{Group: "g", Follow: true})
+	ch := make(chan logs.Batch)
+
+	lv.Update(tea.KeyPressMsg{Code: tea.KeySpace, Text: " "})
+	if !lv.paused {
+		t.Fatal("space should pause")
+	}
+
+	lv.Update(logTailMsg{ch: ch, batch: logs.Batch{Events: testEvents(2, "a")}})
+	if len(lv.events) != 0 || len(lv.pending) != 2 {
+		t.Fatalf("events/pending = %d/%d, want 0/2", len(lv.events), len(lv.pending))
+	}
+
+	lv.Update(tea.KeyPressMsg{Code: tea.KeySpace, Text: " "})
+	if lv.paused || len(lv.events) != 2 || len(lv.pending) != 0 {
+		t.Errorf("after resume: paused=%v events=%d pending=%d", lv.paused, len(lv.events), len(lv.pending))
+	}
+}
+
+func TestLogView_TailClosed(t *testing.T) {
+	lv := newTestLogView(logs.Target{Group: "g", Follow: true})
+	lv.Update(logTailMsg{closed: true})
+	if lv.follow {
+		t.Error("follow should stop when the session closes")
+	}
+}
+
+func TestLogView_StaleTailCloseIgnored(t *testing.T) {
+	lv := newTestLogView(logs.Target{Group: "g", Follow: true})
+	lv.startTail()
+	stale := lv.tailID
+
+	// "f" twice: stop, then start a new tail
+	lv.stopTail()
+	lv.startTail()
+
+	lv.Update(logTailMsg{tailID: stale, closed: true})
+	if !lv.follow || lv.tailCancel == nil {
+		t.Error("a close from a stopped tail should not end the new one")
+	}
+	lv.Update(logTailMsg{tailID: lv.tailID, closed: true})
+	if lv.follow {
+		t.Error("follow should stop when the current tail closes")
+	}
+}
+
+type fakeLogsClient struct {
+	logs.Client
+	inputs []*cloudwatchlogs.FilterLogEventsInput
+}
+
+func (f *fakeLogsClient) FilterLogEvents(_ context.Context, in *cloudwatchlogs.FilterLogEventsInput, _ ...func(*cloudwatchlogs.Options)) (*cloudwatchlogs.FilterLogEventsOutput, error) {
+	f.inputs = append(f.inputs, in)
+	return &cloudwatchlogs.FilterLogEventsOutput{}, nil
+}
+
+func TestLogView_LoadMoreKeepsQuery(t *testing.T) {
+	client := &fakeLogsClient{}
+	lv := newTestLogView(logs.Target{Group: "g"})
+	lv.client = client
+	lv.query = logs.Query{Start: time.Now().Add(-time.Hour), Pattern: "ERROR"}
+	lv.nextToken = "next"
+
+	_, cmd := lv.Update(tea.KeyPressMsg{Code: 'N', Text: "N"})
+	for _, c := range cmd().(tea.BatchMsg) {
+		if msg, ok := c().(logPageMsg); ok {
+			lv.Update(msg)
+		}
+	}
+	if len(client.inputs) == 0 {
+		t.Fatal("N should fetch the next page")
+	}
+	in := client.inputs[0]
+	if *in.StartTime != lv.query.Start.UnixMilli() || *in.NextToken != "next" || *in.FilterPattern != "ERROR" {
+		t.Errorf("input = start %d token %s pattern %s, want the first page's query with the next token", *in.StartTime, *in.NextToken, *in.FilterPattern)
+	}
+}
+
+func TestLogView_RangeKey(t *testing.T) {
+	lv := newTestLogView(logs.Target{Group: "g"})
+	lv.appendEvents(testEvents(2, "a"))
+	run := lv.runID
+
+	_, cmd := lv.Update(tea.KeyPressMsg{Code: '6', Text: "6"})
+	if cmd == nil {
+		t.Fatal("range key should return a fetch command")
+	}
+	if lv.since != 24*time.Hour {
+		t.Errorf("since = %v, want 24h", lv.since)
+	}
+	if lv.runID != run+1 || len(lv.events) != 0 || !lv.loading {
+		t.Errorf("range key should start a new run: runID=%d events=%d loading=%v", lv.runID, len(lv.events), lv.loading)
+	}
+}
+
+func TestLogView_PatternInput(t *testing.T) {
+	lv := newTestLogView(logs.Target{Group: "g"})
+
+	lv.Update(tea.KeyPressMsg{Code: '/', Text: "/"})
+	if !lv.HasActiveInput() {
+		t.Fatal("/ should open the pattern input")
+	}
+	for _, r := range "ERROR" {
+		lv.Update(tea.KeyPressMsg{Code: r, Text: string(r)})
+	}
+	lv.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+
+	if lv.HasActiveInput() {
+		t.Error("enter should close the input")
+	}
+	if lv.pattern != "ERROR" {
+		t.Errorf("pattern = %q, want %q", lv.pattern, "ERROR")
+	}
+}
+
+func TestLogView_Highlight(t *testing.T) {
+	lv := newTestLogView(logs.Target{Group: "g"})
+	lv.highlight = "timeout"
+
+	got := lv.applyHighlight("request Timeout after 3s")
+	if !strings.Contains(got, "Timeout") || got == "request Timeout after 3s" {
+		t.Errorf("applyHighlight() = %q, want styled match", got)
+	}
+	if got := lv.applyHighlight("all good"); got != "all good" {
+		t.Errorf("applyHighlight() without match = %q", got)
+	}
+}
+
+func TestLogView_RenderEvent(t *testing.T) {
+	lv := newTestLogView(logs.Target{Group: "g", Streams: []string{"s"}})
+	e := logs.Event{Time: time.Now(), Stream: "stream-1", Message: `{"level":"info","msg":"ok"}`}
+
+	got := lv.renderEvent(e)
+	if !strings.Contains(got, "\n") {
+		t.Errorf("renderEvent() should pretty-print JSON, got %q", got)
+	}
+	if strings.Contains(got, "stream-1") {
+		t.Error("stream column should be hidden for a single stream")
+	}
+
+	lv.prettyJSON = false
+	lv.showStreams = true
+	got = lv.renderEvent(e)
+	if strings.Contains(got, "\n") {
+		t.Errorf("renderEvent() without JSON formatting = %q", got)
+	}
+	if !strings.Contains(got, "stream-1") {
+		t.Error("stream column should be shown")
+	}
+}
+
+func TestLogView_Close(t *testing.T) {
+	lv := newTestLogView(logs.Target{Group: "g"})
+	ctx, cancel := context.WithCancel(context.Background())
+	lv.tailCancel = cancel
+
+	lv.Close()
+	if ctx.Err() == nil {
+		t.Error("Close() should cancel the live tail")
+	}
+	if lv.tailCancel != nil {
+		t.Error("tailCancel should be cleared")
+	}
+}
+
+func TestShortStream(t *testing.T) {
+	if got := shortStream("short"); got != "short" {
+		t.Errorf("shortStream(short) = %q", got)
+	}
+	got := shortStream("ecs/app/0123456789abcdef0123456789abcdef")
+	if !strings.HasPrefix(got, "…") || !strings.HasSuffix(got, "abcdef") {
+		t.Errorf("shortStream() = %q", got)
+	}
+}
+
+func TestOpenViewTarget(t *testing.T) {
+	ctx := context.Background()
+	res := &mockLogsResource{mockResource: mockResource{id: "/aws/lambda/fn"}, group: "/aws/lambda/fn"}
+
+	v, err := openViewTarget(ctx, action.TargetLogsFollow, res)
+	if err != nil {
+		t.Fatalf("openViewTarget() error = %v", err)
+	}
+	lv, ok := v.(*LogView)
+	if !ok {
+		t.Fatalf("openViewTarget() = %T, want *LogView", v)
+	}
+	if !lv.follow || lv.target.Group != "/aws/lambda/fn" {
+		t.Errorf("follow=%v group=%q", lv.follow, lv.target.Group)
+	}
+
+	v, err = openViewTarget(ctx, action.TargetLogs24h, res)
+	if err != nil {
+		t.Fatalf("openViewTarget() error = %v", err)
+	}
+	if lv := v.(*LogView); lv.since != 24*time.Hour || lv.follow {
+		t.Errorf("since=%v follow=%v, want 24h/false", lv.since, lv.follow)
+	}
+
+	if _, err := openViewTarget(ctx, action.TargetLogs, &mockResource{id: "x"}); err == nil {
+		t.Error("expected error for a resource without logs")
+	}
+	if _, err := openViewTarget(ctx, "unknown", res); err == nil {
+		t.Error("expected error for an unknown target")
+	}
+}
@@ -0,0 +1,489 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"charm.land/bubbles/v2/spinner"
+	"charm.land/bubbles/v2/textinput"
+	"charm.land/bubbles/v2/viewport"
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+
+	"github.com/clawscli/claws/internal/policy"
+	"github.com/clawscli/claws/internal/ui"
+)
+
+type policyTab int
+
+const (
+	policyDocuments policyTab = iota
+	policyEffective
+	policyTrust
+	policySimulate
+)
+
+var policyTabNames = map[policyTab]string{
+	policyDocuments: "Documents",
+	policyEffective: "Effective",
+	policyTrust:     "Trust",
+	policySimulate:  "Simulate",
+}
+
+// PolicyView shows the IAM policy documents of a role, user, group or
+// managed policy: each document decoded as indented JSON, the effective
+// statements aggregated by service with wildcard findings, a role's trust
+// policy, and a simulator for an action and resource.
+type PolicyView struct {
+	ctx    context.Context
+	client policy.Client
+	target policy.Target
+
+	policies  *policy.Policies
+	loading   bool
+	err       error
+	tabs      []policyTab
+	tab       int
+	pane      viewport.Model
+	decisions []policy.Decision
+
+	simulating    bool
+	editing       bool
+	resourceFocus bool
+	actionInput   textinput.Model
+	resourceInput textinput.Model
+	simErr        error
+
+	width   int
+	height  int
+	spinner spinner.Model
+}
+
+// NewPolicyView creates a PolicyView for t.
+func NewPolicyView(ctx context.Context, t policy.Target) *PolicyView {
+	actionInput := textinput.New()
+	actionInput.Prompt = ""
+	actionInput.Placeholder = "s3:GetObject, s3:PutObject"
+	actionInput.CharLimit = 512
+
+	resourceInput := textinput.New()
+	resourceInput.Prompt = ""
+	resourceInput.Placeholder = "*"
+	resourceInput.CharLimit = 2048
+
+	tabs := []policyTab{policyDocuments, policyEffective}
+	if t.Kind == policy.KindRole {
+		tabs = append(tabs, policyTrust)
+	}
+	tabs = append(tabs, policySimulate)
+
+	return &PolicyView{
+		ctx:           ctx,
+		target:        t,
+		loading:       true,
+		tabs:          tabs,
+		pane:          viewport.New(),
+		actionInput:   actionInput,
+		resourceInput: resourceInput,
+		spinner:       ui.NewSpinner(),
+	}
+}
+
+type policyLoadedMsg struct {
+	policies *policy.Policies
+	err      error
+}
+
+type policySimulatedMsg struct {
+	decisions []policy.Decision
+	err       error
+}
+
+// Init implements tea.Model
+func (v *PolicyView) Init() tea.Cmd {
+	return tea.Batch(v.spinner.Tick, v.load())
+}
+
+func (v *PolicyView) ensureClient() error {
+	if v.client != nil {
+		return nil
+	}
+	c, err := policy.NewClient(v.ctx)
+	if err != nil {
+		return err
+	}
+	v.client = c
+	return nil
+}
+
+func (v *PolicyView) load() tea.Cmd {
+	if err := v.ensureClient(); err != nil {
+		return func() tea.Msg { return policyLoadedMsg{err: err} }
+	}
+	ctx, client, target := v.ctx, v.client, v.target
+	return func() tea.Msg {
+		p, err := policy.Load(ctx, client, target)
+		return policyLoadedMsg{policies: p, err: err}
+	}
+}
+
+func (v *PolicyView) simulate() tea.Cmd {
+	if v.policies == nil || v.simulating {
+		return nil
+	}
+	if err := v.ensureClient(); err != nil {
+		v.simErr = err
+		return nil
+	}
+	v.simulating = true
+	v.simErr = nil
+	ctx, client, target, p := v.ctx, v.client, v.target, v.policies
+	actions, resource := v.actionInput.Value(), v.resourceInput.Value()
+	return func() tea.Msg {
+		decisions, err := policy.Simulate(ctx, client, target, p, actions, resource)
+		return policySimulatedMsg{decisions: decisions, err: err}
+	}
+}
+
+// Update implements tea.Model
+func (v *PolicyView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case policyLoadedMsg:
+		v.loading = false
+		v.err = msg.err
+		if msg.err == nil {
+			v.policies = msg.policies
+			v.pane.GotoTop()
+		}
+		return v, nil
+
+	case policySimulatedMsg:
+		v.simulating = false
+		v.simErr = msg.err
+		if msg.err == nil {
+			v.decisions = msg.decisions
+		}
+		return v, nil
+
+	case spinner.TickMsg:
+		if v.loading || v.simulating {
+			var cmd tea.Cmd
+			v.spinner, cmd = v.spinner.Update(msg)
+			return v, cmd
+		}
+		return v, nil
+
+	case tea.KeyPressMsg:
+		if v.editing {
+			return v.handleEditKey(msg)
+		}
+		return v.handleKey(msg)
+	}
+	return v, nil
+}
+
+func (v *PolicyView) currentTab() policyTab {
+	return v.tabs[v.tab]
+}
+
+func (v *PolicyView) handleKey(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
+	switch key := msg.String(); key {
+	case "tab":
+		v.tab = (v.tab + 1) % len(v.tabs)
+		v.pane.GotoTop()
+		return v, nil
+	case "shift+tab":
+		v.tab = (v.tab + len(v.tabs) - 1) % len(v.tabs)
+		v.pane.GotoTop()
+		return v, nil
+	case "1", "2", "3", "4":
+		if i := int(key[0] - '1'); i < len(v.tabs) {
+			v.tab = i
+			v.pane.GotoTop()
+		}
+		return v, nil
+	case "ctrl+r":
+		if !v.loading {
+			v.loading = true
+			return v, tea.Batch(v.spinner.Tick, v.load())
+		}
+		return v, nil
+	case "g":
+		v.pane.GotoTop()
+		return v, nil
+	case "G":
+		v.pane.GotoBottom()
+		return v, nil
+	case "j":
+		v.pane.ScrollDown(1)
+		return v, nil
+	case "k":
+		v.pane.ScrollUp(1)
+		return v, nil
+	}
+
+	if v.currentTab() == policySimulate {
+		switch msg.String() {
+		case "e", "i", "enter":
+			v.editing = true
+			return v, v.focusInput()
+		}
+	}
+
+	var cmd tea.Cmd
+	v.pane, cmd = v.pane.Update(msg)
+	return v, cmd
+}
+
+func (v *PolicyView) handleEditKey(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "esc":
+		v.editing = false
+		v.actionInput.Blur()
+		v.resourceInput.Blur()
+		return v, nil
+	case "tab", "shift+tab":
+		v.resourceFocus = !v.resourceFocus
+		return v, v.focusInput()
+	case "enter":
+		v.editing = false
+		v.actionInput.Blur()
+		v.resourceInput.Blur()
+		return v, tea.Batch(v.spinner.Tick, v.simulate())
+	}
+	var cmd tea.Cmd
+	if v.resourceFocus {
+		v.resourceInput, cmd = v.resourceInput.Update(msg)
+	} else {
+		v.actionInput, cmd = v.actionInput.Update(msg)
+	}
+	return v, cmd
+}
+
+func (v *PolicyView) focusInput() tea.Cmd {
+	if v.resourceFocus {
+		v.actionInput.Blur()
+		return v.resourceInput.Focus()
+	}
+	v.resourceInput.Blur()
+	return v.actionInput.Focus()
+}
+
+func (v *PolicyView) documentsContent() string {
+	if len(v.policies.Documents) == 0 {
+		return ui.DimStyle().Render("No policies attached")
+	}
+	name := lipgloss.NewStyle().Foreground(ui.Current().Accent).Bold(true)
+	var blocks []string
+	for _, d := range v.policies.Documents {
+		head := name.Render("▸ "+d.Name) + " " + ui.DimStyle().Render("("+d.Source+")")
+		if d.ARN != "" {
+			head += "\n  " + ui.DimStyle().Render(d.ARN)
+		}
+		blocks = append(blocks, head+"\n"+d.JSON)
+	}
+	return strings.Join(blocks, "\n\n")
+}
+
+func (v *PolicyView) effectiveContent() string {
+	statements := v.policies.Statements()
+	if len(statements) == 0 {
+		return ui.DimStyle().Render("No permissions granted by identity policies")
+	}
+
+	var lines []string
+	if findings := policy.Findings(statements); len(findings) > 0 {
+		lines = append(lines, lipgloss.NewStyle().Bold(true).Render(fmt.Sprintf("Findings (%d)", len(findings))))
+		for _, f := range findings {
+			style := ui.DimStyle()
+			switch f.Severity {
+			case policy.SeverityHigh:
+				style = ui.DangerStyle()
+			case policy.SeverityMedium:
+				style = ui.WarningStyle()
+			}
+			lines = append(lines, "  "+style.Render(fmt.Sprintf("%-6s", f.Severity))+" "+f.Message+" "+ui.DimStyle().Render("— "+f.Source))
+		}
+		lines = append(lines, "")
+	}
+
+	service := lipgloss.NewStyle().Foreground(ui.Current().Accent).Bold(true)
+	for _, s := range policy.Summarize(statements) {
+		lines = append(lines, service.Render(s.Service))
+		for _, g := range s.Grants {
+			effect := ui.SuccessStyle().Render(fmt.Sprintf("%-5s", g.Effect))
+			if g.Effect == policy.EffectDeny {
+				effect = ui.DangerStyle().Render(fmt.Sprintf("%-5s", g.Effect))
+			}
+			resources := strings.Join(g.Resources, ", ")
+			if g.NotResource {
+				resources = "all except " + resources
+			}
+			line := "  " + effect + " " + g.Action + "  " + ui.DimStyle().Render("on "+resources+" — "+g.Source)
+			if g.Conditional {
+				line += " " + ui.WarningStyle().Render("(conditional)")
+			}
+			lines = append(lines, line)
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+func (v *PolicyView) trustContent() string {
+	trust := v.policies.Trust
+	if trust == nil {
+		return ui.DimStyle().Render("No trust policy")
+	}
+	var lines []string
+	for _, s := range trust.Statements {
+		line := s.Effect + " " + strings.Join(s.Actions, ", ")
+		if len(s.Principals) > 0 {
+			line += " ← " + strings.Join(s.Principals, ", ")
+		}
+		if s.Effect == policy.EffectDeny {
+			line = ui.DangerStyle().Render(line)
+		}
+		lines = append(lines, line)
+		if s.Conditional {
+			lines = append(lines, "  "+ui.WarningStyle().Render("when "+s.Condition()))
+		}
+	}
+	return strings.Join(lines, "\n") + "\n\n" + trust.JSON
+}
+
+func (v *PolicyView) simulateContent() string {
+	if v.simulating {
+		return v.spinner.View() + " Simulating..."
+	}
+	if v.simErr != nil {
+		return ui.DangerStyle().Render(fmt.Sprintf("Error: %v", v.simErr))
+	}
+	if len(v.decisions) == 0 {
+		return ui.DimStyle().Render("Enter actions and a resource ARN, then press enter to simulate")
+	}
+	var lines []string
+	for _, d := range v.decisions {
+		decision := ui.DangerStyle().Render(d.Decision)
+		if d.Allowed() {
+			decision = ui.SuccessStyle().Render(d.Decision)
+		}
+		lines = append(lines, fmt.Sprintf("%s  %s on %s", decision, d.Action, d.Resource))
+		if len(d.Matched) > 0 {
+			lines = append(lines, "  "+ui.DimStyle().Render("matched: "+strings.Join(d.Matched, ", ")))
+		}
+		if len(d.Missing) > 0 {
+			lines = append(lines, "  "+ui.WarningStyle().Render("depends on context: "+strings.Join(d.Missing, ", ")))
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+func (v *PolicyView) paneContent() string {
+	switch v.currentTab() {
+	case policyEffective:
+		return v.effectiveContent()
+	case policyTrust:
+		return v.trustContent()
+	case policySimulate:
+		return v.simulateContent()
+	}
+	return v.documentsContent()
+}
+
+func (v *PolicyView) simulateInputs() string {
+	theme := ui.Current()
+	label := func(name string, focused bool) string {
+		style := lipgloss.NewStyle().Foreground(theme.TextDim).Width(10)
+		if v.editing && focused {
+			style = style.Foreground(theme.Accent).Bold(true)
+		}
+		return style.Render(name)
+	}
+	return label("Actions", !v.resourceFocus) + v.actionInput.View() + "\n" +
+		label("Resource", v.resourceFocus) + v.resourceInput.View() + "\n"
+}
+
+// ViewString returns the view content as a string
+func (v *PolicyView) ViewString() string {
+	theme := ui.Current()
+	header := lipgloss.NewStyle().
+		Foreground(theme.TableHeaderText).
+		Background(theme.TableHeader).
+		Padding(0, 1).
+		Width(v.width).
+		Render(fmt.Sprintf("IAM Policies: %s %s", v.target.Kind, v.target.Name))
+
+	var tabs []string
+	for i, t := range v.tabs {
+		if i == v.tab {
+			tabs = append(tabs, lipgloss.NewStyle().Foreground(theme.Accent).Bold(true).Render("["+policyTabNames[t]+"]"))
+		} else {
+			tabs = append(tabs, ui.DimStyle().Render(" "+policyTabNames[t]+" "))
+		}
+	}
+	status := lipgloss.NewStyle().Foreground(theme.TextDim).Render(v.statusText())
+	out := header + "\n" + lipgloss.NewStyle().Padding(0, 1).Render(strings.Join(tabs, " ")+"  "+status) + "\n"
+
+	if v.err != nil {
+		out += ui.DangerStyle().Render(fmt.Sprintf("Error: %v", v.err)) + "\n"
+	}
+	if v.policies == nil {
+		return out
+	}
+
+	if v.currentTab() == policySimulate {
+		out += v.simulateInputs()
+		v.pane.SetHeight(max(v.height-5, 3))
+	} else {
+		v.pane.SetHeight(max(v.height-3, 3))
+	}
+	v.pane.SetContent(v.paneContent())
+	return out + v.pane.View()
+}
+
+func (v *PolicyView) statusText() string {
+	if v.policies == nil {
+		if v.loading {
+			return v.spinner.View() + " Loading policies..."
+		}
+		return ""
+	}
+	text := fmt.Sprintf("%d documents • %d statements", len(v.policies.Documents), len(v.policies.Statements()))
+	if v.loading {
+		text = v.spinner.View() + " " + text
+	}
+	return text
+}
+
+// View implements tea.Model
+func (v *PolicyView) View() tea.View {
+	return tea.NewView(v.ViewString())
+}
+
+// SetSize implements View
+func (v *PolicyView) SetSize(width, height int) tea.Cmd {
+	v.width = width
+	v.height = height
+	v.pane.SetWidth(width)
+	v.pane.SetHeight(max(height-3, 3))
+	v.actionInput.SetWidth(max(width-12, 20))
+	v.resourceInput.SetWidth(max(width-12, 20))
+	return nil
+}
+
+// StatusLine implements View
+func (v *PolicyView) StatusLine() string {
+	if v.editing {
+		return "enter:simulate • tab:actions/resource • esc:done editing"
+	}
+	if v.currentTab() == policySimulate {
+		return "e:edit • tab:next view • ctrl+r:reload • esc:back"
+	}
+	return "tab:next view • j/k:scroll • ctrl+r:reload • esc:back"
+}
+
+// HasActiveInput implements InputCapture
+func (v *PolicyView) HasActiveInput() bool {
+	return v.editing
+}
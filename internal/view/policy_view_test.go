@@ -0,0 +1,130 @@
+package view
+
+import (
+	"context"
+	"net/url"
+	"strings"
+	"testing"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	"github.com/aws/aws-sdk-go-v2/service/iam/types"
+
+	"github.com/clawscli/claws/internal/action"
+	"github.com/clawscli/claws/internal/policy"
+)
+
+// fakePolicyClient serves a role with one inline policy.
+type fakePolicyClient struct {
+	policy.Client
+	simulated []string
+}
+
+func (f *fakePolicyClient) GetRole(_ context.Context, in *iam.GetRoleInput, _ ...func(*iam.Options)) (*iam.GetRoleOutput, error) {
+	return &iam.GetRoleOutput{Role: &types.Role{
+		RoleName:                 in.RoleName,
+		AssumeRolePolicyDocument: aws.String(url.QueryEscape(`{"Statement":[{"Effect":"Allow","Principal":{"Service":"ecs-tasks.amazonaws.com"},"Action":"sts:AssumeRole"}]}`)),
+	}}, nil
+}
+
+func (f *fakePolicyClient) ListAttachedRolePolicies(_ context.Context, _ *iam.ListAttachedRolePoliciesInput, _ ...func(*iam.Options)) (*iam.ListAttachedRolePoliciesOutput, error) {
+	return &iam.ListAttachedRolePoliciesOutput{}, nil
+}
+
+func (f *fakePolicyClient) ListRolePolicies(_ context.Context, _ *iam.ListRolePoliciesInput, _ ...func(*iam.Options)) (*iam.ListRolePoliciesOutput, error) {
+	return &iam.ListRolePoliciesOutput{PolicyNames: []string{"deploy"}}, nil
+}
+
+func (f *fakePolicyClient) GetRolePolicy(_ context.Context, in *iam.GetRolePolicyInput, _ ...func(*iam.Options)) (*iam.GetRolePolicyOutput, error) {
+	doc := `{"Statement":[{"Effect":"Allow","Action":["iam:PassRole","s3:GetObject"],"Resource":"*"}]}`
+	return &iam.GetRolePolicyOutput{PolicyName: in.PolicyName, PolicyDocument: aws.String(url.QueryEscape(doc))}, nil
+}
+
+func (f *fakePolicyClient) SimulatePrincipalPolicy(_ context.Context, in *iam.SimulatePrincipalPolicyInput, _ ...func(*iam.Options)) (*iam.SimulatePrincipalPolicyOutput, error) {
+	f.simulated = append(f.simulated, in.ActionNames...)
+	return &iam.SimulatePrincipalPolicyOutput{EvaluationResults: []types.EvaluationResult{{
+		EvalActionName:   aws.String(in.ActionNames[0]),
+		EvalResourceName: aws.String(in.ResourceArns[0]),
+		EvalDecision:     types.PolicyEvaluationDecisionTypeImplicitDeny,
+	}}}, nil
+}
+
+type mockPolicyResource struct {
+	mockResource
+}
+
+func (m *mockPolicyResource) PolicyTarget() policy.Target {
+	return policy.Target{Kind: policy.KindRole, Name: m.id}
+}
+
+func newTestPolicyView(client *fakePolicyClient) *PolicyView {
+	v := NewPolicyView(context.Background(), policy.Target{Kind: policy.KindRole, Name: "deployer", ARN: "arn:aws:iam::1:role/deployer"})
+	v.client = client
+	v.SetSize(120, 30)
+	v.Update(v.load()())
+	return v
+}
+
+func TestPolicyView_Tabs(t *testing.T) {
+	v := newTestPolicyView(&fakePolicyClient{})
+
+	if out := v.ViewString(); !strings.Contains(out, "▸ deploy") || !strings.Contains(out, `"iam:PassRole"`) {
+		t.Errorf("documents tab should show the decoded inline policy:\n%s", out)
+	}
+
+	v.Update(tea.KeyPressMsg{Code: '2', Text: "2"})
+	out := v.ViewString()
+	for _, want := range []string{"iam:PassRole on * allows passing any role", "s3", "s3:GetObject"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("effective tab missing %q:\n%s", want, out)
+		}
+	}
+
+	v.Update(tea.KeyPressMsg{Code: '3', Text: "3"})
+	if out := v.ViewString(); !strings.Contains(out, "Allow sts:AssumeRole ← Service: ecs-tasks.amazonaws.com") {
+		t.Errorf("trust tab should summarize the trust policy:\n%s", out)
+	}
+}
+
+func TestPolicyView_Simulate(t *testing.T) {
+	client := &fakePolicyClient{}
+	v := newTestPolicyView(client)
+
+	v.Update(tea.KeyPressMsg{Code: '4', Text: "4"})
+	v.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+	if !v.editing || !v.HasActiveInput() {
+		t.Fatal("enter should focus the simulator inputs")
+	}
+	v.actionInput.SetValue("s3:PutObject")
+	v.resourceInput.SetValue("arn:aws:s3:::data/key")
+	_, cmd := v.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+	if v.editing || !v.simulating {
+		t.Fatal("enter should run the simulation")
+	}
+	for _, msg := range cmd().(tea.BatchMsg) {
+		if m, ok := msg().(policySimulatedMsg); ok {
+			v.Update(m)
+		}
+	}
+
+	if len(client.simulated) != 1 || client.simulated[0] != "s3:PutObject" {
+		t.Errorf("simulated = %v", client.simulated)
+	}
+	if out := v.ViewString(); !strings.Contains(out, "implicitDeny") || !strings.Contains(out, "s3:PutObject on arn:aws:s3:::data/key") {
+		t.Errorf("simulate tab should show the decision:\n%s", out)
+	}
+}
+
+func TestOpenViewTarget_Policy(t *testing.T) {
+	v, err := openViewTarget(context.Background(), action.TargetPolicy, &mockPolicyResource{mockResource{id: "deployer"}})
+	if err != nil {
+		t.Fatalf("openViewTarget() error = %v", err)
+	}
+	if pv, ok := v.(*PolicyView); !ok || len(pv.tabs) != 4 {
+		t.Fatalf("openViewTarget() = %T, want *PolicyView with a trust tab", v)
+	}
+	if _, err := openViewTarget(context.Background(), action.TargetPolicy, &mockResource{id: "x"}); err == nil {
+		t.Error("expected error for a resource without policies")
+	}
+}
@@ -0,0 +1,276 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"charm.land/bubbles/v2/spinner"
+	"charm.land/bubbles/v2/textinput"
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+
+	"github.com/clawscli/claws/internal/action"
+	"github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/config"
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/registry"
+	"github.com/clawscli/claws/internal/tunnel"
+	"github.com/clawscli/claws/internal/ui"
+)
+
+// PortForwardView starts an SSM port forward to a private endpoint through a
+// bastion instance picked from the instances in the endpoint's VPC whose
+// SSM agent is online. The tunnel runs in the background and is listed in
+// the tunnels view. Blocked in read-only mode.
+type PortForwardView struct {
+	ctx      context.Context
+	client   tunnel.Client
+	registry *registry.Registry
+	manager  *tunnel.Manager
+	resource dao.Resource
+	endpoint tunnel.Endpoint
+
+	vpcID    string
+	bastions []tunnel.Bastion
+	cursor   int
+	loading  bool
+	editing  bool
+	port     textinput.Model
+	err      error
+
+	width   int
+	height  int
+	spinner spinner.Model
+}
+
+// NewPortForwardView creates a PortForwardView for the resource's endpoint,
+// with the local port prefilled.
+func NewPortForwardView(ctx context.Context, resource dao.Resource, e tunnel.Endpoint) *PortForwardView {
+	port := textinput.New()
+	port.Prompt = ""
+	port.CharLimit = 5
+	port.SetValue(strconv.Itoa(tunnel.DefaultLocalPort(e)))
+
+	return &PortForwardView{
+		ctx:      ctx,
+		registry: registry.Global,
+		manager:  tunnel.Global,
+		resource: resource,
+		endpoint: e,
+		loading:  true,
+		port:     port,
+		spinner:  ui.NewSpinner(),
+	}
+}
+
+type bastionsMsg struct {
+	vpcID    string
+	bastions []tunnel.Bastion
+	err      error
+}
+
+// Init implements tea.Model
+func (v *PortForwardView) Init() tea.Cmd {
+	return tea.Batch(v.spinner.Tick, v.load())
+}
+
+func (v *PortForwardView) load() tea.Cmd {
+	if v.client == nil {
+		c, err := tunnel.NewClient(v.ctx)
+		if err != nil {
+			return func() tea.Msg { return bastionsMsg{err: err} }
+		}
+		v.client = c
+	}
+	ctx, client, reg, e := v.ctx, v.client, v.registry, v.endpoint
+	return func() tea.Msg {
+		vpcID, err := tunnel.ResolveVPC(ctx, reg, e)
+		if err != nil {
+			return bastionsMsg{err: err}
+		}
+		bastions, err := tunnel.FindBastions(ctx, reg, client, vpcID)
+		return bastionsMsg{vpcID: vpcID, bastions: bastions, err: err}
+	}
+}
+
+// Update implements tea.Model
+func (v *PortForwardView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case bastionsMsg:
+		v.loading = false
+		v.err = msg.err
+		v.vpcID = msg.vpcID
+		v.bastions = msg.bastions
+		v.cursor = 0
+		return v, nil
+
+	case spinner.TickMsg:
+		if v.loading {
+			var cmd tea.Cmd
+			v.spinner, cmd = v.spinner.Update(msg)
+			return v, cmd
+		}
+		return v, nil
+
+	case tea.KeyPressMsg:
+		if v.editing {
+			switch msg.String() {
+			case "esc", "enter", "tab":
+				v.editing = false
+				v.port.Blur()
+				return v, nil
+			}
+			var cmd tea.Cmd
+			v.port, cmd = v.port.Update(msg)
+			return v, cmd
+		}
+		switch msg.String() {
+		case "j", "down":
+			if v.cursor < len(v.bastions)-1 {
+				v.cursor++
+			}
+		case "k", "up":
+			if v.cursor > 0 {
+				v.cursor--
+			}
+		case "p", "e", "tab":
+			v.editing = true
+			return v, v.port.Focus()
+		case "ctrl+r":
+			if !v.loading {
+				v.loading = true
+				return v, tea.Batch(v.spinner.Tick, v.load())
+			}
+		case "enter":
+			return v, v.start()
+		}
+	}
+	return v, nil
+}
+
+// start starts the tunnel through the selected bastion and opens the
+// tunnels view.
+func (v *PortForwardView) start() tea.Cmd {
+	if config.Global().ReadOnly() {
+		v.err = action.ErrReadOnlyDenied
+		return nil
+	}
+	if v.loading || len(v.bastions) == 0 {
+		return nil
+	}
+	localPort, err := tunnel.ParsePort(v.port.Value())
+	if err != nil {
+		v.err = err
+		return nil
+	}
+	bastion := v.bastions[v.cursor]
+	t := &tunnel.Tunnel{
+		Endpoint:  v.endpoint,
+		Name:      v.resource.GetName(),
+		Bastion:   bastion,
+		LocalPort: localPort,
+	}
+	ex := &action.ExecWithHeader{
+		Command:    tunnel.Command(bastion, v.endpoint, localPort),
+		ActionName: "Port Forward",
+		Resource:   v.resource,
+		Service:    v.endpoint.Service,
+		ResType:    v.endpoint.ResourceType,
+		Region:     aws.GetRegionFromContext(v.ctx),
+	}
+	err = v.manager.Start(t, ex)
+	v.record(t, err)
+	if err != nil {
+		v.err = err
+		return nil
+	}
+	v.err = nil
+	tv := NewTunnelsView(v.ctx)
+	tv.manager = v.manager
+	return func() tea.Msg { return NavigateMsg{View: tv} }
+}
+
+// record logs the tunnel like an exec action run from the action menu.
+func (v *PortForwardView) record(t *tunnel.Tunnel, err error) {
+	act := action.Action{Name: "Port Forward", Type: action.ActionTypeExec, Operation: "StartSession"}
+	result := action.ActionResult{Success: err == nil, Error: err}
+	if err == nil {
+		result.Message = fmt.Sprintf("Forwarding %s to %s via %s", t.LocalAddress(), t.Endpoint.Address(), t.Bastion.InstanceID)
+	}
+	action.Record(act, v.endpoint.Service, v.endpoint.ResourceType, v.endpoint.ID, result)
+}
+
+// ViewString returns the view content as a string
+func (v *PortForwardView) ViewString() string {
+	theme := ui.Current()
+	header := lipgloss.NewStyle().
+		Foreground(theme.TableHeaderText).
+		Background(theme.TableHeader).
+		Padding(0, 1).
+		Width(v.width).
+		Render("Port Forward: " + v.endpoint.ID)
+
+	label := lipgloss.NewStyle().Foreground(theme.TextDim).Width(12)
+	body := label.Render("Remote") + v.endpoint.Address() + "\n"
+	if v.vpcID != "" {
+		body += label.Render("VPC") + v.vpcID + "\n"
+	}
+	portLabel := label
+	if v.editing {
+		portLabel = portLabel.Foreground(theme.Accent).Bold(true)
+	}
+	body += portLabel.Render("Local port") + v.port.View() + "\n\n"
+
+	switch {
+	case v.loading:
+		body += v.spinner.View() + " Finding bastion instances..."
+	case len(v.bastions) == 0 && v.err == nil:
+		where := "in any VPC"
+		if v.vpcID != "" {
+			where = "in " + v.vpcID
+		}
+		body += ui.DimStyle().Render("No running instances with an online SSM agent " + where)
+	default:
+		selected := lipgloss.NewStyle().Foreground(theme.SelectionText).Background(theme.Selection)
+		body += ui.DimStyle().Render("Bastion instance") + "\n"
+		for i, b := range v.bastions {
+			line := fmt.Sprintf("%-20s %-32s %s", b.InstanceID, truncateValue(b.Name, 32), b.VpcID)
+			if i == v.cursor {
+				line = selected.Render(line)
+			}
+			body += line + "\n"
+		}
+	}
+	if v.err != nil {
+		body += "\n" + ui.DangerStyle().Render(fmt.Sprintf("Error: %v", v.err))
+	}
+	return header + "\n" + lipgloss.NewStyle().Padding(0, 1).Render(strings.TrimRight(body, "\n"))
+}
+
+// View implements tea.Model
+func (v *PortForwardView) View() tea.View {
+	return tea.NewView(v.ViewString())
+}
+
+// SetSize implements View
+func (v *PortForwardView) SetSize(width, height int) tea.Cmd {
+	v.width = width
+	v.height = height
+	v.port.SetWidth(10)
+	return nil
+}
+
+// StatusLine implements View
+func (v *PortForwardView) StatusLine() string {
+	if v.editing {
+		return "enter:done editing"
+	}
+	return "j/k:bastion • p:local port • enter:start • ctrl+r:reload • esc:back"
+}
+
+// HasActiveInput implements InputCapture
+func (v *PortForwardView) HasActiveInput() bool {
+	return v.editing
+}
@@ -0,0 +1,146 @@
+package view
+
+import (
+	"context"
+	"errors"
+	"net"
+	"strconv"
+	"strings"
+	"testing"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	ssmtypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+
+	"github.com/clawscli/claws/internal/action"
+	"github.com/clawscli/claws/internal/config"
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/registry"
+	"github.com/clawscli/claws/internal/tunnel"
+)
+
+type fakeTunnelClient struct{}
+
+func (fakeTunnelClient) DescribeInstanceInformation(_ context.Context, in *ssm.DescribeInstanceInformationInput, _ ...func(*ssm.Options)) (*ssm.DescribeInstanceInformationOutput, error) {
+	out := &ssm.DescribeInstanceInformationOutput{}
+	for _, id := range in.Filters[0].Values {
+		out.InstanceInformationList = append(out.InstanceInformationList, ssmtypes.InstanceInformation{InstanceId: aws.String(id), PingStatus: ssmtypes.PingStatusOnline})
+	}
+	return out, nil
+}
+
+type fakeInstanceDAO struct {
+	dao.BaseDAO
+}
+
+func (d *fakeInstanceDAO) List(context.Context) ([]dao.Resource, error) {
+	var resources []dao.Resource
+	for _, inst := range []struct{ id, name, vpc string }{{"i-2", "web", "vpc-1"}, {"i-1", "bastion", "vpc-1"}, {"i-3", "other", "vpc-2"}} {
+		resources = append(resources, &dao.BaseResource{ID: inst.id, Name: inst.name, Data: types.Instance{
+			VpcId: aws.String(inst.vpc),
+			State: &types.InstanceState{Name: types.InstanceStateNameRunning},
+		}})
+	}
+	return resources, nil
+}
+
+func (d *fakeInstanceDAO) Get(context.Context, string) (dao.Resource, error) { return nil, nil }
+func (d *fakeInstanceDAO) Delete(context.Context, string) error              { return nil }
+
+type mockTunnelResource struct {
+	mockResource
+}
+
+func (m *mockTunnelResource) TunnelEndpoint() tunnel.Endpoint {
+	return tunnel.Endpoint{Service: "rds", ResourceType: "instances", ID: m.id, Host: "db.internal", Port: 5432, VpcID: "vpc-1"}
+}
+
+func newTestPortForwardView(t *testing.T) *PortForwardView {
+	t.Helper()
+	reg := registry.New()
+	reg.RegisterCustom("ec2", "instances", registry.Entry{DAOFactory: func(context.Context) (dao.DAO, error) {
+		return &fakeInstanceDAO{BaseDAO: dao.NewBaseDAO("ec2", "instances")}, nil
+	}})
+	r := &mockTunnelResource{mockResource{id: "db-1", name: "db-1"}}
+	v := NewPortForwardView(context.Background(), r, r.TunnelEndpoint())
+	v.client = fakeTunnelClient{}
+	v.registry = reg
+	v.manager = &tunnel.Manager{}
+	v.SetSize(120, 20)
+	v.Update(v.load()())
+	return v
+}
+
+func TestPortForwardView_Bastions(t *testing.T) {
+	v := newTestPortForwardView(t)
+	if len(v.bastions) != 2 || v.bastions[0].InstanceID != "i-1" {
+		t.Fatalf("bastions = %+v, want the online instances in vpc-1", v.bastions)
+	}
+	v.Update(tea.KeyPressMsg{Code: 'j', Text: "j"})
+	if v.cursor != 1 {
+		t.Errorf("cursor = %d, want 1", v.cursor)
+	}
+	out := v.ViewString()
+	for _, want := range []string{"db.internal:5432", "vpc-1", "5432", "bastion", "web"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output missing %q:\n%s", want, out)
+		}
+	}
+}
+
+func TestPortForwardView_PortInUse(t *testing.T) {
+	l, err := net.Listen("tcp", "localhost:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+
+	v := newTestPortForwardView(t)
+	v.port.SetValue(strconv.Itoa(l.Addr().(*net.TCPAddr).Port))
+	_, cmd := v.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+	if cmd != nil || v.err == nil || !strings.Contains(v.err.Error(), "in use") {
+		t.Errorf("start should fail on a busy local port: err = %v", v.err)
+	}
+	if len(v.manager.List()) != 0 {
+		t.Error("no tunnel should be started")
+	}
+
+	v.port.SetValue("70000")
+	v.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+	if v.err == nil || !strings.Contains(v.err.Error(), "between 1 and 65535") {
+		t.Errorf("err = %v, want an invalid port error", v.err)
+	}
+}
+
+func TestPortForwardView_ReadOnly(t *testing.T) {
+	v := newTestPortForwardView(t)
+	config.Global().SetReadOnly(true)
+	defer config.Global().SetReadOnly(false)
+
+	v.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+	if !errors.Is(v.err, action.ErrReadOnlyDenied) {
+		t.Errorf("err = %v, want ErrReadOnlyDenied", v.err)
+	}
+	if _, err := openViewTarget(context.Background(), action.TargetPortForward, &mockTunnelResource{mockResource{id: "db-1"}}); !errors.Is(err, action.ErrReadOnlyDenied) {
+		t.Errorf("openViewTarget() error = %v, want ErrReadOnlyDenied", err)
+	}
+}
+
+func TestOpenViewTarget_PortForward(t *testing.T) {
+	v, err := openViewTarget(context.Background(), action.TargetPortForward, &mockTunnelResource{mockResource{id: "db-1"}})
+	if err != nil {
+		t.Fatalf("openViewTarget() error = %v", err)
+	}
+	pv, ok := v.(*PortForwardView)
+	if !ok {
+		t.Fatalf("openViewTarget() = %T, want *PortForwardView", v)
+	}
+	if pv.port.Value() != "5432" {
+		t.Errorf("local port = %q, want the endpoint's port", pv.port.Value())
+	}
+	if _, err := openViewTarget(context.Background(), action.TargetPortForward, &mockResource{id: "x"}); err == nil {
+		t.Error("expected error for a resource without an endpoint")
+	}
+}
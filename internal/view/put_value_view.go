@@ -0,0 +1,433 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+
+	"charm.land/bubbles/v2/spinner"
+	"charm.land/bubbles/v2/textarea"
+	"charm.land/bubbles/v2/viewport"
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+
+	"github.com/clawscli/claws/internal/action"
+	"github.com/clawscli/claws/internal/config"
+	"github.com/clawscli/claws/internal/log"
+	"github.com/clawscli/claws/internal/secretvalue"
+	"github.com/clawscli/claws/internal/ui"
+)
+
+type putValueMode int
+
+const (
+	putValueIdle putValueMode = iota
+	putValueEditing
+	putValueReview
+)
+
+// PutValueView stores a new version of a secret or parameter. The editor
+// starts with the current value, which can also be edited in $EDITOR;
+// before saving, the rows that change are shown (masked until revealed)
+// for confirmation. Blocked in read-only mode.
+type PutValueView struct {
+	ctx    context.Context
+	client secretvalue.Client
+	target secretvalue.Target
+
+	current  secretvalue.Version
+	loaded   bool
+	loading  bool
+	saving   bool
+	mode     putValueMode
+	revealed bool
+	changes  []secretvalue.Change
+	editor   textarea.Model
+	review   viewport.Model
+	err      error
+	message  string
+
+	width   int
+	height  int
+	spinner spinner.Model
+}
+
+// NewPutValueView creates a PutValueView for t.
+func NewPutValueView(ctx context.Context, t secretvalue.Target) *PutValueView {
+	editor := textarea.New()
+	editor.ShowLineNumbers = false
+	editor.CharLimit = 0
+
+	return &PutValueView{
+		ctx:     ctx,
+		target:  t,
+		loading: true,
+		editor:  editor,
+		review:  viewport.New(),
+		spinner: ui.NewSpinner(),
+	}
+}
+
+type putValueLoadedMsg struct {
+	current secretvalue.Version
+	err     error
+}
+
+type putValueSavedMsg struct {
+	value string
+	label string
+	err   error
+}
+
+type putValueEditedMsg struct {
+	value string
+	err   error
+}
+
+// Init implements tea.Model
+func (v *PutValueView) Init() tea.Cmd {
+	if config.Global().ReadOnly() {
+		v.loading = false
+		v.err = action.ErrReadOnlyDenied
+		return nil
+	}
+	return tea.Batch(v.spinner.Tick, v.load())
+}
+
+func (v *PutValueView) load() tea.Cmd {
+	if v.client == nil {
+		c, err := secretvalue.NewClient(v.ctx)
+		if err != nil {
+			return func() tea.Msg { return putValueLoadedMsg{err: err} }
+		}
+		v.client = c
+	}
+	ctx, client, target := v.ctx, v.client, v.target
+	return func() tea.Msg {
+		current, err := secretvalue.LoadCurrent(ctx, client, target)
+		return putValueLoadedMsg{current: current, err: err}
+	}
+}
+
+// Update implements tea.Model
+func (v *PutValueView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case putValueLoadedMsg:
+		v.loading = false
+		if msg.err != nil {
+			v.err = msg.err
+			return v, nil
+		}
+		if msg.current.Binary {
+			v.err = fmt.Errorf("%s has a binary value, which cannot be edited here", v.target.Name)
+			return v, nil
+		}
+		v.current = msg.current
+		v.loaded = true
+		v.editor.SetValue(msg.current.Value)
+		v.editor.MoveToBegin()
+		v.mode = putValueEditing
+		return v, v.editor.Focus()
+
+	case putValueEditedMsg:
+		if msg.err != nil {
+			v.err = msg.err
+			return v, nil
+		}
+		v.err = nil
+		v.editor.SetValue(msg.value)
+		return v, nil
+
+	case putValueSavedMsg:
+		v.saving = false
+		v.record(msg.err)
+		if msg.err != nil {
+			v.err = msg.err
+			return v, nil
+		}
+		v.current = secretvalue.Version{Value: msg.value, Settings: v.current.Settings}
+		v.message = "Saved new version " + msg.label
+		return v, nil
+
+	case spinner.TickMsg:
+		if v.loading || v.saving {
+			var cmd tea.Cmd
+			v.spinner, cmd = v.spinner.Update(msg)
+			return v, cmd
+		}
+		return v, nil
+
+	case tea.KeyPressMsg:
+		switch v.mode {
+		case putValueEditing:
+			return v.handleEditKey(msg)
+		case putValueReview:
+			return v.handleReviewKey(msg)
+		}
+		if v.loaded && !v.saving {
+			switch msg.String() {
+			case "e", "enter":
+				v.message = ""
+				v.mode = putValueEditing
+				return v, v.editor.Focus()
+			case "s":
+				v.askSave()
+			}
+		}
+	}
+	return v, nil
+}
+
+func (v *PutValueView) handleEditKey(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "esc":
+		v.mode = putValueIdle
+		v.editor.Blur()
+		return v, nil
+	case "ctrl+s":
+		v.askSave()
+		return v, nil
+	case "ctrl+e":
+		return v, v.openExternalEditor()
+	}
+	var cmd tea.Cmd
+	v.editor, cmd = v.editor.Update(msg)
+	return v, cmd
+}
+
+func (v *PutValueView) handleReviewKey(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "y", "Y":
+		return v, v.save()
+	case "n", "N", "esc":
+		v.mode = putValueEditing
+		v.revealed = false
+		return v, v.editor.Focus()
+	case "v":
+		v.revealed = !v.revealed
+		if v.revealed {
+			log.Info("secret value revealed", "kind", v.target.Kind.String(), "name", v.target.Name, "version", v.current.ID, "compared_version", "edited")
+		}
+		v.review.SetContent(v.reviewContent())
+		return v, nil
+	}
+	var cmd tea.Cmd
+	v.review, cmd = v.review.Update(msg)
+	return v, cmd
+}
+
+// askSave diffs the edited value with the current one and asks for
+// confirmation.
+func (v *PutValueView) askSave() {
+	if config.Global().ReadOnly() {
+		v.err = action.ErrReadOnlyDenied
+		return
+	}
+	changes := secretvalue.Diff(v.current.Value, v.editor.Value())
+	if len(changes) == 0 {
+		v.err = fmt.Errorf("no changes to save")
+		return
+	}
+	v.err = nil
+	v.changes = changes
+	v.revealed = false
+	v.mode = putValueReview
+	v.editor.Blur()
+	v.review.SetContent(v.reviewContent())
+	v.review.GotoTop()
+}
+
+func (v *PutValueView) save() tea.Cmd {
+	if config.Global().ReadOnly() {
+		v.err = action.ErrReadOnlyDenied
+		return nil
+	}
+	v.mode = putValueIdle
+	v.revealed = false
+	v.saving = true
+	ctx, client, target, current, value := v.ctx, v.client, v.target, v.current, v.editor.Value()
+	return tea.Batch(v.spinner.Tick, func() tea.Msg {
+		label, err := secretvalue.Put(ctx, client, target, current, value)
+		return putValueSavedMsg{value: value, label: label, err: err}
+	})
+}
+
+// record logs the save like an API action run from the action menu.
+func (v *PutValueView) record(err error) {
+	act := action.Action{Name: "Put New Version", Type: action.ActionTypeAPI, Operation: "PutSecretValue"}
+	service, resourceType := "secretsmanager", "secrets"
+	if v.target.Kind == secretvalue.KindParameter {
+		act.Operation = "PutParameter"
+		service, resourceType = "ssm", "parameters"
+	}
+	result := action.ActionResult{Success: err == nil, Error: err}
+	action.Record(act, service, resourceType, v.target.ID, result)
+}
+
+// openExternalEditor edits the value in $VISUAL or $EDITOR (vi when unset).
+// The temporary file is readable only by the user and removed afterwards.
+func (v *PutValueView) openExternalEditor() tea.Cmd {
+	f, err := os.CreateTemp("", "claws-value-*")
+	if err != nil {
+		v.err = err
+		return nil
+	}
+	path := f.Name()
+	original := v.editor.Value()
+	_, err = f.WriteString(original)
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		_ = os.Remove(path)
+		v.err = err
+		return nil
+	}
+
+	args := editorCommand()
+	cmd := exec.Command(args[0], append(args[1:], path)...)
+	return tea.ExecProcess(cmd, func(err error) tea.Msg {
+		defer func() { _ = os.Remove(path) }()
+		if err != nil {
+			return putValueEditedMsg{err: fmt.Errorf("editor: %w", err)}
+		}
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return putValueEditedMsg{err: err}
+		}
+		value := string(data)
+		// Editors add a final newline the value did not have
+		if !strings.HasSuffix(original, "\n") {
+			value = strings.TrimSuffix(value, "\n")
+		}
+		return putValueEditedMsg{value: value}
+	})
+}
+
+// editorCommand returns the user's editor command and its arguments.
+func editorCommand() []string {
+	for _, env := range []string{"VISUAL", "EDITOR"} {
+		if args := strings.Fields(os.Getenv(env)); len(args) > 0 {
+			return args
+		}
+	}
+	return []string{"vi"}
+}
+
+// show returns s, or the mask while the values are hidden.
+func (v *PutValueView) show(s string) string {
+	if !v.revealed {
+		return secretvalue.Mask
+	}
+	return s
+}
+
+// reviewContent renders one line per changed row: ~ changed, + added,
+// - removed.
+func (v *PutValueView) reviewContent() string {
+	key := lipgloss.NewStyle().Foreground(ui.Current().Accent)
+	var lines []string
+	for _, c := range v.changes {
+		name := c.Key
+		if name == "" {
+			name = "value"
+		}
+		switch c.Kind {
+		case secretvalue.Added:
+			lines = append(lines, ui.SuccessStyle().Render("+ ")+key.Render(name)+"  "+ui.SuccessStyle().Render(v.show(c.New)))
+		case secretvalue.Removed:
+			lines = append(lines, ui.DangerStyle().Render("- ")+key.Render(name)+"  "+ui.DangerStyle().Render(v.show(c.Old)))
+		default:
+			lines = append(lines, ui.WarningStyle().Render("~ ")+key.Render(name))
+			lines = append(lines, "    "+ui.DangerStyle().Render(v.show(c.Old))+ui.DimStyle().Render(" → ")+ui.SuccessStyle().Render(v.show(c.New)))
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// ViewString returns the view content as a string
+func (v *PutValueView) ViewString() string {
+	theme := ui.Current()
+	header := lipgloss.NewStyle().
+		Foreground(theme.TableHeaderText).
+		Background(theme.TableHeader).
+		Padding(0, 1).
+		Width(v.width).
+		Render("Put New Version: " + v.target.Name)
+
+	status := lipgloss.NewStyle().Foreground(theme.TextDim).Render(v.statusText())
+	out := header + "\n" + lipgloss.NewStyle().Padding(0, 1).Render(status) + "\n"
+
+	switch {
+	case v.err != nil:
+		out += ui.DangerStyle().Render(fmt.Sprintf("Error: %v", v.err)) + "\n"
+	case v.mode == putValueReview:
+		out += ui.WarningStyle().Render(fmt.Sprintf("Save these changes as a new version of %s?", v.target.Name)) + " " + ui.DimStyle().Render("[y/n]") + "\n"
+		return out + v.review.View()
+	case v.saving:
+		out += v.spinner.View() + " Saving...\n"
+	case v.message != "":
+		out += ui.SuccessStyle().Render(v.message) + "\n"
+	default:
+		out += ui.DimStyle().Render("New value") + "\n"
+	}
+	if !v.loaded {
+		return out
+	}
+	return out + v.editor.View()
+}
+
+func (v *PutValueView) statusText() string {
+	if v.loading {
+		return v.spinner.View() + " Loading current value..."
+	}
+	if !v.loaded {
+		return ""
+	}
+	parts := []string{"current " + secretvalue.Describe(v.target.Kind, v.current)}
+	if s := v.current.Settings; s.Type != "" {
+		settings := s.Type
+		if s.Tier != "" {
+			settings += ", " + s.Tier
+		}
+		if s.KeyID != "" {
+			settings += ", " + s.KeyID
+		}
+		parts = append(parts, settings+" (kept)")
+	}
+	return strings.Join(parts, " • ")
+}
+
+// View implements tea.Model
+func (v *PutValueView) View() tea.View {
+	return tea.NewView(v.ViewString())
+}
+
+// SetSize implements View
+func (v *PutValueView) SetSize(width, height int) tea.Cmd {
+	v.width = width
+	v.height = height
+	v.editor.SetWidth(max(width-2, 20))
+	v.editor.SetHeight(max(height-4, 3))
+	v.review.SetWidth(width)
+	v.review.SetHeight(max(height-4, 3))
+	return nil
+}
+
+// StatusLine implements View
+func (v *PutValueView) StatusLine() string {
+	switch v.mode {
+	case putValueReview:
+		return "y:save • n:back to editing • v:reveal"
+	case putValueEditing:
+		return "ctrl+s:review and save • ctrl+e:$EDITOR • esc:done editing"
+	}
+	return "e:edit • s:save • esc:back"
+}
+
+// HasActiveInput implements InputCapture
+func (v *PutValueView) HasActiveInput() bool {
+	return v.mode != putValueIdle
+}
@@ -0,0 +1,99 @@
+package view
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	tea "charm.land/bubbletea/v2"
+
+	"github.com/clawscli/claws/internal/action"
+	"github.com/clawscli/claws/internal/config"
+	"github.com/clawscli/claws/internal/log"
+	"github.com/clawscli/claws/internal/secretvalue"
+)
+
+func newTestPutValueView(t *testing.T, client *fakeSecretValueClient) *PutValueView {
+	t.Helper()
+	v := NewPutValueView(context.Background(), secretvalue.Target{Kind: secretvalue.KindSecret, ID: "db", Name: "db"})
+	v.client = client
+	v.SetSize(120, 30)
+	v.Update(v.load()())
+	if v.err != nil {
+		t.Fatalf("load error = %v", v.err)
+	}
+	return v
+}
+
+func TestPutValueView_ReviewAndSave(t *testing.T) {
+	var buf bytes.Buffer
+	log.Enable(&buf)
+	defer log.Disable()
+
+	client := &fakeSecretValueClient{}
+	v := newTestPutValueView(t, client)
+	if v.mode != putValueEditing || v.editor.Value() != `{"user":"app","password":"hunter2"}` {
+		t.Fatalf("editor should start with the current value, got %q", v.editor.Value())
+	}
+
+	v.editor.SetValue(`{"user":"app","password":"hunter3"}`)
+	v.Update(tea.KeyPressMsg{Code: 's', Mod: tea.ModCtrl})
+	if v.mode != putValueReview || len(v.changes) != 1 || v.changes[0].Key != "password" {
+		t.Fatalf("ctrl+s should review the change: mode = %v, changes = %+v, err = %v", v.mode, v.changes, v.err)
+	}
+	if out := v.ViewString(); strings.Contains(out, "hunter") {
+		t.Errorf("review values should be masked:\n%s", out)
+	}
+
+	_, cmd := v.Update(tea.KeyPressMsg{Code: 'y', Text: "y"})
+	if cmd == nil {
+		t.Fatal("confirming should save")
+	}
+	runPutValueCmd(v, cmd)
+	if len(client.puts) != 1 || *client.puts[0].SecretString != `{"user":"app","password":"hunter3"}` {
+		t.Fatalf("puts = %+v", client.puts)
+	}
+	if !strings.Contains(v.message, "v-newer-") {
+		t.Errorf("message = %q", v.message)
+	}
+	if !strings.Contains(buf.String(), "action completed") || !strings.Contains(buf.String(), "Put New Version") {
+		t.Errorf("save not recorded: %s", buf.String())
+	}
+}
+
+// runPutValueCmd runs cmd and feeds its messages, including batched ones, to v.
+func runPutValueCmd(v *PutValueView, cmd tea.Cmd) {
+	if cmd == nil {
+		return
+	}
+	switch msg := cmd().(type) {
+	case tea.BatchMsg:
+		for _, c := range msg {
+			runPutValueCmd(v, c)
+		}
+	case putValueSavedMsg:
+		v.Update(msg)
+	}
+}
+
+func TestPutValueView_NoChanges(t *testing.T) {
+	v := newTestPutValueView(t, &fakeSecretValueClient{})
+	v.Update(tea.KeyPressMsg{Code: 's', Mod: tea.ModCtrl})
+	if v.mode == putValueReview || v.err == nil {
+		t.Error("saving an unchanged value should be refused")
+	}
+}
+
+func TestPutValueView_ReadOnly(t *testing.T) {
+	config.Global().SetReadOnly(true)
+	defer config.Global().SetReadOnly(false)
+
+	if _, err := openViewTarget(context.Background(), action.TargetPutValue, &mockSecretValueResource{mockResource{id: "db"}}); !errors.Is(err, action.ErrReadOnlyDenied) {
+		t.Errorf("openViewTarget() error = %v, want read-only denial", err)
+	}
+	if action.IsAllowedInReadOnly(action.Action{Type: action.ActionTypeView, Target: action.TargetPutValue}) {
+		t.Error("Put New Version should not be offered in read-only mode")
+	}
+}
@@ -0,0 +1,468 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"charm.land/bubbles/v2/spinner"
+	"charm.land/bubbles/v2/textinput"
+	"charm.land/bubbles/v2/viewport"
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+
+	"github.com/clawscli/claws/internal/action"
+	"github.com/clawscli/claws/internal/config"
+	"github.com/clawscli/claws/internal/reach"
+	"github.com/clawscli/claws/internal/registry"
+	"github.com/clawscli/claws/internal/ui"
+)
+
+// analysisPollInterval is how often a running Reachability Analyzer
+// analysis is polled.
+const analysisPollInterval = 3 * time.Second
+
+// Reach input fields
+const (
+	reachFieldDestination = iota
+	reachFieldPort
+	reachFieldProtocol
+	reachFieldCount
+)
+
+// ReachView answers "can this instance or network interface reach another
+// one on a port" by evaluating security groups, network ACLs and route
+// tables locally, and can run a VPC Reachability Analyzer path to confirm.
+type ReachView struct {
+	ctx    context.Context
+	client reach.Client
+	from   reach.Endpoint
+
+	// network resolves the destination and loads both endpoints' network
+	// configuration; replaced in tests.
+	network func(ctx context.Context, client reach.Client, from reach.Endpoint, to string) (*reach.Network, reach.Endpoint, error)
+
+	inputs     [reachFieldCount]textinput.Model
+	field      int
+	editing    bool
+	evaluating bool
+	result     *reach.Result
+	err        error
+
+	confirming  bool
+	analyzing   bool
+	analysis    *reach.Analysis
+	analysisErr error
+
+	pane    viewport.Model
+	width   int
+	height  int
+	spinner spinner.Model
+}
+
+// NewReachView creates a ReachView with from as the source.
+func NewReachView(ctx context.Context, from reach.Endpoint) *ReachView {
+	placeholders := [reachFieldCount]string{"i-... or eni-...", "443", "tcp"}
+	var inputs [reachFieldCount]textinput.Model
+	for i := range inputs {
+		inputs[i] = textinput.New()
+		inputs[i].Prompt = ""
+		inputs[i].Placeholder = placeholders[i]
+		inputs[i].CharLimit = 64
+	}
+	inputs[reachFieldPort].SetValue("443")
+	inputs[reachFieldProtocol].SetValue("tcp")
+
+	return &ReachView{
+		ctx:     ctx,
+		from:    from,
+		network: loadReachNetwork,
+		inputs:  inputs,
+		editing: true,
+		pane:    viewport.New(),
+		spinner: ui.NewSpinner(),
+	}
+}
+
+// loadReachNetwork resolves to through its DAO and loads the network
+// configuration between from and to.
+func loadReachNetwork(ctx context.Context, client reach.Client, from reach.Endpoint, to string) (*reach.Network, reach.Endpoint, error) {
+	dst, err := reach.Resolve(ctx, registry.Global, to)
+	if err != nil {
+		return nil, reach.Endpoint{}, err
+	}
+	n, err := reach.LoadNetwork(ctx, registry.Global, client, from, dst)
+	if err != nil {
+		return nil, reach.Endpoint{}, err
+	}
+	return n, dst, nil
+}
+
+type reachEvaluatedMsg struct {
+	result *reach.Result
+	err    error
+}
+
+type reachAnalysisMsg struct {
+	analysis *reach.Analysis
+	err      error
+}
+
+type reachPollMsg struct {
+	id string
+}
+
+// Init implements tea.Model
+func (v *ReachView) Init() tea.Cmd {
+	return v.inputs[v.field].Focus()
+}
+
+func (v *ReachView) ensureClient() error {
+	if v.client != nil {
+		return nil
+	}
+	c, err := reach.NewClient(v.ctx)
+	if err != nil {
+		return err
+	}
+	v.client = c
+	return nil
+}
+
+// request parses the inputs into a destination, protocol and port.
+func (v *ReachView) request() (string, string, int32, error) {
+	to := strings.TrimSpace(v.inputs[reachFieldDestination].Value())
+	if to == "" {
+		return "", "", 0, fmt.Errorf("destination is required")
+	}
+	if to == v.from.ID {
+		return "", "", 0, fmt.Errorf("destination must differ from the source")
+	}
+	protocol := strings.ToLower(strings.TrimSpace(v.inputs[reachFieldProtocol].Value()))
+	if protocol != "tcp" && protocol != "udp" {
+		return "", "", 0, fmt.Errorf("protocol must be tcp or udp")
+	}
+	port, err := strconv.ParseInt(strings.TrimSpace(v.inputs[reachFieldPort].Value()), 10, 32)
+	if err != nil || port < 1 || port > 65535 {
+		return "", "", 0, fmt.Errorf("port must be 1-65535")
+	}
+	return to, protocol, int32(port), nil
+}
+
+func (v *ReachView) evaluate() tea.Cmd {
+	to, protocol, port, err := v.request()
+	if err != nil {
+		v.err = err
+		return nil
+	}
+	if err := v.ensureClient(); err != nil {
+		v.err = err
+		return nil
+	}
+	v.err = nil
+	v.evaluating = true
+	v.analysis, v.analysisErr = nil, nil
+	ctx, client, from, network := v.ctx, v.client, v.from, v.network
+	return tea.Batch(v.spinner.Tick, func() tea.Msg {
+		n, dst, err := network(ctx, client, from, to)
+		if err != nil {
+			return reachEvaluatedMsg{err: err}
+		}
+		res := reach.Evaluate(n, from, dst, protocol, port)
+		return reachEvaluatedMsg{result: &res}
+	})
+}
+
+func (v *ReachView) analyze() tea.Cmd {
+	if config.Global().ReadOnly() {
+		v.analysisErr = action.ErrReadOnlyDenied
+		return nil
+	}
+	if v.result == nil || v.analyzing {
+		return nil
+	}
+	v.analyzing = true
+	v.analysis, v.analysisErr = nil, nil
+	ctx, client, res := v.ctx, v.client, *v.result
+	return tea.Batch(v.spinner.Tick, func() tea.Msg {
+		a, err := reach.Analyze(ctx, client, res.From, res.To, res.Protocol, res.Port)
+		return reachAnalysisMsg{analysis: a, err: err}
+	})
+}
+
+func (v *ReachView) poll(id string) tea.Cmd {
+	ctx, client := v.ctx, v.client
+	return func() tea.Msg {
+		a, err := reach.GetAnalysis(ctx, client, id)
+		return reachAnalysisMsg{analysis: a, err: err}
+	}
+}
+
+// Update implements tea.Model
+func (v *ReachView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case reachEvaluatedMsg:
+		v.evaluating = false
+		v.err = msg.err
+		if msg.err == nil {
+			v.result = msg.result
+			v.pane.GotoTop()
+		}
+		return v, nil
+
+	case reachAnalysisMsg:
+		v.analysisErr = msg.err
+		if msg.err != nil {
+			v.analyzing = false
+			return v, nil
+		}
+		v.analysis = msg.analysis
+		if msg.analysis.Done() {
+			v.analyzing = false
+			return v, nil
+		}
+		id := msg.analysis.ID
+		return v, tea.Tick(analysisPollInterval, func(time.Time) tea.Msg { return reachPollMsg{id: id} })
+
+	case reachPollMsg:
+		if v.analysis == nil || v.analysis.ID != msg.id {
+			return v, nil
+		}
+		return v, v.poll(msg.id)
+
+	case spinner.TickMsg:
+		if v.evaluating || v.analyzing {
+			var cmd tea.Cmd
+			v.spinner, cmd = v.spinner.Update(msg)
+			return v, cmd
+		}
+		return v, nil
+
+	case tea.KeyPressMsg:
+		if v.confirming {
+			return v.handleConfirmKey(msg)
+		}
+		if v.editing {
+			return v.handleEditKey(msg)
+		}
+		return v.handleKey(msg)
+	}
+	return v, nil
+}
+
+func (v *ReachView) handleKey(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "e", "i", "enter":
+		v.editing = true
+		return v, v.inputs[v.field].Focus()
+	case "ctrl+r":
+		if !v.evaluating {
+			return v, v.evaluate()
+		}
+		return v, nil
+	case "A":
+		if v.result != nil && !v.analyzing {
+			if config.Global().ReadOnly() {
+				v.analysisErr = action.ErrReadOnlyDenied
+				return v, nil
+			}
+			v.confirming = true
+		}
+		return v, nil
+	case "g":
+		v.pane.GotoTop()
+		return v, nil
+	case "G":
+		v.pane.GotoBottom()
+		return v, nil
+	case "j":
+		v.pane.ScrollDown(1)
+		return v, nil
+	case "k":
+		v.pane.ScrollUp(1)
+		return v, nil
+	}
+	var cmd tea.Cmd
+	v.pane, cmd = v.pane.Update(msg)
+	return v, cmd
+}
+
+func (v *ReachView) handleEditKey(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "esc":
+		v.editing = false
+		v.inputs[v.field].Blur()
+		return v, nil
+	case "tab", "shift+tab":
+		v.inputs[v.field].Blur()
+		if msg.String() == "tab" {
+			v.field = (v.field + 1) % reachFieldCount
+		} else {
+			v.field = (v.field + reachFieldCount - 1) % reachFieldCount
+		}
+		return v, v.inputs[v.field].Focus()
+	case "enter":
+		v.editing = false
+		v.inputs[v.field].Blur()
+		return v, v.evaluate()
+	}
+	var cmd tea.Cmd
+	v.inputs[v.field], cmd = v.inputs[v.field].Update(msg)
+	return v, cmd
+}
+
+func (v *ReachView) handleConfirmKey(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
+	v.confirming = false
+	switch msg.String() {
+	case "y", "Y":
+		return v, v.analyze()
+	}
+	return v, nil
+}
+
+func (v *ReachView) inputsView() string {
+	theme := ui.Current()
+	names := [reachFieldCount]string{"To", "Port", "Protocol"}
+	var lines []string
+	for i, in := range v.inputs {
+		style := lipgloss.NewStyle().Foreground(theme.TextDim).Width(10)
+		if v.editing && i == v.field {
+			style = style.Foreground(theme.Accent).Bold(true)
+		}
+		lines = append(lines, style.Render(names[i])+in.View())
+	}
+	return strings.Join(lines, "\n") + "\n"
+}
+
+func (v *ReachView) resultContent() string {
+	if v.evaluating {
+		return v.spinner.View() + " Loading security groups, route tables and network ACLs..."
+	}
+	if v.result == nil {
+		return ui.DimStyle().Render("Enter a destination instance or network interface, then press enter")
+	}
+	res := v.result
+
+	var verdict string
+	switch {
+	case !res.Reachable():
+		verdict = ui.DangerStyle().Render("✗ Not reachable")
+	case !res.Conclusive():
+		verdict = ui.WarningStyle().Render("? Possibly reachable")
+	default:
+		verdict = ui.SuccessStyle().Render("✓ Reachable")
+	}
+	lines := []string{
+		lipgloss.NewStyle().Bold(true).Render(verdict) + "  " +
+			fmt.Sprintf("%s → %s on %s/%d", res.From.Label(), res.To.Label(), res.Protocol, res.Port),
+		ui.DimStyle().Render(fmt.Sprintf("%s in %s → %s in %s", res.From.IP, res.FromSubnet, res.To.IP, res.ToSubnet)),
+		"",
+	}
+	for _, c := range res.Checks {
+		mark, style := "✓", ui.SuccessStyle()
+		switch c.Verdict {
+		case reach.Denied:
+			mark, style = "✗", ui.DangerStyle()
+		case reach.Unknown:
+			mark, style = "?", ui.WarningStyle()
+		case reach.Skipped:
+			mark, style = "-", ui.DimStyle()
+		}
+		lines = append(lines, fmt.Sprintf("%s %-28s %s", style.Render(mark), c.Step, ui.DimStyle().Render(c.Detail)))
+	}
+
+	lines = append(lines, "", lipgloss.NewStyle().Bold(true).Render("Reachability Analyzer"))
+	lines = append(lines, v.analysisLines()...)
+	return strings.Join(lines, "\n")
+}
+
+func (v *ReachView) analysisLines() []string {
+	if v.analysisErr != nil {
+		return []string{ui.DangerStyle().Render(fmt.Sprintf("Error: %v", v.analysisErr))}
+	}
+	a := v.analysis
+	if a == nil {
+		if v.analyzing {
+			return []string{v.spinner.View() + " Starting analysis..."}
+		}
+		return []string{ui.DimStyle().Render("Press A to confirm with a VPC Reachability Analyzer path")}
+	}
+	if !a.Done() {
+		return []string{v.spinner.View() + " Analyzing " + a.ID + "..."}
+	}
+
+	lines := []string{ui.DimStyle().Render(a.ID + " • " + a.PathID)}
+	switch {
+	case a.Status != "succeeded":
+		lines = append(lines, ui.DangerStyle().Render(a.Status+": "+a.Message))
+	case a.PathFound:
+		lines = append(lines, ui.SuccessStyle().Render("✓ Path found"))
+		if len(a.Hops) > 0 {
+			lines = append(lines, "  "+strings.Join(a.Hops, " → "))
+		}
+	default:
+		lines = append(lines, ui.DangerStyle().Render("✗ No path found"))
+		for _, e := range a.Explanations {
+			lines = append(lines, "  "+e)
+		}
+	}
+	return lines
+}
+
+// ViewString returns the view content as a string
+func (v *ReachView) ViewString() string {
+	theme := ui.Current()
+	header := lipgloss.NewStyle().
+		Foreground(theme.TableHeaderText).
+		Background(theme.TableHeader).
+		Padding(0, 1).
+		Width(v.width).
+		Render("Reachability from " + v.from.Label())
+
+	out := header + "\n" + v.inputsView()
+	if v.err != nil {
+		out += ui.DangerStyle().Render(fmt.Sprintf("Error: %v", v.err)) + "\n"
+	}
+	if v.confirming {
+		out += ui.WarningStyle().Render("Create a Network Insights path and run a billed analysis? (y/n)") + "\n"
+	}
+	v.pane.SetContent(v.resultContent())
+	return out + v.pane.View()
+}
+
+// View implements tea.Model
+func (v *ReachView) View() tea.View {
+	return tea.NewView(v.ViewString())
+}
+
+// SetSize implements View
+func (v *ReachView) SetSize(width, height int) tea.Cmd {
+	v.width = width
+	v.height = height
+	v.pane.SetWidth(width)
+	v.pane.SetHeight(max(height-6, 3))
+	for i := range v.inputs {
+		v.inputs[i].SetWidth(max(width-12, 20))
+	}
+	return nil
+}
+
+// StatusLine implements View
+func (v *ReachView) StatusLine() string {
+	switch {
+	case v.confirming:
+		return "y:run analysis • n:cancel"
+	case v.editing:
+		return "enter:evaluate • tab:next field • esc:done editing"
+	case v.result != nil:
+		return "e:edit • A:reachability analyzer • ctrl+r:re-evaluate • j/k:scroll • esc:back"
+	}
+	return "e:edit • esc:back"
+}
+
+// HasActiveInput implements InputCapture
+func (v *ReachView) HasActiveInput() bool {
+	return v.editing || v.confirming
+}
@@ -0,0 +1,198 @@
+package view
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+
+	"github.com/clawscli/claws/internal/action"
+	"github.com/clawscli/claws/internal/config"
+	"github.com/clawscli/claws/internal/reach"
+)
+
+// fakeReachClient answers a Reachability Analyzer run that finds no path.
+type fakeReachClient struct {
+	reach.Client
+	paths int
+}
+
+func (f *fakeReachClient) CreateNetworkInsightsPath(_ context.Context, in *ec2.CreateNetworkInsightsPathInput, _ ...func(*ec2.Options)) (*ec2.CreateNetworkInsightsPathOutput, error) {
+	f.paths++
+	return &ec2.CreateNetworkInsightsPathOutput{NetworkInsightsPath: &types.NetworkInsightsPath{NetworkInsightsPathId: aws.String("nip-1")}}, nil
+}
+
+func (f *fakeReachClient) StartNetworkInsightsAnalysis(_ context.Context, in *ec2.StartNetworkInsightsAnalysisInput, _ ...func(*ec2.Options)) (*ec2.StartNetworkInsightsAnalysisOutput, error) {
+	return &ec2.StartNetworkInsightsAnalysisOutput{NetworkInsightsAnalysis: &types.NetworkInsightsAnalysis{
+		NetworkInsightsAnalysisId: aws.String("nia-1"),
+		NetworkInsightsPathId:     in.NetworkInsightsPathId,
+		Status:                    types.AnalysisStatusSucceeded,
+		NetworkPathFound:          aws.Bool(false),
+		Explanations:              []types.Explanation{{ExplanationCode: aws.String("ENI_SG_RULES_MISMATCH")}},
+	}}, nil
+}
+
+var (
+	reachWeb = reach.Endpoint{ID: "i-web", Name: "web", IP: "10.0.1.10", SubnetID: "subnet-a", VpcID: "vpc-1", SecurityGroups: []string{"sg-web"}}
+	reachDB  = reach.Endpoint{ID: "i-db", IP: "10.0.1.20", SubnetID: "subnet-a", VpcID: "vpc-1", SecurityGroups: []string{"sg-db"}}
+)
+
+// reachTestNetwork lets sg-web reach anything and sg-db accept 5432 from sg-web.
+func reachTestNetwork(context.Context, reach.Client, reach.Endpoint, string) (*reach.Network, reach.Endpoint, error) {
+	return &reach.Network{
+		SecurityGroups: map[string]types.SecurityGroup{
+			"sg-web": {GroupId: aws.String("sg-web"), IpPermissionsEgress: []types.IpPermission{
+				{IpProtocol: aws.String("-1"), IpRanges: []types.IpRange{{CidrIp: aws.String("0.0.0.0/0")}}},
+			}},
+			"sg-db": {GroupId: aws.String("sg-db"), IpPermissions: []types.IpPermission{
+				{IpProtocol: aws.String("tcp"), FromPort: aws.Int32(5432), ToPort: aws.Int32(5432), UserIdGroupPairs: []types.UserIdGroupPair{{GroupId: aws.String("sg-web")}}},
+			}},
+		},
+		RouteTables: []types.RouteTable{{
+			RouteTableId: aws.String("rtb-1"), VpcId: aws.String("vpc-1"),
+			Associations: []types.RouteTableAssociation{{Main: aws.Bool(true)}},
+			Routes:       []types.Route{{DestinationCidrBlock: aws.String("10.0.0.0/16"), GatewayId: aws.String("local")}},
+		}},
+	}, reachDB, nil
+}
+
+func newTestReachView(client *fakeReachClient) *ReachView {
+	v := NewReachView(context.Background(), reachWeb)
+	v.client = client
+	v.network = reachTestNetwork
+	v.SetSize(120, 30)
+	return v
+}
+
+// runReachCmd runs cmd and feeds its messages, skipping spinner ticks.
+func runReachCmd(v *ReachView, cmd tea.Cmd) {
+	if cmd == nil {
+		return
+	}
+	msg := cmd()
+	if batch, ok := msg.(tea.BatchMsg); ok {
+		for _, c := range batch {
+			runReachCmd(v, c)
+		}
+		return
+	}
+	switch msg.(type) {
+	case reachEvaluatedMsg, reachAnalysisMsg:
+		v.Update(msg)
+	}
+}
+
+func TestReachView_Evaluate(t *testing.T) {
+	v := newTestReachView(&fakeReachClient{})
+	if !v.HasActiveInput() {
+		t.Fatal("the view should open with the destination focused")
+	}
+
+	v.inputs[reachFieldDestination].SetValue("i-db")
+	v.inputs[reachFieldPort].SetValue("5432")
+	_, cmd := v.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+	if v.editing || !v.evaluating {
+		t.Fatal("enter should evaluate the path")
+	}
+	runReachCmd(v, cmd)
+
+	out := v.ViewString()
+	for _, want := range []string{"✓ Reachable", "i-web (web) → i-db on tcp/5432", "sg-web", "Network ACLs"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("result missing %q:\n%s", want, out)
+		}
+	}
+
+	v.Update(tea.KeyPressMsg{Code: 'e', Text: "e"})
+	v.inputs[reachFieldPort].SetValue("22")
+	_, cmd = v.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+	runReachCmd(v, cmd)
+	if out := v.ViewString(); !strings.Contains(out, "✗ Not reachable") {
+		t.Errorf("port 22 should be denied by sg-db:\n%s", out)
+	}
+}
+
+func TestReachView_InvalidInput(t *testing.T) {
+	v := newTestReachView(&fakeReachClient{})
+	v.inputs[reachFieldDestination].SetValue("i-db")
+	v.inputs[reachFieldPort].SetValue("70000")
+	if _, cmd := v.Update(tea.KeyPressMsg{Code: tea.KeyEnter}); cmd != nil || v.err == nil {
+		t.Errorf("an out of range port should be rejected, err = %v", v.err)
+	}
+	v.inputs[reachFieldPort].SetValue("443")
+	v.inputs[reachFieldProtocol].SetValue("icmp")
+	if v.Update(tea.KeyPressMsg{Code: tea.KeyEnter}); v.err == nil {
+		t.Error("icmp should be rejected")
+	}
+}
+
+func TestReachView_Analyzer(t *testing.T) {
+	client := &fakeReachClient{}
+	v := newTestReachView(client)
+	v.inputs[reachFieldDestination].SetValue("i-db")
+	_, cmd := v.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+	runReachCmd(v, cmd)
+
+	v.Update(tea.KeyPressMsg{Code: 'A', Text: "A"})
+	if !v.confirming || !v.HasActiveInput() {
+		t.Fatal("A should ask for confirmation")
+	}
+	v.Update(tea.KeyPressMsg{Code: 'n', Text: "n"})
+	if v.confirming || client.paths != 0 {
+		t.Fatal("n should cancel without creating a path")
+	}
+
+	v.Update(tea.KeyPressMsg{Code: 'A', Text: "A"})
+	_, cmd = v.Update(tea.KeyPressMsg{Code: 'y', Text: "y"})
+	runReachCmd(v, cmd)
+	if client.paths != 1 || v.analyzing {
+		t.Fatalf("paths = %d, analyzing = %v", client.paths, v.analyzing)
+	}
+	if out := v.ViewString(); !strings.Contains(out, "No path found") || !strings.Contains(out, "ENI_SG_RULES_MISMATCH") {
+		t.Errorf("analysis result missing:\n%s", out)
+	}
+}
+
+func TestReachView_AnalyzerReadOnly(t *testing.T) {
+	config.Global().SetReadOnly(true)
+	defer config.Global().SetReadOnly(false)
+
+	client := &fakeReachClient{}
+	v := newTestReachView(client)
+	v.inputs[reachFieldDestination].SetValue("i-db")
+	_, cmd := v.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+	runReachCmd(v, cmd)
+
+	v.Update(tea.KeyPressMsg{Code: 'A', Text: "A"})
+	if v.confirming || !errors.Is(v.analysisErr, action.ErrReadOnlyDenied) {
+		t.Errorf("analyzer should be denied in read-only mode, err = %v", v.analysisErr)
+	}
+}
+
+type mockReachResource struct {
+	mockResource
+}
+
+func (m *mockReachResource) ReachEndpoint() reach.Endpoint {
+	e := reachWeb
+	e.ID = m.id
+	return e
+}
+
+func TestOpenViewTarget_Reach(t *testing.T) {
+	v, err := openViewTarget(context.Background(), action.TargetReach, &mockReachResource{mockResource{id: "i-web"}})
+	if err != nil {
+		t.Fatalf("openViewTarget() error = %v", err)
+	}
+	if rv, ok := v.(*ReachView); !ok || rv.from.ID != "i-web" {
+		t.Fatalf("openViewTarget() = %T, want *ReachView from i-web", v)
+	}
+	if _, err := openViewTarget(context.Background(), action.TargetReach, &mockResource{id: "x"}); err == nil {
+		t.Error("expected error for a resource that cannot be an endpoint")
+	}
+}
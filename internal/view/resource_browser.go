@@ -16,6 +16,7 @@ import (
 	"github.com/clawscli/claws/internal/aws"
 	"github.com/clawscli/claws/internal/config"
 	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/logs"
 	"github.com/clawscli/claws/internal/metrics"
 	"github.com/clawscli/claws/internal/registry"
 	"github.com/clawscli/claws/internal/render"
@@ -491,3 +492,28 @@ func (r *ResourceBrowser) GetMarkedResourceName() string {
 	}
 	return r.markedResource.GetName()
 }
+
+// SelectedLogGroups implements LogGroupProvider: the marked and current log
+// groups, or the log group of a resource with logs (e.g. a Lambda function).
+func (r *ResourceBrowser) SelectedLogGroups() []string {
+	if len(r.filtered) == 0 || r.table.Cursor() >= len(r.filtered) {
+		return nil
+	}
+
+	var groups []string
+	candidates := []dao.Resource{r.filtered[r.table.Cursor()]}
+	if r.markedResource != nil {
+		candidates = append([]dao.Resource{r.markedResource}, candidates...)
+	}
+	for _, res := range candidates {
+		provider, ok := dao.UnwrapResource(res).(logs.Provider)
+		if !ok {
+			continue
+		}
+		// Targets that need resolving (e.g. ECS tasks) are skipped
+		if group := provider.LogTarget().Group; group != "" && !slices.Contains(groups, group) {
+			groups = append(groups, group)
+		}
+	}
+	return groups
+}
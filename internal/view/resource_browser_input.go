@@ -6,6 +6,7 @@ import (
 
 	"github.com/clawscli/claws/internal/action"
 	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/usage"
 )
 
 func (r *ResourceBrowser) handleKeyPress(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
@@ -38,6 +39,8 @@ func (r *ResourceBrowser) handleKeyPress(msg tea.KeyPressMsg) (tea.Model, tea.Cm
 		return r.handleEnter()
 	case "a":
 		return r.handleAction()
+	case "U":
+		return r.handleUsage()
 	case "tab":
 		r.cycleResourceType(1)
 		return r, tea.Batch(r.loadResources, r.spinner.Tick)
@@ -132,6 +135,17 @@ func (r *ResourceBrowser) handleMetricsToggle() (tea.Model, tea.Cmd) {
 	return r, nil
 }
 
+func (r *ResourceBrowser) handleUsage() (tea.Model, tea.Cmd) {
+	if len(r.filtered) == 0 || r.table.Cursor() >= len(r.filtered) || !usage.Global.Supports(r.service, r.resourceType) {
+		return r, nil
+	}
+	ctx, resource := r.contextForResource(r.filtered[r.table.Cursor()])
+	usageView := NewUsageView(ctx, r.registry, r.service, r.resourceType, resource)
+	return r, func() tea.Msg {
+		return NavigateMsg{View: usageView}
+	}
+}
+
 func (r *ResourceBrowser) handleEnter() (tea.Model, tea.Cmd) {
 	if len(r.filtered) > 0 && r.table.Cursor() < len(r.filtered) {
 		ctx, resource := r.contextForResource(r.filtered[r.table.Cursor()])
@@ -154,6 +168,9 @@ func (r *ResourceBrowser) handleAction() (tea.Model, tea.Cmd) {
 		if actions := action.Global.Get(r.service, r.resourceType); len(actions) > 0 {
 			ctx, resource := r.contextForResource(r.filtered[r.table.Cursor()])
 			actionMenu := NewActionMenu(ctx, resource, r.service, r.resourceType)
+			if r.markedResource != nil && r.markedResource.GetID() != resource.GetID() {
+				actionMenu.SetMarked(dao.UnwrapResource(r.markedResource))
+			}
 			return r, func() tea.Msg {
 				return ShowModalMsg{Modal: &Modal{Content: actionMenu}}
 			}
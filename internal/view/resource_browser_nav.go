@@ -8,6 +8,7 @@ import (
 
 	"github.com/clawscli/claws/internal/action"
 	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/usage"
 )
 
 // handleNavigation processes navigation key shortcuts
@@ -57,6 +58,7 @@ func (r *ResourceBrowser) StatusLine() string {
 	total := len(r.resources)
 	shown := len(r.filtered)
 	hasActions := len(action.Global.Get(r.service, r.resourceType)) > 0
+	hasUsage := usage.Global.Supports(r.service, r.resourceType)
 
 	// Build auto-reload info
 	autoReloadInfo := ""
@@ -113,6 +115,9 @@ func (r *ResourceBrowser) StatusLine() string {
 		if hasActions {
 			base += " a:actions"
 		}
+		if hasUsage {
+			base += " U:used-by"
+		}
 		base += " m:mark" + metricsHint
 		if navInfo != "" {
 			base += " " + navInfo
@@ -124,6 +129,9 @@ func (r *ResourceBrowser) StatusLine() string {
 	if hasActions {
 		base += " a:actions"
 	}
+	if hasUsage {
+		base += " U:used-by"
+	}
 	base += " m:mark" + metricsHint
 	if navInfo != "" {
 		base += " " + navInfo
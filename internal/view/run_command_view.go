@@ -0,0 +1,294 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"charm.land/bubbles/v2/textarea"
+	"charm.land/bubbles/v2/textinput"
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+
+	"github.com/clawscli/claws/internal/action"
+	"github.com/clawscli/claws/internal/config"
+	"github.com/clawscli/claws/internal/runcmd"
+	"github.com/clawscli/claws/internal/ui"
+)
+
+// Run command form fields, in tab order
+const (
+	runFieldCommand = iota
+	runFieldInstances
+	runFieldDocument
+	runFieldCount
+)
+
+// RunCommandView sends an SSM Run Command to one or more instances, then
+// opens the command's output. The instances start as the selected and
+// marked ones and can be edited. Blocked in read-only mode.
+type RunCommandView struct {
+	ctx    context.Context
+	client runcmd.Client
+
+	editing   bool
+	field     int
+	confirm   bool
+	sending   bool
+	instances textinput.Model
+	document  textinput.Model
+	editor    textarea.Model
+	ids       []string
+	err       error
+
+	width  int
+	height int
+}
+
+// NewRunCommandView creates a RunCommandView for the instances, with the
+// command editor focused and the document matching their platform.
+func NewRunCommandView(ctx context.Context, instances []runcmd.Instance) *RunCommandView {
+	ids := make([]string, len(instances))
+	for i, inst := range instances {
+		ids[i] = inst.ID
+	}
+	in := textinput.New()
+	in.Prompt = ""
+	in.Placeholder = "i-0123456789abcdef0, ..."
+	in.SetValue(strings.Join(ids, ", "))
+
+	doc := textinput.New()
+	doc.Prompt = ""
+	doc.CharLimit = 128
+	doc.SetValue(runcmd.DefaultDocument(instances))
+
+	editor := textarea.New()
+	editor.ShowLineNumbers = false
+	editor.CharLimit = 0
+	editor.Placeholder = "one command per line"
+
+	v := &RunCommandView{
+		ctx:       ctx,
+		editing:   true,
+		instances: in,
+		document:  doc,
+		editor:    editor,
+	}
+	v.editor.Focus()
+	return v
+}
+
+type commandSentMsg struct {
+	target runcmd.Target
+	err    error
+}
+
+// Init implements tea.Model
+func (v *RunCommandView) Init() tea.Cmd {
+	return textarea.Blink
+}
+
+// Update implements tea.Model
+func (v *RunCommandView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case commandSentMsg:
+		v.sending = false
+		v.record(msg.target, msg.err)
+		if msg.err != nil {
+			v.err = msg.err
+			return v, nil
+		}
+		ov := NewCommandOutputView(v.ctx, msg.target)
+		ov.client = v.client
+		return v, func() tea.Msg { return NavigateMsg{View: ov} }
+
+	case tea.KeyPressMsg:
+		if v.confirm {
+			switch msg.String() {
+			case "y", "Y":
+				v.confirm = false
+				return v, v.send()
+			case "n", "N", "esc", "q":
+				v.confirm = false
+			}
+			return v, nil
+		}
+		if !v.editing {
+			switch msg.String() {
+			case "e", "enter":
+				v.editing = true
+				return v, v.focus()
+			case "s":
+				v.askSend()
+			}
+			return v, nil
+		}
+		return v.handleEditKey(msg)
+	}
+	return v, nil
+}
+
+func (v *RunCommandView) handleEditKey(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "esc":
+		v.editing = false
+		v.instances.Blur()
+		v.document.Blur()
+		v.editor.Blur()
+		return v, nil
+	case "tab":
+		v.field = (v.field + 1) % runFieldCount
+		return v, v.focus()
+	case "shift+tab":
+		v.field = (v.field + runFieldCount - 1) % runFieldCount
+		return v, v.focus()
+	case "ctrl+s":
+		v.askSend()
+		return v, nil
+	}
+	var cmd tea.Cmd
+	switch v.field {
+	case runFieldInstances:
+		v.instances, cmd = v.instances.Update(msg)
+	case runFieldDocument:
+		v.document, cmd = v.document.Update(msg)
+	default:
+		v.editor, cmd = v.editor.Update(msg)
+	}
+	return v, cmd
+}
+
+func (v *RunCommandView) focus() tea.Cmd {
+	v.instances.Blur()
+	v.document.Blur()
+	v.editor.Blur()
+	switch v.field {
+	case runFieldInstances:
+		return v.instances.Focus()
+	case runFieldDocument:
+		return v.document.Focus()
+	}
+	return v.editor.Focus()
+}
+
+// askSend checks the form and asks for confirmation.
+func (v *RunCommandView) askSend() {
+	if config.Global().ReadOnly() {
+		v.err = action.ErrReadOnlyDenied
+		return
+	}
+	ids, err := runcmd.ParseInstanceIDs(v.instances.Value())
+	if err != nil {
+		v.err = err
+		return
+	}
+	if strings.TrimSpace(v.editor.Value()) == "" {
+		v.err = fmt.Errorf("command is empty")
+		return
+	}
+	v.err = nil
+	v.ids = ids
+	v.confirm = true
+}
+
+func (v *RunCommandView) send() tea.Cmd {
+	if config.Global().ReadOnly() {
+		v.err = action.ErrReadOnlyDenied
+		return nil
+	}
+	if v.client == nil {
+		c, err := runcmd.NewClient(v.ctx)
+		if err != nil {
+			v.err = err
+			return nil
+		}
+		v.client = c
+	}
+	v.sending = true
+	ctx, client, ids := v.ctx, v.client, v.ids
+	document, commands := v.document.Value(), v.editor.Value()
+	return func() tea.Msg {
+		target, err := runcmd.Send(ctx, client, document, commands, ids)
+		return commandSentMsg{target: target, err: err}
+	}
+}
+
+// record logs the send like an API action run from the action menu.
+func (v *RunCommandView) record(t runcmd.Target, err error) {
+	act := action.Action{Name: "Run Command", Type: action.ActionTypeAPI, Operation: "SendCommand"}
+	result := action.ActionResult{Success: err == nil, Error: err}
+	if err == nil {
+		result.Message = "Sent command " + t.CommandID
+	}
+	action.Record(act, "ec2", "instances", strings.Join(v.ids, ","), result)
+}
+
+// ViewString returns the view content as a string
+func (v *RunCommandView) ViewString() string {
+	theme := ui.Current()
+	header := lipgloss.NewStyle().
+		Foreground(theme.TableHeaderText).
+		Background(theme.TableHeader).
+		Padding(0, 1).
+		Width(v.width).
+		Render("Run Command")
+
+	label := func(name string, field int) string {
+		style := lipgloss.NewStyle().Foreground(theme.TextDim).Width(11)
+		if v.editing && v.field == field {
+			style = style.Foreground(theme.Accent).Bold(true)
+		}
+		return style.Render(name)
+	}
+	fields := label("Instances", runFieldInstances) + v.instances.View() + "\n" +
+		label("Document", runFieldDocument) + v.document.View()
+	out := header + "\n" + lipgloss.NewStyle().Padding(0, 1).Render(fields) + "\n"
+
+	switch {
+	case v.confirm:
+		noun := "instance"
+		if len(v.ids) != 1 {
+			noun = "instances"
+		}
+		out += ui.WarningStyle().Render(fmt.Sprintf("Run %s on %d %s?", v.document.Value(), len(v.ids), noun)) + " " + ui.DimStyle().Render("[y/n]") + "\n"
+	case v.sending:
+		out += ui.DimStyle().Render("Sending command...") + "\n"
+	case v.err != nil:
+		out += ui.DangerStyle().Render(fmt.Sprintf("Error: %v", v.err)) + "\n"
+	default:
+		out += label("Command", runFieldCommand) + "\n"
+	}
+	return out + v.editor.View()
+}
+
+// View implements tea.Model
+func (v *RunCommandView) View() tea.View {
+	return tea.NewView(v.ViewString())
+}
+
+// SetSize implements View
+func (v *RunCommandView) SetSize(width, height int) tea.Cmd {
+	v.width = width
+	v.height = height
+	v.instances.SetWidth(max(width-15, 20))
+	v.document.SetWidth(max(width-15, 20))
+	v.editor.SetWidth(max(width-2, 20))
+	v.editor.SetHeight(max(height-5, 3))
+	return nil
+}
+
+// StatusLine implements View
+func (v *RunCommandView) StatusLine() string {
+	switch {
+	case v.confirm:
+		return "y:confirm • n:cancel"
+	case v.editing:
+		return "ctrl+s:run • tab:next field • esc:done editing"
+	}
+	return "e:edit • s:run • esc:back"
+}
+
+// HasActiveInput implements InputCapture
+func (v *RunCommandView) HasActiveInput() bool {
+	return v.editing || v.confirm
+}
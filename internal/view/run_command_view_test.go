@@ -0,0 +1,152 @@
+package view
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	"github.com/aws/aws-sdk-go-v2/service/ssm/types"
+
+	"github.com/clawscli/claws/internal/action"
+	"github.com/clawscli/claws/internal/config"
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/runcmd"
+)
+
+type fakeRunCmdClient struct {
+	sent   []*ssm.SendCommandInput
+	status types.CommandInvocationStatus
+}
+
+func (f *fakeRunCmdClient) SendCommand(_ context.Context, in *ssm.SendCommandInput, _ ...func(*ssm.Options)) (*ssm.SendCommandOutput, error) {
+	f.sent = append(f.sent, in)
+	return &ssm.SendCommandOutput{Command: &types.Command{CommandId: aws.String("cmd-1")}}, nil
+}
+
+func (f *fakeRunCmdClient) ListCommandInvocations(_ context.Context, _ *ssm.ListCommandInvocationsInput, _ ...func(*ssm.Options)) (*ssm.ListCommandInvocationsOutput, error) {
+	return &ssm.ListCommandInvocationsOutput{CommandInvocations: []types.CommandInvocation{
+		{InstanceId: aws.String("i-1"), InstanceName: aws.String("web-1")},
+		{InstanceId: aws.String("i-2"), InstanceName: aws.String("web-2")},
+	}}, nil
+}
+
+func (f *fakeRunCmdClient) GetCommandInvocation(_ context.Context, in *ssm.GetCommandInvocationInput, _ ...func(*ssm.Options)) (*ssm.GetCommandInvocationOutput, error) {
+	out := &ssm.GetCommandInvocationOutput{Status: f.status, StandardOutputContent: aws.String("hello from " + *in.InstanceId)}
+	if *in.InstanceId == "i-2" && f.status == types.CommandInvocationStatusSuccess {
+		out.Status = types.CommandInvocationStatusFailed
+		out.ResponseCode = 1
+		out.StandardErrorContent = aws.String("permission denied")
+	}
+	return out, nil
+}
+
+type mockRunResource struct {
+	mockResource
+}
+
+func (m *mockRunResource) RunInstance() runcmd.Instance {
+	return runcmd.Instance{ID: m.id, Name: "web"}
+}
+
+// typeCommand types s into the focused field.
+func typeCommand(v *RunCommandView, s string) {
+	for _, r := range s {
+		v.Update(tea.KeyPressMsg{Code: r, Text: string(r)})
+	}
+}
+
+func TestRunCommandView_Send(t *testing.T) {
+	client := &fakeRunCmdClient{status: types.CommandInvocationStatusInProgress}
+	v := NewRunCommandView(context.Background(), []runcmd.Instance{{ID: "i-1"}, {ID: "i-2"}})
+	v.client = client
+	v.SetSize(100, 20)
+
+	if v.instances.Value() != "i-1, i-2" || v.document.Value() != runcmd.DocumentShell {
+		t.Errorf("instances = %q, document = %q", v.instances.Value(), v.document.Value())
+	}
+
+	v.Update(tea.KeyPressMsg{Code: 's', Mod: tea.ModCtrl})
+	if v.confirm || v.err == nil {
+		t.Error("an empty command should be rejected")
+	}
+
+	typeCommand(v, "uptime")
+	v.Update(tea.KeyPressMsg{Code: 's', Mod: tea.ModCtrl})
+	if !v.confirm {
+		t.Fatalf("ctrl+s should ask for confirmation, err = %v", v.err)
+	}
+	if !strings.Contains(v.ViewString(), "on 2 instances?") {
+		t.Errorf("confirmation should show the instance count:\n%s", v.ViewString())
+	}
+
+	_, cmd := v.Update(tea.KeyPressMsg{Code: 'y', Text: "y"})
+	if cmd == nil {
+		t.Fatal("confirming should send the command")
+	}
+	_, cmd = v.Update(cmd())
+	if len(client.sent) != 1 || len(client.sent[0].InstanceIds) != 2 || client.sent[0].Parameters["commands"][0] != "uptime" {
+		t.Fatalf("sent = %+v", client.sent)
+	}
+	if cmd == nil {
+		t.Fatal("sending should open the output view")
+	}
+	nav, ok := cmd().(NavigateMsg)
+	if !ok {
+		t.Fatal("expected NavigateMsg")
+	}
+	ov, ok := nav.View.(*CommandOutputView)
+	if !ok || ov.target.CommandID != "cmd-1" || ov.client != client {
+		t.Errorf("navigated to %T", nav.View)
+	}
+}
+
+func TestRunCommandView_EditInstances(t *testing.T) {
+	v := NewRunCommandView(context.Background(), []runcmd.Instance{{ID: "i-1"}})
+	typeCommand(v, "uptime")
+	v.Update(tea.KeyPressMsg{Code: tea.KeyTab})
+	if v.field != runFieldInstances {
+		t.Fatalf("tab should move to the instances, field = %d", v.field)
+	}
+	typeCommand(v, " web-1")
+	v.Update(tea.KeyPressMsg{Code: 's', Mod: tea.ModCtrl})
+	if v.confirm || v.err == nil {
+		t.Error("a name instead of an instance ID should be rejected")
+	}
+}
+
+func TestRunCommandView_ReadOnly(t *testing.T) {
+	config.Global().SetReadOnly(true)
+	defer config.Global().SetReadOnly(false)
+
+	v := NewRunCommandView(context.Background(), []runcmd.Instance{{ID: "i-1"}})
+	typeCommand(v, "uptime")
+	v.Update(tea.KeyPressMsg{Code: 's', Mod: tea.ModCtrl})
+	if v.confirm || !errors.Is(v.err, action.ErrReadOnlyDenied) {
+		t.Errorf("send should be blocked in read-only mode: confirm = %v, err = %v", v.confirm, v.err)
+	}
+	if _, err := openViewTarget(context.Background(), action.TargetRunCommand, &mockRunResource{mockResource{id: "i-1"}}); !errors.Is(err, action.ErrReadOnlyDenied) {
+		t.Errorf("openViewTarget() error = %v, want ErrReadOnlyDenied", err)
+	}
+}
+
+func TestOpenViewTarget_RunCommand(t *testing.T) {
+	ctx := dao.WithMarked(context.Background(), &mockRunResource{mockResource{id: "i-2"}})
+	v, err := openViewTarget(ctx, action.TargetRunCommand, &mockRunResource{mockResource{id: "i-1"}})
+	if err != nil {
+		t.Fatalf("openViewTarget() error = %v", err)
+	}
+	rv, ok := v.(*RunCommandView)
+	if !ok {
+		t.Fatalf("openViewTarget() = %T, want *RunCommandView", v)
+	}
+	if rv.instances.Value() != "i-1, i-2" {
+		t.Errorf("instances = %q, want the selected and marked instances", rv.instances.Value())
+	}
+	if _, err := openViewTarget(context.Background(), action.TargetRunCommand, &mockResource{id: "x"}); err == nil {
+		t.Error("expected error for a resource that cannot run commands")
+	}
+}
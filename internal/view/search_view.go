@@ -0,0 +1,450 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"charm.land/bubbles/v2/spinner"
+	"charm.land/bubbles/v2/table"
+	"charm.land/bubbles/v2/textinput"
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+
+	"github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/config"
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/registry"
+	"github.com/clawscli/claws/internal/search"
+	"github.com/clawscli/claws/internal/ui"
+)
+
+// SearchView streams omnisearch hits across resource types into a table.
+type SearchView struct {
+	ctx      context.Context
+	registry *registry.Registry
+	query    string
+	scope    []string
+
+	cancel  context.CancelFunc
+	results chan search.Batch
+	runID   int
+
+	table    table.Model
+	hits     []search.Hit
+	filtered []search.Hit
+	errors   []string
+	total    int
+	done     int
+	running  bool
+	err      error
+	width    int
+	height   int
+	spinner  spinner.Model
+
+	filterActive bool
+	filterText   string
+	filterInput  textinput.Model
+}
+
+// NewSearchView creates a SearchView for query. scope limits the search to
+// the given services or service/resource types; empty means everything.
+func NewSearchView(ctx context.Context, reg *registry.Registry, query string, scope []string) *SearchView {
+	ti := textinput.New()
+	ti.Placeholder = FilterPlaceholder
+	ti.Prompt = "/"
+	ti.CharLimit = 100
+
+	return &SearchView{
+		ctx:         ctx,
+		registry:    reg,
+		query:       query,
+		scope:       scope,
+		filterInput: ti,
+		spinner:     ui.NewSpinner(),
+	}
+}
+
+// ParseSearchArgs splits ":search" arguments into a query and optional scope.
+// Syntax: "<query> [in:svc[/resource],...]".
+func ParseSearchArgs(args string) (string, []string) {
+	var queryParts, scope []string
+	for _, field := range strings.Fields(args) {
+		if strings.HasPrefix(field, "in:") {
+			for _, s := range strings.Split(strings.TrimPrefix(field, "in:"), ",") {
+				if s = strings.TrimSpace(s); s != "" {
+					scope = append(scope, s)
+				}
+			}
+			continue
+		}
+		queryParts = append(queryParts, field)
+	}
+	return strings.Join(queryParts, " "), scope
+}
+
+type searchBatchMsg struct {
+	runID int
+	batch search.Batch
+}
+
+type searchDoneMsg struct {
+	runID int
+}
+
+func (v *SearchView) Init() tea.Cmd {
+	return v.start()
+}
+
+func (v *SearchView) start() tea.Cmd {
+	if v.cancel != nil {
+		v.cancel()
+	}
+	v.runID++
+	v.hits = nil
+	v.errors = nil
+	v.err = nil
+	v.done = 0
+	v.total = 0
+	v.applyFilter()
+	v.buildTable()
+
+	if strings.TrimSpace(v.query) == "" {
+		v.err = fmt.Errorf("usage: :search <query> [in:service[/resource],...]")
+		return nil
+	}
+
+	pairs, err := search.Scope(v.registry, v.scope)
+	if err != nil {
+		v.err = err
+		return nil
+	}
+	targets := search.Targets(pairs, config.Global().Selections(), config.Global().Regions())
+	v.total = len(targets)
+	v.running = true
+
+	ctx, cancel := context.WithCancel(v.ctx)
+	v.cancel = cancel
+	// Buffered so workers never block if the view is discarded mid-search.
+	v.results = make(chan search.Batch, len(targets))
+	go search.Run(ctx, v.registry, v.query, targets, search.DefaultCache, search.DefaultConcurrency, v.results)
+
+	return tea.Batch(v.waitForBatch(), v.spinner.Tick)
+}
+
+func (v *SearchView) waitForBatch() tea.Cmd {
+	ch, runID := v.results, v.runID
+	return func() tea.Msg {
+		batch, ok := <-ch
+		if !ok {
+			return searchDoneMsg{runID: runID}
+		}
+		return searchBatchMsg{runID: runID, batch: batch}
+	}
+}
+
+func (v *SearchView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case searchBatchMsg:
+		if msg.runID != v.runID {
+			return v, nil
+		}
+		v.done++
+		if msg.batch.Err != nil {
+			v.errors = append(v.errors, fmt.Sprintf("%s: %v", msg.batch.Target, msg.batch.Err))
+		}
+		if len(msg.batch.Hits) > 0 {
+			cursor := v.table.Cursor()
+			v.hits = append(v.hits, msg.batch.Hits...)
+			search.SortHits(v.hits)
+			v.applyFilter()
+			v.buildTable()
+			v.table.SetCursor(cursor)
+		}
+		return v, v.waitForBatch()
+
+	case searchDoneMsg:
+		if msg.runID == v.runID {
+			v.running = false
+		}
+		return v, nil
+
+	case spinner.TickMsg:
+		if v.running {
+			var cmd tea.Cmd
+			v.spinner, cmd = v.spinner.Update(msg)
+			return v, cmd
+		}
+		return v, nil
+
+	case tea.MouseWheelMsg:
+		var cmd tea.Cmd
+		v.table, cmd = v.table.Update(msg)
+		return v, cmd
+
+	case tea.KeyPressMsg:
+		if v.filterActive {
+			switch msg.String() {
+			case "esc":
+				v.filterActive = false
+				v.filterInput.Blur()
+				return v, nil
+			case "enter":
+				v.filterActive = false
+				v.filterInput.Blur()
+				v.filterText = v.filterInput.Value()
+				v.applyFilter()
+				v.buildTable()
+				return v, nil
+			default:
+				var cmd tea.Cmd
+				v.filterInput, cmd = v.filterInput.Update(msg)
+				v.filterText = v.filterInput.Value()
+				v.applyFilter()
+				v.buildTable()
+				return v, cmd
+			}
+		}
+
+		switch msg.String() {
+		case "/":
+			v.filterActive = true
+			v.filterInput.Focus()
+			return v, textinput.Blink
+
+		case "c":
+			v.filterText = ""
+			v.filterInput.SetValue("")
+			v.applyFilter()
+			v.buildTable()
+			return v, nil
+
+		case "ctrl+r":
+			search.DefaultCache.Clear()
+			return v, v.start()
+
+		case "enter", "d":
+			return v.openHit()
+
+		case "j", "down":
+			v.table.MoveDown(1)
+			return v, nil
+
+		case "k", "up":
+			v.table.MoveUp(1)
+			return v, nil
+		}
+	}
+
+	var cmd tea.Cmd
+	v.table, cmd = v.table.Update(msg)
+	return v, cmd
+}
+
+func (v *SearchView) openHit() (tea.Model, tea.Cmd) {
+	if len(v.filtered) == 0 || v.table.Cursor() >= len(v.filtered) {
+		return v, nil
+	}
+
+	hit := v.filtered[v.table.Cursor()]
+	ctx := v.ctx
+	if profile := dao.GetResourceProfile(hit.Resource); profile != "" {
+		ctx = aws.WithSelectionOverride(ctx, config.ProfileSelectionFromID(profile))
+	}
+	if region := dao.GetResourceRegion(hit.Resource); region != "" {
+		ctx = aws.WithRegionOverride(ctx, region)
+	}
+
+	renderer, err := v.registry.GetRenderer(hit.Service, hit.ResourceType)
+	if err != nil {
+		return v, nil
+	}
+	daoInst, err := v.registry.GetDAO(ctx, hit.Service, hit.ResourceType)
+	if err != nil {
+		daoInst = nil
+	}
+
+	detailView := NewDetailView(ctx, dao.UnwrapResource(hit.Resource), renderer, hit.Service, hit.ResourceType, v.registry, daoInst)
+	return v, func() tea.Msg {
+		return NavigateMsg{View: detailView}
+	}
+}
+
+func (v *SearchView) applyFilter() {
+	if v.filterText == "" {
+		v.filtered = v.hits
+		return
+	}
+
+	filter := strings.ToLower(v.filterText)
+	v.filtered = nil
+	for _, hit := range v.hits {
+		if fuzzyMatch(hit.Service, filter) ||
+			fuzzyMatch(hit.ResourceType, filter) ||
+			fuzzyMatch(hit.Resource.GetID(), filter) ||
+			fuzzyMatch(hit.Resource.GetName(), filter) ||
+			fuzzyMatch(hit.Value, filter) {
+			v.filtered = append(v.filtered, hit)
+		}
+	}
+}
+
+func (v *SearchView) buildTable() {
+	isMultiRegion := config.Global().IsMultiRegion()
+	isMultiProfile := config.Global().IsMultiProfile()
+
+	columns := []table.Column{
+		{Title: "Service", Width: 14},
+		{Title: "Type", Width: 18},
+		{Title: "ID", Width: 30},
+		{Title: "Name", Width: 26},
+		{Title: "Match", Width: 36},
+	}
+	if isMultiProfile {
+		columns = append(columns, table.Column{Title: "Profile", Width: 16})
+	}
+	if isMultiRegion || isMultiProfile {
+		columns = append(columns, table.Column{Title: "Region", Width: 14})
+	}
+
+	rows := make([]table.Row, len(v.filtered))
+	for i, hit := range v.filtered {
+		match := hit.Field
+		if hit.Field != "id" && hit.Field != "name" {
+			match = fmt.Sprintf("%s: %s", hit.Field, hit.Value)
+		}
+		if hit.Exact {
+			match = "= " + match
+		}
+		row := table.Row{
+			hit.Service,
+			hit.ResourceType,
+			hit.Resource.GetID(),
+			hit.Resource.GetName(),
+			match,
+		}
+		if isMultiProfile {
+			row = append(row, dao.GetResourceProfile(hit.Resource))
+		}
+		if isMultiRegion || isMultiProfile {
+			row = append(row, dao.GetResourceRegion(hit.Resource))
+		}
+		rows[i] = row
+	}
+
+	tableHeight := v.height - 4
+	if tableHeight < 10 {
+		tableHeight = 20
+	}
+	tableWidth := v.width
+	if tableWidth < 80 {
+		tableWidth = 120
+	}
+
+	tbl := table.New(
+		table.WithColumns(columns),
+		table.WithRows(rows),
+		table.WithFocused(true),
+		table.WithHeight(tableHeight),
+		table.WithWidth(tableWidth),
+	)
+
+	s := table.DefaultStyles()
+	theme := ui.Current()
+	s.Header = s.Header.
+		BorderStyle(lipgloss.NormalBorder()).
+		BorderForeground(theme.TableBorder).
+		BorderBottom(true).
+		Bold(false)
+	s.Selected = s.Selected.
+		Foreground(theme.SelectionText).
+		Background(theme.Selection).
+		Bold(false)
+
+	tbl.SetStyles(s)
+	v.table = tbl
+}
+
+func (v *SearchView) ViewString() string {
+	theme := ui.Current()
+
+	title := fmt.Sprintf("Search: %s", v.query)
+	if len(v.scope) > 0 {
+		title += fmt.Sprintf(" (in %s)", strings.Join(v.scope, ","))
+	}
+	header := lipgloss.NewStyle().
+		Foreground(theme.TableHeaderText).
+		Background(theme.TableHeader).
+		Padding(0, 1).
+		Width(v.width).
+		Render(title)
+
+	if v.err != nil {
+		return header + "\n" + ui.DangerStyle().Render(fmt.Sprintf("Error: %v", v.err))
+	}
+
+	statusLine := fmt.Sprintf("Found %d resources", len(v.hits))
+	if v.filterText != "" {
+		statusLine = fmt.Sprintf("Found %d/%d resources", len(v.filtered), len(v.hits))
+	}
+	if v.running {
+		statusLine = fmt.Sprintf("%s %s (searched %d/%d)", v.spinner.View(), statusLine, v.done, v.total)
+	}
+	if len(v.errors) > 0 {
+		statusLine += fmt.Sprintf(" [%d lookups failed]", len(v.errors))
+	}
+	status := lipgloss.NewStyle().
+		Foreground(theme.TextDim).
+		Padding(0, 1).
+		Render(statusLine)
+
+	filterView := ""
+	if v.filterActive {
+		filterView = lipgloss.NewStyle().
+			Padding(0, 1).
+			Render(v.filterInput.View()) + "\n"
+	} else if v.filterText != "" {
+		filterView = lipgloss.NewStyle().
+			Foreground(theme.Accent).
+			Italic(true).
+			Render(fmt.Sprintf("filter: %s", v.filterText)) + "\n"
+	}
+
+	if len(v.hits) == 0 {
+		if v.running {
+			return header + "\n" + status
+		}
+		return header + "\n" + status + "\n" + ui.DimStyle().Render(fmt.Sprintf("No resources matching '%s' found", v.query))
+	}
+
+	if len(v.filtered) == 0 {
+		return header + "\n" + status + "\n" + filterView +
+			ui.DimStyle().Render("No matching resources (press 'c' to clear filter)")
+	}
+
+	return header + "\n" + status + "\n" + filterView + v.table.View()
+}
+
+func (v *SearchView) View() tea.View {
+	return tea.NewView(v.ViewString())
+}
+
+func (v *SearchView) SetSize(width, height int) tea.Cmd {
+	v.width = width
+	v.height = height
+	v.filterInput.SetWidth(width - 4)
+	v.buildTable()
+	return nil
+}
+
+func (v *SearchView) StatusLine() string {
+	base := fmt.Sprintf("Search: %s • %d results", v.query, len(v.filtered))
+	if v.filterText != "" {
+		base = fmt.Sprintf("Search: %s • %d/%d (/%s)", v.query, len(v.filtered), len(v.hits), v.filterText)
+	}
+	return base + " • enter:open /:filter ctrl+r:refresh"
+}
+
+func (v *SearchView) HasActiveInput() bool {
+	return v.filterActive
+}
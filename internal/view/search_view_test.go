@@ -0,0 +1,96 @@
+package view
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/registry"
+	"github.com/clawscli/claws/internal/search"
+)
+
+func TestParseSearchArgs(t *testing.T) {
+	tests := []struct {
+		args      string
+		wantQuery string
+		wantScope []string
+	}{
+		{"", "", nil},
+		{" 10.0.1.5", "10.0.1.5", nil},
+		{"web in:ec2,lambda", "web", []string{"ec2", "lambda"}},
+		{"in:ec2/instances my app", "my app", []string{"ec2/instances"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.args, func(t *testing.T) {
+			query, scope := ParseSearchArgs(tt.args)
+			if query != tt.wantQuery {
+				t.Errorf("query = %q, want %q", query, tt.wantQuery)
+			}
+			if strings.Join(scope, ",") != strings.Join(tt.wantScope, ",") {
+				t.Errorf("scope = %v, want %v", scope, tt.wantScope)
+			}
+		})
+	}
+}
+
+func TestSearchView_EmptyQuery(t *testing.T) {
+	v := NewSearchView(context.Background(), registry.New(), "", nil)
+	v.SetSize(120, 40)
+	if cmd := v.Init(); cmd != nil {
+		t.Error("empty query should not start a search")
+	}
+	if !strings.Contains(v.ViewString(), "usage: :search") {
+		t.Errorf("expected usage error, got %q", v.ViewString())
+	}
+}
+
+func TestSearchView_Batches(t *testing.T) {
+	v := NewSearchView(context.Background(), registry.New(), "web", nil)
+	v.SetSize(120, 40)
+	v.runID = 1
+	v.running = true
+	v.total = 3
+	v.results = make(chan search.Batch)
+
+	v.Update(searchBatchMsg{runID: 1, batch: search.Batch{
+		Target: search.Target{Service: "lambda", Resource: "functions"},
+		Hits: []search.Hit{
+			{Service: "lambda", ResourceType: "functions", Field: "name", Resource: &dao.BaseResource{ID: "web-fn", Name: "web-fn"}},
+		},
+	}})
+	v.Update(searchBatchMsg{runID: 1, batch: search.Batch{
+		Target: search.Target{Service: "ec2", Resource: "instances"},
+		Hits: []search.Hit{
+			{Service: "ec2", ResourceType: "instances", Field: "name", Exact: true, Resource: &dao.BaseResource{ID: "i-1", Name: "web"}},
+		},
+	}})
+	v.Update(searchBatchMsg{runID: 1, batch: search.Batch{
+		Target: search.Target{Service: "sqs", Resource: "queues"},
+		Err:    errors.New("denied"),
+	}})
+	// Stale batch from a previous run is ignored
+	v.Update(searchBatchMsg{runID: 0, batch: search.Batch{
+		Hits: []search.Hit{{Service: "old", Resource: &dao.BaseResource{ID: "old"}}},
+	}})
+
+	if v.done != 3 {
+		t.Errorf("done = %d, want 3", v.done)
+	}
+	if len(v.hits) != 2 || v.hits[0].Service != "ec2" {
+		t.Errorf("hits not sorted exact-first: %+v", v.hits)
+	}
+	if len(v.errors) != 1 {
+		t.Errorf("errors = %v, want 1", v.errors)
+	}
+
+	v.Update(searchDoneMsg{runID: 1})
+	if v.running {
+		t.Error("running should be false after done")
+	}
+	if !strings.Contains(v.ViewString(), "Found 2 resources") {
+		t.Errorf("unexpected view: %q", v.ViewString())
+	}
+}
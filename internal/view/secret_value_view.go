@@ -0,0 +1,459 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"charm.land/bubbles/v2/spinner"
+	"charm.land/bubbles/v2/viewport"
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+
+	"github.com/clawscli/claws/internal/log"
+	"github.com/clawscli/claws/internal/secretvalue"
+	"github.com/clawscli/claws/internal/ui"
+)
+
+// SecretValueView shows a secret's or parameter's value inside the TUI, so
+// it never reaches terminal scrollback. Values stay masked until revealed;
+// JSON objects are shown as key/value rows that can be copied one by one.
+// [ and ] step through versions, and d compares a version with the one
+// before it. Only the version list is read when the view opens; a version's
+// value is read when it is shown. Reveals and copies are recorded in the
+// debug log.
+type SecretValueView struct {
+	ctx    context.Context
+	client secretvalue.Client
+	target secretvalue.Target
+
+	versions []secretvalue.Version
+	fetching map[string]bool // version IDs whose value is being read
+	index    int             // shown version, 0 = newest
+	cursor   int
+	revealed bool
+	diff     bool
+	loading  bool
+	err      error
+	message  string
+
+	viewport viewport.Model
+	width    int
+	height   int
+	spinner  spinner.Model
+}
+
+// NewSecretValueView creates a SecretValueView for t, showing the newest
+// version masked.
+func NewSecretValueView(ctx context.Context, t secretvalue.Target) *SecretValueView {
+	return &SecretValueView{
+		ctx:      ctx,
+		target:   t,
+		fetching: make(map[string]bool),
+		loading:  true,
+		viewport: viewport.New(),
+		spinner:  ui.NewSpinner(),
+	}
+}
+
+type secretValueLoadedMsg struct {
+	versions []secretvalue.Version
+	err      error
+}
+
+type secretVersionLoadedMsg struct {
+	version secretvalue.Version
+	err     error
+}
+
+// Init implements tea.Model
+func (v *SecretValueView) Init() tea.Cmd {
+	return tea.Batch(v.spinner.Tick, v.load())
+}
+
+func (v *SecretValueView) load() tea.Cmd {
+	if v.client == nil {
+		c, err := secretvalue.NewClient(v.ctx)
+		if err != nil {
+			return func() tea.Msg { return secretValueLoadedMsg{err: err} }
+		}
+		v.client = c
+	}
+	ctx, client, target := v.ctx, v.client, v.target
+	return func() tea.Msg {
+		versions, err := secretvalue.Load(ctx, client, target)
+		return secretValueLoadedMsg{versions: versions, err: err}
+	}
+}
+
+// loadValues reads the values of the shown version and, when diffing, the
+// version before it.
+func (v *SecretValueView) loadValues() tea.Cmd {
+	var cmds []tea.Cmd
+	load := func(ver secretvalue.Version) {
+		if ver.Loaded || v.fetching[ver.ID] {
+			return
+		}
+		v.fetching[ver.ID] = true
+		ctx, client, target := v.ctx, v.client, v.target
+		cmds = append(cmds, func() tea.Msg {
+			loaded, err := secretvalue.LoadValue(ctx, client, target, ver)
+			return secretVersionLoadedMsg{version: loaded, err: err}
+		})
+	}
+	if ver, ok := v.current(); ok {
+		load(ver)
+	}
+	if prev, ok := v.previous(); ok && v.diff {
+		load(prev)
+	}
+	return tea.Batch(cmds...)
+}
+
+// Update implements tea.Model
+func (v *SecretValueView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case secretValueLoadedMsg:
+		v.loading = false
+		v.err = msg.err
+		if msg.err == nil {
+			v.versions = msg.versions
+			v.index = min(v.index, max(len(v.versions)-1, 0))
+			v.cursor = 0
+			v.refresh()
+			return v, v.loadValues()
+		}
+		return v, nil
+
+	case secretVersionLoadedMsg:
+		delete(v.fetching, msg.version.ID)
+		if msg.err != nil {
+			v.err = msg.err
+			return v, nil
+		}
+		for i := range v.versions {
+			if v.versions[i].ID == msg.version.ID {
+				v.versions[i] = msg.version
+			}
+		}
+		v.refresh()
+		return v, nil
+
+	case spinner.TickMsg:
+		if v.loading {
+			var cmd tea.Cmd
+			v.spinner, cmd = v.spinner.Update(msg)
+			return v, cmd
+		}
+		return v, nil
+
+	case tea.KeyPressMsg:
+		return v.handleKey(msg)
+	}
+	return v, nil
+}
+
+func (v *SecretValueView) handleKey(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "v":
+		v.toggleReveal()
+		return v, nil
+	case "d":
+		v.diff = !v.diff
+		v.cursor = 0
+		v.refresh()
+		return v, v.loadValues()
+	case "[":
+		return v, v.stepVersion(1)
+	case "]":
+		return v, v.stepVersion(-1)
+	case "j", "down":
+		v.moveCursor(1)
+		return v, nil
+	case "k", "up":
+		v.moveCursor(-1)
+		return v, nil
+	case "y":
+		return v, v.copyRow()
+	case "Y":
+		return v, v.copyValue()
+	case "ctrl+r":
+		if v.loading {
+			return v, nil
+		}
+		v.loading = true
+		v.message = ""
+		v.err = nil
+		v.fetching = make(map[string]bool)
+		return v, tea.Batch(v.spinner.Tick, v.load())
+	case "g":
+		v.viewport.GotoTop()
+		return v, nil
+	case "G":
+		v.viewport.GotoBottom()
+		return v, nil
+	}
+	var cmd tea.Cmd
+	v.viewport, cmd = v.viewport.Update(msg)
+	return v, cmd
+}
+
+func (v *SecretValueView) current() (secretvalue.Version, bool) {
+	if v.index < 0 || v.index >= len(v.versions) {
+		return secretvalue.Version{}, false
+	}
+	return v.versions[v.index], true
+}
+
+// previous returns the version before the shown one, to diff against.
+func (v *SecretValueView) previous() (secretvalue.Version, bool) {
+	if v.index+1 >= len(v.versions) {
+		return secretvalue.Version{}, false
+	}
+	return v.versions[v.index+1], true
+}
+
+func (v *SecretValueView) toggleReveal() {
+	ver, ok := v.current()
+	if !ok {
+		return
+	}
+	v.revealed = !v.revealed
+	if v.revealed {
+		args := []any{"kind", v.target.Kind.String(), "name", v.target.Name, "version", ver.ID}
+		if prev, ok := v.previous(); ok && v.diff {
+			args = append(args, "compared_version", prev.ID)
+		}
+		log.Info("secret value revealed", args...)
+	}
+	v.refresh()
+}
+
+// stepVersion shows an older (delta 1) or newer (delta -1) version, masked
+// again, and reads its value if it has not been read yet.
+func (v *SecretValueView) stepVersion(delta int) tea.Cmd {
+	i := v.index + delta
+	if i < 0 || i >= len(v.versions) {
+		return nil
+	}
+	v.index = i
+	v.cursor = 0
+	v.revealed = false
+	v.message = ""
+	v.refresh()
+	return v.loadValues()
+}
+
+func (v *SecretValueView) rows() []secretvalue.Row {
+	ver, ok := v.current()
+	if !ok || v.diff || !ver.Loaded {
+		return nil
+	}
+	return secretvalue.Rows(ver.Value)
+}
+
+func (v *SecretValueView) moveCursor(delta int) {
+	n := len(v.rows())
+	if n == 0 {
+		v.viewport.ScrollDown(delta)
+		return
+	}
+	v.cursor = max(0, min(v.cursor+delta, n-1))
+	v.refresh()
+	if v.cursor < v.viewport.YOffset() {
+		v.viewport.SetYOffset(v.cursor)
+	} else if v.cursor >= v.viewport.YOffset()+v.viewport.Height() {
+		v.viewport.SetYOffset(v.cursor - v.viewport.Height() + 1)
+	}
+}
+
+func (v *SecretValueView) copyRow() tea.Cmd {
+	rows := v.rows()
+	if v.cursor >= len(rows) {
+		return nil
+	}
+	row := rows[v.cursor]
+	v.logCopy(row.Key)
+	if row.Key == "" {
+		v.message = "Copied value"
+	} else {
+		v.message = "Copied " + row.Key
+	}
+	return tea.SetClipboard(row.Value)
+}
+
+func (v *SecretValueView) copyValue() tea.Cmd {
+	ver, ok := v.current()
+	if !ok || !ver.Loaded {
+		return nil
+	}
+	v.logCopy("")
+	v.message = "Copied value"
+	return tea.SetClipboard(ver.Value)
+}
+
+func (v *SecretValueView) logCopy(key string) {
+	ver, _ := v.current()
+	log.Info("secret value copied", "kind", v.target.Kind.String(), "name", v.target.Name, "version", ver.ID, "key", key)
+}
+
+// show returns s, or the mask while the value is hidden.
+func (v *SecretValueView) show(s string) string {
+	if !v.revealed {
+		return secretvalue.Mask
+	}
+	return s
+}
+
+func (v *SecretValueView) refresh() {
+	v.viewport.SetContent(v.content())
+}
+
+func (v *SecretValueView) content() string {
+	ver, ok := v.current()
+	if !ok {
+		return ui.DimStyle().Render("No versions")
+	}
+	if v.diff {
+		return v.diffContent(ver)
+	}
+	if !ver.Loaded {
+		return ui.DimStyle().Render("Loading value...")
+	}
+
+	rows := secretvalue.Rows(ver.Value)
+	if len(rows) == 1 && rows[0].Key == "" {
+		if ver.Binary && v.revealed {
+			return ui.DimStyle().Render("binary, base64-encoded") + "\n" + rows[0].Value
+		}
+		return v.show(rows[0].Value)
+	}
+
+	keyWidth := 0
+	for _, r := range rows {
+		keyWidth = max(keyWidth, lipgloss.Width(r.Key))
+	}
+	keyStyle := lipgloss.NewStyle().Foreground(ui.Current().Accent).Width(keyWidth + 2)
+	selected := lipgloss.NewStyle().Foreground(ui.Current().SelectionText).Background(ui.Current().Selection)
+	lines := make([]string, len(rows))
+	for i, r := range rows {
+		value := strings.ReplaceAll(v.show(r.Value), "\n", `\n`)
+		if i == v.cursor {
+			lines[i] = selected.Render(r.Key + strings.Repeat(" ", keyWidth+2-lipgloss.Width(r.Key)) + value)
+			continue
+		}
+		lines[i] = keyStyle.Render(r.Key) + value
+	}
+	return strings.Join(lines, "\n")
+}
+
+// diffContent renders one line per changed row: ~ changed, + added,
+// - removed. Values are masked unless revealed.
+func (v *SecretValueView) diffContent(ver secretvalue.Version) string {
+	prev, ok := v.previous()
+	if !ok {
+		return ui.DimStyle().Render("No earlier version to compare with")
+	}
+	if !prev.Loaded || !ver.Loaded {
+		return ui.DimStyle().Render("Loading values...")
+	}
+	changes := secretvalue.Diff(prev.Value, ver.Value)
+	if len(changes) == 0 {
+		return ui.DimStyle().Render("No differences")
+	}
+
+	key := lipgloss.NewStyle().Foreground(ui.Current().Accent)
+	var lines []string
+	for _, c := range changes {
+		name := c.Key
+		if name == "" {
+			name = "value"
+		}
+		switch c.Kind {
+		case secretvalue.Added:
+			lines = append(lines, ui.SuccessStyle().Render("+ ")+key.Render(name)+"  "+ui.SuccessStyle().Render(v.show(c.New)))
+		case secretvalue.Removed:
+			lines = append(lines, ui.DangerStyle().Render("- ")+key.Render(name)+"  "+ui.DangerStyle().Render(v.show(c.Old)))
+		default:
+			lines = append(lines, ui.WarningStyle().Render("~ ")+key.Render(name))
+			lines = append(lines, "    "+ui.DangerStyle().Render(v.show(c.Old))+ui.DimStyle().Render(" → ")+ui.SuccessStyle().Render(v.show(c.New)))
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// ViewString returns the view content as a string
+func (v *SecretValueView) ViewString() string {
+	theme := ui.Current()
+	title := "Secret Value: " + v.target.Name
+	if v.target.Kind == secretvalue.KindParameter {
+		title = "Parameter Value: " + v.target.Name
+	}
+	header := lipgloss.NewStyle().
+		Foreground(theme.TableHeaderText).
+		Background(theme.TableHeader).
+		Padding(0, 1).
+		Width(v.width).
+		Render(title)
+
+	status := lipgloss.NewStyle().Foreground(theme.TextDim).Render(v.statusText())
+	out := header + "\n" + lipgloss.NewStyle().Padding(0, 1).Render(status) + "\n"
+
+	if v.err != nil {
+		return out + ui.DangerStyle().Render(fmt.Sprintf("Error: %v", v.err)) + "\n"
+	}
+	if v.loading && len(v.versions) == 0 {
+		return out
+	}
+	return out + v.viewport.View()
+}
+
+func (v *SecretValueView) statusText() string {
+	if v.loading {
+		return v.spinner.View() + " Loading versions..."
+	}
+	ver, ok := v.current()
+	if !ok {
+		return ""
+	}
+	parts := []string{
+		secretvalue.Describe(v.target.Kind, ver),
+		fmt.Sprintf("version %d of %d", len(v.versions)-v.index, len(v.versions)),
+	}
+	if v.revealed {
+		parts = append(parts, ui.WarningStyle().Render("revealed"))
+	} else {
+		parts = append(parts, "masked")
+	}
+	if prev, ok := v.previous(); ok && v.diff {
+		parts = append(parts, "diff vs "+prev.Label(v.target.Kind))
+	}
+	if v.message != "" {
+		parts = append(parts, v.message)
+	}
+	return strings.Join(parts, " • ")
+}
+
+// View implements tea.Model
+func (v *SecretValueView) View() tea.View {
+	return tea.NewView(v.ViewString())
+}
+
+// SetSize implements View
+func (v *SecretValueView) SetSize(width, height int) tea.Cmd {
+	v.width = width
+	v.height = height
+	v.viewport.SetWidth(width)
+	v.viewport.SetHeight(max(height-3, 3))
+	v.refresh()
+	return nil
+}
+
+// StatusLine implements View
+func (v *SecretValueView) StatusLine() string {
+	reveal := "v:reveal"
+	if v.revealed {
+		reveal = "v:hide"
+	}
+	return reveal + " • y:copy row • Y:copy value • [/]:older/newer version • d:diff • ctrl+r:reload • esc:back"
+}
@@ -0,0 +1,187 @@
+package view
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
+	smtypes "github.com/aws/aws-sdk-go-v2/service/secretsmanager/types"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+
+	"github.com/clawscli/claws/internal/action"
+	"github.com/clawscli/claws/internal/config"
+	"github.com/clawscli/claws/internal/log"
+	"github.com/clawscli/claws/internal/secretvalue"
+)
+
+type fakeSecretValueClient struct {
+	puts []*secretsmanager.PutSecretValueInput
+	gets []string // versions whose value was read
+}
+
+func (fakeSecretValueClient) ListSecretVersionIds(_ context.Context, _ *secretsmanager.ListSecretVersionIdsInput, _ ...func(*secretsmanager.Options)) (*secretsmanager.ListSecretVersionIdsOutput, error) {
+	return &secretsmanager.ListSecretVersionIdsOutput{Versions: []smtypes.SecretVersionsListEntry{
+		{VersionId: aws.String("v-new"), VersionStages: []string{"AWSCURRENT"}, CreatedDate: aws.Time(time.Date(2026, 9, 2, 0, 0, 0, 0, time.UTC))},
+		{VersionId: aws.String("v-old"), VersionStages: []string{"AWSPREVIOUS"}, CreatedDate: aws.Time(time.Date(2026, 9, 1, 0, 0, 0, 0, time.UTC))},
+	}}, nil
+}
+
+func (f *fakeSecretValueClient) GetSecretValue(_ context.Context, in *secretsmanager.GetSecretValueInput, _ ...func(*secretsmanager.Options)) (*secretsmanager.GetSecretValueOutput, error) {
+	f.gets = append(f.gets, aws.ToString(in.VersionId))
+	if in.VersionId != nil && *in.VersionId == "v-old" {
+		return &secretsmanager.GetSecretValueOutput{SecretString: aws.String(`{"user":"app","password":"hunter1"}`)}, nil
+	}
+	return &secretsmanager.GetSecretValueOutput{SecretString: aws.String(`{"user":"app","password":"hunter2"}`)}, nil
+}
+
+func (fakeSecretValueClient) GetParameterHistory(_ context.Context, _ *ssm.GetParameterHistoryInput, _ ...func(*ssm.Options)) (*ssm.GetParameterHistoryOutput, error) {
+	return &ssm.GetParameterHistoryOutput{}, nil
+}
+
+func (fakeSecretValueClient) GetParameter(_ context.Context, _ *ssm.GetParameterInput, _ ...func(*ssm.Options)) (*ssm.GetParameterOutput, error) {
+	return &ssm.GetParameterOutput{}, nil
+}
+
+func (f *fakeSecretValueClient) PutSecretValue(_ context.Context, in *secretsmanager.PutSecretValueInput, _ ...func(*secretsmanager.Options)) (*secretsmanager.PutSecretValueOutput, error) {
+	f.puts = append(f.puts, in)
+	return &secretsmanager.PutSecretValueOutput{VersionId: aws.String("v-newer-id")}, nil
+}
+
+func (f *fakeSecretValueClient) PutParameter(_ context.Context, _ *ssm.PutParameterInput, _ ...func(*ssm.Options)) (*ssm.PutParameterOutput, error) {
+	return &ssm.PutParameterOutput{}, nil
+}
+
+type mockSecretValueResource struct {
+	mockResource
+}
+
+func (m *mockSecretValueResource) SecretValueTarget() secretvalue.Target {
+	return secretvalue.Target{Kind: secretvalue.KindSecret, ID: m.id, Name: m.id}
+}
+
+func newTestSecretValueView(t *testing.T, client *fakeSecretValueClient) *SecretValueView {
+	t.Helper()
+	v := NewSecretValueView(context.Background(), secretvalue.Target{Kind: secretvalue.KindSecret, ID: "db", Name: "db"})
+	v.client = client
+	v.SetSize(120, 30)
+	_, cmd := v.Update(v.load()())
+	runSecretValueCmd(v, cmd)
+	if v.err != nil {
+		t.Fatalf("load error = %v", v.err)
+	}
+	return v
+}
+
+// runSecretValueCmd runs cmd and feeds its messages, including batched
+// ones, to v.
+func runSecretValueCmd(v *SecretValueView, cmd tea.Cmd) {
+	if cmd == nil {
+		return
+	}
+	switch msg := cmd().(type) {
+	case tea.BatchMsg:
+		for _, c := range msg {
+			runSecretValueCmd(v, c)
+		}
+	case secretVersionLoadedMsg:
+		v.Update(msg)
+	}
+}
+
+func TestSecretValueView_MaskedByDefault(t *testing.T) {
+	client := &fakeSecretValueClient{}
+	v := newTestSecretValueView(t, client)
+
+	out := v.ViewString()
+	if strings.Contains(out, "hunter2") {
+		t.Fatalf("value shown before reveal:\n%s", out)
+	}
+	for _, want := range []string{"password", "user", secretvalue.Mask, "AWSCURRENT", "masked"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("view missing %q:\n%s", want, out)
+		}
+	}
+	if len(client.gets) != 1 || client.gets[0] != "v-new" {
+		t.Errorf("values read on open = %v, want only the shown version", client.gets)
+	}
+}
+
+func TestSecretValueView_RevealLogsEvent(t *testing.T) {
+	var buf bytes.Buffer
+	log.Enable(&buf)
+	defer log.Disable()
+
+	v := newTestSecretValueView(t, &fakeSecretValueClient{})
+	v.Update(tea.KeyPressMsg{Code: 'v', Text: "v"})
+	if !strings.Contains(v.ViewString(), "hunter2") {
+		t.Errorf("value not shown after reveal:\n%s", v.ViewString())
+	}
+	if !strings.Contains(buf.String(), "secret value revealed") || !strings.Contains(buf.String(), "v-new") {
+		t.Errorf("reveal not logged: %s", buf.String())
+	}
+
+	// Stepping to another version reads its value and masks again
+	_, cmd := v.Update(tea.KeyPressMsg{Code: '[', Text: "["})
+	runSecretValueCmd(v, cmd)
+	if ver, _ := v.current(); !ver.Loaded {
+		t.Error("older version's value not read after stepping to it")
+	}
+	if v.revealed || strings.Contains(v.ViewString(), "hunter1") {
+		t.Errorf("older version should be masked:\n%s", v.ViewString())
+	}
+}
+
+func TestSecretValueView_CopyRow(t *testing.T) {
+	v := newTestSecretValueView(t, &fakeSecretValueClient{})
+	// Rows are sorted: password, user
+	v.Update(tea.KeyPressMsg{Code: 'j', Text: "j"})
+	_, cmd := v.Update(tea.KeyPressMsg{Code: 'y', Text: "y"})
+	if cmd == nil {
+		t.Fatal("y should copy the selected row")
+	}
+	if v.message != "Copied user" {
+		t.Errorf("message = %q", v.message)
+	}
+}
+
+func TestSecretValueView_Diff(t *testing.T) {
+	v := newTestSecretValueView(t, &fakeSecretValueClient{})
+	_, cmd := v.Update(tea.KeyPressMsg{Code: 'd', Text: "d"})
+	runSecretValueCmd(v, cmd)
+
+	out := v.ViewString()
+	if !strings.Contains(out, "password") || strings.Contains(out, "user") {
+		t.Errorf("diff should list only the changed key:\n%s", out)
+	}
+	if strings.Contains(out, "hunter") {
+		t.Errorf("diff values should be masked:\n%s", out)
+	}
+}
+
+func TestOpenViewTarget_SecretValue(t *testing.T) {
+	v, err := openViewTarget(context.Background(), action.TargetSecretValue, &mockSecretValueResource{mockResource{id: "db"}})
+	if err != nil {
+		t.Fatalf("openViewTarget() error = %v", err)
+	}
+	if _, ok := v.(*SecretValueView); !ok {
+		t.Fatalf("openViewTarget() = %T, want *SecretValueView", v)
+	}
+	if _, err := openViewTarget(context.Background(), action.TargetSecretValue, &mockResource{id: "x"}); err == nil {
+		t.Error("expected error for a resource without a secret value")
+	}
+}
+
+func TestOpenViewTarget_SecretValueReadOnly(t *testing.T) {
+	config.Global().SetReadOnly(true)
+	defer config.Global().SetReadOnly(false)
+
+	if _, err := openViewTarget(context.Background(), action.TargetSecretValue, &mockSecretValueResource{mockResource{id: "db"}}); !errors.Is(err, action.ErrReadOnlyDenied) {
+		t.Errorf("openViewTarget() error = %v, want read-only denial", err)
+	}
+}
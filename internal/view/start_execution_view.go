@@ -0,0 +1,250 @@
+package view
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"charm.land/bubbles/v2/textarea"
+	"charm.land/bubbles/v2/textinput"
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+
+	"github.com/clawscli/claws/internal/action"
+	"github.com/clawscli/claws/internal/config"
+	"github.com/clawscli/claws/internal/states"
+	"github.com/clawscli/claws/internal/ui"
+)
+
+// StartExecutionView starts an execution of a state machine with a JSON
+// input and an optional name, then opens the new execution's history.
+// Blocked in read-only mode.
+type StartExecutionView struct {
+	ctx     context.Context
+	client  states.Client
+	machine states.Machine
+
+	editing   bool
+	nameFocus bool
+	confirm   bool
+	starting  bool
+	nameInput textinput.Model
+	editor    textarea.Model
+	err       error
+
+	width  int
+	height int
+}
+
+// NewStartExecutionView creates a StartExecutionView for m, with the input
+// editor focused.
+func NewStartExecutionView(ctx context.Context, m states.Machine) *StartExecutionView {
+	name := textinput.New()
+	name.Prompt = ""
+	name.Placeholder = "generated when empty"
+	name.CharLimit = 80
+
+	editor := textarea.New()
+	editor.ShowLineNumbers = false
+	editor.CharLimit = 0
+	editor.SetValue("{}")
+
+	v := &StartExecutionView{
+		ctx:       ctx,
+		machine:   m,
+		editing:   true,
+		nameInput: name,
+		editor:    editor,
+	}
+	v.editor.Focus()
+	return v
+}
+
+type executionStartedMsg struct {
+	target states.Target
+	err    error
+}
+
+// Init implements tea.Model
+func (v *StartExecutionView) Init() tea.Cmd {
+	return textarea.Blink
+}
+
+// Update implements tea.Model
+func (v *StartExecutionView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case executionStartedMsg:
+		v.starting = false
+		if msg.err != nil {
+			v.err = msg.err
+			return v, nil
+		}
+		ev := NewExecutionView(v.ctx, msg.target)
+		ev.client = v.client
+		return v, func() tea.Msg { return NavigateMsg{View: ev} }
+
+	case tea.KeyPressMsg:
+		if v.confirm {
+			switch msg.String() {
+			case "y", "Y":
+				v.confirm = false
+				return v, v.start()
+			case "n", "N", "esc", "q":
+				v.confirm = false
+			}
+			return v, nil
+		}
+		if !v.editing {
+			switch msg.String() {
+			case "e", "enter":
+				v.editing = true
+				return v, v.focus()
+			case "s":
+				v.askStart()
+			}
+			return v, nil
+		}
+		return v.handleEditKey(msg)
+	}
+	return v, nil
+}
+
+func (v *StartExecutionView) handleEditKey(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "esc":
+		v.editing = false
+		v.nameInput.Blur()
+		v.editor.Blur()
+		return v, nil
+	case "tab", "shift+tab":
+		v.nameFocus = !v.nameFocus
+		return v, v.focus()
+	case "ctrl+s":
+		v.askStart()
+		return v, nil
+	}
+	var cmd tea.Cmd
+	if v.nameFocus {
+		v.nameInput, cmd = v.nameInput.Update(msg)
+	} else {
+		v.editor, cmd = v.editor.Update(msg)
+	}
+	return v, cmd
+}
+
+func (v *StartExecutionView) focus() tea.Cmd {
+	if v.nameFocus {
+		v.editor.Blur()
+		return v.nameInput.Focus()
+	}
+	v.nameInput.Blur()
+	return v.editor.Focus()
+}
+
+// askStart checks the input and asks for confirmation.
+func (v *StartExecutionView) askStart() {
+	if config.Global().ReadOnly() {
+		v.err = action.ErrReadOnlyDenied
+		return
+	}
+	if input := strings.TrimSpace(v.editor.Value()); input != "" {
+		if err := validateJSON(input); err != nil {
+			v.err = err
+			return
+		}
+	}
+	v.err = nil
+	v.confirm = true
+}
+
+func (v *StartExecutionView) start() tea.Cmd {
+	if config.Global().ReadOnly() {
+		v.err = action.ErrReadOnlyDenied
+		return nil
+	}
+	if v.client == nil {
+		c, err := states.NewClient(v.ctx)
+		if err != nil {
+			v.err = err
+			return nil
+		}
+		v.client = c
+	}
+	v.starting = true
+	ctx, client, m := v.ctx, v.client, v.machine
+	name, input := v.nameInput.Value(), v.editor.Value()
+	return func() tea.Msg {
+		target, err := states.Start(ctx, client, m, name, input)
+		return executionStartedMsg{target: target, err: err}
+	}
+}
+
+// validateJSON returns the parse error of s.
+func validateJSON(s string) error {
+	var v any
+	if err := json.Unmarshal([]byte(s), &v); err != nil {
+		return fmt.Errorf("input is not valid JSON: %w", err)
+	}
+	return nil
+}
+
+// ViewString returns the view content as a string
+func (v *StartExecutionView) ViewString() string {
+	theme := ui.Current()
+	header := lipgloss.NewStyle().
+		Foreground(theme.TableHeaderText).
+		Background(theme.TableHeader).
+		Padding(0, 1).
+		Width(v.width).
+		Render("Start Execution: " + v.machine.Name)
+
+	label := lipgloss.NewStyle().Foreground(theme.TextDim).Width(8)
+	if v.editing && v.nameFocus {
+		label = label.Foreground(theme.Accent).Bold(true)
+	}
+	out := header + "\n" + lipgloss.NewStyle().Padding(0, 1).Render(label.Render("Name")+v.nameInput.View()) + "\n"
+
+	switch {
+	case v.confirm:
+		out += ui.WarningStyle().Render(fmt.Sprintf("Start an execution of %s?", v.machine.Name)) + " " + ui.DimStyle().Render("[y/n]") + "\n"
+	case v.starting:
+		out += ui.DimStyle().Render("Starting...") + "\n"
+	case v.err != nil:
+		out += ui.DangerStyle().Render(fmt.Sprintf("Error: %v", v.err)) + "\n"
+	default:
+		out += ui.DimStyle().Render("Execution input (JSON)") + "\n"
+	}
+	return out + v.editor.View()
+}
+
+// View implements tea.Model
+func (v *StartExecutionView) View() tea.View {
+	return tea.NewView(v.ViewString())
+}
+
+// SetSize implements View
+func (v *StartExecutionView) SetSize(width, height int) tea.Cmd {
+	v.width = width
+	v.height = height
+	v.nameInput.SetWidth(max(width-12, 20))
+	v.editor.SetWidth(max(width-2, 20))
+	v.editor.SetHeight(max(height-4, 3))
+	return nil
+}
+
+// StatusLine implements View
+func (v *StartExecutionView) StatusLine() string {
+	switch {
+	case v.confirm:
+		return "y:confirm • n:cancel"
+	case v.editing:
+		return "ctrl+s:start • tab:name/input • esc:done editing"
+	}
+	return "e:edit • s:start • esc:back"
+}
+
+// HasActiveInput implements InputCapture
+func (v *StartExecutionView) HasActiveInput() bool {
+	return v.editing || v.confirm
+}
@@ -18,7 +18,6 @@ import (
 
 	"github.com/clawscli/claws/internal/aws"
 	"github.com/clawscli/claws/internal/config"
-	"github.com/clawscli/claws/internal/dao"
 	"github.com/clawscli/claws/internal/log"
 	"github.com/clawscli/claws/internal/registry"
 	"github.com/clawscli/claws/internal/ui"
@@ -393,67 +392,20 @@ func (v *TagSearchView) navigateToResource() (tea.Model, tea.Cmd) {
 		return v, nil
 	}
 
-	service, resourceType := res.ARN.ServiceResourceType()
-	if service == "" || resourceType == "" {
-		return v, nil
-	}
-
-	if _, ok := v.registry.Get(service, resourceType); !ok {
-		return v, nil
-	}
-
 	ctx := v.ctx
 	if res.Region != "" {
 		ctx = aws.WithRegionOverride(ctx, res.Region)
 	}
 
-	if filterKey, filterValue := res.ARN.ExtractParentFilter(); filterKey != "" {
-		ctx = dao.WithFilter(ctx, filterKey, filterValue)
-	}
-
-	renderer, err := v.registry.GetRenderer(service, resourceType)
+	detailView, err := NewARNDetailView(ctx, v.registry, res.ARN, res.Tags)
 	if err != nil {
 		return v, nil
 	}
-	daoInst, err := v.registry.GetDAO(ctx, service, resourceType)
-	if err != nil {
-		daoInst = nil
-	}
-
-	resourceID := v.getResourceIDForGet(res.ARN)
-	minimalResource := &dao.BaseResource{
-		ID:   resourceID,
-		Name: res.ARN.ShortID(),
-		ARN:  res.RawARN,
-		Tags: res.Tags,
-	}
-
-	detailView := NewDetailView(ctx, minimalResource, renderer, service, resourceType, v.registry, daoInst)
 	return v, func() tea.Msg {
 		return NavigateMsg{View: detailView}
 	}
 }
 
-func (v *TagSearchView) getResourceIDForGet(arn *aws.ARN) string {
-	switch arn.Service {
-	case "states":
-		return arn.Raw
-	case "bedrock-agentcore":
-		// ARN: arn:aws:bedrock-agentcore:region:account:runtime/RUNTIME_ID/runtime-endpoint/DEFAULT
-		// Extract just the runtime ID (first segment) for GetAgentRuntime API
-		// idx > 0 (not >= 0): if "/" is at position 0, the prefix would be empty string which is invalid
-		if idx := strings.Index(arn.ResourceID, "/"); idx > 0 {
-			return arn.ResourceID[:idx]
-		}
-		return arn.ResourceID
-	default:
-		if arn.ResourceID != "" {
-			return arn.ResourceID
-		}
-		return arn.Raw
-	}
-}
-
 func (v *TagSearchView) applyFilter() {
 	if v.filterText == "" {
 		v.filtered = v.resources
@@ -0,0 +1,247 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"charm.land/bubbles/v2/spinner"
+	"charm.land/bubbles/v2/viewport"
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+	"github.com/aws/aws-sdk-go-v2/service/ecs/types"
+
+	"github.com/clawscli/claws/internal/taskdef"
+	"github.com/clawscli/claws/internal/ui"
+)
+
+// TaskDefDiffView shows what changed between two revisions of an ECS task
+// definition family: by default the selected revision against the one
+// before it, stepping the base revision back and forth with [ and ].
+type TaskDefDiffView struct {
+	ctx    context.Context
+	client taskdef.Client
+	target taskdef.Target
+	base   taskdef.Target // zero Revision: the previous existing revision
+
+	changes []taskdef.Change
+	loaded  bool
+	loading bool
+	err     error
+
+	viewport viewport.Model
+	width    int
+	height   int
+	spinner  spinner.Model
+}
+
+// NewTaskDefDiffView creates a TaskDefDiffView comparing t with its
+// previous revision.
+func NewTaskDefDiffView(ctx context.Context, t taskdef.Target) *TaskDefDiffView {
+	return &TaskDefDiffView{
+		ctx:      ctx,
+		target:   t,
+		base:     taskdef.Target{Family: t.Family},
+		loading:  true,
+		viewport: viewport.New(),
+		spinner:  ui.NewSpinner(),
+	}
+}
+
+type taskDefDiffMsg struct {
+	base    taskdef.Target
+	changes []taskdef.Change
+	err     error
+}
+
+// Init implements tea.Model
+func (v *TaskDefDiffView) Init() tea.Cmd {
+	return tea.Batch(v.spinner.Tick, v.load())
+}
+
+func (v *TaskDefDiffView) load() tea.Cmd {
+	if v.client == nil {
+		c, err := taskdef.NewClient(v.ctx)
+		if err != nil {
+			return func() tea.Msg { return taskDefDiffMsg{err: err} }
+		}
+		v.client = c
+	}
+	ctx, client, target, base := v.ctx, v.client, v.target, v.base
+	return func() tea.Msg {
+		current, err := taskdef.Load(ctx, client, target)
+		if err != nil {
+			return taskDefDiffMsg{base: base, err: err}
+		}
+		var previous *types.TaskDefinition
+		if base.Revision == 0 {
+			previous, err = taskdef.LoadPrevious(ctx, client, target)
+		} else {
+			previous, err = taskdef.Load(ctx, client, base)
+		}
+		if err != nil {
+			return taskDefDiffMsg{base: base, err: err}
+		}
+		base.Revision = previous.Revision
+		changes, err := taskdef.Diff(previous, current)
+		return taskDefDiffMsg{base: base, changes: changes, err: err}
+	}
+}
+
+// Update implements tea.Model
+func (v *TaskDefDiffView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case taskDefDiffMsg:
+		v.loading = false
+		v.base = msg.base
+		v.err = msg.err
+		v.loaded = msg.err == nil
+		if msg.err == nil {
+			v.changes = msg.changes
+			v.viewport.SetContent(v.content())
+			v.viewport.GotoTop()
+		}
+		return v, nil
+
+	case spinner.TickMsg:
+		if v.loading {
+			var cmd tea.Cmd
+			v.spinner, cmd = v.spinner.Update(msg)
+			return v, cmd
+		}
+		return v, nil
+
+	case tea.KeyPressMsg:
+		return v.handleKey(msg)
+	}
+	return v, nil
+}
+
+func (v *TaskDefDiffView) handleKey(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "[":
+		return v.stepBase(-1)
+	case "]":
+		return v.stepBase(1)
+	case "ctrl+r":
+		return v.reload()
+	case "g":
+		v.viewport.GotoTop()
+		return v, nil
+	case "G":
+		v.viewport.GotoBottom()
+		return v, nil
+	case "j":
+		v.viewport.ScrollDown(1)
+		return v, nil
+	case "k":
+		v.viewport.ScrollUp(1)
+		return v, nil
+	}
+	var cmd tea.Cmd
+	v.viewport, cmd = v.viewport.Update(msg)
+	return v, cmd
+}
+
+// stepBase compares with an older (delta -1) or newer (delta 1) base
+// revision, always older than the target.
+func (v *TaskDefDiffView) stepBase(delta int32) (tea.Model, tea.Cmd) {
+	if v.loading || v.base.Revision == 0 {
+		return v, nil
+	}
+	rev := v.base.Revision + delta
+	if rev < 1 || rev >= v.target.Revision {
+		return v, nil
+	}
+	v.base.Revision = rev
+	return v.reload()
+}
+
+func (v *TaskDefDiffView) reload() (tea.Model, tea.Cmd) {
+	if v.loading {
+		return v, nil
+	}
+	v.loading = true
+	return v, tea.Batch(v.spinner.Tick, v.load())
+}
+
+// content renders one line per change: ~ changed, + added, - removed.
+func (v *TaskDefDiffView) content() string {
+	if len(v.changes) == 0 {
+		return ui.DimStyle().Render("No differences")
+	}
+
+	path := lipgloss.NewStyle().Foreground(ui.Current().Accent)
+	var lines []string
+	for _, c := range v.changes {
+		switch c.Kind {
+		case taskdef.Added:
+			lines = append(lines, ui.SuccessStyle().Render("+ ")+path.Render(c.Path)+"  "+ui.SuccessStyle().Render(c.New))
+		case taskdef.Removed:
+			lines = append(lines, ui.DangerStyle().Render("- ")+path.Render(c.Path)+"  "+ui.DangerStyle().Render(c.Old))
+		default:
+			lines = append(lines, ui.WarningStyle().Render("~ ")+path.Render(c.Path))
+			lines = append(lines, "    "+ui.DangerStyle().Render(c.Old)+ui.DimStyle().Render(" → ")+ui.SuccessStyle().Render(c.New))
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// ViewString returns the view content as a string
+func (v *TaskDefDiffView) ViewString() string {
+	theme := ui.Current()
+	title := fmt.Sprintf("Task Definition Diff: %s", v.target)
+	if v.base.Revision > 0 {
+		title = fmt.Sprintf("Task Definition Diff: %s  revision %d → %d", v.target.Family, v.base.Revision, v.target.Revision)
+	}
+	header := lipgloss.NewStyle().
+		Foreground(theme.TableHeaderText).
+		Background(theme.TableHeader).
+		Padding(0, 1).
+		Width(v.width).
+		Render(title)
+
+	status := lipgloss.NewStyle().Foreground(theme.TextDim).Render(v.statusText())
+	out := header + "\n" + lipgloss.NewStyle().Padding(0, 1).Render(status) + "\n"
+
+	if v.err != nil {
+		out += ui.DangerStyle().Render(fmt.Sprintf("Error: %v", v.err)) + "\n"
+	}
+	if !v.loaded {
+		return out
+	}
+	return out + v.viewport.View()
+}
+
+func (v *TaskDefDiffView) statusText() string {
+	if v.loading {
+		return v.spinner.View() + " Loading revisions..."
+	}
+	if !v.loaded {
+		return ""
+	}
+	counts := map[taskdef.ChangeKind]int{}
+	for _, c := range v.changes {
+		counts[c.Kind]++
+	}
+	return fmt.Sprintf("%d changed • %d added • %d removed", counts[taskdef.Changed], counts[taskdef.Added], counts[taskdef.Removed])
+}
+
+// View implements tea.Model
+func (v *TaskDefDiffView) View() tea.View {
+	return tea.NewView(v.ViewString())
+}
+
+// SetSize implements View
+func (v *TaskDefDiffView) SetSize(width, height int) tea.Cmd {
+	v.width = width
+	v.height = height
+	v.viewport.SetWidth(width)
+	v.viewport.SetHeight(max(height-3, 3))
+	return nil
+}
+
+// StatusLine implements View
+func (v *TaskDefDiffView) StatusLine() string {
+	return "[/]:older/newer base revision • j/k:scroll • ctrl+r:reload • esc:back"
+}
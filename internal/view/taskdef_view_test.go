@@ -0,0 +1,134 @@
+package view
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ecs"
+	"github.com/aws/aws-sdk-go-v2/service/ecs/types"
+
+	"github.com/clawscli/claws/internal/action"
+	"github.com/clawscli/claws/internal/taskdef"
+)
+
+type fakeTaskDefClient struct {
+	revisions map[string]*types.TaskDefinition
+}
+
+func (f *fakeTaskDefClient) DescribeTaskDefinition(_ context.Context, in *ecs.DescribeTaskDefinitionInput, _ ...func(*ecs.Options)) (*ecs.DescribeTaskDefinitionOutput, error) {
+	td, ok := f.revisions[*in.TaskDefinition]
+	if !ok {
+		return nil, errors.New("ClientException: unable to describe task definition")
+	}
+	return &ecs.DescribeTaskDefinitionOutput{TaskDefinition: td}, nil
+}
+
+func testTaskDef(rev int32, image, memory string) *types.TaskDefinition {
+	return &types.TaskDefinition{
+		Family:   aws.String("web"),
+		Revision: rev,
+		Memory:   aws.String(memory),
+		ContainerDefinitions: []types.ContainerDefinition{{
+			Name:  aws.String("app"),
+			Image: aws.String(image),
+			Environment: []types.KeyValuePair{
+				{Name: aws.String("API_TOKEN"), Value: aws.String("token-" + image)},
+			},
+		}},
+	}
+}
+
+func newTestTaskDefDiffView() *TaskDefDiffView {
+	v := NewTaskDefDiffView(context.Background(), taskdef.Target{Family: "web", Revision: 4})
+	v.client = &fakeTaskDefClient{revisions: map[string]*types.TaskDefinition{
+		"web:1": testTaskDef(1, "web:0.9", "512"),
+		"web:2": testTaskDef(2, "web:1.0", "512"),
+		"web:4": testTaskDef(4, "web:1.1", "1024"),
+	}}
+	v.SetSize(120, 30)
+	return v
+}
+
+// runTaskDefCmd runs cmd and feeds its diff result, skipping spinner ticks.
+func runTaskDefCmd(v *TaskDefDiffView, cmd tea.Cmd) {
+	if cmd == nil {
+		return
+	}
+	msg := cmd()
+	if batch, ok := msg.(tea.BatchMsg); ok {
+		for _, c := range batch {
+			runTaskDefCmd(v, c)
+		}
+		return
+	}
+	if _, ok := msg.(taskDefDiffMsg); ok {
+		v.Update(msg)
+	}
+}
+
+func TestTaskDefDiffView(t *testing.T) {
+	v := newTestTaskDefDiffView()
+	runTaskDefCmd(v, v.Init())
+
+	if v.err != nil || v.base.Revision != 2 {
+		t.Fatalf("base = %d, err = %v; want the previous existing revision 2", v.base.Revision, v.err)
+	}
+	out := v.ViewString()
+	for _, want := range []string{"revision 2 → 4", "containerDefinitions[app].image", "web:1.0", "web:1.1", "512", "1024", "3 changed"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("diff missing %q:\n%s", want, out)
+		}
+	}
+	if strings.Contains(out, "token-") {
+		t.Errorf("sensitive environment values should be masked:\n%s", out)
+	}
+
+	_, cmd := v.Update(tea.KeyPressMsg{Code: '[', Text: "["})
+	runTaskDefCmd(v, cmd)
+	if v.base.Revision != 1 || !strings.Contains(v.ViewString(), "web:0.9") {
+		t.Errorf("[ should compare with revision 1, base = %d", v.base.Revision)
+	}
+	if _, cmd := v.Update(tea.KeyPressMsg{Code: '[', Text: "["}); cmd != nil {
+		t.Error("[ should stop at the first revision")
+	}
+
+	_, cmd = v.Update(tea.KeyPressMsg{Code: ']', Text: "]"})
+	runTaskDefCmd(v, cmd)
+	_, cmd = v.Update(tea.KeyPressMsg{Code: ']', Text: "]"})
+	runTaskDefCmd(v, cmd)
+	if v.base.Revision != 3 || v.err == nil {
+		t.Errorf("base = %d, err = %v; want an error for deleted revision 3", v.base.Revision, v.err)
+	}
+	if _, cmd := v.Update(tea.KeyPressMsg{Code: ']', Text: "]"}); cmd != nil {
+		t.Error("] should stop before the compared revision")
+	}
+}
+
+type mockTaskDefResource struct {
+	mockResource
+	target taskdef.Target
+}
+
+func (m *mockTaskDefResource) TaskDefTarget() taskdef.Target {
+	return m.target
+}
+
+func TestOpenViewTarget_TaskDefDiff(t *testing.T) {
+	r := &mockTaskDefResource{mockResource{id: "web:4"}, taskdef.Target{Family: "web", Revision: 4}}
+	v, err := openViewTarget(context.Background(), action.TargetTaskDefDiff, r)
+	if err != nil {
+		t.Fatalf("openViewTarget() error = %v", err)
+	}
+	if _, ok := v.(*TaskDefDiffView); !ok {
+		t.Fatalf("openViewTarget() = %T, want *TaskDefDiffView", v)
+	}
+
+	r.target.Revision = 1
+	if _, err := openViewTarget(context.Background(), action.TargetTaskDefDiff, r); err == nil {
+		t.Error("expected error for the first revision")
+	}
+}
@@ -0,0 +1,287 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"charm.land/bubbles/v2/spinner"
+	"charm.land/bubbles/v2/viewport"
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+
+	"github.com/clawscli/claws/internal/template"
+	"github.com/clawscli/claws/internal/ui"
+)
+
+type templateTab int
+
+const (
+	templateBody templateTab = iota
+	templateParams
+	templateTabCount
+)
+
+var templateTabNames = []string{"Template", "Parameters"}
+
+// TemplateView shows a CloudFormation stack's template and parameters.
+// Stacks that use transforms can switch between the original template and
+// the processed one that was deployed.
+type TemplateView struct {
+	ctx    context.Context
+	client template.Client
+	target template.Target
+
+	tmpl    *template.Template
+	stage   string
+	tab     templateTab
+	loading bool
+	err     error
+	message string
+
+	viewport viewport.Model
+	width    int
+	height   int
+	spinner  spinner.Model
+}
+
+// NewTemplateView creates a TemplateView for t, showing the original template.
+func NewTemplateView(ctx context.Context, t template.Target) *TemplateView {
+	return &TemplateView{
+		ctx:      ctx,
+		target:   t,
+		stage:    template.StageOriginal,
+		loading:  true,
+		viewport: viewport.New(),
+		spinner:  ui.NewSpinner(),
+	}
+}
+
+type templateLoadedMsg struct {
+	tmpl *template.Template
+	err  error
+}
+
+// Init implements tea.Model
+func (v *TemplateView) Init() tea.Cmd {
+	return tea.Batch(v.spinner.Tick, v.load())
+}
+
+func (v *TemplateView) load() tea.Cmd {
+	if v.client == nil {
+		c, err := template.NewClient(v.ctx)
+		if err != nil {
+			return func() tea.Msg { return templateLoadedMsg{err: err} }
+		}
+		v.client = c
+	}
+	ctx, client, target, stage := v.ctx, v.client, v.target, v.stage
+	return func() tea.Msg {
+		tmpl, err := template.Load(ctx, client, target, stage)
+		return templateLoadedMsg{tmpl: tmpl, err: err}
+	}
+}
+
+// Update implements tea.Model
+func (v *TemplateView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case templateLoadedMsg:
+		v.loading = false
+		v.err = msg.err
+		if msg.err == nil {
+			v.tmpl = msg.tmpl
+			v.setContent()
+			v.viewport.GotoTop()
+		}
+		return v, nil
+
+	case spinner.TickMsg:
+		if v.loading {
+			var cmd tea.Cmd
+			v.spinner, cmd = v.spinner.Update(msg)
+			return v, cmd
+		}
+		return v, nil
+
+	case tea.KeyPressMsg:
+		return v.handleKey(msg)
+	}
+	return v, nil
+}
+
+func (v *TemplateView) handleKey(msg tea.KeyPressMsg) (tea.Model, tea.Cmd) {
+	v.message = ""
+	switch msg.String() {
+	case "tab", "shift+tab":
+		v.tab = (v.tab + 1) % templateTabCount
+		v.setContent()
+		v.viewport.GotoTop()
+		return v, nil
+	case "1", "2":
+		v.tab = templateTab(msg.String()[0] - '1')
+		v.setContent()
+		v.viewport.GotoTop()
+		return v, nil
+	case "p":
+		if v.tmpl == nil || v.loading || !v.tmpl.HasStage(template.StageProcessed) {
+			return v, nil
+		}
+		if v.stage == template.StageProcessed {
+			v.stage = template.StageOriginal
+		} else {
+			v.stage = template.StageProcessed
+		}
+		v.loading = true
+		return v, tea.Batch(v.spinner.Tick, v.load())
+	case "ctrl+r":
+		if !v.loading {
+			v.loading = true
+			return v, tea.Batch(v.spinner.Tick, v.load())
+		}
+		return v, nil
+	case "y":
+		if v.tmpl != nil {
+			v.message = "Copied template"
+			return v, tea.SetClipboard(v.tmpl.Body)
+		}
+		return v, nil
+	case "g":
+		v.viewport.GotoTop()
+		return v, nil
+	case "G":
+		v.viewport.GotoBottom()
+		return v, nil
+	case "j":
+		v.viewport.ScrollDown(1)
+		return v, nil
+	case "k":
+		v.viewport.ScrollUp(1)
+		return v, nil
+	}
+	var cmd tea.Cmd
+	v.viewport, cmd = v.viewport.Update(msg)
+	return v, cmd
+}
+
+func (v *TemplateView) setContent() {
+	if v.tmpl == nil {
+		return
+	}
+	if v.tab == templateParams {
+		v.viewport.SetContent(v.paramsContent())
+		return
+	}
+	v.viewport.SetContent(v.tmpl.Pretty())
+}
+
+func (v *TemplateView) paramsContent() string {
+	params := v.tmpl.Parameters
+	if len(params) == 0 {
+		return ui.DimStyle().Render("No parameters")
+	}
+
+	keyWidth := 12
+	for _, p := range params {
+		keyWidth = max(keyWidth, min(lipgloss.Width(p.Key), 40))
+	}
+	label := lipgloss.NewStyle().Foreground(ui.Current().Accent).Width(keyWidth)
+
+	var lines []string
+	for _, p := range params {
+		value := p.Value
+		switch {
+		case p.NoEcho:
+			value = ui.DimStyle().Render(value + " (NoEcho)")
+		case p.ResolvedValue != "":
+			value += " → " + p.ResolvedValue
+		}
+		lines = append(lines, label.Render(truncateValue(p.Key, keyWidth))+"  "+value)
+
+		var info []string
+		if p.Type != "" {
+			info = append(info, p.Type)
+		}
+		if p.Default != "" && p.Default != p.Value {
+			info = append(info, "default: "+p.Default)
+		}
+		if p.Description != "" {
+			info = append(info, p.Description)
+		}
+		if len(info) > 0 {
+			lines = append(lines, strings.Repeat(" ", keyWidth+2)+ui.DimStyle().Render(strings.Join(info, " • ")))
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// ViewString returns the view content as a string
+func (v *TemplateView) ViewString() string {
+	theme := ui.Current()
+	header := lipgloss.NewStyle().
+		Foreground(theme.TableHeaderText).
+		Background(theme.TableHeader).
+		Padding(0, 1).
+		Width(v.width).
+		Render("Stack Template: " + v.target.StackName)
+
+	var tabs []string
+	for i, name := range templateTabNames {
+		if templateTab(i) == v.tab {
+			tabs = append(tabs, lipgloss.NewStyle().Foreground(theme.Accent).Bold(true).Render("["+name+"]"))
+		} else {
+			tabs = append(tabs, ui.DimStyle().Render(" "+name+" "))
+		}
+	}
+	status := lipgloss.NewStyle().Foreground(theme.TextDim).Render(v.statusText())
+	out := header + "\n" + lipgloss.NewStyle().Padding(0, 1).Render(strings.Join(tabs, " ")+"  "+status) + "\n"
+
+	if v.err != nil {
+		out += ui.DangerStyle().Render(fmt.Sprintf("Error: %v", v.err)) + "\n"
+	}
+	if v.tmpl == nil {
+		return out
+	}
+	return out + v.viewport.View()
+}
+
+func (v *TemplateView) statusText() string {
+	var parts []string
+	if v.loading {
+		parts = append(parts, v.spinner.View()+" Loading template...")
+	}
+	if v.tmpl != nil {
+		format := "YAML"
+		if v.tmpl.IsJSON() {
+			format = "JSON"
+		}
+		parts = append(parts, fmt.Sprintf("%s • %s • %d parameters", v.tmpl.Stage, format, len(v.tmpl.Parameters)))
+	}
+	if v.message != "" {
+		parts = append(parts, v.message)
+	}
+	return strings.Join(parts, " • ")
+}
+
+// View implements tea.Model
+func (v *TemplateView) View() tea.View {
+	return tea.NewView(v.ViewString())
+}
+
+// SetSize implements View
+func (v *TemplateView) SetSize(width, height int) tea.Cmd {
+	v.width = width
+	v.height = height
+	v.viewport.SetWidth(width)
+	v.viewport.SetHeight(max(height-3, 3))
+	return nil
+}
+
+// StatusLine implements View
+func (v *TemplateView) StatusLine() string {
+	parts := []string{"tab:template/parameters"}
+	if v.tmpl != nil && v.tmpl.HasStage(template.StageProcessed) {
+		parts = append(parts, "p:original/processed")
+	}
+	parts = append(parts, "y:copy template", "j/k:scroll", "ctrl+r:reload")
+	return strings.Join(parts, " • ") + " • esc:back"
+}
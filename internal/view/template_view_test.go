@@ -0,0 +1,94 @@
+package view
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+
+	"github.com/clawscli/claws/internal/action"
+	"github.com/clawscli/claws/internal/template"
+)
+
+type fakeTemplateClient struct{}
+
+func (fakeTemplateClient) GetTemplate(_ context.Context, in *cloudformation.GetTemplateInput, _ ...func(*cloudformation.Options)) (*cloudformation.GetTemplateOutput, error) {
+	body := "Transform: AWS::Serverless-2016-10-31\nResources:\n  Fn:\n    Type: AWS::Serverless::Function\n"
+	if in.TemplateStage == types.TemplateStageProcessed {
+		body = `{"Resources":{"Fn":{"Type":"AWS::Lambda::Function"}}}`
+	}
+	return &cloudformation.GetTemplateOutput{
+		TemplateBody:    aws.String(body),
+		StagesAvailable: []types.TemplateStage{types.TemplateStageOriginal, types.TemplateStageProcessed},
+	}, nil
+}
+
+func (fakeTemplateClient) GetTemplateSummary(_ context.Context, _ *cloudformation.GetTemplateSummaryInput, _ ...func(*cloudformation.Options)) (*cloudformation.GetTemplateSummaryOutput, error) {
+	return &cloudformation.GetTemplateSummaryOutput{Parameters: []types.ParameterDeclaration{
+		{ParameterKey: aws.String("Stage"), DefaultValue: aws.String("dev"), Description: aws.String("Deployment stage")},
+	}}, nil
+}
+
+func (fakeTemplateClient) DescribeStacks(_ context.Context, _ *cloudformation.DescribeStacksInput, _ ...func(*cloudformation.Options)) (*cloudformation.DescribeStacksOutput, error) {
+	return &cloudformation.DescribeStacksOutput{Stacks: []types.Stack{{Parameters: []types.Parameter{
+		{ParameterKey: aws.String("Stage"), ParameterValue: aws.String("prod")},
+	}}}}, nil
+}
+
+type mockTemplateResource struct {
+	mockResource
+}
+
+func (m *mockTemplateResource) TemplateTarget() template.Target {
+	return template.Target{StackName: m.id}
+}
+
+func TestTemplateView(t *testing.T) {
+	v := NewTemplateView(context.Background(), template.Target{StackName: "app"})
+	v.client = fakeTemplateClient{}
+	v.SetSize(120, 30)
+	v.Update(v.load()())
+
+	out := v.ViewString()
+	if !strings.Contains(out, "AWS::Serverless::Function") || !strings.Contains(out, "Original • YAML") {
+		t.Errorf("template tab should show the original YAML:\n%s", out)
+	}
+
+	v.Update(tea.KeyPressMsg{Code: tea.KeyTab})
+	out = v.ViewString()
+	for _, want := range []string{"Stage", "prod", "default: dev", "Deployment stage"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("parameters tab missing %q:\n%s", want, out)
+		}
+	}
+
+	_, cmd := v.Update(tea.KeyPressMsg{Code: 'p', Text: "p"})
+	if !v.loading || v.stage != template.StageProcessed {
+		t.Fatalf("p should load the processed template: stage = %s", v.stage)
+	}
+	v.Update(v.load()())
+	v.Update(tea.KeyPressMsg{Code: '1', Text: "1"})
+	if out := v.ViewString(); !strings.Contains(out, `"AWS::Lambda::Function"`) || !strings.Contains(out, "Processed • JSON") {
+		t.Errorf("processed template should be shown as JSON:\n%s", out)
+	}
+	if cmd == nil {
+		t.Error("switching stages should return a load command")
+	}
+}
+
+func TestOpenViewTarget_Template(t *testing.T) {
+	v, err := openViewTarget(context.Background(), action.TargetTemplate, &mockTemplateResource{mockResource{id: "app"}})
+	if err != nil {
+		t.Fatalf("openViewTarget() error = %v", err)
+	}
+	if _, ok := v.(*TemplateView); !ok {
+		t.Fatalf("openViewTarget() = %T, want *TemplateView", v)
+	}
+	if _, err := openViewTarget(context.Background(), action.TargetTemplate, &mockResource{id: "x"}); err == nil {
+		t.Error("expected error for a resource without a template")
+	}
+}
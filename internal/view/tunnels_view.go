@@ -0,0 +1,190 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+
+	"github.com/clawscli/claws/internal/render"
+	"github.com/clawscli/claws/internal/tunnel"
+	"github.com/clawscli/claws/internal/ui"
+)
+
+// tunnelsRefreshInterval is how often the tunnels view redraws states and
+// ages.
+const tunnelsRefreshInterval = time.Second
+
+// tunnelOutputLines is the number of session output lines shown for the
+// selected tunnel.
+const tunnelOutputLines = 6
+
+// TunnelsView lists the port forwards started in this session. Running
+// tunnels can be stopped and ended ones removed; the selected tunnel's
+// latest session output is shown below the list.
+type TunnelsView struct {
+	ctx     context.Context
+	manager *tunnel.Manager
+
+	cursor  int
+	message string
+	err     error
+
+	width  int
+	height int
+}
+
+// NewTunnelsView creates a TunnelsView for the tunnels of this session.
+func NewTunnelsView(ctx context.Context) *TunnelsView {
+	return &TunnelsView{ctx: ctx, manager: tunnel.Global}
+}
+
+type tunnelsRefreshMsg struct{}
+
+// Init implements tea.Model
+func (v *TunnelsView) Init() tea.Cmd {
+	return v.tick()
+}
+
+func (v *TunnelsView) tick() tea.Cmd {
+	return tea.Tick(tunnelsRefreshInterval, func(time.Time) tea.Msg { return tunnelsRefreshMsg{} })
+}
+
+// Update implements tea.Model
+func (v *TunnelsView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tunnelsRefreshMsg:
+		return v, v.tick()
+
+	case tea.KeyPressMsg:
+		tunnels := v.manager.List()
+		v.cursor = min(v.cursor, max(len(tunnels)-1, 0))
+		switch msg.String() {
+		case "j", "down":
+			if v.cursor < len(tunnels)-1 {
+				v.cursor++
+			}
+		case "k", "up":
+			if v.cursor > 0 {
+				v.cursor--
+			}
+		case "x":
+			if v.cursor < len(tunnels) {
+				t := tunnels[v.cursor]
+				v.err = v.manager.Stop(t)
+				if v.err == nil {
+					v.message = fmt.Sprintf("Stopping tunnel %d", t.ID)
+				}
+			}
+		case "d":
+			if v.cursor < len(tunnels) {
+				t := tunnels[v.cursor]
+				if t.Running() {
+					v.err = fmt.Errorf("tunnel %d is running; stop it with x first", t.ID)
+					return v, nil
+				}
+				v.manager.Remove(t)
+				v.err = nil
+				v.message = fmt.Sprintf("Removed tunnel %d", t.ID)
+				v.cursor = max(v.cursor-1, 0)
+			}
+		case "y":
+			if v.cursor < len(tunnels) {
+				addr := tunnels[v.cursor].LocalAddress()
+				v.message = "Copied " + addr
+				return v, tea.SetClipboard(addr)
+			}
+		}
+	}
+	return v, nil
+}
+
+func tunnelState(t *tunnel.Tunnel) (string, lipgloss.Style) {
+	switch {
+	case t.Running():
+		return "running", ui.SuccessStyle()
+	case t.Err() != nil:
+		return "failed", ui.DangerStyle()
+	}
+	return "stopped", ui.DimStyle()
+}
+
+// ViewString returns the view content as a string
+func (v *TunnelsView) ViewString() string {
+	theme := ui.Current()
+	tunnels := v.manager.List()
+	v.cursor = min(v.cursor, max(len(tunnels)-1, 0))
+
+	header := lipgloss.NewStyle().
+		Foreground(theme.TableHeaderText).
+		Background(theme.TableHeader).
+		Padding(0, 1).
+		Width(v.width).
+		Render("Tunnels")
+
+	running := 0
+	for _, t := range tunnels {
+		if t.Running() {
+			running++
+		}
+	}
+	parts := []string{fmt.Sprintf("%d running", running), fmt.Sprintf("%d total", len(tunnels))}
+	if v.message != "" {
+		parts = append(parts, v.message)
+	}
+	status := lipgloss.NewStyle().Foreground(theme.TextDim).Render(strings.Join(parts, " • "))
+	out := header + "\n" + lipgloss.NewStyle().Padding(0, 1).Render(status) + "\n"
+	if v.err != nil {
+		out += ui.DangerStyle().Render(fmt.Sprintf("Error: %v", v.err)) + "\n"
+	}
+	if len(tunnels) == 0 {
+		return out + ui.DimStyle().Render("No tunnels. Start one with Port Forward on an RDS instance, ElastiCache cluster or OpenSearch domain.")
+	}
+
+	columns := "%-4s %-16s %-40s %-32s %-24s %-8s %s"
+	out += ui.DimStyle().Render(fmt.Sprintf(columns, "ID", "LOCAL", "REMOTE", "VIA", "RESOURCE", "STATE", "AGE")) + "\n"
+	selected := lipgloss.NewStyle().Foreground(theme.SelectionText).Background(theme.Selection)
+	for i, t := range tunnels {
+		state, style := tunnelState(t)
+		age := render.FormatAge(t.Started)
+		cols := fmt.Sprintf("%-4d %-16s %-40s %-32s %-24s ", t.ID, t.LocalAddress(),
+			truncateValue(t.Endpoint.Address(), 40), truncateValue(t.Bastion.Label(), 32), truncateValue(t.Name, 24))
+		if i == v.cursor {
+			out += selected.Render(cols+fmt.Sprintf("%-8s %s", state, age)) + "\n"
+			continue
+		}
+		out += cols + style.Render(fmt.Sprintf("%-8s", state)) + " " + age + "\n"
+	}
+
+	t := tunnels[v.cursor]
+	out += "\n" + ui.DimStyle().Render(fmt.Sprintf("Session output of tunnel %d", t.ID)) + "\n"
+	if err := t.Err(); err != nil {
+		out += ui.DangerStyle().Render(fmt.Sprintf("Ended: %v", err)) + "\n"
+	}
+	output := t.Output()
+	if output == "" {
+		return out + ui.DimStyle().Render("No output yet")
+	}
+	lines := strings.Split(output, "\n")
+	return out + strings.Join(lines[max(len(lines)-tunnelOutputLines, 0):], "\n")
+}
+
+// View implements tea.Model
+func (v *TunnelsView) View() tea.View {
+	return tea.NewView(v.ViewString())
+}
+
+// SetSize implements View
+func (v *TunnelsView) SetSize(width, height int) tea.Cmd {
+	v.width = width
+	v.height = height
+	return nil
+}
+
+// StatusLine implements View
+func (v *TunnelsView) StatusLine() string {
+	return "j/k:select • x:stop • d:remove • y:copy local address • esc:back"
+}
@@ -0,0 +1,96 @@
+package view
+
+import (
+	"context"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+
+	"github.com/clawscli/claws/internal/action"
+	"github.com/clawscli/claws/internal/registry"
+	"github.com/clawscli/claws/internal/tunnel"
+)
+
+func startTestTunnel(t *testing.T, m *tunnel.Manager) *tunnel.Tunnel {
+	t.Helper()
+	l, err := net.Listen("tcp", "localhost:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	port := l.Addr().(*net.TCPAddr).Port
+	_ = l.Close()
+
+	tun := &tunnel.Tunnel{
+		Endpoint:  tunnel.Endpoint{Host: "db.internal", Port: 5432},
+		Name:      "orders-db",
+		Bastion:   tunnel.Bastion{InstanceID: "i-1", Name: "bastion"},
+		LocalPort: port,
+	}
+	ex := &action.ExecWithHeader{Command: "echo Waiting for connections...; sleep 30", SkipAWSEnv: true}
+	if err := m.Start(tun, ex); err != nil {
+		t.Fatalf("Start() error = %v", err)
+	}
+	t.Cleanup(m.StopAll)
+	return tun
+}
+
+func TestTunnelsView(t *testing.T) {
+	m := &tunnel.Manager{}
+	tun := startTestTunnel(t, m)
+	v := NewTunnelsView(context.Background())
+	v.manager = m
+	v.SetSize(160, 20)
+
+	out := v.ViewString()
+	for _, want := range []string{"1 running", tun.LocalAddress(), "db.internal:5432", "bastion (i-1)", "orders-db", "running"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output missing %q:\n%s", want, out)
+		}
+	}
+
+	_, cmd := v.Update(tea.KeyPressMsg{Code: 'y', Text: "y"})
+	if cmd == nil || !strings.Contains(v.message, tun.LocalAddress()) {
+		t.Errorf("y should copy the local address, message = %q", v.message)
+	}
+
+	v.Update(tea.KeyPressMsg{Code: 'd', Text: "d"})
+	if v.err == nil || len(m.List()) != 1 {
+		t.Error("d should not remove a running tunnel")
+	}
+
+	v.Update(tea.KeyPressMsg{Code: 'x', Text: "x"})
+	deadline := time.Now().Add(5 * time.Second)
+	for tun.Running() && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+	if tun.Running() {
+		t.Fatal("x should stop the tunnel")
+	}
+	if out := v.ViewString(); !strings.Contains(out, "0 running") || !strings.Contains(out, "stopped") {
+		t.Errorf("output should show the tunnel ended:\n%s", out)
+	}
+
+	v.Update(tea.KeyPressMsg{Code: 'd', Text: "d"})
+	if len(m.List()) != 0 {
+		t.Error("d should remove an ended tunnel")
+	}
+	if out := v.ViewString(); !strings.Contains(out, "No tunnels") {
+		t.Errorf("output should show the empty state:\n%s", out)
+	}
+}
+
+func TestCommandInput_TunnelsCommand(t *testing.T) {
+	ci := NewCommandInput(context.Background(), registry.New())
+	ci.Activate()
+	ci.textInput.SetValue("tunnels")
+	_, nav := ci.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+	if nav == nil {
+		t.Fatal("Expected NavigateMsg for :tunnels")
+	}
+	if _, ok := nav.View.(*TunnelsView); !ok {
+		t.Errorf("View = %T, want *TunnelsView", nav.View)
+	}
+}
@@ -0,0 +1,387 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"charm.land/bubbles/v2/spinner"
+	"charm.land/bubbles/v2/table"
+	"charm.land/bubbles/v2/textinput"
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+
+	"github.com/clawscli/claws/internal/aws"
+	"github.com/clawscli/claws/internal/config"
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/registry"
+	"github.com/clawscli/claws/internal/ui"
+	"github.com/clawscli/claws/internal/usage"
+)
+
+const usageSearchTimeout = 60 * time.Second
+
+// globalUsageServices lists services whose resources are global, so their
+// references must be searched in every selected region.
+var globalUsageServices = map[string]bool{
+	"iam": true,
+}
+
+// UsageView lists resources that reference a target resource ("used by").
+type UsageView struct {
+	ctx      context.Context
+	registry *registry.Registry
+	service  string
+	resType  string
+	target   dao.Resource
+
+	table      table.Model
+	references []usage.Reference
+	filtered   []usage.Reference
+	errors     []string
+	loading    bool
+	width      int
+	height     int
+	spinner    spinner.Model
+
+	filterActive bool
+	filterText   string
+	filterInput  textinput.Model
+}
+
+// NewUsageView creates a new UsageView for the given target resource.
+// ctx should carry the target's region/profile override, if any.
+func NewUsageView(ctx context.Context, reg *registry.Registry, service, resType string, target dao.Resource) *UsageView {
+	ti := textinput.New()
+	ti.Placeholder = FilterPlaceholder
+	ti.Prompt = "/"
+	ti.CharLimit = 100
+
+	return &UsageView{
+		ctx:         ctx,
+		registry:    reg,
+		service:     service,
+		resType:     resType,
+		target:      dao.UnwrapResource(target),
+		loading:     true,
+		filterInput: ti,
+		spinner:     ui.NewSpinner(),
+	}
+}
+
+type usageLoadedMsg struct {
+	result usage.Result
+}
+
+func (v *UsageView) Init() tea.Cmd {
+	return tea.Batch(v.findReferences, v.spinner.Tick)
+}
+
+func (v *UsageView) findReferences() tea.Msg {
+	ctx, cancel := context.WithTimeout(v.ctx, usageSearchTimeout)
+	defer cancel()
+
+	finders := usage.Global.Get(v.service, v.resType)
+
+	regions := []string{""}
+	if globalUsageServices[v.service] && config.Global().IsMultiRegion() {
+		regions = config.Global().Regions()
+	}
+
+	var combined usage.Result
+	for _, region := range regions {
+		regionCtx := ctx
+		if region != "" {
+			regionCtx = aws.WithRegionOverride(ctx, region)
+		}
+		result := usage.Find(regionCtx, v.registry, v.target, finders)
+		combined.References = append(combined.References, result.References...)
+		for _, e := range result.Errors {
+			if region != "" {
+				e = region + ": " + e
+			}
+			combined.Errors = append(combined.Errors, e)
+		}
+	}
+	return usageLoadedMsg{result: combined}
+}
+
+func (v *UsageView) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case usageLoadedMsg:
+		v.loading = false
+		v.references = msg.result.References
+		v.errors = msg.result.Errors
+		v.applyFilter()
+		v.buildTable()
+		return v, nil
+
+	case spinner.TickMsg:
+		if v.loading {
+			var cmd tea.Cmd
+			v.spinner, cmd = v.spinner.Update(msg)
+			return v, cmd
+		}
+		return v, nil
+
+	case tea.MouseWheelMsg:
+		var cmd tea.Cmd
+		v.table, cmd = v.table.Update(msg)
+		return v, cmd
+
+	case tea.KeyPressMsg:
+		if v.filterActive {
+			switch msg.String() {
+			case "esc":
+				v.filterActive = false
+				v.filterInput.Blur()
+				return v, nil
+			case "enter":
+				v.filterActive = false
+				v.filterInput.Blur()
+				v.filterText = v.filterInput.Value()
+				v.applyFilter()
+				v.buildTable()
+				return v, nil
+			default:
+				var cmd tea.Cmd
+				v.filterInput, cmd = v.filterInput.Update(msg)
+				v.filterText = v.filterInput.Value()
+				v.applyFilter()
+				v.buildTable()
+				return v, cmd
+			}
+		}
+
+		switch msg.String() {
+		case "/":
+			v.filterActive = true
+			v.filterInput.Focus()
+			return v, textinput.Blink
+
+		case "c":
+			v.filterText = ""
+			v.filterInput.SetValue("")
+			v.applyFilter()
+			v.buildTable()
+			return v, nil
+
+		case "ctrl+r":
+			v.loading = true
+			v.references = nil
+			v.errors = nil
+			return v, tea.Batch(v.findReferences, v.spinner.Tick)
+
+		case "enter", "d":
+			return v.navigateToReference()
+
+		case "j", "down":
+			v.table.MoveDown(1)
+			return v, nil
+
+		case "k", "up":
+			v.table.MoveUp(1)
+			return v, nil
+		}
+	}
+
+	var cmd tea.Cmd
+	v.table, cmd = v.table.Update(msg)
+	return v, cmd
+}
+
+func (v *UsageView) navigateToReference() (tea.Model, tea.Cmd) {
+	if len(v.filtered) == 0 || v.table.Cursor() >= len(v.filtered) {
+		return v, nil
+	}
+
+	ref := v.filtered[v.table.Cursor()]
+	ctx := v.ctx
+	if region := dao.GetResourceRegion(ref.Resource); region != "" {
+		ctx = aws.WithRegionOverride(ctx, region)
+	}
+
+	renderer, err := v.registry.GetRenderer(ref.Service, ref.ResourceType)
+	if err != nil {
+		return v, nil
+	}
+	daoInst, err := v.registry.GetDAO(ctx, ref.Service, ref.ResourceType)
+	if err != nil {
+		daoInst = nil
+	}
+
+	detailView := NewDetailView(ctx, dao.UnwrapResource(ref.Resource), renderer, ref.Service, ref.ResourceType, v.registry, daoInst)
+	return v, func() tea.Msg {
+		return NavigateMsg{View: detailView}
+	}
+}
+
+func (v *UsageView) applyFilter() {
+	if v.filterText == "" {
+		v.filtered = v.references
+		return
+	}
+
+	filter := strings.ToLower(v.filterText)
+	v.filtered = nil
+	for _, ref := range v.references {
+		if fuzzyMatch(ref.Service, filter) ||
+			fuzzyMatch(ref.ResourceType, filter) ||
+			fuzzyMatch(ref.Relation, filter) ||
+			fuzzyMatch(ref.Resource.GetID(), filter) ||
+			fuzzyMatch(ref.Resource.GetName(), filter) {
+			v.filtered = append(v.filtered, ref)
+		}
+	}
+}
+
+func (v *UsageView) buildTable() {
+	isMultiRegion := config.Global().IsMultiRegion()
+
+	columns := []table.Column{
+		{Title: "Service", Width: 14},
+		{Title: "Type", Width: 20},
+		{Title: "Relation", Width: 24},
+		{Title: "ID", Width: 36},
+		{Title: "Name", Width: 30},
+	}
+	if isMultiRegion {
+		columns = append(columns, table.Column{Title: "Region", Width: 14})
+	}
+
+	rows := make([]table.Row, len(v.filtered))
+	for i, ref := range v.filtered {
+		row := table.Row{
+			ref.Service,
+			ref.ResourceType,
+			ref.Relation,
+			ref.Resource.GetID(),
+			ref.Resource.GetName(),
+		}
+		if isMultiRegion {
+			row = append(row, dao.GetResourceRegion(ref.Resource))
+		}
+		rows[i] = row
+	}
+
+	tableHeight := v.height - 4
+	if tableHeight < 10 {
+		tableHeight = 20
+	}
+	tableWidth := v.width
+	if tableWidth < 80 {
+		tableWidth = 120
+	}
+
+	tbl := table.New(
+		table.WithColumns(columns),
+		table.WithRows(rows),
+		table.WithFocused(true),
+		table.WithHeight(tableHeight),
+		table.WithWidth(tableWidth),
+	)
+
+	s := table.DefaultStyles()
+	theme := ui.Current()
+	s.Header = s.Header.
+		BorderStyle(lipgloss.NormalBorder()).
+		BorderForeground(theme.TableBorder).
+		BorderBottom(true).
+		Bold(false)
+	s.Selected = s.Selected.
+		Foreground(theme.SelectionText).
+		Background(theme.Selection).
+		Bold(false)
+
+	tbl.SetStyles(s)
+	v.table = tbl
+}
+
+func (v *UsageView) targetLabel() string {
+	label := v.target.GetID()
+	if name := v.target.GetName(); name != "" && name != label {
+		label = fmt.Sprintf("%s (%s)", name, label)
+	}
+	return label
+}
+
+func (v *UsageView) ViewString() string {
+	theme := ui.Current()
+
+	header := lipgloss.NewStyle().
+		Foreground(theme.TableHeaderText).
+		Background(theme.TableHeader).
+		Padding(0, 1).
+		Width(v.width).
+		Render(fmt.Sprintf("Used by: %s/%s %s", v.service, v.resType, v.targetLabel()))
+
+	if v.loading {
+		return header + "\n" + v.spinner.View() + " Searching references..."
+	}
+
+	statusLine := fmt.Sprintf("Found %d references", len(v.references))
+	if v.filterText != "" {
+		statusLine = fmt.Sprintf("Found %d/%d references", len(v.filtered), len(v.references))
+	}
+	if len(v.errors) > 0 {
+		statusLine += fmt.Sprintf(" [%d lookups failed]", len(v.errors))
+	}
+	status := lipgloss.NewStyle().
+		Foreground(theme.TextDim).
+		Padding(0, 1).
+		Render(statusLine)
+
+	filterView := ""
+	if v.filterActive {
+		filterView = lipgloss.NewStyle().
+			Padding(0, 1).
+			Render(v.filterInput.View()) + "\n"
+	} else if v.filterText != "" {
+		filterView = lipgloss.NewStyle().
+			Foreground(theme.Accent).
+			Italic(true).
+			Render(fmt.Sprintf("filter: %s", v.filterText)) + "\n"
+	}
+
+	if len(v.references) == 0 {
+		msg := ui.DimStyle().Render("No referencing resources found")
+		if len(v.errors) > 0 {
+			msg += "\n" + ui.DangerStyle().Render(strings.Join(v.errors, "\n"))
+		}
+		return header + "\n" + status + "\n" + msg
+	}
+
+	if len(v.filtered) == 0 {
+		return header + "\n" + status + "\n" + filterView +
+			ui.DimStyle().Render("No matching references (press 'c' to clear filter)")
+	}
+
+	return header + "\n" + status + "\n" + filterView + v.table.View()
+}
+
+func (v *UsageView) View() tea.View {
+	return tea.NewView(v.ViewString())
+}
+
+func (v *UsageView) SetSize(width, height int) tea.Cmd {
+	v.width = width
+	v.height = height
+	v.filterInput.SetWidth(width - 4)
+	if len(v.references) > 0 {
+		v.buildTable()
+	}
+	return nil
+}
+
+func (v *UsageView) StatusLine() string {
+	base := fmt.Sprintf("Used by %s • %d references", v.targetLabel(), len(v.filtered))
+	if v.filterText != "" {
+		base = fmt.Sprintf("Used by %s • %d/%d (/%s)", v.targetLabel(), len(v.filtered), len(v.references), v.filterText)
+	}
+	return base + " • enter:open /:filter ctrl+r:refresh"
+}
+
+func (v *UsageView) HasActiveInput() bool {
+	return v.filterActive
+}
@@ -0,0 +1,75 @@
+package view
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	tea "charm.land/bubbletea/v2"
+
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/registry"
+	"github.com/clawscli/claws/internal/usage"
+)
+
+func newTestUsageView() *UsageView {
+	target := &dao.BaseResource{ID: "sg-123", Name: "web"}
+	v := NewUsageView(context.Background(), registry.New(), "ec2", "security-groups", target)
+	v.SetSize(120, 40)
+	v.Update(usageLoadedMsg{result: usage.Result{
+		References: []usage.Reference{
+			{Service: "ec2", ResourceType: "instances", Relation: "security group", Resource: &dao.BaseResource{ID: "i-abc", Name: "app"}},
+			{Service: "lambda", ResourceType: "functions", Relation: "security group", Resource: &dao.BaseResource{ID: "fn", Name: "handler"}},
+		},
+		Errors: []string{"rds/instances: access denied"},
+	}})
+	return v
+}
+
+func TestUsageView_Loaded(t *testing.T) {
+	v := newTestUsageView()
+
+	if v.loading {
+		t.Error("loading should be false after usageLoadedMsg")
+	}
+	if len(v.filtered) != 2 {
+		t.Errorf("filtered = %d, want 2", len(v.filtered))
+	}
+
+	out := v.ViewString()
+	if !strings.Contains(out, "Used by: ec2/security-groups web (sg-123)") {
+		t.Errorf("header missing target label: %q", out)
+	}
+	if !strings.Contains(out, "1 lookups failed") {
+		t.Errorf("view should report failed lookups: %q", out)
+	}
+}
+
+func TestUsageView_Filter(t *testing.T) {
+	v := newTestUsageView()
+
+	v.filterText = "lambda"
+	v.applyFilter()
+	if len(v.filtered) != 1 || v.filtered[0].Service != "lambda" {
+		t.Errorf("filter by service failed: %+v", v.filtered)
+	}
+
+	v.Update(tea.KeyPressMsg{Code: 'c', Text: "c"})
+	if len(v.filtered) != 2 {
+		t.Errorf("clear filter: filtered = %d, want 2", len(v.filtered))
+	}
+}
+
+func TestUsageView_Empty(t *testing.T) {
+	target := &dao.BaseResource{ID: "sg-empty"}
+	v := NewUsageView(context.Background(), registry.New(), "ec2", "security-groups", target)
+	v.SetSize(120, 40)
+	v.Update(usageLoadedMsg{})
+
+	if !strings.Contains(v.ViewString(), "No referencing resources found") {
+		t.Error("empty result should show no references message")
+	}
+	if model, _ := v.Update(tea.KeyPressMsg{Code: tea.KeyEnter}); model != v {
+		t.Error("enter with no references should stay on the view")
+	}
+}
@@ -39,6 +39,12 @@ type InputCapture interface {
 	HasActiveInput() bool
 }
 
+// Closer is an optional interface for views that hold resources, such as
+// streaming sessions, which must be released when the view is left.
+type Closer interface {
+	Close()
+}
+
 // NavigateMsg is sent when navigating to a new view
 type NavigateMsg struct {
 	View       View
@@ -138,6 +144,12 @@ func (h *NavigationHelper) HandleKey(key string, resource dao.Resource) tea.Cmd
 	navigations := navigator.Navigations(resource)
 	for _, nav := range navigations {
 		if nav.Key == key {
+			if nav.Logs != nil {
+				logView := NewLogView(h.Ctx, *nav.Logs)
+				return func() tea.Msg {
+					return NavigateMsg{View: logView}
+				}
+			}
 			var newBrowser *ResourceBrowser
 			if nav.AutoReload {
 				interval := nav.ReloadInterval
@@ -0,0 +1,229 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/clawscli/claws/internal/action"
+	"github.com/clawscli/claws/internal/config"
+	"github.com/clawscli/claws/internal/consoleoutput"
+	"github.com/clawscli/claws/internal/dao"
+	"github.com/clawscli/claws/internal/dynamo"
+	"github.com/clawscli/claws/internal/invoke"
+	"github.com/clawscli/claws/internal/logs"
+	"github.com/clawscli/claws/internal/policy"
+	"github.com/clawscli/claws/internal/reach"
+	"github.com/clawscli/claws/internal/runcmd"
+	"github.com/clawscli/claws/internal/scale"
+	"github.com/clawscli/claws/internal/secretvalue"
+	"github.com/clawscli/claws/internal/states"
+	"github.com/clawscli/claws/internal/taskdef"
+	"github.com/clawscli/claws/internal/template"
+	"github.com/clawscli/claws/internal/tunnel"
+)
+
+// viewTargets opens the view for an ActionTypeView action's Target.
+var viewTargets = map[string]func(ctx context.Context, resource dao.Resource) (View, error){
+	action.TargetLogs:           logViewOpener(time.Hour, false),
+	action.TargetLogs24h:        logViewOpener(24*time.Hour, false),
+	action.TargetLogsFollow:     logViewOpener(5*time.Minute, true),
+	action.TargetPreview:        openContentView,
+	action.TargetItems:          openItemsView,
+	action.TargetInvoke:         openInvokeView,
+	action.TargetExecution:      openExecutionView,
+	action.TargetStartExecution: openStartExecutionView,
+	action.TargetTemplate:       openTemplateView,
+	action.TargetPolicy:         openPolicyView,
+	action.TargetReach:          openReachView,
+	action.TargetTaskDefDiff:    openTaskDefDiffView,
+	action.TargetDesiredCount:   openDesiredCountView,
+	action.TargetSecretValue:    openSecretValueView,
+	action.TargetPutValue:       openPutValueView,
+	action.TargetRunCommand:     openRunCommandView,
+	action.TargetCommandOutput:  openCommandOutputView,
+	action.TargetConsoleOutput:  openConsoleOutputView,
+	action.TargetPortForward:    openPortForwardView,
+}
+
+func logViewOpener(since time.Duration, follow bool) func(context.Context, dao.Resource) (View, error) {
+	return func(ctx context.Context, resource dao.Resource) (View, error) {
+		provider, ok := dao.UnwrapResource(resource).(logs.Provider)
+		if !ok {
+			return nil, fmt.Errorf("%s has no logs", resource.GetID())
+		}
+		target := provider.LogTarget()
+		target.Since = since
+		target.Follow = follow
+		return NewLogView(ctx, target), nil
+	}
+}
+
+func openContentView(ctx context.Context, resource dao.Resource) (View, error) {
+	source, ok := dao.UnwrapResource(resource).(ContentSource)
+	if !ok {
+		return nil, fmt.Errorf("%s has no content to preview", resource.GetID())
+	}
+	return NewContentView(ctx, source), nil
+}
+
+func openItemsView(ctx context.Context, resource dao.Resource) (View, error) {
+	provider, ok := dao.UnwrapResource(resource).(dynamo.Provider)
+	if !ok {
+		return nil, fmt.Errorf("%s has no items", resource.GetID())
+	}
+	return NewItemsView(ctx, provider.ItemTable()), nil
+}
+
+func openInvokeView(ctx context.Context, resource dao.Resource) (View, error) {
+	provider, ok := dao.UnwrapResource(resource).(invoke.Provider)
+	if !ok {
+		return nil, fmt.Errorf("%s cannot be invoked", resource.GetID())
+	}
+	return NewInvokeView(ctx, provider.InvokeTarget()), nil
+}
+
+func openExecutionView(ctx context.Context, resource dao.Resource) (View, error) {
+	provider, ok := dao.UnwrapResource(resource).(states.Provider)
+	if !ok {
+		return nil, fmt.Errorf("%s has no execution history", resource.GetID())
+	}
+	return NewExecutionView(ctx, provider.ExecutionTarget()), nil
+}
+
+func openStartExecutionView(ctx context.Context, resource dao.Resource) (View, error) {
+	provider, ok := dao.UnwrapResource(resource).(states.MachineProvider)
+	if !ok {
+		return nil, fmt.Errorf("%s is not a state machine", resource.GetID())
+	}
+	return NewStartExecutionView(ctx, provider.MachineTarget()), nil
+}
+
+func openTemplateView(ctx context.Context, resource dao.Resource) (View, error) {
+	provider, ok := dao.UnwrapResource(resource).(template.Provider)
+	if !ok {
+		return nil, fmt.Errorf("%s has no template", resource.GetID())
+	}
+	return NewTemplateView(ctx, provider.TemplateTarget()), nil
+}
+
+func openPolicyView(ctx context.Context, resource dao.Resource) (View, error) {
+	provider, ok := dao.UnwrapResource(resource).(policy.Provider)
+	if !ok {
+		return nil, fmt.Errorf("%s has no IAM policies", resource.GetID())
+	}
+	return NewPolicyView(ctx, provider.PolicyTarget()), nil
+}
+
+func openReachView(ctx context.Context, resource dao.Resource) (View, error) {
+	provider, ok := dao.UnwrapResource(resource).(reach.Provider)
+	if !ok {
+		return nil, fmt.Errorf("%s cannot be a reachability source", resource.GetID())
+	}
+	from := provider.ReachEndpoint()
+	if from.IP == "" || from.SubnetID == "" {
+		return nil, fmt.Errorf("%s has no private IP address in a subnet", resource.GetID())
+	}
+	return NewReachView(ctx, from), nil
+}
+
+func openTaskDefDiffView(ctx context.Context, resource dao.Resource) (View, error) {
+	provider, ok := dao.UnwrapResource(resource).(taskdef.Provider)
+	if !ok {
+		return nil, fmt.Errorf("%s is not a task definition", resource.GetID())
+	}
+	t := provider.TaskDefTarget()
+	if t.Revision <= 1 {
+		return nil, fmt.Errorf("%s has no earlier revision to compare with", t)
+	}
+	return NewTaskDefDiffView(ctx, t), nil
+}
+
+// openViewTarget creates the view for target and resource.
+func openViewTarget(ctx context.Context, target string, resource dao.Resource) (View, error) {
+	open, ok := viewTargets[target]
+	if !ok {
+		return nil, fmt.Errorf("unknown view target: %s", target)
+	}
+	return open(ctx, resource)
+}
+
+func openDesiredCountView(ctx context.Context, resource dao.Resource) (View, error) {
+	provider, ok := dao.UnwrapResource(resource).(scale.Provider)
+	if !ok {
+		return nil, fmt.Errorf("%s has no desired count", resource.GetID())
+	}
+	return NewDesiredCountView(ctx, provider.ScaleTarget()), nil
+}
+
+func openSecretValueView(ctx context.Context, resource dao.Resource) (View, error) {
+	if config.Global().ReadOnly() {
+		return nil, action.ErrReadOnlyDenied
+	}
+	provider, ok := dao.UnwrapResource(resource).(secretvalue.Provider)
+	if !ok {
+		return nil, fmt.Errorf("%s has no secret value", resource.GetID())
+	}
+	return NewSecretValueView(ctx, provider.SecretValueTarget()), nil
+}
+
+func openPutValueView(ctx context.Context, resource dao.Resource) (View, error) {
+	if config.Global().ReadOnly() {
+		return nil, action.ErrReadOnlyDenied
+	}
+	provider, ok := dao.UnwrapResource(resource).(secretvalue.Provider)
+	if !ok {
+		return nil, fmt.Errorf("%s has no secret value", resource.GetID())
+	}
+	return NewPutValueView(ctx, provider.SecretValueTarget()), nil
+}
+
+// openRunCommandView targets the selected instance and, when it is another
+// instance, the marked one.
+func openRunCommandView(ctx context.Context, resource dao.Resource) (View, error) {
+	if config.Global().ReadOnly() {
+		return nil, action.ErrReadOnlyDenied
+	}
+	provider, ok := dao.UnwrapResource(resource).(runcmd.Provider)
+	if !ok {
+		return nil, fmt.Errorf("%s cannot run commands", resource.GetID())
+	}
+	instances := []runcmd.Instance{provider.RunInstance()}
+	if marked, ok := dao.UnwrapResource(dao.MarkedFromContext(ctx)).(runcmd.Provider); ok {
+		if inst := marked.RunInstance(); inst.ID != instances[0].ID {
+			instances = append(instances, inst)
+		}
+	}
+	return NewRunCommandView(ctx, instances), nil
+}
+
+func openCommandOutputView(ctx context.Context, resource dao.Resource) (View, error) {
+	provider, ok := dao.UnwrapResource(resource).(runcmd.TargetProvider)
+	if !ok {
+		return nil, fmt.Errorf("%s has no command output", resource.GetID())
+	}
+	return NewCommandOutputView(ctx, provider.CommandTarget()), nil
+}
+
+func openConsoleOutputView(ctx context.Context, resource dao.Resource) (View, error) {
+	provider, ok := dao.UnwrapResource(resource).(consoleoutput.Provider)
+	if !ok {
+		return nil, fmt.Errorf("%s has no console output", resource.GetID())
+	}
+	return NewConsoleOutputView(ctx, provider.ConsoleTarget()), nil
+}
+
+func openPortForwardView(ctx context.Context, resource dao.Resource) (View, error) {
+	if config.Global().ReadOnly() {
+		return nil, action.ErrReadOnlyDenied
+	}
+	provider, ok := dao.UnwrapResource(resource).(tunnel.Provider)
+	if !ok {
+		return nil, fmt.Errorf("%s has no endpoint to forward to", resource.GetID())
+	}
+	e := provider.TunnelEndpoint()
+	if e.Host == "" {
+		return nil, fmt.Errorf("%s has no endpoint yet", resource.GetID())
+	}
+	return NewPortForwardView(ctx, resource, e), nil
+}